@@ -3321,3 +3321,928 @@ func (this *ForceUnloadTaskQueuePartitionResponse) Equal(that interface{}) bool
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type SimulateScheduleRequest to the protobuf v3 wire format
+func (val *SimulateScheduleRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SimulateScheduleRequest from the protobuf v3 wire format
+func (val *SimulateScheduleRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SimulateScheduleRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SimulateScheduleRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SimulateScheduleRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SimulateScheduleRequest
+	switch t := that.(type) {
+	case *SimulateScheduleRequest:
+		that1 = t
+	case SimulateScheduleRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SimulateScheduleResponse to the protobuf v3 wire format
+func (val *SimulateScheduleResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SimulateScheduleResponse from the protobuf v3 wire format
+func (val *SimulateScheduleResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SimulateScheduleResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SimulateScheduleResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SimulateScheduleResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SimulateScheduleResponse
+	switch t := that.(type) {
+	case *SimulateScheduleResponse:
+		that1 = t
+	case SimulateScheduleResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpsertBusinessCalendarRequest to the protobuf v3 wire format
+func (val *UpsertBusinessCalendarRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpsertBusinessCalendarRequest from the protobuf v3 wire format
+func (val *UpsertBusinessCalendarRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpsertBusinessCalendarRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpsertBusinessCalendarRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpsertBusinessCalendarRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpsertBusinessCalendarRequest
+	switch t := that.(type) {
+	case *UpsertBusinessCalendarRequest:
+		that1 = t
+	case UpsertBusinessCalendarRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpsertBusinessCalendarResponse to the protobuf v3 wire format
+func (val *UpsertBusinessCalendarResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpsertBusinessCalendarResponse from the protobuf v3 wire format
+func (val *UpsertBusinessCalendarResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpsertBusinessCalendarResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpsertBusinessCalendarResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpsertBusinessCalendarResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpsertBusinessCalendarResponse
+	switch t := that.(type) {
+	case *UpsertBusinessCalendarResponse:
+		that1 = t
+	case UpsertBusinessCalendarResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteBusinessCalendarRequest to the protobuf v3 wire format
+func (val *DeleteBusinessCalendarRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteBusinessCalendarRequest from the protobuf v3 wire format
+func (val *DeleteBusinessCalendarRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteBusinessCalendarRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteBusinessCalendarRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteBusinessCalendarRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteBusinessCalendarRequest
+	switch t := that.(type) {
+	case *DeleteBusinessCalendarRequest:
+		that1 = t
+	case DeleteBusinessCalendarRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteBusinessCalendarResponse to the protobuf v3 wire format
+func (val *DeleteBusinessCalendarResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteBusinessCalendarResponse from the protobuf v3 wire format
+func (val *DeleteBusinessCalendarResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteBusinessCalendarResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteBusinessCalendarResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteBusinessCalendarResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteBusinessCalendarResponse
+	switch t := that.(type) {
+	case *DeleteBusinessCalendarResponse:
+		that1 = t
+	case DeleteBusinessCalendarResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListBusinessCalendarsRequest to the protobuf v3 wire format
+func (val *ListBusinessCalendarsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListBusinessCalendarsRequest from the protobuf v3 wire format
+func (val *ListBusinessCalendarsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListBusinessCalendarsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListBusinessCalendarsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListBusinessCalendarsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListBusinessCalendarsRequest
+	switch t := that.(type) {
+	case *ListBusinessCalendarsRequest:
+		that1 = t
+	case ListBusinessCalendarsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListBusinessCalendarsResponse to the protobuf v3 wire format
+func (val *ListBusinessCalendarsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListBusinessCalendarsResponse from the protobuf v3 wire format
+func (val *ListBusinessCalendarsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListBusinessCalendarsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListBusinessCalendarsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListBusinessCalendarsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListBusinessCalendarsResponse
+	switch t := that.(type) {
+	case *ListBusinessCalendarsResponse:
+		that1 = t
+	case ListBusinessCalendarsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SetScheduleBusinessCalendarsRequest to the protobuf v3 wire format
+func (val *SetScheduleBusinessCalendarsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SetScheduleBusinessCalendarsRequest from the protobuf v3 wire format
+func (val *SetScheduleBusinessCalendarsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SetScheduleBusinessCalendarsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SetScheduleBusinessCalendarsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SetScheduleBusinessCalendarsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SetScheduleBusinessCalendarsRequest
+	switch t := that.(type) {
+	case *SetScheduleBusinessCalendarsRequest:
+		that1 = t
+	case SetScheduleBusinessCalendarsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SetScheduleBusinessCalendarsResponse to the protobuf v3 wire format
+func (val *SetScheduleBusinessCalendarsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SetScheduleBusinessCalendarsResponse from the protobuf v3 wire format
+func (val *SetScheduleBusinessCalendarsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SetScheduleBusinessCalendarsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SetScheduleBusinessCalendarsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SetScheduleBusinessCalendarsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SetScheduleBusinessCalendarsResponse
+	switch t := that.(type) {
+	case *SetScheduleBusinessCalendarsResponse:
+		that1 = t
+	case SetScheduleBusinessCalendarsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListAuditEventsRequest to the protobuf v3 wire format
+func (val *ListAuditEventsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListAuditEventsRequest from the protobuf v3 wire format
+func (val *ListAuditEventsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListAuditEventsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListAuditEventsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListAuditEventsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListAuditEventsRequest
+	switch t := that.(type) {
+	case *ListAuditEventsRequest:
+		that1 = t
+	case ListAuditEventsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListAuditEventsResponse to the protobuf v3 wire format
+func (val *ListAuditEventsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListAuditEventsResponse from the protobuf v3 wire format
+func (val *ListAuditEventsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListAuditEventsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListAuditEventsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListAuditEventsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListAuditEventsResponse
+	switch t := that.(type) {
+	case *ListAuditEventsResponse:
+		that1 = t
+	case ListAuditEventsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type MigrateTaskQueueBacklogRequest to the protobuf v3 wire format
+func (val *MigrateTaskQueueBacklogRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MigrateTaskQueueBacklogRequest from the protobuf v3 wire format
+func (val *MigrateTaskQueueBacklogRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MigrateTaskQueueBacklogRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MigrateTaskQueueBacklogRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MigrateTaskQueueBacklogRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MigrateTaskQueueBacklogRequest
+	switch t := that.(type) {
+	case *MigrateTaskQueueBacklogRequest:
+		that1 = t
+	case MigrateTaskQueueBacklogRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type MigrateTaskQueueBacklogResponse to the protobuf v3 wire format
+func (val *MigrateTaskQueueBacklogResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MigrateTaskQueueBacklogResponse from the protobuf v3 wire format
+func (val *MigrateTaskQueueBacklogResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MigrateTaskQueueBacklogResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MigrateTaskQueueBacklogResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MigrateTaskQueueBacklogResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MigrateTaskQueueBacklogResponse
+	switch t := that.(type) {
+	case *MigrateTaskQueueBacklogResponse:
+		that1 = t
+	case MigrateTaskQueueBacklogResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateActivityTypeRateLimitsRequest to the protobuf v3 wire format
+func (val *UpdateActivityTypeRateLimitsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateActivityTypeRateLimitsRequest from the protobuf v3 wire format
+func (val *UpdateActivityTypeRateLimitsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateActivityTypeRateLimitsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateActivityTypeRateLimitsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateActivityTypeRateLimitsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateActivityTypeRateLimitsRequest
+	switch t := that.(type) {
+	case *UpdateActivityTypeRateLimitsRequest:
+		that1 = t
+	case UpdateActivityTypeRateLimitsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateActivityTypeRateLimitsResponse to the protobuf v3 wire format
+func (val *UpdateActivityTypeRateLimitsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateActivityTypeRateLimitsResponse from the protobuf v3 wire format
+func (val *UpdateActivityTypeRateLimitsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateActivityTypeRateLimitsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateActivityTypeRateLimitsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateActivityTypeRateLimitsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateActivityTypeRateLimitsResponse
+	switch t := that.(type) {
+	case *UpdateActivityTypeRateLimitsResponse:
+		that1 = t
+	case UpdateActivityTypeRateLimitsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type AggregateWorkflowExecutionsRequest to the protobuf v3 wire format
+func (val *AggregateWorkflowExecutionsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type AggregateWorkflowExecutionsRequest from the protobuf v3 wire format
+func (val *AggregateWorkflowExecutionsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *AggregateWorkflowExecutionsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two AggregateWorkflowExecutionsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *AggregateWorkflowExecutionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *AggregateWorkflowExecutionsRequest
+	switch t := that.(type) {
+	case *AggregateWorkflowExecutionsRequest:
+		that1 = t
+	case AggregateWorkflowExecutionsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type AggregateWorkflowExecutionsResponse to the protobuf v3 wire format
+func (val *AggregateWorkflowExecutionsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type AggregateWorkflowExecutionsResponse from the protobuf v3 wire format
+func (val *AggregateWorkflowExecutionsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *AggregateWorkflowExecutionsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two AggregateWorkflowExecutionsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *AggregateWorkflowExecutionsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *AggregateWorkflowExecutionsResponse
+	switch t := that.(type) {
+	case *AggregateWorkflowExecutionsResponse:
+		that1 = t
+	case AggregateWorkflowExecutionsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartBatchOperationRequest to the protobuf v3 wire format
+func (val *StartBatchOperationRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartBatchOperationRequest from the protobuf v3 wire format
+func (val *StartBatchOperationRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartBatchOperationRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartBatchOperationRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartBatchOperationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartBatchOperationRequest
+	switch t := that.(type) {
+	case *StartBatchOperationRequest:
+		that1 = t
+	case StartBatchOperationRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartBatchOperationResponse to the protobuf v3 wire format
+func (val *StartBatchOperationResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartBatchOperationResponse from the protobuf v3 wire format
+func (val *StartBatchOperationResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartBatchOperationResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartBatchOperationResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartBatchOperationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartBatchOperationResponse
+	switch t := that.(type) {
+	case *StartBatchOperationResponse:
+		that1 = t
+	case StartBatchOperationResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetBatchOperationReportRequest to the protobuf v3 wire format
+func (val *GetBatchOperationReportRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetBatchOperationReportRequest from the protobuf v3 wire format
+func (val *GetBatchOperationReportRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetBatchOperationReportRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetBatchOperationReportRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetBatchOperationReportRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetBatchOperationReportRequest
+	switch t := that.(type) {
+	case *GetBatchOperationReportRequest:
+		that1 = t
+	case GetBatchOperationReportRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetBatchOperationReportResponse to the protobuf v3 wire format
+func (val *GetBatchOperationReportResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetBatchOperationReportResponse from the protobuf v3 wire format
+func (val *GetBatchOperationReportResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetBatchOperationReportResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetBatchOperationReportResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetBatchOperationReportResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetBatchOperationReportResponse
+	switch t := that.(type) {
+	case *GetBatchOperationReportResponse:
+		that1 = t
+	case GetBatchOperationReportResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type BatchOperationFailure to the protobuf v3 wire format
+func (val *BatchOperationFailure) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type BatchOperationFailure from the protobuf v3 wire format
+func (val *BatchOperationFailure) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *BatchOperationFailure) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two BatchOperationFailure values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *BatchOperationFailure) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *BatchOperationFailure
+	switch t := that.(type) {
+	case *BatchOperationFailure:
+		that1 = t
+	case BatchOperationFailure:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type BatchOperationUpsertProperties to the protobuf v3 wire format
+func (val *BatchOperationUpsertProperties) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type BatchOperationUpsertProperties from the protobuf v3 wire format
+func (val *BatchOperationUpsertProperties) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *BatchOperationUpsertProperties) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two BatchOperationUpsertProperties values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *BatchOperationUpsertProperties) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *BatchOperationUpsertProperties
+	switch t := that.(type) {
+	case *BatchOperationUpsertProperties:
+		that1 = t
+	case BatchOperationUpsertProperties:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type BatchOperationSignalWithStart to the protobuf v3 wire format
+func (val *BatchOperationSignalWithStart) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type BatchOperationSignalWithStart from the protobuf v3 wire format
+func (val *BatchOperationSignalWithStart) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *BatchOperationSignalWithStart) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two BatchOperationSignalWithStart values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *BatchOperationSignalWithStart) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *BatchOperationSignalWithStart
+	switch t := that.(type) {
+	case *BatchOperationSignalWithStart:
+		that1 = t
+	case BatchOperationSignalWithStart:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
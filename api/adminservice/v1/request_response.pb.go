@@ -33,10 +33,12 @@ import (
 	sync "sync"
 	unsafe "unsafe"
 
+	v117 "go.temporal.io/api/batch/v1"
 	v1 "go.temporal.io/api/common/v1"
 	v16 "go.temporal.io/api/enums/v1"
 	v110 "go.temporal.io/api/namespace/v1"
 	v111 "go.temporal.io/api/replication/v1"
+	v115 "go.temporal.io/api/schedule/v1"
 	v114 "go.temporal.io/api/taskqueue/v1"
 	v19 "go.temporal.io/api/version/v1"
 	v17 "go.temporal.io/api/workflow/v1"
@@ -47,6 +49,7 @@ import (
 	v13 "go.temporal.io/server/api/namespace/v1"
 	v12 "go.temporal.io/server/api/persistence/v1"
 	v15 "go.temporal.io/server/api/replication/v1"
+	v116 "go.temporal.io/server/api/schedule/v1"
 	v113 "go.temporal.io/server/api/taskqueue/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -5252,6 +5255,1848 @@ func (x *ForceUnloadTaskQueuePartitionResponse) GetWasLoaded() bool {
 	return false
 }
 
+type SimulateScheduleRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Schedule id used to seed jitter, so that simulated times match the ones of a schedule
+	// created with this id. Optional.
+	ScheduleId string `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// The proposed schedule. Spec, policies and state (paused, remaining actions) are simulated.
+	Schedule *v115.Schedule `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// (-- api-linter: core::0142::time-field-names=disabled
+	//
+	//	aip.dev/not-precedent: Simulated time range. --)
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// (-- api-linter: core::0142::time-field-names=disabled
+	//
+	//	aip.dev/not-precedent: Simulated time range. --)
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// How long each started workflow is assumed to run. Zero means workflows close as soon as
+	// they are started, so no overlap occurs.
+	WorkflowRunDuration *durationpb.Duration `protobuf:"bytes,6,opt,name=workflow_run_duration,json=workflowRunDuration,proto3" json:"workflow_run_duration,omitempty"`
+	// Maximum number of actions to return. Defaults to and is capped at 1000.
+	MaximumActions int32 `protobuf:"varint,7,opt,name=maximum_actions,json=maximumActions,proto3" json:"maximum_actions,omitempty"`
+	// Simulates the scheduler being unavailable, e.g. during an outage, from start_time until this
+	// time. Actions that were due in the meantime are taken late, when the scheduler resumes, and
+	// are subject to the catchup window. Optional.
+	SchedulerResumeTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=scheduler_resume_time,json=schedulerResumeTime,proto3" json:"scheduler_resume_time,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SimulateScheduleRequest) Reset() {
+	*x = SimulateScheduleRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateScheduleRequest) ProtoMessage() {}
+
+func (x *SimulateScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateScheduleRequest.ProtoReflect.Descriptor instead.
+func (*SimulateScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SimulateScheduleRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *SimulateScheduleRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *SimulateScheduleRequest) GetSchedule() *v115.Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *SimulateScheduleRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *SimulateScheduleRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *SimulateScheduleRequest) GetWorkflowRunDuration() *durationpb.Duration {
+	if x != nil {
+		return x.WorkflowRunDuration
+	}
+	return nil
+}
+
+func (x *SimulateScheduleRequest) GetMaximumActions() int32 {
+	if x != nil {
+		return x.MaximumActions
+	}
+	return 0
+}
+
+func (x *SimulateScheduleRequest) GetSchedulerResumeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SchedulerResumeTime
+	}
+	return nil
+}
+
+type SimulateScheduleResponse struct {
+	state   protoimpl.MessageState          `protogen:"open.v1"`
+	Actions []*v116.SimulatedScheduleAction `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+	// Number of actions that were started.
+	ActionCount         int64 `protobuf:"varint,2,opt,name=action_count,json=actionCount,proto3" json:"action_count,omitempty"`
+	OverlapSkipped      int64 `protobuf:"varint,3,opt,name=overlap_skipped,json=overlapSkipped,proto3" json:"overlap_skipped,omitempty"`
+	BufferDropped       int64 `protobuf:"varint,4,opt,name=buffer_dropped,json=bufferDropped,proto3" json:"buffer_dropped,omitempty"`
+	MissedCatchupWindow int64 `protobuf:"varint,5,opt,name=missed_catchup_window,json=missedCatchupWindow,proto3" json:"missed_catchup_window,omitempty"`
+	// Set if the simulation stopped before end_time because maximum_actions was reached.
+	Truncated     bool `protobuf:"varint,6,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulateScheduleResponse) Reset() {
+	*x = SimulateScheduleResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateScheduleResponse) ProtoMessage() {}
+
+func (x *SimulateScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateScheduleResponse.ProtoReflect.Descriptor instead.
+func (*SimulateScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *SimulateScheduleResponse) GetActions() []*v116.SimulatedScheduleAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *SimulateScheduleResponse) GetActionCount() int64 {
+	if x != nil {
+		return x.ActionCount
+	}
+	return 0
+}
+
+func (x *SimulateScheduleResponse) GetOverlapSkipped() int64 {
+	if x != nil {
+		return x.OverlapSkipped
+	}
+	return 0
+}
+
+func (x *SimulateScheduleResponse) GetBufferDropped() int64 {
+	if x != nil {
+		return x.BufferDropped
+	}
+	return 0
+}
+
+func (x *SimulateScheduleResponse) GetMissedCatchupWindow() int64 {
+	if x != nil {
+		return x.MissedCatchupWindow
+	}
+	return 0
+}
+
+func (x *SimulateScheduleResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type UpsertBusinessCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Calendar      *v116.BusinessCalendar `protobuf:"bytes,2,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertBusinessCalendarRequest) Reset() {
+	*x = UpsertBusinessCalendarRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertBusinessCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertBusinessCalendarRequest) ProtoMessage() {}
+
+func (x *UpsertBusinessCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertBusinessCalendarRequest.ProtoReflect.Descriptor instead.
+func (*UpsertBusinessCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *UpsertBusinessCalendarRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *UpsertBusinessCalendarRequest) GetCalendar() *v116.BusinessCalendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return nil
+}
+
+type UpsertBusinessCalendarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calendar      *v116.BusinessCalendar `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertBusinessCalendarResponse) Reset() {
+	*x = UpsertBusinessCalendarResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertBusinessCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertBusinessCalendarResponse) ProtoMessage() {}
+
+func (x *UpsertBusinessCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertBusinessCalendarResponse.ProtoReflect.Descriptor instead.
+func (*UpsertBusinessCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *UpsertBusinessCalendarResponse) GetCalendar() *v116.BusinessCalendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return nil
+}
+
+type DeleteBusinessCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBusinessCalendarRequest) Reset() {
+	*x = DeleteBusinessCalendarRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBusinessCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBusinessCalendarRequest) ProtoMessage() {}
+
+func (x *DeleteBusinessCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBusinessCalendarRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBusinessCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *DeleteBusinessCalendarRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DeleteBusinessCalendarRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteBusinessCalendarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBusinessCalendarResponse) Reset() {
+	*x = DeleteBusinessCalendarResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBusinessCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBusinessCalendarResponse) ProtoMessage() {}
+
+func (x *DeleteBusinessCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBusinessCalendarResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBusinessCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{94}
+}
+
+type ListBusinessCalendarsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBusinessCalendarsRequest) Reset() {
+	*x = ListBusinessCalendarsRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBusinessCalendarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBusinessCalendarsRequest) ProtoMessage() {}
+
+func (x *ListBusinessCalendarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBusinessCalendarsRequest.ProtoReflect.Descriptor instead.
+func (*ListBusinessCalendarsRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ListBusinessCalendarsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ListBusinessCalendarsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Calendars     []*v116.BusinessCalendar `protobuf:"bytes,1,rep,name=calendars,proto3" json:"calendars,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBusinessCalendarsResponse) Reset() {
+	*x = ListBusinessCalendarsResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBusinessCalendarsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBusinessCalendarsResponse) ProtoMessage() {}
+
+func (x *ListBusinessCalendarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBusinessCalendarsResponse.ProtoReflect.Descriptor instead.
+func (*ListBusinessCalendarsResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ListBusinessCalendarsResponse) GetCalendars() []*v116.BusinessCalendar {
+	if x != nil {
+		return x.Calendars
+	}
+	return nil
+}
+
+type SetScheduleBusinessCalendarsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Namespace  string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ScheduleId string                 `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// Business calendars of the namespace to reference, by name. The calendar definitions are
+	// resolved from the namespace and must not be set.
+	BusinessCalendars []*v116.ScheduleBusinessCalendar `protobuf:"bytes,3,rep,name=business_calendars,json=businessCalendars,proto3" json:"business_calendars,omitempty"`
+	Identity          string                           `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	RequestId         string                           `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SetScheduleBusinessCalendarsRequest) Reset() {
+	*x = SetScheduleBusinessCalendarsRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetScheduleBusinessCalendarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetScheduleBusinessCalendarsRequest) ProtoMessage() {}
+
+func (x *SetScheduleBusinessCalendarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetScheduleBusinessCalendarsRequest.ProtoReflect.Descriptor instead.
+func (*SetScheduleBusinessCalendarsRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *SetScheduleBusinessCalendarsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *SetScheduleBusinessCalendarsRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *SetScheduleBusinessCalendarsRequest) GetBusinessCalendars() []*v116.ScheduleBusinessCalendar {
+	if x != nil {
+		return x.BusinessCalendars
+	}
+	return nil
+}
+
+func (x *SetScheduleBusinessCalendarsRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *SetScheduleBusinessCalendarsRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type SetScheduleBusinessCalendarsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The references with their definitions resolved from the namespace.
+	BusinessCalendars []*v116.ScheduleBusinessCalendar `protobuf:"bytes,1,rep,name=business_calendars,json=businessCalendars,proto3" json:"business_calendars,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SetScheduleBusinessCalendarsResponse) Reset() {
+	*x = SetScheduleBusinessCalendarsResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetScheduleBusinessCalendarsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetScheduleBusinessCalendarsResponse) ProtoMessage() {}
+
+func (x *SetScheduleBusinessCalendarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetScheduleBusinessCalendarsResponse.ProtoReflect.Descriptor instead.
+func (*SetScheduleBusinessCalendarsResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *SetScheduleBusinessCalendarsResponse) GetBusinessCalendars() []*v116.ScheduleBusinessCalendar {
+	if x != nil {
+		return x.BusinessCalendars
+	}
+	return nil
+}
+
+type ListAuditEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken []byte                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Namespace whose events are returned. If empty, the events of calls that are not scoped to a
+	// namespace, e.g. cluster-level admin and operator calls, are returned.
+	Namespace     string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ListAuditEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ListAuditEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*v12.AuditEvent      `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextPageToken []byte                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*v12.AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResponse) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type MigrateTaskQueueBacklogRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Partition whose backlog is migrated.
+	TaskQueuePartition *v113.TaskQueuePartition `protobuf:"bytes,2,opt,name=task_queue_partition,json=taskQueuePartition,proto3" json:"task_queue_partition,omitempty"`
+	// Build ID of the versioned queue to migrate. Empty means the unversioned queue.
+	BuildId string `protobuf:"bytes,3,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	// Task queue of the same type that the tasks are added to.
+	TargetTaskQueue string `protobuf:"bytes,4,opt,name=target_task_queue,json=targetTaskQueue,proto3" json:"target_task_queue,omitempty"`
+	// If true, migrated tasks are kept in the source backlog. Otherwise they are removed from it.
+	Copy bool `protobuf:"varint,5,opt,name=copy,proto3" json:"copy,omitempty"`
+	// If set, only tasks of this workflow are migrated.
+	WorkflowId string `protobuf:"bytes,6,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	// Position to continue from, as returned in the previous response.
+	Subqueue   int32 `protobuf:"varint,7,opt,name=subqueue,proto3" json:"subqueue,omitempty"`
+	LastTaskId int64 `protobuf:"varint,8,opt,name=last_task_id,json=lastTaskId,proto3" json:"last_task_id,omitempty"`
+	// Maximum number of tasks to read in this call.
+	BatchSize     int32 `protobuf:"varint,9,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MigrateTaskQueueBacklogRequest) Reset() {
+	*x = MigrateTaskQueueBacklogRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigrateTaskQueueBacklogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateTaskQueueBacklogRequest) ProtoMessage() {}
+
+func (x *MigrateTaskQueueBacklogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateTaskQueueBacklogRequest.ProtoReflect.Descriptor instead.
+func (*MigrateTaskQueueBacklogRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *MigrateTaskQueueBacklogRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *MigrateTaskQueueBacklogRequest) GetTaskQueuePartition() *v113.TaskQueuePartition {
+	if x != nil {
+		return x.TaskQueuePartition
+	}
+	return nil
+}
+
+func (x *MigrateTaskQueueBacklogRequest) GetBuildId() string {
+	if x != nil {
+		return x.BuildId
+	}
+	return ""
+}
+
+func (x *MigrateTaskQueueBacklogRequest) GetTargetTaskQueue() string {
+	if x != nil {
+		return x.TargetTaskQueue
+	}
+	return ""
+}
+
+func (x *MigrateTaskQueueBacklogRequest) GetCopy() bool {
+	if x != nil {
+		return x.Copy
+	}
+	return false
+}
+
+func (x *MigrateTaskQueueBacklogRequest) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *MigrateTaskQueueBacklogRequest) GetSubqueue() int32 {
+	if x != nil {
+		return x.Subqueue
+	}
+	return 0
+}
+
+func (x *MigrateTaskQueueBacklogRequest) GetLastTaskId() int64 {
+	if x != nil {
+		return x.LastTaskId
+	}
+	return 0
+}
+
+func (x *MigrateTaskQueueBacklogRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type MigrateTaskQueueBacklogResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Position to continue from in the next call.
+	Subqueue      int32 `protobuf:"varint,1,opt,name=subqueue,proto3" json:"subqueue,omitempty"`
+	LastTaskId    int64 `protobuf:"varint,2,opt,name=last_task_id,json=lastTaskId,proto3" json:"last_task_id,omitempty"`
+	TasksScanned  int32 `protobuf:"varint,3,opt,name=tasks_scanned,json=tasksScanned,proto3" json:"tasks_scanned,omitempty"`
+	TasksMigrated int32 `protobuf:"varint,4,opt,name=tasks_migrated,json=tasksMigrated,proto3" json:"tasks_migrated,omitempty"`
+	// True when the whole backlog has been read.
+	Done          bool `protobuf:"varint,5,opt,name=done,proto3" json:"done,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MigrateTaskQueueBacklogResponse) Reset() {
+	*x = MigrateTaskQueueBacklogResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigrateTaskQueueBacklogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateTaskQueueBacklogResponse) ProtoMessage() {}
+
+func (x *MigrateTaskQueueBacklogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateTaskQueueBacklogResponse.ProtoReflect.Descriptor instead.
+func (*MigrateTaskQueueBacklogResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *MigrateTaskQueueBacklogResponse) GetSubqueue() int32 {
+	if x != nil {
+		return x.Subqueue
+	}
+	return 0
+}
+
+func (x *MigrateTaskQueueBacklogResponse) GetLastTaskId() int64 {
+	if x != nil {
+		return x.LastTaskId
+	}
+	return 0
+}
+
+func (x *MigrateTaskQueueBacklogResponse) GetTasksScanned() int32 {
+	if x != nil {
+		return x.TasksScanned
+	}
+	return 0
+}
+
+func (x *MigrateTaskQueueBacklogResponse) GetTasksMigrated() int32 {
+	if x != nil {
+		return x.TasksMigrated
+	}
+	return 0
+}
+
+func (x *MigrateTaskQueueBacklogResponse) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+type UpdateActivityTypeRateLimitsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskQueue string                 `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	// Limits to add or replace, keyed by activity type name, in tasks per second.
+	SetRateLimits map[string]float64 `protobuf:"bytes,3,rep,name=set_rate_limits,json=setRateLimits,proto3" json:"set_rate_limits,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	// Activity types whose limits should be removed.
+	RemoveActivityTypes []string `protobuf:"bytes,4,rep,name=remove_activity_types,json=removeActivityTypes,proto3" json:"remove_activity_types,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UpdateActivityTypeRateLimitsRequest) Reset() {
+	*x = UpdateActivityTypeRateLimitsRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateActivityTypeRateLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateActivityTypeRateLimitsRequest) ProtoMessage() {}
+
+func (x *UpdateActivityTypeRateLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateActivityTypeRateLimitsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateActivityTypeRateLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *UpdateActivityTypeRateLimitsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *UpdateActivityTypeRateLimitsRequest) GetTaskQueue() string {
+	if x != nil {
+		return x.TaskQueue
+	}
+	return ""
+}
+
+func (x *UpdateActivityTypeRateLimitsRequest) GetSetRateLimits() map[string]float64 {
+	if x != nil {
+		return x.SetRateLimits
+	}
+	return nil
+}
+
+func (x *UpdateActivityTypeRateLimitsRequest) GetRemoveActivityTypes() []string {
+	if x != nil {
+		return x.RemoveActivityTypes
+	}
+	return nil
+}
+
+type UpdateActivityTypeRateLimitsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// All per-activity-type limits on the task queue after the update.
+	RateLimits    map[string]float64 `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateActivityTypeRateLimitsResponse) Reset() {
+	*x = UpdateActivityTypeRateLimitsResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateActivityTypeRateLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateActivityTypeRateLimitsResponse) ProtoMessage() {}
+
+func (x *UpdateActivityTypeRateLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateActivityTypeRateLimitsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateActivityTypeRateLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *UpdateActivityTypeRateLimitsResponse) GetRateLimits() map[string]float64 {
+	if x != nil {
+		return x.RateLimits
+	}
+	return nil
+}
+
+type AggregateWorkflowExecutionsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Visibility query selecting the executions to aggregate. 'group by' and 'order by' are not allowed.
+	Query string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// At least one of histogram and percentiles must be set.
+	Histogram     *AggregateWorkflowExecutionsRequest_HistogramAggregation   `protobuf:"bytes,3,opt,name=histogram,proto3" json:"histogram,omitempty"`
+	Percentiles   *AggregateWorkflowExecutionsRequest_PercentilesAggregation `protobuf:"bytes,4,opt,name=percentiles,proto3" json:"percentiles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregateWorkflowExecutionsRequest) Reset() {
+	*x = AggregateWorkflowExecutionsRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregateWorkflowExecutionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateWorkflowExecutionsRequest) ProtoMessage() {}
+
+func (x *AggregateWorkflowExecutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateWorkflowExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*AggregateWorkflowExecutionsRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *AggregateWorkflowExecutionsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *AggregateWorkflowExecutionsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *AggregateWorkflowExecutionsRequest) GetHistogram() *AggregateWorkflowExecutionsRequest_HistogramAggregation {
+	if x != nil {
+		return x.Histogram
+	}
+	return nil
+}
+
+func (x *AggregateWorkflowExecutionsRequest) GetPercentiles() *AggregateWorkflowExecutionsRequest_PercentilesAggregation {
+	if x != nil {
+		return x.Percentiles
+	}
+	return nil
+}
+
+type AggregateWorkflowExecutionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Non-empty buckets sorted by start time.
+	Buckets []*AggregateWorkflowExecutionsResponse_HistogramBucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	// Values in the order of the requested percents.
+	Percentiles   []*AggregateWorkflowExecutionsResponse_PercentileValue `protobuf:"bytes,2,rep,name=percentiles,proto3" json:"percentiles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregateWorkflowExecutionsResponse) Reset() {
+	*x = AggregateWorkflowExecutionsResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregateWorkflowExecutionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateWorkflowExecutionsResponse) ProtoMessage() {}
+
+func (x *AggregateWorkflowExecutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateWorkflowExecutionsResponse.ProtoReflect.Descriptor instead.
+func (*AggregateWorkflowExecutionsResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *AggregateWorkflowExecutionsResponse) GetBuckets() []*AggregateWorkflowExecutionsResponse_HistogramBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *AggregateWorkflowExecutionsResponse) GetPercentiles() []*AggregateWorkflowExecutionsResponse_PercentileValue {
+	if x != nil {
+		return x.Percentiles
+	}
+	return nil
+}
+
+type StartBatchOperationRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Visibility query selecting the target executions. Mutually exclusive with executions.
+	VisibilityQuery string `protobuf:"bytes,2,opt,name=visibility_query,json=visibilityQuery,proto3" json:"visibility_query,omitempty"`
+	// Explicit list of target executions. For signal with start only workflow ids are used.
+	Executions []*v1.WorkflowExecution `protobuf:"bytes,3,rep,name=executions,proto3" json:"executions,omitempty"`
+	// Workflow id of the batch job.
+	JobId    string `protobuf:"bytes,4,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Reason   string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Identity string `protobuf:"bytes,6,opt,name=identity,proto3" json:"identity,omitempty"`
+	// Max operations per second, capped by the worker.BatcherRPS dynamic config.
+	MaxOperationsPerSecond float32 `protobuf:"fixed32,7,opt,name=max_operations_per_second,json=maxOperationsPerSecond,proto3" json:"max_operations_per_second,omitempty"`
+	// Operations of the public StartBatchOperation API can be started here too, to run them as a dry run.
+	// Their identity fields are ignored in favor of identity.
+	//
+	// Types that are valid to be assigned to Operation:
+	//
+	//	*StartBatchOperationRequest_UpsertPropertiesOperation
+	//	*StartBatchOperationRequest_SignalWithStartOperation
+	//	*StartBatchOperationRequest_TerminationOperation
+	//	*StartBatchOperationRequest_SignalOperation
+	//	*StartBatchOperationRequest_CancellationOperation
+	//	*StartBatchOperationRequest_DeletionOperation
+	//	*StartBatchOperationRequest_ResetOperation
+	Operation isStartBatchOperationRequest_Operation `protobuf_oneof:"operation"`
+	// Only resolve and count the target executions, without applying the operation to them.
+	DryRun        bool `protobuf:"varint,10,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartBatchOperationRequest) Reset() {
+	*x = StartBatchOperationRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartBatchOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartBatchOperationRequest) ProtoMessage() {}
+
+func (x *StartBatchOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartBatchOperationRequest.ProtoReflect.Descriptor instead.
+func (*StartBatchOperationRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *StartBatchOperationRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *StartBatchOperationRequest) GetVisibilityQuery() string {
+	if x != nil {
+		return x.VisibilityQuery
+	}
+	return ""
+}
+
+func (x *StartBatchOperationRequest) GetExecutions() []*v1.WorkflowExecution {
+	if x != nil {
+		return x.Executions
+	}
+	return nil
+}
+
+func (x *StartBatchOperationRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *StartBatchOperationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StartBatchOperationRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *StartBatchOperationRequest) GetMaxOperationsPerSecond() float32 {
+	if x != nil {
+		return x.MaxOperationsPerSecond
+	}
+	return 0
+}
+
+func (x *StartBatchOperationRequest) GetOperation() isStartBatchOperationRequest_Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+func (x *StartBatchOperationRequest) GetUpsertPropertiesOperation() *BatchOperationUpsertProperties {
+	if x != nil {
+		if x, ok := x.Operation.(*StartBatchOperationRequest_UpsertPropertiesOperation); ok {
+			return x.UpsertPropertiesOperation
+		}
+	}
+	return nil
+}
+
+func (x *StartBatchOperationRequest) GetSignalWithStartOperation() *BatchOperationSignalWithStart {
+	if x != nil {
+		if x, ok := x.Operation.(*StartBatchOperationRequest_SignalWithStartOperation); ok {
+			return x.SignalWithStartOperation
+		}
+	}
+	return nil
+}
+
+func (x *StartBatchOperationRequest) GetTerminationOperation() *v117.BatchOperationTermination {
+	if x != nil {
+		if x, ok := x.Operation.(*StartBatchOperationRequest_TerminationOperation); ok {
+			return x.TerminationOperation
+		}
+	}
+	return nil
+}
+
+func (x *StartBatchOperationRequest) GetSignalOperation() *v117.BatchOperationSignal {
+	if x != nil {
+		if x, ok := x.Operation.(*StartBatchOperationRequest_SignalOperation); ok {
+			return x.SignalOperation
+		}
+	}
+	return nil
+}
+
+func (x *StartBatchOperationRequest) GetCancellationOperation() *v117.BatchOperationCancellation {
+	if x != nil {
+		if x, ok := x.Operation.(*StartBatchOperationRequest_CancellationOperation); ok {
+			return x.CancellationOperation
+		}
+	}
+	return nil
+}
+
+func (x *StartBatchOperationRequest) GetDeletionOperation() *v117.BatchOperationDeletion {
+	if x != nil {
+		if x, ok := x.Operation.(*StartBatchOperationRequest_DeletionOperation); ok {
+			return x.DeletionOperation
+		}
+	}
+	return nil
+}
+
+func (x *StartBatchOperationRequest) GetResetOperation() *v117.BatchOperationReset {
+	if x != nil {
+		if x, ok := x.Operation.(*StartBatchOperationRequest_ResetOperation); ok {
+			return x.ResetOperation
+		}
+	}
+	return nil
+}
+
+func (x *StartBatchOperationRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type isStartBatchOperationRequest_Operation interface {
+	isStartBatchOperationRequest_Operation()
+}
+
+type StartBatchOperationRequest_UpsertPropertiesOperation struct {
+	UpsertPropertiesOperation *BatchOperationUpsertProperties `protobuf:"bytes,8,opt,name=upsert_properties_operation,json=upsertPropertiesOperation,proto3,oneof"`
+}
+
+type StartBatchOperationRequest_SignalWithStartOperation struct {
+	SignalWithStartOperation *BatchOperationSignalWithStart `protobuf:"bytes,9,opt,name=signal_with_start_operation,json=signalWithStartOperation,proto3,oneof"`
+}
+
+type StartBatchOperationRequest_TerminationOperation struct {
+	TerminationOperation *v117.BatchOperationTermination `protobuf:"bytes,11,opt,name=termination_operation,json=terminationOperation,proto3,oneof"`
+}
+
+type StartBatchOperationRequest_SignalOperation struct {
+	SignalOperation *v117.BatchOperationSignal `protobuf:"bytes,12,opt,name=signal_operation,json=signalOperation,proto3,oneof"`
+}
+
+type StartBatchOperationRequest_CancellationOperation struct {
+	CancellationOperation *v117.BatchOperationCancellation `protobuf:"bytes,13,opt,name=cancellation_operation,json=cancellationOperation,proto3,oneof"`
+}
+
+type StartBatchOperationRequest_DeletionOperation struct {
+	DeletionOperation *v117.BatchOperationDeletion `protobuf:"bytes,14,opt,name=deletion_operation,json=deletionOperation,proto3,oneof"`
+}
+
+type StartBatchOperationRequest_ResetOperation struct {
+	ResetOperation *v117.BatchOperationReset `protobuf:"bytes,15,opt,name=reset_operation,json=resetOperation,proto3,oneof"`
+}
+
+func (*StartBatchOperationRequest_UpsertPropertiesOperation) isStartBatchOperationRequest_Operation() {
+}
+
+func (*StartBatchOperationRequest_SignalWithStartOperation) isStartBatchOperationRequest_Operation() {
+}
+
+func (*StartBatchOperationRequest_TerminationOperation) isStartBatchOperationRequest_Operation() {}
+
+func (*StartBatchOperationRequest_SignalOperation) isStartBatchOperationRequest_Operation() {}
+
+func (*StartBatchOperationRequest_CancellationOperation) isStartBatchOperationRequest_Operation() {}
+
+func (*StartBatchOperationRequest_DeletionOperation) isStartBatchOperationRequest_Operation() {}
+
+func (*StartBatchOperationRequest_ResetOperation) isStartBatchOperationRequest_Operation() {}
+
+type StartBatchOperationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartBatchOperationResponse) Reset() {
+	*x = StartBatchOperationResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartBatchOperationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartBatchOperationResponse) ProtoMessage() {}
+
+func (x *StartBatchOperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartBatchOperationResponse.ProtoReflect.Descriptor instead.
+func (*StartBatchOperationResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{108}
+}
+
+type GetBatchOperationReportRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Workflow id of the batch job.
+	JobId string `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// Token of the failure manifest page to return, empty for the first page.
+	NextPageToken []byte `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBatchOperationReportRequest) Reset() {
+	*x = GetBatchOperationReportRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBatchOperationReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBatchOperationReportRequest) ProtoMessage() {}
+
+func (x *GetBatchOperationReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBatchOperationReportRequest.ProtoReflect.Descriptor instead.
+func (*GetBatchOperationReportRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *GetBatchOperationReportRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetBatchOperationReportRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *GetBatchOperationReportRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type GetBatchOperationReportResponse struct {
+	state  protoimpl.MessageState  `protogen:"open.v1"`
+	State  v16.BatchOperationState `protobuf:"varint,1,opt,name=state,proto3,enum=temporal.api.enums.v1.BatchOperationState" json:"state,omitempty"`
+	DryRun bool                    `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Estimated count of target executions, computed when the job starts.
+	TotalOperationCount int64 `protobuf:"varint,3,opt,name=total_operation_count,json=totalOperationCount,proto3" json:"total_operation_count,omitempty"`
+	// Count of target executions resolved from the visibility query or execution list so far.
+	ResolvedOperationCount int64 `protobuf:"varint,4,opt,name=resolved_operation_count,json=resolvedOperationCount,proto3" json:"resolved_operation_count,omitempty"`
+	CompleteOperationCount int64 `protobuf:"varint,5,opt,name=complete_operation_count,json=completeOperationCount,proto3" json:"complete_operation_count,omitempty"`
+	FailureOperationCount  int64 `protobuf:"varint,6,opt,name=failure_operation_count,json=failureOperationCount,proto3" json:"failure_operation_count,omitempty"`
+	// One page of the failure manifest.
+	Failures []*BatchOperationFailure `protobuf:"bytes,7,rep,name=failures,proto3" json:"failures,omitempty"`
+	// Set if the failure manifest reached its size limit and later failures are only counted.
+	FailureManifestTruncated bool   `protobuf:"varint,8,opt,name=failure_manifest_truncated,json=failureManifestTruncated,proto3" json:"failure_manifest_truncated,omitempty"`
+	NextPageToken            []byte `protobuf:"bytes,9,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *GetBatchOperationReportResponse) Reset() {
+	*x = GetBatchOperationReportResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBatchOperationReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBatchOperationReportResponse) ProtoMessage() {}
+
+func (x *GetBatchOperationReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBatchOperationReportResponse.ProtoReflect.Descriptor instead.
+func (*GetBatchOperationReportResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *GetBatchOperationReportResponse) GetState() v16.BatchOperationState {
+	if x != nil {
+		return x.State
+	}
+	return v16.BatchOperationState(0)
+}
+
+func (x *GetBatchOperationReportResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *GetBatchOperationReportResponse) GetTotalOperationCount() int64 {
+	if x != nil {
+		return x.TotalOperationCount
+	}
+	return 0
+}
+
+func (x *GetBatchOperationReportResponse) GetResolvedOperationCount() int64 {
+	if x != nil {
+		return x.ResolvedOperationCount
+	}
+	return 0
+}
+
+func (x *GetBatchOperationReportResponse) GetCompleteOperationCount() int64 {
+	if x != nil {
+		return x.CompleteOperationCount
+	}
+	return 0
+}
+
+func (x *GetBatchOperationReportResponse) GetFailureOperationCount() int64 {
+	if x != nil {
+		return x.FailureOperationCount
+	}
+	return 0
+}
+
+func (x *GetBatchOperationReportResponse) GetFailures() []*BatchOperationFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+func (x *GetBatchOperationReportResponse) GetFailureManifestTruncated() bool {
+	if x != nil {
+		return x.FailureManifestTruncated
+	}
+	return false
+}
+
+func (x *GetBatchOperationReportResponse) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+// An execution the batch job gave up on.
+type BatchOperationFailure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Execution     *v1.WorkflowExecution  `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Attempts      int32                  `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchOperationFailure) Reset() {
+	*x = BatchOperationFailure{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchOperationFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchOperationFailure) ProtoMessage() {}
+
+func (x *BatchOperationFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchOperationFailure.ProtoReflect.Descriptor instead.
+func (*BatchOperationFailure) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *BatchOperationFailure) GetExecution() *v1.WorkflowExecution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *BatchOperationFailure) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BatchOperationFailure) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+// Upserts search attributes and/or memo of executions. Memo of running executions is upserted with a
+// WorkflowPropertiesModifiedExternally history event, while their search attributes can only be upserted
+// by the workflow itself. Closed executions are updated without a history event.
+// A batch operation with search attributes must target closed executions only: it's rejected with
+// FailedPrecondition if its visibility query or executions match running executions when it starts, and
+// executions that are still running when the batch job reaches them are reported as failures.
+type BatchOperationUpsertProperties struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Field with nil payload data is removed.
+	SearchAttributes *v1.SearchAttributes `protobuf:"bytes,1,opt,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
+	// Field with nil payload data is removed.
+	Memo          *v1.Memo `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchOperationUpsertProperties) Reset() {
+	*x = BatchOperationUpsertProperties{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchOperationUpsertProperties) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchOperationUpsertProperties) ProtoMessage() {}
+
+func (x *BatchOperationUpsertProperties) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchOperationUpsertProperties.ProtoReflect.Descriptor instead.
+func (*BatchOperationUpsertProperties) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *BatchOperationUpsertProperties) GetSearchAttributes() *v1.SearchAttributes {
+	if x != nil {
+		return x.SearchAttributes
+	}
+	return nil
+}
+
+func (x *BatchOperationUpsertProperties) GetMemo() *v1.Memo {
+	if x != nil {
+		return x.Memo
+	}
+	return nil
+}
+
+// Signals each target workflow id, starting a new execution with the given options if it is not running.
+type BatchOperationSignalWithStart struct {
+	state                    protoimpl.MessageState    `protogen:"open.v1"`
+	WorkflowType             *v1.WorkflowType          `protobuf:"bytes,1,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	TaskQueue                *v114.TaskQueue           `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	Input                    *v1.Payloads              `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
+	WorkflowExecutionTimeout *durationpb.Duration      `protobuf:"bytes,4,opt,name=workflow_execution_timeout,json=workflowExecutionTimeout,proto3" json:"workflow_execution_timeout,omitempty"`
+	WorkflowRunTimeout       *durationpb.Duration      `protobuf:"bytes,5,opt,name=workflow_run_timeout,json=workflowRunTimeout,proto3" json:"workflow_run_timeout,omitempty"`
+	WorkflowTaskTimeout      *durationpb.Duration      `protobuf:"bytes,6,opt,name=workflow_task_timeout,json=workflowTaskTimeout,proto3" json:"workflow_task_timeout,omitempty"`
+	WorkflowIdReusePolicy    v16.WorkflowIdReusePolicy `protobuf:"varint,7,opt,name=workflow_id_reuse_policy,json=workflowIdReusePolicy,proto3,enum=temporal.api.enums.v1.WorkflowIdReusePolicy" json:"workflow_id_reuse_policy,omitempty"`
+	SignalName               string                    `protobuf:"bytes,8,opt,name=signal_name,json=signalName,proto3" json:"signal_name,omitempty"`
+	SignalInput              *v1.Payloads              `protobuf:"bytes,9,opt,name=signal_input,json=signalInput,proto3" json:"signal_input,omitempty"`
+	Memo                     *v1.Memo                  `protobuf:"bytes,10,opt,name=memo,proto3" json:"memo,omitempty"`
+	SearchAttributes         *v1.SearchAttributes      `protobuf:"bytes,11,opt,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
+	Header                   *v1.Header                `protobuf:"bytes,12,opt,name=header,proto3" json:"header,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *BatchOperationSignalWithStart) Reset() {
+	*x = BatchOperationSignalWithStart{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchOperationSignalWithStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchOperationSignalWithStart) ProtoMessage() {}
+
+func (x *BatchOperationSignalWithStart) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchOperationSignalWithStart.ProtoReflect.Descriptor instead.
+func (*BatchOperationSignalWithStart) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *BatchOperationSignalWithStart) GetWorkflowType() *v1.WorkflowType {
+	if x != nil {
+		return x.WorkflowType
+	}
+	return nil
+}
+
+func (x *BatchOperationSignalWithStart) GetTaskQueue() *v114.TaskQueue {
+	if x != nil {
+		return x.TaskQueue
+	}
+	return nil
+}
+
+func (x *BatchOperationSignalWithStart) GetInput() *v1.Payloads {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *BatchOperationSignalWithStart) GetWorkflowExecutionTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.WorkflowExecutionTimeout
+	}
+	return nil
+}
+
+func (x *BatchOperationSignalWithStart) GetWorkflowRunTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.WorkflowRunTimeout
+	}
+	return nil
+}
+
+func (x *BatchOperationSignalWithStart) GetWorkflowTaskTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.WorkflowTaskTimeout
+	}
+	return nil
+}
+
+func (x *BatchOperationSignalWithStart) GetWorkflowIdReusePolicy() v16.WorkflowIdReusePolicy {
+	if x != nil {
+		return x.WorkflowIdReusePolicy
+	}
+	return v16.WorkflowIdReusePolicy(0)
+}
+
+func (x *BatchOperationSignalWithStart) GetSignalName() string {
+	if x != nil {
+		return x.SignalName
+	}
+	return ""
+}
+
+func (x *BatchOperationSignalWithStart) GetSignalInput() *v1.Payloads {
+	if x != nil {
+		return x.SignalInput
+	}
+	return nil
+}
+
+func (x *BatchOperationSignalWithStart) GetMemo() *v1.Memo {
+	if x != nil {
+		return x.Memo
+	}
+	return nil
+}
+
+func (x *BatchOperationSignalWithStart) GetSearchAttributes() *v1.SearchAttributes {
+	if x != nil {
+		return x.SearchAttributes
+	}
+	return nil
+}
+
+func (x *BatchOperationSignalWithStart) GetHeader() *v1.Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
 type AddTasksRequest_Task struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CategoryId    int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
@@ -5262,7 +7107,7 @@ type AddTasksRequest_Task struct {
 
 func (x *AddTasksRequest_Task) Reset() {
 	*x = AddTasksRequest_Task{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[96]
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5274,7 +7119,7 @@ func (x *AddTasksRequest_Task) String() string {
 func (*AddTasksRequest_Task) ProtoMessage() {}
 
 func (x *AddTasksRequest_Task) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[96]
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5314,7 +7159,7 @@ type ListQueuesResponse_QueueInfo struct {
 
 func (x *ListQueuesResponse_QueueInfo) Reset() {
 	*x = ListQueuesResponse_QueueInfo{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[97]
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5326,7 +7171,7 @@ func (x *ListQueuesResponse_QueueInfo) String() string {
 func (*ListQueuesResponse_QueueInfo) ProtoMessage() {}
 
 func (x *ListQueuesResponse_QueueInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[97]
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5356,11 +7201,296 @@ func (x *ListQueuesResponse_QueueInfo) GetMessageCount() int64 {
 	return 0
 }
 
+// Counts executions in fixed size time buckets of a datetime search attribute. Buckets are
+// aligned to the Unix epoch and executions without a value for the field are skipped.
+type AggregateWorkflowExecutionsRequest_HistogramAggregation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Datetime search attribute, e.g. StartTime or CloseTime.
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// Bucket size, must be a whole number of seconds.
+	Interval *durationpb.Duration `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Optional keyword search attribute to split each bucket by, e.g. ExecutionStatus.
+	GroupBy       string `protobuf:"bytes,3,opt,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregateWorkflowExecutionsRequest_HistogramAggregation) Reset() {
+	*x = AggregateWorkflowExecutionsRequest_HistogramAggregation{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregateWorkflowExecutionsRequest_HistogramAggregation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateWorkflowExecutionsRequest_HistogramAggregation) ProtoMessage() {}
+
+func (x *AggregateWorkflowExecutionsRequest_HistogramAggregation) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateWorkflowExecutionsRequest_HistogramAggregation.ProtoReflect.Descriptor instead.
+func (*AggregateWorkflowExecutionsRequest_HistogramAggregation) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{105, 0}
+}
+
+func (x *AggregateWorkflowExecutionsRequest_HistogramAggregation) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *AggregateWorkflowExecutionsRequest_HistogramAggregation) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *AggregateWorkflowExecutionsRequest_HistogramAggregation) GetGroupBy() string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return ""
+}
+
+type AggregateWorkflowExecutionsRequest_PercentilesAggregation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Int or Double search attribute, e.g. ExecutionDuration.
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// Percentiles to compute, each in range (0, 100].
+	Percents      []float64 `protobuf:"fixed64,2,rep,packed,name=percents,proto3" json:"percents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregateWorkflowExecutionsRequest_PercentilesAggregation) Reset() {
+	*x = AggregateWorkflowExecutionsRequest_PercentilesAggregation{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregateWorkflowExecutionsRequest_PercentilesAggregation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateWorkflowExecutionsRequest_PercentilesAggregation) ProtoMessage() {}
+
+func (x *AggregateWorkflowExecutionsRequest_PercentilesAggregation) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateWorkflowExecutionsRequest_PercentilesAggregation.ProtoReflect.Descriptor instead.
+func (*AggregateWorkflowExecutionsRequest_PercentilesAggregation) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{105, 1}
+}
+
+func (x *AggregateWorkflowExecutionsRequest_PercentilesAggregation) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *AggregateWorkflowExecutionsRequest_PercentilesAggregation) GetPercents() []float64 {
+	if x != nil {
+		return x.Percents
+	}
+	return nil
+}
+
+type AggregateWorkflowExecutionsResponse_HistogramBucket struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	Count     int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// Count per value of the group by field. Only set if group_by was requested.
+	Groups        []*AggregateWorkflowExecutionsResponse_AggregationGroup `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregateWorkflowExecutionsResponse_HistogramBucket) Reset() {
+	*x = AggregateWorkflowExecutionsResponse_HistogramBucket{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregateWorkflowExecutionsResponse_HistogramBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateWorkflowExecutionsResponse_HistogramBucket) ProtoMessage() {}
+
+func (x *AggregateWorkflowExecutionsResponse_HistogramBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateWorkflowExecutionsResponse_HistogramBucket.ProtoReflect.Descriptor instead.
+func (*AggregateWorkflowExecutionsResponse_HistogramBucket) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{106, 0}
+}
+
+func (x *AggregateWorkflowExecutionsResponse_HistogramBucket) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *AggregateWorkflowExecutionsResponse_HistogramBucket) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *AggregateWorkflowExecutionsResponse_HistogramBucket) GetGroups() []*AggregateWorkflowExecutionsResponse_AggregationGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type AggregateWorkflowExecutionsResponse_AggregationGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupValue    *v1.Payload            `protobuf:"bytes,1,opt,name=group_value,json=groupValue,proto3" json:"group_value,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregateWorkflowExecutionsResponse_AggregationGroup) Reset() {
+	*x = AggregateWorkflowExecutionsResponse_AggregationGroup{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregateWorkflowExecutionsResponse_AggregationGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateWorkflowExecutionsResponse_AggregationGroup) ProtoMessage() {}
+
+func (x *AggregateWorkflowExecutionsResponse_AggregationGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateWorkflowExecutionsResponse_AggregationGroup.ProtoReflect.Descriptor instead.
+func (*AggregateWorkflowExecutionsResponse_AggregationGroup) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{106, 1}
+}
+
+func (x *AggregateWorkflowExecutionsResponse_AggregationGroup) GetGroupValue() *v1.Payload {
+	if x != nil {
+		return x.GroupValue
+	}
+	return nil
+}
+
+func (x *AggregateWorkflowExecutionsResponse_AggregationGroup) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AggregateWorkflowExecutionsResponse_PercentileValue struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Percent float64                `protobuf:"fixed64,1,opt,name=percent,proto3" json:"percent,omitempty"`
+	// ExecutionDuration values are in nanoseconds. Zero if no execution has a value.
+	Value         float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregateWorkflowExecutionsResponse_PercentileValue) Reset() {
+	*x = AggregateWorkflowExecutionsResponse_PercentileValue{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregateWorkflowExecutionsResponse_PercentileValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateWorkflowExecutionsResponse_PercentileValue) ProtoMessage() {}
+
+func (x *AggregateWorkflowExecutionsResponse_PercentileValue) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateWorkflowExecutionsResponse_PercentileValue.ProtoReflect.Descriptor instead.
+func (*AggregateWorkflowExecutionsResponse_PercentileValue) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{106, 2}
+}
+
+func (x *AggregateWorkflowExecutionsResponse_PercentileValue) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *AggregateWorkflowExecutionsResponse_PercentileValue) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
 var File_temporal_server_api_adminservice_v1_request_response_proto protoreflect.FileDescriptor
 
 const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc = "" +
 	"\n" +
-	":temporal/server/api/adminservice/v1/request_response.proto\x12#temporal.server.api.adminservice.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\"temporal/api/enums/v1/common.proto\x1a&temporal/api/enums/v1/task_queue.proto\x1a$temporal/api/common/v1/message.proto\x1a%temporal/api/version/v1/message.proto\x1a&temporal/api/workflow/v1/message.proto\x1a'temporal/api/namespace/v1/message.proto\x1a)temporal/api/replication/v1/message.proto\x1a'temporal/api/taskqueue/v1/message.proto\x1a,temporal/server/api/cluster/v1/message.proto\x1a'temporal/server/api/common/v1/dlq.proto\x1a)temporal/server/api/enums/v1/common.proto\x1a*temporal/server/api/enums/v1/cluster.proto\x1a'temporal/server/api/enums/v1/task.proto\x1a&temporal/server/api/enums/v1/dlq.proto\x1a,temporal/server/api/history/v1/message.proto\x1a.temporal/server/api/namespace/v1/message.proto\x1a0temporal/server/api/replication/v1/message.proto\x1a9temporal/server/api/persistence/v1/cluster_metadata.proto\x1a3temporal/server/api/persistence/v1/executions.proto\x1a?temporal/server/api/persistence/v1/workflow_mutable_state.proto\x1a.temporal/server/api/persistence/v1/tasks.proto\x1a,temporal/server/api/persistence/v1/hsm.proto\x1a.temporal/server/api/taskqueue/v1/message.proto\"\x83\x01\n" +
+	":temporal/server/api/adminservice/v1/request_response.proto\x12#temporal.server.api.adminservice.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a#temporal/api/batch/v1/message.proto\x1a+temporal/api/enums/v1/batch_operation.proto\x1a\"temporal/api/enums/v1/common.proto\x1a&temporal/api/enums/v1/task_queue.proto\x1a$temporal/api/enums/v1/workflow.proto\x1a$temporal/api/common/v1/message.proto\x1a%temporal/api/version/v1/message.proto\x1a&temporal/api/workflow/v1/message.proto\x1a'temporal/api/namespace/v1/message.proto\x1a)temporal/api/replication/v1/message.proto\x1a&temporal/api/schedule/v1/message.proto\x1a'temporal/api/taskqueue/v1/message.proto\x1a,temporal/server/api/cluster/v1/message.proto\x1a'temporal/server/api/common/v1/dlq.proto\x1a)temporal/server/api/enums/v1/common.proto\x1a*temporal/server/api/enums/v1/cluster.proto\x1a'temporal/server/api/enums/v1/task.proto\x1a&temporal/server/api/enums/v1/dlq.proto\x1a,temporal/server/api/history/v1/message.proto\x1a.temporal/server/api/namespace/v1/message.proto\x1a0temporal/server/api/replication/v1/message.proto\x1a-temporal/server/api/schedule/v1/message.proto\x1a.temporal/server/api/persistence/v1/audit.proto\x1a9temporal/server/api/persistence/v1/cluster_metadata.proto\x1a3temporal/server/api/persistence/v1/executions.proto\x1a?temporal/server/api/persistence/v1/workflow_mutable_state.proto\x1a.temporal/server/api/persistence/v1/tasks.proto\x1a,temporal/server/api/persistence/v1/hsm.proto\x1a.temporal/server/api/taskqueue/v1/message.proto\"\x83\x01\n" +
 	"\x1aRebuildMutableStateRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12G\n" +
 	"\texecution\x18\x02 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\"\x1d\n" +
@@ -5744,7 +7874,175 @@ const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc =
 	"\x14task_queue_partition\x18\x02 \x01(\v24.temporal.server.api.taskqueue.v1.TaskQueuePartitionR\x12taskQueuePartition\"F\n" +
 	"%ForceUnloadTaskQueuePartitionResponse\x12\x1d\n" +
 	"\n" +
-	"was_loaded\x18\x01 \x01(\bR\twasLoadedB8Z6go.temporal.io/server/api/adminservice/v1;adminserviceb\x06proto3"
+	"was_loaded\x18\x01 \x01(\bR\twasLoaded\"\xd2\x03\n" +
+	"\x17SimulateScheduleRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1f\n" +
+	"\vschedule_id\x18\x02 \x01(\tR\n" +
+	"scheduleId\x12>\n" +
+	"\bschedule\x18\x03 \x01(\v2\".temporal.api.schedule.v1.ScheduleR\bschedule\x129\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12M\n" +
+	"\x15workflow_run_duration\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x13workflowRunDuration\x12'\n" +
+	"\x0fmaximum_actions\x18\a \x01(\x05R\x0emaximumActions\x12N\n" +
+	"\x15scheduler_resume_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x13schedulerResumeTime\"\xb3\x02\n" +
+	"\x18SimulateScheduleResponse\x12R\n" +
+	"\aactions\x18\x01 \x03(\v28.temporal.server.api.schedule.v1.SimulatedScheduleActionR\aactions\x12!\n" +
+	"\faction_count\x18\x02 \x01(\x03R\vactionCount\x12'\n" +
+	"\x0foverlap_skipped\x18\x03 \x01(\x03R\x0eoverlapSkipped\x12%\n" +
+	"\x0ebuffer_dropped\x18\x04 \x01(\x03R\rbufferDropped\x122\n" +
+	"\x15missed_catchup_window\x18\x05 \x01(\x03R\x13missedCatchupWindow\x12\x1c\n" +
+	"\ttruncated\x18\x06 \x01(\bR\ttruncated\"\x8c\x01\n" +
+	"\x1dUpsertBusinessCalendarRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12M\n" +
+	"\bcalendar\x18\x02 \x01(\v21.temporal.server.api.schedule.v1.BusinessCalendarR\bcalendar\"o\n" +
+	"\x1eUpsertBusinessCalendarResponse\x12M\n" +
+	"\bcalendar\x18\x01 \x01(\v21.temporal.server.api.schedule.v1.BusinessCalendarR\bcalendar\"Q\n" +
+	"\x1dDeleteBusinessCalendarRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\" \n" +
+	"\x1eDeleteBusinessCalendarResponse\"<\n" +
+	"\x1cListBusinessCalendarsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\"p\n" +
+	"\x1dListBusinessCalendarsResponse\x12O\n" +
+	"\tcalendars\x18\x01 \x03(\v21.temporal.server.api.schedule.v1.BusinessCalendarR\tcalendars\"\x89\x02\n" +
+	"#SetScheduleBusinessCalendarsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1f\n" +
+	"\vschedule_id\x18\x02 \x01(\tR\n" +
+	"scheduleId\x12h\n" +
+	"\x12business_calendars\x18\x03 \x03(\v29.temporal.server.api.schedule.v1.ScheduleBusinessCalendarR\x11businessCalendars\x12\x1a\n" +
+	"\bidentity\x18\x04 \x01(\tR\bidentity\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x05 \x01(\tR\trequestId\"\x90\x01\n" +
+	"$SetScheduleBusinessCalendarsResponse\x12h\n" +
+	"\x12business_calendars\x18\x01 \x03(\v29.temporal.server.api.schedule.v1.ScheduleBusinessCalendarR\x11businessCalendars\"{\n" +
+	"\x16ListAuditEventsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\fR\rnextPageToken\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\"\x89\x01\n" +
+	"\x17ListAuditEventsResponse\x12F\n" +
+	"\x06events\x18\x01 \x03(\v2..temporal.server.api.persistence.v1.AuditEventR\x06events\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\fR\rnextPageToken\"\xff\x02\n" +
+	"\x1eMigrateTaskQueueBacklogRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12f\n" +
+	"\x14task_queue_partition\x18\x02 \x01(\v24.temporal.server.api.taskqueue.v1.TaskQueuePartitionR\x12taskQueuePartition\x12\x19\n" +
+	"\bbuild_id\x18\x03 \x01(\tR\abuildId\x12*\n" +
+	"\x11target_task_queue\x18\x04 \x01(\tR\x0ftargetTaskQueue\x12\x12\n" +
+	"\x04copy\x18\x05 \x01(\bR\x04copy\x12\x1f\n" +
+	"\vworkflow_id\x18\x06 \x01(\tR\n" +
+	"workflowId\x12\x1a\n" +
+	"\bsubqueue\x18\a \x01(\x05R\bsubqueue\x12 \n" +
+	"\flast_task_id\x18\b \x01(\x03R\n" +
+	"lastTaskId\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\t \x01(\x05R\tbatchSize\"\xbf\x01\n" +
+	"\x1fMigrateTaskQueueBacklogResponse\x12\x1a\n" +
+	"\bsubqueue\x18\x01 \x01(\x05R\bsubqueue\x12 \n" +
+	"\flast_task_id\x18\x02 \x01(\x03R\n" +
+	"lastTaskId\x12#\n" +
+	"\rtasks_scanned\x18\x03 \x01(\x05R\ftasksScanned\x12%\n" +
+	"\x0etasks_migrated\x18\x04 \x01(\x05R\rtasksMigrated\x12\x12\n" +
+	"\x04done\x18\x05 \x01(\bR\x04done\"\xde\x02\n" +
+	"#UpdateActivityTypeRateLimitsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1d\n" +
+	"\n" +
+	"task_queue\x18\x02 \x01(\tR\ttaskQueue\x12\x83\x01\n" +
+	"\x0fset_rate_limits\x18\x03 \x03(\v2[.temporal.server.api.adminservice.v1.UpdateActivityTypeRateLimitsRequest.SetRateLimitsEntryR\rsetRateLimits\x122\n" +
+	"\x15remove_activity_types\x18\x04 \x03(\tR\x13removeActivityTypes\x1a@\n" +
+	"\x12SetRateLimitsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\xe1\x01\n" +
+	"$UpdateActivityTypeRateLimitsResponse\x12z\n" +
+	"\vrate_limits\x18\x01 \x03(\v2Y.temporal.server.api.adminservice.v1.UpdateActivityTypeRateLimitsResponse.RateLimitsEntryR\n" +
+	"rateLimits\x1a=\n" +
+	"\x0fRateLimitsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\xa3\x04\n" +
+	"\"AggregateWorkflowExecutionsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12z\n" +
+	"\thistogram\x18\x03 \x01(\v2\\.temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsRequest.HistogramAggregationR\thistogram\x12\x80\x01\n" +
+	"\vpercentiles\x18\x04 \x01(\v2^.temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsRequest.PercentilesAggregationR\vpercentiles\x1a~\n" +
+	"\x14HistogramAggregation\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x125\n" +
+	"\binterval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12\x19\n" +
+	"\bgroup_by\x18\x03 \x01(\tR\agroupBy\x1aJ\n" +
+	"\x16PercentilesAggregation\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1a\n" +
+	"\bpercents\x18\x02 \x03(\x01R\bpercents\"\x9c\x05\n" +
+	"#AggregateWorkflowExecutionsResponse\x12r\n" +
+	"\abuckets\x18\x01 \x03(\v2X.temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse.HistogramBucketR\abuckets\x12z\n" +
+	"\vpercentiles\x18\x02 \x03(\v2X.temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse.PercentileValueR\vpercentiles\x1a\xd5\x01\n" +
+	"\x0fHistogramBucket\x129\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12q\n" +
+	"\x06groups\x18\x03 \x03(\v2Y.temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse.AggregationGroupR\x06groups\x1aj\n" +
+	"\x10AggregationGroup\x12@\n" +
+	"\vgroup_value\x18\x01 \x01(\v2\x1f.temporal.api.common.v1.PayloadR\n" +
+	"groupValue\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x1aA\n" +
+	"\x0fPercentileValue\x12\x18\n" +
+	"\apercent\x18\x01 \x01(\x01R\apercent\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\"\xd0\b\n" +
+	"\x1aStartBatchOperationRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12)\n" +
+	"\x10visibility_query\x18\x02 \x01(\tR\x0fvisibilityQuery\x12I\n" +
+	"\n" +
+	"executions\x18\x03 \x03(\v2).temporal.api.common.v1.WorkflowExecutionR\n" +
+	"executions\x12\x15\n" +
+	"\x06job_id\x18\x04 \x01(\tR\x05jobId\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1a\n" +
+	"\bidentity\x18\x06 \x01(\tR\bidentity\x129\n" +
+	"\x19max_operations_per_second\x18\a \x01(\x02R\x16maxOperationsPerSecond\x12\x85\x01\n" +
+	"\x1bupsert_properties_operation\x18\b \x01(\v2C.temporal.server.api.adminservice.v1.BatchOperationUpsertPropertiesH\x00R\x19upsertPropertiesOperation\x12\x83\x01\n" +
+	"\x1bsignal_with_start_operation\x18\t \x01(\v2B.temporal.server.api.adminservice.v1.BatchOperationSignalWithStartH\x00R\x18signalWithStartOperation\x12g\n" +
+	"\x15termination_operation\x18\v \x01(\v20.temporal.api.batch.v1.BatchOperationTerminationH\x00R\x14terminationOperation\x12X\n" +
+	"\x10signal_operation\x18\f \x01(\v2+.temporal.api.batch.v1.BatchOperationSignalH\x00R\x0fsignalOperation\x12j\n" +
+	"\x16cancellation_operation\x18\r \x01(\v21.temporal.api.batch.v1.BatchOperationCancellationH\x00R\x15cancellationOperation\x12^\n" +
+	"\x12deletion_operation\x18\x0e \x01(\v2-.temporal.api.batch.v1.BatchOperationDeletionH\x00R\x11deletionOperation\x12U\n" +
+	"\x0freset_operation\x18\x0f \x01(\v2*.temporal.api.batch.v1.BatchOperationResetH\x00R\x0eresetOperation\x12\x17\n" +
+	"\adry_run\x18\n" +
+	" \x01(\bR\x06dryRunB\v\n" +
+	"\toperation\"\x1d\n" +
+	"\x1bStartBatchOperationResponse\"}\n" +
+	"\x1eGetBatchOperationReportRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x15\n" +
+	"\x06job_id\x18\x02 \x01(\tR\x05jobId\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\fR\rnextPageToken\"\x9a\x04\n" +
+	"\x1fGetBatchOperationReportResponse\x12@\n" +
+	"\x05state\x18\x01 \x01(\x0e2*.temporal.api.enums.v1.BatchOperationStateR\x05state\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x122\n" +
+	"\x15total_operation_count\x18\x03 \x01(\x03R\x13totalOperationCount\x128\n" +
+	"\x18resolved_operation_count\x18\x04 \x01(\x03R\x16resolvedOperationCount\x128\n" +
+	"\x18complete_operation_count\x18\x05 \x01(\x03R\x16completeOperationCount\x126\n" +
+	"\x17failure_operation_count\x18\x06 \x01(\x03R\x15failureOperationCount\x12V\n" +
+	"\bfailures\x18\a \x03(\v2:.temporal.server.api.adminservice.v1.BatchOperationFailureR\bfailures\x12<\n" +
+	"\x1afailure_manifest_truncated\x18\b \x01(\bR\x18failureManifestTruncated\x12&\n" +
+	"\x0fnext_page_token\x18\t \x01(\fR\rnextPageToken\"\x92\x01\n" +
+	"\x15BatchOperationFailure\x12G\n" +
+	"\texecution\x18\x01 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x1a\n" +
+	"\battempts\x18\x03 \x01(\x05R\battempts\"\xa9\x01\n" +
+	"\x1eBatchOperationUpsertProperties\x12U\n" +
+	"\x11search_attributes\x18\x01 \x01(\v2(.temporal.api.common.v1.SearchAttributesR\x10searchAttributes\x120\n" +
+	"\x04memo\x18\x02 \x01(\v2\x1c.temporal.api.common.v1.MemoR\x04memo\"\xea\x06\n" +
+	"\x1dBatchOperationSignalWithStart\x12I\n" +
+	"\rworkflow_type\x18\x01 \x01(\v2$.temporal.api.common.v1.WorkflowTypeR\fworkflowType\x12C\n" +
+	"\n" +
+	"task_queue\x18\x02 \x01(\v2$.temporal.api.taskqueue.v1.TaskQueueR\ttaskQueue\x126\n" +
+	"\x05input\x18\x03 \x01(\v2 .temporal.api.common.v1.PayloadsR\x05input\x12W\n" +
+	"\x1aworkflow_execution_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x18workflowExecutionTimeout\x12K\n" +
+	"\x14workflow_run_timeout\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x12workflowRunTimeout\x12M\n" +
+	"\x15workflow_task_timeout\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x13workflowTaskTimeout\x12e\n" +
+	"\x18workflow_id_reuse_policy\x18\a \x01(\x0e2,.temporal.api.enums.v1.WorkflowIdReusePolicyR\x15workflowIdReusePolicy\x12\x1f\n" +
+	"\vsignal_name\x18\b \x01(\tR\n" +
+	"signalName\x12C\n" +
+	"\fsignal_input\x18\t \x01(\v2 .temporal.api.common.v1.PayloadsR\vsignalInput\x120\n" +
+	"\x04memo\x18\n" +
+	" \x01(\v2\x1c.temporal.api.common.v1.MemoR\x04memo\x12U\n" +
+	"\x11search_attributes\x18\v \x01(\v2(.temporal.api.common.v1.SearchAttributesR\x10searchAttributes\x126\n" +
+	"\x06header\x18\f \x01(\v2\x1e.temporal.api.common.v1.HeaderR\x06headerB8Z6go.temporal.io/server/api/adminservice/v1;adminserviceb\x06proto3"
 
 var (
 	file_temporal_server_api_adminservice_v1_request_response_proto_rawDescOnce sync.Once
@@ -5758,245 +8056,343 @@ func file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescData
 }
 
-var file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes = make([]protoimpl.MessageInfo, 99)
+var file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes = make([]protoimpl.MessageInfo, 131)
 var file_temporal_server_api_adminservice_v1_request_response_proto_goTypes = []any{
-	(*RebuildMutableStateRequest)(nil),                  // 0: temporal.server.api.adminservice.v1.RebuildMutableStateRequest
-	(*RebuildMutableStateResponse)(nil),                 // 1: temporal.server.api.adminservice.v1.RebuildMutableStateResponse
-	(*ImportWorkflowExecutionRequest)(nil),              // 2: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest
-	(*ImportWorkflowExecutionResponse)(nil),             // 3: temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
-	(*DescribeMutableStateRequest)(nil),                 // 4: temporal.server.api.adminservice.v1.DescribeMutableStateRequest
-	(*DescribeMutableStateResponse)(nil),                // 5: temporal.server.api.adminservice.v1.DescribeMutableStateResponse
-	(*DescribeHistoryHostRequest)(nil),                  // 6: temporal.server.api.adminservice.v1.DescribeHistoryHostRequest
-	(*DescribeHistoryHostResponse)(nil),                 // 7: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
-	(*CloseShardRequest)(nil),                           // 8: temporal.server.api.adminservice.v1.CloseShardRequest
-	(*CloseShardResponse)(nil),                          // 9: temporal.server.api.adminservice.v1.CloseShardResponse
-	(*GetShardRequest)(nil),                             // 10: temporal.server.api.adminservice.v1.GetShardRequest
-	(*GetShardResponse)(nil),                            // 11: temporal.server.api.adminservice.v1.GetShardResponse
-	(*ListHistoryTasksRequest)(nil),                     // 12: temporal.server.api.adminservice.v1.ListHistoryTasksRequest
-	(*ListHistoryTasksResponse)(nil),                    // 13: temporal.server.api.adminservice.v1.ListHistoryTasksResponse
-	(*Task)(nil),                                        // 14: temporal.server.api.adminservice.v1.Task
-	(*RemoveTaskRequest)(nil),                           // 15: temporal.server.api.adminservice.v1.RemoveTaskRequest
-	(*RemoveTaskResponse)(nil),                          // 16: temporal.server.api.adminservice.v1.RemoveTaskResponse
-	(*GetWorkflowExecutionRawHistoryV2Request)(nil),     // 17: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request
-	(*GetWorkflowExecutionRawHistoryV2Response)(nil),    // 18: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
-	(*GetWorkflowExecutionRawHistoryRequest)(nil),       // 19: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest
-	(*GetWorkflowExecutionRawHistoryResponse)(nil),      // 20: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
-	(*GetReplicationMessagesRequest)(nil),               // 21: temporal.server.api.adminservice.v1.GetReplicationMessagesRequest
-	(*GetReplicationMessagesResponse)(nil),              // 22: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
-	(*GetNamespaceReplicationMessagesRequest)(nil),      // 23: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest
-	(*GetNamespaceReplicationMessagesResponse)(nil),     // 24: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
-	(*GetDLQReplicationMessagesRequest)(nil),            // 25: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest
-	(*GetDLQReplicationMessagesResponse)(nil),           // 26: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
-	(*ReapplyEventsRequest)(nil),                        // 27: temporal.server.api.adminservice.v1.ReapplyEventsRequest
-	(*ReapplyEventsResponse)(nil),                       // 28: temporal.server.api.adminservice.v1.ReapplyEventsResponse
-	(*AddSearchAttributesRequest)(nil),                  // 29: temporal.server.api.adminservice.v1.AddSearchAttributesRequest
-	(*AddSearchAttributesResponse)(nil),                 // 30: temporal.server.api.adminservice.v1.AddSearchAttributesResponse
-	(*RemoveSearchAttributesRequest)(nil),               // 31: temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest
-	(*RemoveSearchAttributesResponse)(nil),              // 32: temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
-	(*GetSearchAttributesRequest)(nil),                  // 33: temporal.server.api.adminservice.v1.GetSearchAttributesRequest
-	(*GetSearchAttributesResponse)(nil),                 // 34: temporal.server.api.adminservice.v1.GetSearchAttributesResponse
-	(*DescribeClusterRequest)(nil),                      // 35: temporal.server.api.adminservice.v1.DescribeClusterRequest
-	(*DescribeClusterResponse)(nil),                     // 36: temporal.server.api.adminservice.v1.DescribeClusterResponse
-	(*ListClustersRequest)(nil),                         // 37: temporal.server.api.adminservice.v1.ListClustersRequest
-	(*ListClustersResponse)(nil),                        // 38: temporal.server.api.adminservice.v1.ListClustersResponse
-	(*AddOrUpdateRemoteClusterRequest)(nil),             // 39: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest
-	(*AddOrUpdateRemoteClusterResponse)(nil),            // 40: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
-	(*RemoveRemoteClusterRequest)(nil),                  // 41: temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest
-	(*RemoveRemoteClusterResponse)(nil),                 // 42: temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
-	(*ListClusterMembersRequest)(nil),                   // 43: temporal.server.api.adminservice.v1.ListClusterMembersRequest
-	(*ListClusterMembersResponse)(nil),                  // 44: temporal.server.api.adminservice.v1.ListClusterMembersResponse
-	(*GetDLQMessagesRequest)(nil),                       // 45: temporal.server.api.adminservice.v1.GetDLQMessagesRequest
-	(*GetDLQMessagesResponse)(nil),                      // 46: temporal.server.api.adminservice.v1.GetDLQMessagesResponse
-	(*PurgeDLQMessagesRequest)(nil),                     // 47: temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest
-	(*PurgeDLQMessagesResponse)(nil),                    // 48: temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
-	(*MergeDLQMessagesRequest)(nil),                     // 49: temporal.server.api.adminservice.v1.MergeDLQMessagesRequest
-	(*MergeDLQMessagesResponse)(nil),                    // 50: temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
-	(*RefreshWorkflowTasksRequest)(nil),                 // 51: temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest
-	(*RefreshWorkflowTasksResponse)(nil),                // 52: temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
-	(*ResendReplicationTasksRequest)(nil),               // 53: temporal.server.api.adminservice.v1.ResendReplicationTasksRequest
-	(*ResendReplicationTasksResponse)(nil),              // 54: temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
-	(*GetTaskQueueTasksRequest)(nil),                    // 55: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest
-	(*GetTaskQueueTasksResponse)(nil),                   // 56: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
-	(*DeleteWorkflowExecutionRequest)(nil),              // 57: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
-	(*DeleteWorkflowExecutionResponse)(nil),             // 58: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
-	(*StreamWorkflowReplicationMessagesRequest)(nil),    // 59: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
-	(*StreamWorkflowReplicationMessagesResponse)(nil),   // 60: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
-	(*GetNamespaceRequest)(nil),                         // 61: temporal.server.api.adminservice.v1.GetNamespaceRequest
-	(*GetNamespaceResponse)(nil),                        // 62: temporal.server.api.adminservice.v1.GetNamespaceResponse
-	(*GetDLQTasksRequest)(nil),                          // 63: temporal.server.api.adminservice.v1.GetDLQTasksRequest
-	(*GetDLQTasksResponse)(nil),                         // 64: temporal.server.api.adminservice.v1.GetDLQTasksResponse
-	(*PurgeDLQTasksRequest)(nil),                        // 65: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
-	(*PurgeDLQTasksResponse)(nil),                       // 66: temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
-	(*DLQJobToken)(nil),                                 // 67: temporal.server.api.adminservice.v1.DLQJobToken
-	(*MergeDLQTasksRequest)(nil),                        // 68: temporal.server.api.adminservice.v1.MergeDLQTasksRequest
-	(*MergeDLQTasksResponse)(nil),                       // 69: temporal.server.api.adminservice.v1.MergeDLQTasksResponse
-	(*DescribeDLQJobRequest)(nil),                       // 70: temporal.server.api.adminservice.v1.DescribeDLQJobRequest
-	(*DescribeDLQJobResponse)(nil),                      // 71: temporal.server.api.adminservice.v1.DescribeDLQJobResponse
-	(*CancelDLQJobRequest)(nil),                         // 72: temporal.server.api.adminservice.v1.CancelDLQJobRequest
-	(*CancelDLQJobResponse)(nil),                        // 73: temporal.server.api.adminservice.v1.CancelDLQJobResponse
-	(*AddTasksRequest)(nil),                             // 74: temporal.server.api.adminservice.v1.AddTasksRequest
-	(*AddTasksResponse)(nil),                            // 75: temporal.server.api.adminservice.v1.AddTasksResponse
-	(*ListQueuesRequest)(nil),                           // 76: temporal.server.api.adminservice.v1.ListQueuesRequest
-	(*ListQueuesResponse)(nil),                          // 77: temporal.server.api.adminservice.v1.ListQueuesResponse
-	(*DeepHealthCheckRequest)(nil),                      // 78: temporal.server.api.adminservice.v1.DeepHealthCheckRequest
-	(*DeepHealthCheckResponse)(nil),                     // 79: temporal.server.api.adminservice.v1.DeepHealthCheckResponse
-	(*SyncWorkflowStateRequest)(nil),                    // 80: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
-	(*SyncWorkflowStateResponse)(nil),                   // 81: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
-	(*GenerateLastHistoryReplicationTasksRequest)(nil),  // 82: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
-	(*GenerateLastHistoryReplicationTasksResponse)(nil), // 83: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
-	(*DescribeTaskQueuePartitionRequest)(nil),           // 84: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
-	(*InternalTaskQueueStatus)(nil),                     // 85: temporal.server.api.adminservice.v1.InternalTaskQueueStatus
-	(*DescribeTaskQueuePartitionResponse)(nil),          // 86: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
-	(*ForceUnloadTaskQueuePartitionRequest)(nil),        // 87: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
-	(*ForceUnloadTaskQueuePartitionResponse)(nil),       // 88: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
-	nil,                                       // 89: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry
-	nil,                                       // 90: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry
-	nil,                                       // 91: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry
-	nil,                                       // 92: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry
-	nil,                                       // 93: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry
-	nil,                                       // 94: temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry
-	nil,                                       // 95: temporal.server.api.adminservice.v1.DescribeClusterResponse.TagsEntry
-	(*AddTasksRequest_Task)(nil),              // 96: temporal.server.api.adminservice.v1.AddTasksRequest.Task
-	(*ListQueuesResponse_QueueInfo)(nil),      // 97: temporal.server.api.adminservice.v1.ListQueuesResponse.QueueInfo
-	nil,                                       // 98: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry
-	(*v1.WorkflowExecution)(nil),              // 99: temporal.api.common.v1.WorkflowExecution
-	(*v1.DataBlob)(nil),                       // 100: temporal.api.common.v1.DataBlob
-	(*v11.VersionHistory)(nil),                // 101: temporal.server.api.history.v1.VersionHistory
-	(*v12.WorkflowMutableState)(nil),          // 102: temporal.server.api.persistence.v1.WorkflowMutableState
-	(*v13.NamespaceCacheInfo)(nil),            // 103: temporal.server.api.namespace.v1.NamespaceCacheInfo
-	(*v12.ShardInfo)(nil),                     // 104: temporal.server.api.persistence.v1.ShardInfo
-	(*v11.TaskRange)(nil),                     // 105: temporal.server.api.history.v1.TaskRange
-	(v14.TaskType)(0),                         // 106: temporal.server.api.enums.v1.TaskType
-	(*timestamppb.Timestamp)(nil),             // 107: google.protobuf.Timestamp
-	(*v15.ReplicationToken)(nil),              // 108: temporal.server.api.replication.v1.ReplicationToken
-	(*v15.ReplicationMessages)(nil),           // 109: temporal.server.api.replication.v1.ReplicationMessages
-	(*v15.ReplicationTaskInfo)(nil),           // 110: temporal.server.api.replication.v1.ReplicationTaskInfo
-	(*v15.ReplicationTask)(nil),               // 111: temporal.server.api.replication.v1.ReplicationTask
-	(*v17.WorkflowExecutionInfo)(nil),         // 112: temporal.api.workflow.v1.WorkflowExecutionInfo
-	(*v18.MembershipInfo)(nil),                // 113: temporal.server.api.cluster.v1.MembershipInfo
-	(*v19.VersionInfo)(nil),                   // 114: temporal.api.version.v1.VersionInfo
-	(*v12.ClusterMetadata)(nil),               // 115: temporal.server.api.persistence.v1.ClusterMetadata
-	(*durationpb.Duration)(nil),               // 116: google.protobuf.Duration
-	(v14.ClusterMemberRole)(0),                // 117: temporal.server.api.enums.v1.ClusterMemberRole
-	(*v18.ClusterMember)(nil),                 // 118: temporal.server.api.cluster.v1.ClusterMember
-	(v14.DeadLetterQueueType)(0),              // 119: temporal.server.api.enums.v1.DeadLetterQueueType
-	(v16.TaskQueueType)(0),                    // 120: temporal.api.enums.v1.TaskQueueType
-	(*v12.AllocatedTaskInfo)(nil),             // 121: temporal.server.api.persistence.v1.AllocatedTaskInfo
-	(*v15.SyncReplicationState)(nil),          // 122: temporal.server.api.replication.v1.SyncReplicationState
-	(*v15.WorkflowReplicationMessages)(nil),   // 123: temporal.server.api.replication.v1.WorkflowReplicationMessages
-	(*v110.NamespaceInfo)(nil),                // 124: temporal.api.namespace.v1.NamespaceInfo
-	(*v110.NamespaceConfig)(nil),              // 125: temporal.api.namespace.v1.NamespaceConfig
-	(*v111.NamespaceReplicationConfig)(nil),   // 126: temporal.api.replication.v1.NamespaceReplicationConfig
-	(*v111.FailoverStatus)(nil),               // 127: temporal.api.replication.v1.FailoverStatus
-	(*v112.HistoryDLQKey)(nil),                // 128: temporal.server.api.common.v1.HistoryDLQKey
-	(*v112.HistoryDLQTask)(nil),               // 129: temporal.server.api.common.v1.HistoryDLQTask
-	(*v112.HistoryDLQTaskMetadata)(nil),       // 130: temporal.server.api.common.v1.HistoryDLQTaskMetadata
-	(v14.DLQOperationType)(0),                 // 131: temporal.server.api.enums.v1.DLQOperationType
-	(v14.DLQOperationState)(0),                // 132: temporal.server.api.enums.v1.DLQOperationState
-	(v14.HealthState)(0),                      // 133: temporal.server.api.enums.v1.HealthState
-	(*v12.VersionedTransition)(nil),           // 134: temporal.server.api.persistence.v1.VersionedTransition
-	(*v11.VersionHistories)(nil),              // 135: temporal.server.api.history.v1.VersionHistories
-	(*v15.VersionedTransitionArtifact)(nil),   // 136: temporal.server.api.replication.v1.VersionedTransitionArtifact
-	(*v113.TaskQueuePartition)(nil),           // 137: temporal.server.api.taskqueue.v1.TaskQueuePartition
-	(*v114.TaskQueueVersionSelection)(nil),    // 138: temporal.api.taskqueue.v1.TaskQueueVersionSelection
-	(*v114.TaskIdBlock)(nil),                  // 139: temporal.api.taskqueue.v1.TaskIdBlock
-	(v16.IndexedValueType)(0),                 // 140: temporal.api.enums.v1.IndexedValueType
-	(*v113.TaskQueueVersionInfoInternal)(nil), // 141: temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal
+	(*RebuildMutableStateRequest)(nil),                                // 0: temporal.server.api.adminservice.v1.RebuildMutableStateRequest
+	(*RebuildMutableStateResponse)(nil),                               // 1: temporal.server.api.adminservice.v1.RebuildMutableStateResponse
+	(*ImportWorkflowExecutionRequest)(nil),                            // 2: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest
+	(*ImportWorkflowExecutionResponse)(nil),                           // 3: temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
+	(*DescribeMutableStateRequest)(nil),                               // 4: temporal.server.api.adminservice.v1.DescribeMutableStateRequest
+	(*DescribeMutableStateResponse)(nil),                              // 5: temporal.server.api.adminservice.v1.DescribeMutableStateResponse
+	(*DescribeHistoryHostRequest)(nil),                                // 6: temporal.server.api.adminservice.v1.DescribeHistoryHostRequest
+	(*DescribeHistoryHostResponse)(nil),                               // 7: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
+	(*CloseShardRequest)(nil),                                         // 8: temporal.server.api.adminservice.v1.CloseShardRequest
+	(*CloseShardResponse)(nil),                                        // 9: temporal.server.api.adminservice.v1.CloseShardResponse
+	(*GetShardRequest)(nil),                                           // 10: temporal.server.api.adminservice.v1.GetShardRequest
+	(*GetShardResponse)(nil),                                          // 11: temporal.server.api.adminservice.v1.GetShardResponse
+	(*ListHistoryTasksRequest)(nil),                                   // 12: temporal.server.api.adminservice.v1.ListHistoryTasksRequest
+	(*ListHistoryTasksResponse)(nil),                                  // 13: temporal.server.api.adminservice.v1.ListHistoryTasksResponse
+	(*Task)(nil),                                                      // 14: temporal.server.api.adminservice.v1.Task
+	(*RemoveTaskRequest)(nil),                                         // 15: temporal.server.api.adminservice.v1.RemoveTaskRequest
+	(*RemoveTaskResponse)(nil),                                        // 16: temporal.server.api.adminservice.v1.RemoveTaskResponse
+	(*GetWorkflowExecutionRawHistoryV2Request)(nil),                   // 17: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request
+	(*GetWorkflowExecutionRawHistoryV2Response)(nil),                  // 18: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
+	(*GetWorkflowExecutionRawHistoryRequest)(nil),                     // 19: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest
+	(*GetWorkflowExecutionRawHistoryResponse)(nil),                    // 20: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
+	(*GetReplicationMessagesRequest)(nil),                             // 21: temporal.server.api.adminservice.v1.GetReplicationMessagesRequest
+	(*GetReplicationMessagesResponse)(nil),                            // 22: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
+	(*GetNamespaceReplicationMessagesRequest)(nil),                    // 23: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest
+	(*GetNamespaceReplicationMessagesResponse)(nil),                   // 24: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
+	(*GetDLQReplicationMessagesRequest)(nil),                          // 25: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest
+	(*GetDLQReplicationMessagesResponse)(nil),                         // 26: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
+	(*ReapplyEventsRequest)(nil),                                      // 27: temporal.server.api.adminservice.v1.ReapplyEventsRequest
+	(*ReapplyEventsResponse)(nil),                                     // 28: temporal.server.api.adminservice.v1.ReapplyEventsResponse
+	(*AddSearchAttributesRequest)(nil),                                // 29: temporal.server.api.adminservice.v1.AddSearchAttributesRequest
+	(*AddSearchAttributesResponse)(nil),                               // 30: temporal.server.api.adminservice.v1.AddSearchAttributesResponse
+	(*RemoveSearchAttributesRequest)(nil),                             // 31: temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest
+	(*RemoveSearchAttributesResponse)(nil),                            // 32: temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
+	(*GetSearchAttributesRequest)(nil),                                // 33: temporal.server.api.adminservice.v1.GetSearchAttributesRequest
+	(*GetSearchAttributesResponse)(nil),                               // 34: temporal.server.api.adminservice.v1.GetSearchAttributesResponse
+	(*DescribeClusterRequest)(nil),                                    // 35: temporal.server.api.adminservice.v1.DescribeClusterRequest
+	(*DescribeClusterResponse)(nil),                                   // 36: temporal.server.api.adminservice.v1.DescribeClusterResponse
+	(*ListClustersRequest)(nil),                                       // 37: temporal.server.api.adminservice.v1.ListClustersRequest
+	(*ListClustersResponse)(nil),                                      // 38: temporal.server.api.adminservice.v1.ListClustersResponse
+	(*AddOrUpdateRemoteClusterRequest)(nil),                           // 39: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest
+	(*AddOrUpdateRemoteClusterResponse)(nil),                          // 40: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
+	(*RemoveRemoteClusterRequest)(nil),                                // 41: temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest
+	(*RemoveRemoteClusterResponse)(nil),                               // 42: temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
+	(*ListClusterMembersRequest)(nil),                                 // 43: temporal.server.api.adminservice.v1.ListClusterMembersRequest
+	(*ListClusterMembersResponse)(nil),                                // 44: temporal.server.api.adminservice.v1.ListClusterMembersResponse
+	(*GetDLQMessagesRequest)(nil),                                     // 45: temporal.server.api.adminservice.v1.GetDLQMessagesRequest
+	(*GetDLQMessagesResponse)(nil),                                    // 46: temporal.server.api.adminservice.v1.GetDLQMessagesResponse
+	(*PurgeDLQMessagesRequest)(nil),                                   // 47: temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest
+	(*PurgeDLQMessagesResponse)(nil),                                  // 48: temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
+	(*MergeDLQMessagesRequest)(nil),                                   // 49: temporal.server.api.adminservice.v1.MergeDLQMessagesRequest
+	(*MergeDLQMessagesResponse)(nil),                                  // 50: temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
+	(*RefreshWorkflowTasksRequest)(nil),                               // 51: temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest
+	(*RefreshWorkflowTasksResponse)(nil),                              // 52: temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
+	(*ResendReplicationTasksRequest)(nil),                             // 53: temporal.server.api.adminservice.v1.ResendReplicationTasksRequest
+	(*ResendReplicationTasksResponse)(nil),                            // 54: temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
+	(*GetTaskQueueTasksRequest)(nil),                                  // 55: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest
+	(*GetTaskQueueTasksResponse)(nil),                                 // 56: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
+	(*DeleteWorkflowExecutionRequest)(nil),                            // 57: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
+	(*DeleteWorkflowExecutionResponse)(nil),                           // 58: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
+	(*StreamWorkflowReplicationMessagesRequest)(nil),                  // 59: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
+	(*StreamWorkflowReplicationMessagesResponse)(nil),                 // 60: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
+	(*GetNamespaceRequest)(nil),                                       // 61: temporal.server.api.adminservice.v1.GetNamespaceRequest
+	(*GetNamespaceResponse)(nil),                                      // 62: temporal.server.api.adminservice.v1.GetNamespaceResponse
+	(*GetDLQTasksRequest)(nil),                                        // 63: temporal.server.api.adminservice.v1.GetDLQTasksRequest
+	(*GetDLQTasksResponse)(nil),                                       // 64: temporal.server.api.adminservice.v1.GetDLQTasksResponse
+	(*PurgeDLQTasksRequest)(nil),                                      // 65: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
+	(*PurgeDLQTasksResponse)(nil),                                     // 66: temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
+	(*DLQJobToken)(nil),                                               // 67: temporal.server.api.adminservice.v1.DLQJobToken
+	(*MergeDLQTasksRequest)(nil),                                      // 68: temporal.server.api.adminservice.v1.MergeDLQTasksRequest
+	(*MergeDLQTasksResponse)(nil),                                     // 69: temporal.server.api.adminservice.v1.MergeDLQTasksResponse
+	(*DescribeDLQJobRequest)(nil),                                     // 70: temporal.server.api.adminservice.v1.DescribeDLQJobRequest
+	(*DescribeDLQJobResponse)(nil),                                    // 71: temporal.server.api.adminservice.v1.DescribeDLQJobResponse
+	(*CancelDLQJobRequest)(nil),                                       // 72: temporal.server.api.adminservice.v1.CancelDLQJobRequest
+	(*CancelDLQJobResponse)(nil),                                      // 73: temporal.server.api.adminservice.v1.CancelDLQJobResponse
+	(*AddTasksRequest)(nil),                                           // 74: temporal.server.api.adminservice.v1.AddTasksRequest
+	(*AddTasksResponse)(nil),                                          // 75: temporal.server.api.adminservice.v1.AddTasksResponse
+	(*ListQueuesRequest)(nil),                                         // 76: temporal.server.api.adminservice.v1.ListQueuesRequest
+	(*ListQueuesResponse)(nil),                                        // 77: temporal.server.api.adminservice.v1.ListQueuesResponse
+	(*DeepHealthCheckRequest)(nil),                                    // 78: temporal.server.api.adminservice.v1.DeepHealthCheckRequest
+	(*DeepHealthCheckResponse)(nil),                                   // 79: temporal.server.api.adminservice.v1.DeepHealthCheckResponse
+	(*SyncWorkflowStateRequest)(nil),                                  // 80: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
+	(*SyncWorkflowStateResponse)(nil),                                 // 81: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
+	(*GenerateLastHistoryReplicationTasksRequest)(nil),                // 82: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
+	(*GenerateLastHistoryReplicationTasksResponse)(nil),               // 83: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
+	(*DescribeTaskQueuePartitionRequest)(nil),                         // 84: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
+	(*InternalTaskQueueStatus)(nil),                                   // 85: temporal.server.api.adminservice.v1.InternalTaskQueueStatus
+	(*DescribeTaskQueuePartitionResponse)(nil),                        // 86: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
+	(*ForceUnloadTaskQueuePartitionRequest)(nil),                      // 87: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
+	(*ForceUnloadTaskQueuePartitionResponse)(nil),                     // 88: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
+	(*SimulateScheduleRequest)(nil),                                   // 89: temporal.server.api.adminservice.v1.SimulateScheduleRequest
+	(*SimulateScheduleResponse)(nil),                                  // 90: temporal.server.api.adminservice.v1.SimulateScheduleResponse
+	(*UpsertBusinessCalendarRequest)(nil),                             // 91: temporal.server.api.adminservice.v1.UpsertBusinessCalendarRequest
+	(*UpsertBusinessCalendarResponse)(nil),                            // 92: temporal.server.api.adminservice.v1.UpsertBusinessCalendarResponse
+	(*DeleteBusinessCalendarRequest)(nil),                             // 93: temporal.server.api.adminservice.v1.DeleteBusinessCalendarRequest
+	(*DeleteBusinessCalendarResponse)(nil),                            // 94: temporal.server.api.adminservice.v1.DeleteBusinessCalendarResponse
+	(*ListBusinessCalendarsRequest)(nil),                              // 95: temporal.server.api.adminservice.v1.ListBusinessCalendarsRequest
+	(*ListBusinessCalendarsResponse)(nil),                             // 96: temporal.server.api.adminservice.v1.ListBusinessCalendarsResponse
+	(*SetScheduleBusinessCalendarsRequest)(nil),                       // 97: temporal.server.api.adminservice.v1.SetScheduleBusinessCalendarsRequest
+	(*SetScheduleBusinessCalendarsResponse)(nil),                      // 98: temporal.server.api.adminservice.v1.SetScheduleBusinessCalendarsResponse
+	(*ListAuditEventsRequest)(nil),                                    // 99: temporal.server.api.adminservice.v1.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),                                   // 100: temporal.server.api.adminservice.v1.ListAuditEventsResponse
+	(*MigrateTaskQueueBacklogRequest)(nil),                            // 101: temporal.server.api.adminservice.v1.MigrateTaskQueueBacklogRequest
+	(*MigrateTaskQueueBacklogResponse)(nil),                           // 102: temporal.server.api.adminservice.v1.MigrateTaskQueueBacklogResponse
+	(*UpdateActivityTypeRateLimitsRequest)(nil),                       // 103: temporal.server.api.adminservice.v1.UpdateActivityTypeRateLimitsRequest
+	(*UpdateActivityTypeRateLimitsResponse)(nil),                      // 104: temporal.server.api.adminservice.v1.UpdateActivityTypeRateLimitsResponse
+	(*AggregateWorkflowExecutionsRequest)(nil),                        // 105: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsRequest
+	(*AggregateWorkflowExecutionsResponse)(nil),                       // 106: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse
+	(*StartBatchOperationRequest)(nil),                                // 107: temporal.server.api.adminservice.v1.StartBatchOperationRequest
+	(*StartBatchOperationResponse)(nil),                               // 108: temporal.server.api.adminservice.v1.StartBatchOperationResponse
+	(*GetBatchOperationReportRequest)(nil),                            // 109: temporal.server.api.adminservice.v1.GetBatchOperationReportRequest
+	(*GetBatchOperationReportResponse)(nil),                           // 110: temporal.server.api.adminservice.v1.GetBatchOperationReportResponse
+	(*BatchOperationFailure)(nil),                                     // 111: temporal.server.api.adminservice.v1.BatchOperationFailure
+	(*BatchOperationUpsertProperties)(nil),                            // 112: temporal.server.api.adminservice.v1.BatchOperationUpsertProperties
+	(*BatchOperationSignalWithStart)(nil),                             // 113: temporal.server.api.adminservice.v1.BatchOperationSignalWithStart
+	nil,                                                               // 114: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry
+	nil,                                                               // 115: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry
+	nil,                                                               // 116: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry
+	nil,                                                               // 117: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry
+	nil,                                                               // 118: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry
+	nil,                                                               // 119: temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry
+	nil,                                                               // 120: temporal.server.api.adminservice.v1.DescribeClusterResponse.TagsEntry
+	(*AddTasksRequest_Task)(nil),                                      // 121: temporal.server.api.adminservice.v1.AddTasksRequest.Task
+	(*ListQueuesResponse_QueueInfo)(nil),                              // 122: temporal.server.api.adminservice.v1.ListQueuesResponse.QueueInfo
+	nil,                                                               // 123: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry
+	nil,                                                               // 124: temporal.server.api.adminservice.v1.UpdateActivityTypeRateLimitsRequest.SetRateLimitsEntry
+	nil,                                                               // 125: temporal.server.api.adminservice.v1.UpdateActivityTypeRateLimitsResponse.RateLimitsEntry
+	(*AggregateWorkflowExecutionsRequest_HistogramAggregation)(nil),   // 126: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsRequest.HistogramAggregation
+	(*AggregateWorkflowExecutionsRequest_PercentilesAggregation)(nil), // 127: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsRequest.PercentilesAggregation
+	(*AggregateWorkflowExecutionsResponse_HistogramBucket)(nil),       // 128: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse.HistogramBucket
+	(*AggregateWorkflowExecutionsResponse_AggregationGroup)(nil),      // 129: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse.AggregationGroup
+	(*AggregateWorkflowExecutionsResponse_PercentileValue)(nil),       // 130: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse.PercentileValue
+	(*v1.WorkflowExecution)(nil),                                      // 131: temporal.api.common.v1.WorkflowExecution
+	(*v1.DataBlob)(nil),                                               // 132: temporal.api.common.v1.DataBlob
+	(*v11.VersionHistory)(nil),                                        // 133: temporal.server.api.history.v1.VersionHistory
+	(*v12.WorkflowMutableState)(nil),                                  // 134: temporal.server.api.persistence.v1.WorkflowMutableState
+	(*v13.NamespaceCacheInfo)(nil),                                    // 135: temporal.server.api.namespace.v1.NamespaceCacheInfo
+	(*v12.ShardInfo)(nil),                                             // 136: temporal.server.api.persistence.v1.ShardInfo
+	(*v11.TaskRange)(nil),                                             // 137: temporal.server.api.history.v1.TaskRange
+	(v14.TaskType)(0),                                                 // 138: temporal.server.api.enums.v1.TaskType
+	(*timestamppb.Timestamp)(nil),                                     // 139: google.protobuf.Timestamp
+	(*v15.ReplicationToken)(nil),                                      // 140: temporal.server.api.replication.v1.ReplicationToken
+	(*v15.ReplicationMessages)(nil),                                   // 141: temporal.server.api.replication.v1.ReplicationMessages
+	(*v15.ReplicationTaskInfo)(nil),                                   // 142: temporal.server.api.replication.v1.ReplicationTaskInfo
+	(*v15.ReplicationTask)(nil),                                       // 143: temporal.server.api.replication.v1.ReplicationTask
+	(*v17.WorkflowExecutionInfo)(nil),                                 // 144: temporal.api.workflow.v1.WorkflowExecutionInfo
+	(*v18.MembershipInfo)(nil),                                        // 145: temporal.server.api.cluster.v1.MembershipInfo
+	(*v19.VersionInfo)(nil),                                           // 146: temporal.api.version.v1.VersionInfo
+	(*v12.ClusterMetadata)(nil),                                       // 147: temporal.server.api.persistence.v1.ClusterMetadata
+	(*durationpb.Duration)(nil),                                       // 148: google.protobuf.Duration
+	(v14.ClusterMemberRole)(0),                                        // 149: temporal.server.api.enums.v1.ClusterMemberRole
+	(*v18.ClusterMember)(nil),                                         // 150: temporal.server.api.cluster.v1.ClusterMember
+	(v14.DeadLetterQueueType)(0),                                      // 151: temporal.server.api.enums.v1.DeadLetterQueueType
+	(v16.TaskQueueType)(0),                                            // 152: temporal.api.enums.v1.TaskQueueType
+	(*v12.AllocatedTaskInfo)(nil),                                     // 153: temporal.server.api.persistence.v1.AllocatedTaskInfo
+	(*v15.SyncReplicationState)(nil),                                  // 154: temporal.server.api.replication.v1.SyncReplicationState
+	(*v15.WorkflowReplicationMessages)(nil),                           // 155: temporal.server.api.replication.v1.WorkflowReplicationMessages
+	(*v110.NamespaceInfo)(nil),                                        // 156: temporal.api.namespace.v1.NamespaceInfo
+	(*v110.NamespaceConfig)(nil),                                      // 157: temporal.api.namespace.v1.NamespaceConfig
+	(*v111.NamespaceReplicationConfig)(nil),                           // 158: temporal.api.replication.v1.NamespaceReplicationConfig
+	(*v111.FailoverStatus)(nil),                                       // 159: temporal.api.replication.v1.FailoverStatus
+	(*v112.HistoryDLQKey)(nil),                                        // 160: temporal.server.api.common.v1.HistoryDLQKey
+	(*v112.HistoryDLQTask)(nil),                                       // 161: temporal.server.api.common.v1.HistoryDLQTask
+	(*v112.HistoryDLQTaskMetadata)(nil),                               // 162: temporal.server.api.common.v1.HistoryDLQTaskMetadata
+	(v14.DLQOperationType)(0),                                         // 163: temporal.server.api.enums.v1.DLQOperationType
+	(v14.DLQOperationState)(0),                                        // 164: temporal.server.api.enums.v1.DLQOperationState
+	(v14.HealthState)(0),                                              // 165: temporal.server.api.enums.v1.HealthState
+	(*v12.VersionedTransition)(nil),                                   // 166: temporal.server.api.persistence.v1.VersionedTransition
+	(*v11.VersionHistories)(nil),                                      // 167: temporal.server.api.history.v1.VersionHistories
+	(*v15.VersionedTransitionArtifact)(nil),                           // 168: temporal.server.api.replication.v1.VersionedTransitionArtifact
+	(*v113.TaskQueuePartition)(nil),                                   // 169: temporal.server.api.taskqueue.v1.TaskQueuePartition
+	(*v114.TaskQueueVersionSelection)(nil),                            // 170: temporal.api.taskqueue.v1.TaskQueueVersionSelection
+	(*v114.TaskIdBlock)(nil),                                          // 171: temporal.api.taskqueue.v1.TaskIdBlock
+	(*v115.Schedule)(nil),                                             // 172: temporal.api.schedule.v1.Schedule
+	(*v116.SimulatedScheduleAction)(nil),                              // 173: temporal.server.api.schedule.v1.SimulatedScheduleAction
+	(*v116.BusinessCalendar)(nil),                                     // 174: temporal.server.api.schedule.v1.BusinessCalendar
+	(*v116.ScheduleBusinessCalendar)(nil),                             // 175: temporal.server.api.schedule.v1.ScheduleBusinessCalendar
+	(*v12.AuditEvent)(nil),                                            // 176: temporal.server.api.persistence.v1.AuditEvent
+	(*v117.BatchOperationTermination)(nil),                            // 177: temporal.api.batch.v1.BatchOperationTermination
+	(*v117.BatchOperationSignal)(nil),                                 // 178: temporal.api.batch.v1.BatchOperationSignal
+	(*v117.BatchOperationCancellation)(nil),                           // 179: temporal.api.batch.v1.BatchOperationCancellation
+	(*v117.BatchOperationDeletion)(nil),                               // 180: temporal.api.batch.v1.BatchOperationDeletion
+	(*v117.BatchOperationReset)(nil),                                  // 181: temporal.api.batch.v1.BatchOperationReset
+	(v16.BatchOperationState)(0),                                      // 182: temporal.api.enums.v1.BatchOperationState
+	(*v1.SearchAttributes)(nil),                                       // 183: temporal.api.common.v1.SearchAttributes
+	(*v1.Memo)(nil),                                                   // 184: temporal.api.common.v1.Memo
+	(*v1.WorkflowType)(nil),                                           // 185: temporal.api.common.v1.WorkflowType
+	(*v114.TaskQueue)(nil),                                            // 186: temporal.api.taskqueue.v1.TaskQueue
+	(*v1.Payloads)(nil),                                               // 187: temporal.api.common.v1.Payloads
+	(v16.WorkflowIdReusePolicy)(0),                                    // 188: temporal.api.enums.v1.WorkflowIdReusePolicy
+	(*v1.Header)(nil),                                                 // 189: temporal.api.common.v1.Header
+	(v16.IndexedValueType)(0),                                         // 190: temporal.api.enums.v1.IndexedValueType
+	(*v113.TaskQueueVersionInfoInternal)(nil),                         // 191: temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal
+	(*v1.Payload)(nil),                                                // 192: temporal.api.common.v1.Payload
 }
 var file_temporal_server_api_adminservice_v1_request_response_proto_depIdxs = []int32{
-	99,  // 0: temporal.server.api.adminservice.v1.RebuildMutableStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	99,  // 1: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	100, // 2: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.history_batches:type_name -> temporal.api.common.v1.DataBlob
-	101, // 3: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
-	99,  // 4: temporal.server.api.adminservice.v1.DescribeMutableStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	102, // 5: temporal.server.api.adminservice.v1.DescribeMutableStateResponse.cache_mutable_state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
-	102, // 6: temporal.server.api.adminservice.v1.DescribeMutableStateResponse.database_mutable_state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
-	99,  // 7: temporal.server.api.adminservice.v1.DescribeHistoryHostRequest.workflow_execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	103, // 8: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse.namespace_cache:type_name -> temporal.server.api.namespace.v1.NamespaceCacheInfo
-	104, // 9: temporal.server.api.adminservice.v1.GetShardResponse.shard_info:type_name -> temporal.server.api.persistence.v1.ShardInfo
-	105, // 10: temporal.server.api.adminservice.v1.ListHistoryTasksRequest.task_range:type_name -> temporal.server.api.history.v1.TaskRange
+	131, // 0: temporal.server.api.adminservice.v1.RebuildMutableStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	131, // 1: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	132, // 2: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.history_batches:type_name -> temporal.api.common.v1.DataBlob
+	133, // 3: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
+	131, // 4: temporal.server.api.adminservice.v1.DescribeMutableStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	134, // 5: temporal.server.api.adminservice.v1.DescribeMutableStateResponse.cache_mutable_state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
+	134, // 6: temporal.server.api.adminservice.v1.DescribeMutableStateResponse.database_mutable_state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
+	131, // 7: temporal.server.api.adminservice.v1.DescribeHistoryHostRequest.workflow_execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	135, // 8: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse.namespace_cache:type_name -> temporal.server.api.namespace.v1.NamespaceCacheInfo
+	136, // 9: temporal.server.api.adminservice.v1.GetShardResponse.shard_info:type_name -> temporal.server.api.persistence.v1.ShardInfo
+	137, // 10: temporal.server.api.adminservice.v1.ListHistoryTasksRequest.task_range:type_name -> temporal.server.api.history.v1.TaskRange
 	14,  // 11: temporal.server.api.adminservice.v1.ListHistoryTasksResponse.tasks:type_name -> temporal.server.api.adminservice.v1.Task
-	106, // 12: temporal.server.api.adminservice.v1.Task.task_type:type_name -> temporal.server.api.enums.v1.TaskType
-	107, // 13: temporal.server.api.adminservice.v1.Task.fire_time:type_name -> google.protobuf.Timestamp
-	107, // 14: temporal.server.api.adminservice.v1.RemoveTaskRequest.visibility_time:type_name -> google.protobuf.Timestamp
-	99,  // 15: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	100, // 16: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response.history_batches:type_name -> temporal.api.common.v1.DataBlob
-	101, // 17: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
-	99,  // 18: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	100, // 19: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse.history_batches:type_name -> temporal.api.common.v1.DataBlob
-	101, // 20: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
-	108, // 21: temporal.server.api.adminservice.v1.GetReplicationMessagesRequest.tokens:type_name -> temporal.server.api.replication.v1.ReplicationToken
-	89,  // 22: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.shard_messages:type_name -> temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry
-	109, // 23: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse.messages:type_name -> temporal.server.api.replication.v1.ReplicationMessages
-	110, // 24: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest.task_infos:type_name -> temporal.server.api.replication.v1.ReplicationTaskInfo
-	111, // 25: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse.replication_tasks:type_name -> temporal.server.api.replication.v1.ReplicationTask
-	99,  // 26: temporal.server.api.adminservice.v1.ReapplyEventsRequest.workflow_execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	100, // 27: temporal.server.api.adminservice.v1.ReapplyEventsRequest.events:type_name -> temporal.api.common.v1.DataBlob
-	90,  // 28: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.search_attributes:type_name -> temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry
-	91,  // 29: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.custom_attributes:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry
-	92,  // 30: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.system_attributes:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry
-	93,  // 31: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.mapping:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry
-	112, // 32: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.add_workflow_execution_info:type_name -> temporal.api.workflow.v1.WorkflowExecutionInfo
-	94,  // 33: temporal.server.api.adminservice.v1.DescribeClusterResponse.supported_clients:type_name -> temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry
-	113, // 34: temporal.server.api.adminservice.v1.DescribeClusterResponse.membership_info:type_name -> temporal.server.api.cluster.v1.MembershipInfo
-	114, // 35: temporal.server.api.adminservice.v1.DescribeClusterResponse.version_info:type_name -> temporal.api.version.v1.VersionInfo
-	95,  // 36: temporal.server.api.adminservice.v1.DescribeClusterResponse.tags:type_name -> temporal.server.api.adminservice.v1.DescribeClusterResponse.TagsEntry
-	115, // 37: temporal.server.api.adminservice.v1.ListClustersResponse.clusters:type_name -> temporal.server.api.persistence.v1.ClusterMetadata
-	116, // 38: temporal.server.api.adminservice.v1.ListClusterMembersRequest.last_heartbeat_within:type_name -> google.protobuf.Duration
-	117, // 39: temporal.server.api.adminservice.v1.ListClusterMembersRequest.role:type_name -> temporal.server.api.enums.v1.ClusterMemberRole
-	107, // 40: temporal.server.api.adminservice.v1.ListClusterMembersRequest.session_started_after_time:type_name -> google.protobuf.Timestamp
-	118, // 41: temporal.server.api.adminservice.v1.ListClusterMembersResponse.active_members:type_name -> temporal.server.api.cluster.v1.ClusterMember
-	119, // 42: temporal.server.api.adminservice.v1.GetDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
-	119, // 43: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
-	111, // 44: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.replication_tasks:type_name -> temporal.server.api.replication.v1.ReplicationTask
-	110, // 45: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.replication_tasks_info:type_name -> temporal.server.api.replication.v1.ReplicationTaskInfo
-	119, // 46: temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
-	119, // 47: temporal.server.api.adminservice.v1.MergeDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
-	99,  // 48: temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	120, // 49: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
-	121, // 50: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse.tasks:type_name -> temporal.server.api.persistence.v1.AllocatedTaskInfo
-	99,  // 51: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	122, // 52: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest.sync_replication_state:type_name -> temporal.server.api.replication.v1.SyncReplicationState
-	123, // 53: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse.messages:type_name -> temporal.server.api.replication.v1.WorkflowReplicationMessages
-	124, // 54: temporal.server.api.adminservice.v1.GetNamespaceResponse.info:type_name -> temporal.api.namespace.v1.NamespaceInfo
-	125, // 55: temporal.server.api.adminservice.v1.GetNamespaceResponse.config:type_name -> temporal.api.namespace.v1.NamespaceConfig
-	126, // 56: temporal.server.api.adminservice.v1.GetNamespaceResponse.replication_config:type_name -> temporal.api.replication.v1.NamespaceReplicationConfig
-	127, // 57: temporal.server.api.adminservice.v1.GetNamespaceResponse.failover_history:type_name -> temporal.api.replication.v1.FailoverStatus
-	128, // 58: temporal.server.api.adminservice.v1.GetDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
-	129, // 59: temporal.server.api.adminservice.v1.GetDLQTasksResponse.dlq_tasks:type_name -> temporal.server.api.common.v1.HistoryDLQTask
-	128, // 60: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
-	130, // 61: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest.inclusive_max_task_metadata:type_name -> temporal.server.api.common.v1.HistoryDLQTaskMetadata
-	128, // 62: temporal.server.api.adminservice.v1.MergeDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
-	130, // 63: temporal.server.api.adminservice.v1.MergeDLQTasksRequest.inclusive_max_task_metadata:type_name -> temporal.server.api.common.v1.HistoryDLQTaskMetadata
-	128, // 64: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
-	131, // 65: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.operation_type:type_name -> temporal.server.api.enums.v1.DLQOperationType
-	132, // 66: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.operation_state:type_name -> temporal.server.api.enums.v1.DLQOperationState
-	107, // 67: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.start_time:type_name -> google.protobuf.Timestamp
-	107, // 68: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.end_time:type_name -> google.protobuf.Timestamp
-	96,  // 69: temporal.server.api.adminservice.v1.AddTasksRequest.tasks:type_name -> temporal.server.api.adminservice.v1.AddTasksRequest.Task
-	97,  // 70: temporal.server.api.adminservice.v1.ListQueuesResponse.queues:type_name -> temporal.server.api.adminservice.v1.ListQueuesResponse.QueueInfo
-	133, // 71: temporal.server.api.adminservice.v1.DeepHealthCheckResponse.state:type_name -> temporal.server.api.enums.v1.HealthState
-	99,  // 72: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	134, // 73: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.versioned_transition:type_name -> temporal.server.api.persistence.v1.VersionedTransition
-	135, // 74: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.version_histories:type_name -> temporal.server.api.history.v1.VersionHistories
-	136, // 75: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse.versioned_transition_artifact:type_name -> temporal.server.api.replication.v1.VersionedTransitionArtifact
-	99,  // 76: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	137, // 77: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
-	138, // 78: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest.build_ids:type_name -> temporal.api.taskqueue.v1.TaskQueueVersionSelection
-	139, // 79: temporal.server.api.adminservice.v1.InternalTaskQueueStatus.task_id_block:type_name -> temporal.api.taskqueue.v1.TaskIdBlock
-	98,  // 80: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.versions_info_internal:type_name -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry
-	137, // 81: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
-	109, // 82: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry.value:type_name -> temporal.server.api.replication.v1.ReplicationMessages
-	140, // 83: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
-	140, // 84: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
-	140, // 85: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
-	100, // 86: temporal.server.api.adminservice.v1.AddTasksRequest.Task.blob:type_name -> temporal.api.common.v1.DataBlob
-	141, // 87: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry.value:type_name -> temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal
-	88,  // [88:88] is the sub-list for method output_type
-	88,  // [88:88] is the sub-list for method input_type
-	88,  // [88:88] is the sub-list for extension type_name
-	88,  // [88:88] is the sub-list for extension extendee
-	0,   // [0:88] is the sub-list for field type_name
+	138, // 12: temporal.server.api.adminservice.v1.Task.task_type:type_name -> temporal.server.api.enums.v1.TaskType
+	139, // 13: temporal.server.api.adminservice.v1.Task.fire_time:type_name -> google.protobuf.Timestamp
+	139, // 14: temporal.server.api.adminservice.v1.RemoveTaskRequest.visibility_time:type_name -> google.protobuf.Timestamp
+	131, // 15: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	132, // 16: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response.history_batches:type_name -> temporal.api.common.v1.DataBlob
+	133, // 17: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
+	131, // 18: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	132, // 19: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse.history_batches:type_name -> temporal.api.common.v1.DataBlob
+	133, // 20: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
+	140, // 21: temporal.server.api.adminservice.v1.GetReplicationMessagesRequest.tokens:type_name -> temporal.server.api.replication.v1.ReplicationToken
+	114, // 22: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.shard_messages:type_name -> temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry
+	141, // 23: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse.messages:type_name -> temporal.server.api.replication.v1.ReplicationMessages
+	142, // 24: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest.task_infos:type_name -> temporal.server.api.replication.v1.ReplicationTaskInfo
+	143, // 25: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse.replication_tasks:type_name -> temporal.server.api.replication.v1.ReplicationTask
+	131, // 26: temporal.server.api.adminservice.v1.ReapplyEventsRequest.workflow_execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	132, // 27: temporal.server.api.adminservice.v1.ReapplyEventsRequest.events:type_name -> temporal.api.common.v1.DataBlob
+	115, // 28: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.search_attributes:type_name -> temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry
+	116, // 29: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.custom_attributes:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry
+	117, // 30: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.system_attributes:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry
+	118, // 31: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.mapping:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry
+	144, // 32: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.add_workflow_execution_info:type_name -> temporal.api.workflow.v1.WorkflowExecutionInfo
+	119, // 33: temporal.server.api.adminservice.v1.DescribeClusterResponse.supported_clients:type_name -> temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry
+	145, // 34: temporal.server.api.adminservice.v1.DescribeClusterResponse.membership_info:type_name -> temporal.server.api.cluster.v1.MembershipInfo
+	146, // 35: temporal.server.api.adminservice.v1.DescribeClusterResponse.version_info:type_name -> temporal.api.version.v1.VersionInfo
+	120, // 36: temporal.server.api.adminservice.v1.DescribeClusterResponse.tags:type_name -> temporal.server.api.adminservice.v1.DescribeClusterResponse.TagsEntry
+	147, // 37: temporal.server.api.adminservice.v1.ListClustersResponse.clusters:type_name -> temporal.server.api.persistence.v1.ClusterMetadata
+	148, // 38: temporal.server.api.adminservice.v1.ListClusterMembersRequest.last_heartbeat_within:type_name -> google.protobuf.Duration
+	149, // 39: temporal.server.api.adminservice.v1.ListClusterMembersRequest.role:type_name -> temporal.server.api.enums.v1.ClusterMemberRole
+	139, // 40: temporal.server.api.adminservice.v1.ListClusterMembersRequest.session_started_after_time:type_name -> google.protobuf.Timestamp
+	150, // 41: temporal.server.api.adminservice.v1.ListClusterMembersResponse.active_members:type_name -> temporal.server.api.cluster.v1.ClusterMember
+	151, // 42: temporal.server.api.adminservice.v1.GetDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
+	151, // 43: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
+	143, // 44: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.replication_tasks:type_name -> temporal.server.api.replication.v1.ReplicationTask
+	142, // 45: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.replication_tasks_info:type_name -> temporal.server.api.replication.v1.ReplicationTaskInfo
+	151, // 46: temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
+	151, // 47: temporal.server.api.adminservice.v1.MergeDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
+	131, // 48: temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	152, // 49: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	153, // 50: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse.tasks:type_name -> temporal.server.api.persistence.v1.AllocatedTaskInfo
+	131, // 51: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	154, // 52: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest.sync_replication_state:type_name -> temporal.server.api.replication.v1.SyncReplicationState
+	155, // 53: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse.messages:type_name -> temporal.server.api.replication.v1.WorkflowReplicationMessages
+	156, // 54: temporal.server.api.adminservice.v1.GetNamespaceResponse.info:type_name -> temporal.api.namespace.v1.NamespaceInfo
+	157, // 55: temporal.server.api.adminservice.v1.GetNamespaceResponse.config:type_name -> temporal.api.namespace.v1.NamespaceConfig
+	158, // 56: temporal.server.api.adminservice.v1.GetNamespaceResponse.replication_config:type_name -> temporal.api.replication.v1.NamespaceReplicationConfig
+	159, // 57: temporal.server.api.adminservice.v1.GetNamespaceResponse.failover_history:type_name -> temporal.api.replication.v1.FailoverStatus
+	160, // 58: temporal.server.api.adminservice.v1.GetDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
+	161, // 59: temporal.server.api.adminservice.v1.GetDLQTasksResponse.dlq_tasks:type_name -> temporal.server.api.common.v1.HistoryDLQTask
+	160, // 60: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
+	162, // 61: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest.inclusive_max_task_metadata:type_name -> temporal.server.api.common.v1.HistoryDLQTaskMetadata
+	160, // 62: temporal.server.api.adminservice.v1.MergeDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
+	162, // 63: temporal.server.api.adminservice.v1.MergeDLQTasksRequest.inclusive_max_task_metadata:type_name -> temporal.server.api.common.v1.HistoryDLQTaskMetadata
+	160, // 64: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
+	163, // 65: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.operation_type:type_name -> temporal.server.api.enums.v1.DLQOperationType
+	164, // 66: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.operation_state:type_name -> temporal.server.api.enums.v1.DLQOperationState
+	139, // 67: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.start_time:type_name -> google.protobuf.Timestamp
+	139, // 68: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.end_time:type_name -> google.protobuf.Timestamp
+	121, // 69: temporal.server.api.adminservice.v1.AddTasksRequest.tasks:type_name -> temporal.server.api.adminservice.v1.AddTasksRequest.Task
+	122, // 70: temporal.server.api.adminservice.v1.ListQueuesResponse.queues:type_name -> temporal.server.api.adminservice.v1.ListQueuesResponse.QueueInfo
+	165, // 71: temporal.server.api.adminservice.v1.DeepHealthCheckResponse.state:type_name -> temporal.server.api.enums.v1.HealthState
+	131, // 72: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	166, // 73: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.versioned_transition:type_name -> temporal.server.api.persistence.v1.VersionedTransition
+	167, // 74: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.version_histories:type_name -> temporal.server.api.history.v1.VersionHistories
+	168, // 75: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse.versioned_transition_artifact:type_name -> temporal.server.api.replication.v1.VersionedTransitionArtifact
+	131, // 76: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	169, // 77: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
+	170, // 78: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest.build_ids:type_name -> temporal.api.taskqueue.v1.TaskQueueVersionSelection
+	171, // 79: temporal.server.api.adminservice.v1.InternalTaskQueueStatus.task_id_block:type_name -> temporal.api.taskqueue.v1.TaskIdBlock
+	123, // 80: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.versions_info_internal:type_name -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry
+	169, // 81: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
+	172, // 82: temporal.server.api.adminservice.v1.SimulateScheduleRequest.schedule:type_name -> temporal.api.schedule.v1.Schedule
+	139, // 83: temporal.server.api.adminservice.v1.SimulateScheduleRequest.start_time:type_name -> google.protobuf.Timestamp
+	139, // 84: temporal.server.api.adminservice.v1.SimulateScheduleRequest.end_time:type_name -> google.protobuf.Timestamp
+	148, // 85: temporal.server.api.adminservice.v1.SimulateScheduleRequest.workflow_run_duration:type_name -> google.protobuf.Duration
+	139, // 86: temporal.server.api.adminservice.v1.SimulateScheduleRequest.scheduler_resume_time:type_name -> google.protobuf.Timestamp
+	173, // 87: temporal.server.api.adminservice.v1.SimulateScheduleResponse.actions:type_name -> temporal.server.api.schedule.v1.SimulatedScheduleAction
+	174, // 88: temporal.server.api.adminservice.v1.UpsertBusinessCalendarRequest.calendar:type_name -> temporal.server.api.schedule.v1.BusinessCalendar
+	174, // 89: temporal.server.api.adminservice.v1.UpsertBusinessCalendarResponse.calendar:type_name -> temporal.server.api.schedule.v1.BusinessCalendar
+	174, // 90: temporal.server.api.adminservice.v1.ListBusinessCalendarsResponse.calendars:type_name -> temporal.server.api.schedule.v1.BusinessCalendar
+	175, // 91: temporal.server.api.adminservice.v1.SetScheduleBusinessCalendarsRequest.business_calendars:type_name -> temporal.server.api.schedule.v1.ScheduleBusinessCalendar
+	175, // 92: temporal.server.api.adminservice.v1.SetScheduleBusinessCalendarsResponse.business_calendars:type_name -> temporal.server.api.schedule.v1.ScheduleBusinessCalendar
+	176, // 93: temporal.server.api.adminservice.v1.ListAuditEventsResponse.events:type_name -> temporal.server.api.persistence.v1.AuditEvent
+	169, // 94: temporal.server.api.adminservice.v1.MigrateTaskQueueBacklogRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
+	124, // 95: temporal.server.api.adminservice.v1.UpdateActivityTypeRateLimitsRequest.set_rate_limits:type_name -> temporal.server.api.adminservice.v1.UpdateActivityTypeRateLimitsRequest.SetRateLimitsEntry
+	125, // 96: temporal.server.api.adminservice.v1.UpdateActivityTypeRateLimitsResponse.rate_limits:type_name -> temporal.server.api.adminservice.v1.UpdateActivityTypeRateLimitsResponse.RateLimitsEntry
+	126, // 97: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsRequest.histogram:type_name -> temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsRequest.HistogramAggregation
+	127, // 98: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsRequest.percentiles:type_name -> temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsRequest.PercentilesAggregation
+	128, // 99: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse.buckets:type_name -> temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse.HistogramBucket
+	130, // 100: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse.percentiles:type_name -> temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse.PercentileValue
+	131, // 101: temporal.server.api.adminservice.v1.StartBatchOperationRequest.executions:type_name -> temporal.api.common.v1.WorkflowExecution
+	112, // 102: temporal.server.api.adminservice.v1.StartBatchOperationRequest.upsert_properties_operation:type_name -> temporal.server.api.adminservice.v1.BatchOperationUpsertProperties
+	113, // 103: temporal.server.api.adminservice.v1.StartBatchOperationRequest.signal_with_start_operation:type_name -> temporal.server.api.adminservice.v1.BatchOperationSignalWithStart
+	177, // 104: temporal.server.api.adminservice.v1.StartBatchOperationRequest.termination_operation:type_name -> temporal.api.batch.v1.BatchOperationTermination
+	178, // 105: temporal.server.api.adminservice.v1.StartBatchOperationRequest.signal_operation:type_name -> temporal.api.batch.v1.BatchOperationSignal
+	179, // 106: temporal.server.api.adminservice.v1.StartBatchOperationRequest.cancellation_operation:type_name -> temporal.api.batch.v1.BatchOperationCancellation
+	180, // 107: temporal.server.api.adminservice.v1.StartBatchOperationRequest.deletion_operation:type_name -> temporal.api.batch.v1.BatchOperationDeletion
+	181, // 108: temporal.server.api.adminservice.v1.StartBatchOperationRequest.reset_operation:type_name -> temporal.api.batch.v1.BatchOperationReset
+	182, // 109: temporal.server.api.adminservice.v1.GetBatchOperationReportResponse.state:type_name -> temporal.api.enums.v1.BatchOperationState
+	111, // 110: temporal.server.api.adminservice.v1.GetBatchOperationReportResponse.failures:type_name -> temporal.server.api.adminservice.v1.BatchOperationFailure
+	131, // 111: temporal.server.api.adminservice.v1.BatchOperationFailure.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	183, // 112: temporal.server.api.adminservice.v1.BatchOperationUpsertProperties.search_attributes:type_name -> temporal.api.common.v1.SearchAttributes
+	184, // 113: temporal.server.api.adminservice.v1.BatchOperationUpsertProperties.memo:type_name -> temporal.api.common.v1.Memo
+	185, // 114: temporal.server.api.adminservice.v1.BatchOperationSignalWithStart.workflow_type:type_name -> temporal.api.common.v1.WorkflowType
+	186, // 115: temporal.server.api.adminservice.v1.BatchOperationSignalWithStart.task_queue:type_name -> temporal.api.taskqueue.v1.TaskQueue
+	187, // 116: temporal.server.api.adminservice.v1.BatchOperationSignalWithStart.input:type_name -> temporal.api.common.v1.Payloads
+	148, // 117: temporal.server.api.adminservice.v1.BatchOperationSignalWithStart.workflow_execution_timeout:type_name -> google.protobuf.Duration
+	148, // 118: temporal.server.api.adminservice.v1.BatchOperationSignalWithStart.workflow_run_timeout:type_name -> google.protobuf.Duration
+	148, // 119: temporal.server.api.adminservice.v1.BatchOperationSignalWithStart.workflow_task_timeout:type_name -> google.protobuf.Duration
+	188, // 120: temporal.server.api.adminservice.v1.BatchOperationSignalWithStart.workflow_id_reuse_policy:type_name -> temporal.api.enums.v1.WorkflowIdReusePolicy
+	187, // 121: temporal.server.api.adminservice.v1.BatchOperationSignalWithStart.signal_input:type_name -> temporal.api.common.v1.Payloads
+	184, // 122: temporal.server.api.adminservice.v1.BatchOperationSignalWithStart.memo:type_name -> temporal.api.common.v1.Memo
+	183, // 123: temporal.server.api.adminservice.v1.BatchOperationSignalWithStart.search_attributes:type_name -> temporal.api.common.v1.SearchAttributes
+	189, // 124: temporal.server.api.adminservice.v1.BatchOperationSignalWithStart.header:type_name -> temporal.api.common.v1.Header
+	141, // 125: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry.value:type_name -> temporal.server.api.replication.v1.ReplicationMessages
+	190, // 126: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
+	190, // 127: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
+	190, // 128: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
+	132, // 129: temporal.server.api.adminservice.v1.AddTasksRequest.Task.blob:type_name -> temporal.api.common.v1.DataBlob
+	191, // 130: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry.value:type_name -> temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal
+	148, // 131: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsRequest.HistogramAggregation.interval:type_name -> google.protobuf.Duration
+	139, // 132: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse.HistogramBucket.start_time:type_name -> google.protobuf.Timestamp
+	129, // 133: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse.HistogramBucket.groups:type_name -> temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse.AggregationGroup
+	192, // 134: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse.AggregationGroup.group_value:type_name -> temporal.api.common.v1.Payload
+	135, // [135:135] is the sub-list for method output_type
+	135, // [135:135] is the sub-list for method input_type
+	135, // [135:135] is the sub-list for extension type_name
+	135, // [135:135] is the sub-list for extension extendee
+	0,   // [0:135] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_adminservice_v1_request_response_proto_init() }
@@ -6014,13 +8410,22 @@ func file_temporal_server_api_adminservice_v1_request_response_proto_init() {
 		(*GetNamespaceRequest_Namespace)(nil),
 		(*GetNamespaceRequest_Id)(nil),
 	}
+	file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[107].OneofWrappers = []any{
+		(*StartBatchOperationRequest_UpsertPropertiesOperation)(nil),
+		(*StartBatchOperationRequest_SignalWithStartOperation)(nil),
+		(*StartBatchOperationRequest_TerminationOperation)(nil),
+		(*StartBatchOperationRequest_SignalOperation)(nil),
+		(*StartBatchOperationRequest_CancellationOperation)(nil),
+		(*StartBatchOperationRequest_DeletionOperation)(nil),
+		(*StartBatchOperationRequest_ResetOperation)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc), len(file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   99,
+			NumMessages:   131,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
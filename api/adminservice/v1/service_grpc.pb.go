@@ -85,6 +85,17 @@ const (
 	AdminService_GenerateLastHistoryReplicationTasks_FullMethodName = "/temporal.server.api.adminservice.v1.AdminService/GenerateLastHistoryReplicationTasks"
 	AdminService_DescribeTaskQueuePartition_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueuePartition"
 	AdminService_ForceUnloadTaskQueuePartition_FullMethodName       = "/temporal.server.api.adminservice.v1.AdminService/ForceUnloadTaskQueuePartition"
+	AdminService_SimulateSchedule_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/SimulateSchedule"
+	AdminService_UpsertBusinessCalendar_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/UpsertBusinessCalendar"
+	AdminService_DeleteBusinessCalendar_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/DeleteBusinessCalendar"
+	AdminService_ListBusinessCalendars_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/ListBusinessCalendars"
+	AdminService_SetScheduleBusinessCalendars_FullMethodName        = "/temporal.server.api.adminservice.v1.AdminService/SetScheduleBusinessCalendars"
+	AdminService_ListAuditEvents_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/ListAuditEvents"
+	AdminService_MigrateTaskQueueBacklog_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/MigrateTaskQueueBacklog"
+	AdminService_UpdateActivityTypeRateLimits_FullMethodName        = "/temporal.server.api.adminservice.v1.AdminService/UpdateActivityTypeRateLimits"
+	AdminService_AggregateWorkflowExecutions_FullMethodName         = "/temporal.server.api.adminservice.v1.AdminService/AggregateWorkflowExecutions"
+	AdminService_StartBatchOperation_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/StartBatchOperation"
+	AdminService_GetBatchOperationReport_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/GetBatchOperationReport"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -175,6 +186,47 @@ type AdminServiceClient interface {
 	GenerateLastHistoryReplicationTasks(ctx context.Context, in *GenerateLastHistoryReplicationTasksRequest, opts ...grpc.CallOption) (*GenerateLastHistoryReplicationTasksResponse, error)
 	DescribeTaskQueuePartition(ctx context.Context, in *DescribeTaskQueuePartitionRequest, opts ...grpc.CallOption) (*DescribeTaskQueuePartitionResponse, error)
 	ForceUnloadTaskQueuePartition(ctx context.Context, in *ForceUnloadTaskQueuePartitionRequest, opts ...grpc.CallOption) (*ForceUnloadTaskQueuePartitionResponse, error)
+	// SimulateSchedule projects the actions a proposed schedule would take over a time range,
+	// taking the overlap policy, catchup window, jitter and pause state into account. The
+	// schedule does not need to exist.
+	// It only needs namespace read access, and the frontend also serves it to namespace users on the HTTP API as
+	// POST /api/v1/namespaces/{namespace}/schedule-simulation, since WorkflowService has no equivalent RPC.
+	SimulateSchedule(ctx context.Context, in *SimulateScheduleRequest, opts ...grpc.CallOption) (*SimulateScheduleResponse, error)
+	// UpsertBusinessCalendar creates or replaces a business calendar of a namespace. Schedules
+	// reference business calendars by name to skip or shift actions on non-business days.
+	UpsertBusinessCalendar(ctx context.Context, in *UpsertBusinessCalendarRequest, opts ...grpc.CallOption) (*UpsertBusinessCalendarResponse, error)
+	DeleteBusinessCalendar(ctx context.Context, in *DeleteBusinessCalendarRequest, opts ...grpc.CallOption) (*DeleteBusinessCalendarResponse, error)
+	ListBusinessCalendars(ctx context.Context, in *ListBusinessCalendarsRequest, opts ...grpc.CallOption) (*ListBusinessCalendarsResponse, error)
+	// SetScheduleBusinessCalendars replaces the business calendars that a schedule references.
+	// The references are kept in the schedule's server-side state, not in its memo, and an empty
+	// list removes them. It only needs namespace write access.
+	SetScheduleBusinessCalendars(ctx context.Context, in *SetScheduleBusinessCalendarsRequest, opts ...grpc.CallOption) (*SetScheduleBusinessCalendarsResponse, error)
+	// ListAuditEvents returns audit events recorded by the persistence audit sink, oldest first.
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	// MigrateTaskQueueBacklog moves or copies a batch of backlog tasks of a task queue partition to
+	// another task queue. Must be called repeatedly with the returned position until done.
+	MigrateTaskQueueBacklog(ctx context.Context, in *MigrateTaskQueueBacklogRequest, opts ...grpc.CallOption) (*MigrateTaskQueueBacklogResponse, error)
+	// UpdateActivityTypeRateLimits adds, replaces or removes per-activity-type dispatch rate limits
+	// of a task queue. An empty request returns the current limits.
+	// Only the new matcher enforces the limits: setting limits fails with FailedPrecondition unless
+	// matching.useNewMatcher is enabled for the activity task queue.
+	// (-- api-linter: core::0134::response-message-name=disabled
+	//
+	//	aip.dev/not-precedent: UpdateActivityTypeRateLimits RPC doesn't follow Google API format. --)
+	//
+	// (-- api-linter: core::0134::method-signature=disabled
+	//
+	//	aip.dev/not-precedent: UpdateActivityTypeRateLimits RPC doesn't follow Google API format. --)
+	UpdateActivityTypeRateLimits(ctx context.Context, in *UpdateActivityTypeRateLimitsRequest, opts ...grpc.CallOption) (*UpdateActivityTypeRateLimitsResponse, error)
+	// AggregateWorkflowExecutions computes a time bucketed histogram and/or percentiles of a numeric
+	// search attribute over the workflow executions matching a visibility query.
+	AggregateWorkflowExecutions(ctx context.Context, in *AggregateWorkflowExecutionsRequest, opts ...grpc.CallOption) (*AggregateWorkflowExecutionsResponse, error)
+	// StartBatchOperation starts a batch job of a type that is not available in the public
+	// StartBatchOperation API. The job can be described and stopped with the public batch APIs.
+	StartBatchOperation(ctx context.Context, in *StartBatchOperationRequest, opts ...grpc.CallOption) (*StartBatchOperationResponse, error)
+	// GetBatchOperationReport returns the progress of a batch job and a page of its failure manifest,
+	// the executions the job gave up on with the last error of each.
+	GetBatchOperationReport(ctx context.Context, in *GetBatchOperationReportRequest, opts ...grpc.CallOption) (*GetBatchOperationReportResponse, error)
 }
 
 type adminServiceClient struct {
@@ -594,6 +646,105 @@ func (c *adminServiceClient) ForceUnloadTaskQueuePartition(ctx context.Context,
 	return out, nil
 }
 
+func (c *adminServiceClient) SimulateSchedule(ctx context.Context, in *SimulateScheduleRequest, opts ...grpc.CallOption) (*SimulateScheduleResponse, error) {
+	out := new(SimulateScheduleResponse)
+	err := c.cc.Invoke(ctx, AdminService_SimulateSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpsertBusinessCalendar(ctx context.Context, in *UpsertBusinessCalendarRequest, opts ...grpc.CallOption) (*UpsertBusinessCalendarResponse, error) {
+	out := new(UpsertBusinessCalendarResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpsertBusinessCalendar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteBusinessCalendar(ctx context.Context, in *DeleteBusinessCalendarRequest, opts ...grpc.CallOption) (*DeleteBusinessCalendarResponse, error) {
+	out := new(DeleteBusinessCalendarResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteBusinessCalendar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListBusinessCalendars(ctx context.Context, in *ListBusinessCalendarsRequest, opts ...grpc.CallOption) (*ListBusinessCalendarsResponse, error) {
+	out := new(ListBusinessCalendarsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListBusinessCalendars_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetScheduleBusinessCalendars(ctx context.Context, in *SetScheduleBusinessCalendarsRequest, opts ...grpc.CallOption) (*SetScheduleBusinessCalendarsResponse, error) {
+	out := new(SetScheduleBusinessCalendarsResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetScheduleBusinessCalendars_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListAuditEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) MigrateTaskQueueBacklog(ctx context.Context, in *MigrateTaskQueueBacklogRequest, opts ...grpc.CallOption) (*MigrateTaskQueueBacklogResponse, error) {
+	out := new(MigrateTaskQueueBacklogResponse)
+	err := c.cc.Invoke(ctx, AdminService_MigrateTaskQueueBacklog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateActivityTypeRateLimits(ctx context.Context, in *UpdateActivityTypeRateLimitsRequest, opts ...grpc.CallOption) (*UpdateActivityTypeRateLimitsResponse, error) {
+	out := new(UpdateActivityTypeRateLimitsResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateActivityTypeRateLimits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AggregateWorkflowExecutions(ctx context.Context, in *AggregateWorkflowExecutionsRequest, opts ...grpc.CallOption) (*AggregateWorkflowExecutionsResponse, error) {
+	out := new(AggregateWorkflowExecutionsResponse)
+	err := c.cc.Invoke(ctx, AdminService_AggregateWorkflowExecutions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StartBatchOperation(ctx context.Context, in *StartBatchOperationRequest, opts ...grpc.CallOption) (*StartBatchOperationResponse, error) {
+	out := new(StartBatchOperationResponse)
+	err := c.cc.Invoke(ctx, AdminService_StartBatchOperation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetBatchOperationReport(ctx context.Context, in *GetBatchOperationReportRequest, opts ...grpc.CallOption) (*GetBatchOperationReportResponse, error) {
+	out := new(GetBatchOperationReportResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetBatchOperationReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility
@@ -682,6 +833,47 @@ type AdminServiceServer interface {
 	GenerateLastHistoryReplicationTasks(context.Context, *GenerateLastHistoryReplicationTasksRequest) (*GenerateLastHistoryReplicationTasksResponse, error)
 	DescribeTaskQueuePartition(context.Context, *DescribeTaskQueuePartitionRequest) (*DescribeTaskQueuePartitionResponse, error)
 	ForceUnloadTaskQueuePartition(context.Context, *ForceUnloadTaskQueuePartitionRequest) (*ForceUnloadTaskQueuePartitionResponse, error)
+	// SimulateSchedule projects the actions a proposed schedule would take over a time range,
+	// taking the overlap policy, catchup window, jitter and pause state into account. The
+	// schedule does not need to exist.
+	// It only needs namespace read access, and the frontend also serves it to namespace users on the HTTP API as
+	// POST /api/v1/namespaces/{namespace}/schedule-simulation, since WorkflowService has no equivalent RPC.
+	SimulateSchedule(context.Context, *SimulateScheduleRequest) (*SimulateScheduleResponse, error)
+	// UpsertBusinessCalendar creates or replaces a business calendar of a namespace. Schedules
+	// reference business calendars by name to skip or shift actions on non-business days.
+	UpsertBusinessCalendar(context.Context, *UpsertBusinessCalendarRequest) (*UpsertBusinessCalendarResponse, error)
+	DeleteBusinessCalendar(context.Context, *DeleteBusinessCalendarRequest) (*DeleteBusinessCalendarResponse, error)
+	ListBusinessCalendars(context.Context, *ListBusinessCalendarsRequest) (*ListBusinessCalendarsResponse, error)
+	// SetScheduleBusinessCalendars replaces the business calendars that a schedule references.
+	// The references are kept in the schedule's server-side state, not in its memo, and an empty
+	// list removes them. It only needs namespace write access.
+	SetScheduleBusinessCalendars(context.Context, *SetScheduleBusinessCalendarsRequest) (*SetScheduleBusinessCalendarsResponse, error)
+	// ListAuditEvents returns audit events recorded by the persistence audit sink, oldest first.
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	// MigrateTaskQueueBacklog moves or copies a batch of backlog tasks of a task queue partition to
+	// another task queue. Must be called repeatedly with the returned position until done.
+	MigrateTaskQueueBacklog(context.Context, *MigrateTaskQueueBacklogRequest) (*MigrateTaskQueueBacklogResponse, error)
+	// UpdateActivityTypeRateLimits adds, replaces or removes per-activity-type dispatch rate limits
+	// of a task queue. An empty request returns the current limits.
+	// Only the new matcher enforces the limits: setting limits fails with FailedPrecondition unless
+	// matching.useNewMatcher is enabled for the activity task queue.
+	// (-- api-linter: core::0134::response-message-name=disabled
+	//
+	//	aip.dev/not-precedent: UpdateActivityTypeRateLimits RPC doesn't follow Google API format. --)
+	//
+	// (-- api-linter: core::0134::method-signature=disabled
+	//
+	//	aip.dev/not-precedent: UpdateActivityTypeRateLimits RPC doesn't follow Google API format. --)
+	UpdateActivityTypeRateLimits(context.Context, *UpdateActivityTypeRateLimitsRequest) (*UpdateActivityTypeRateLimitsResponse, error)
+	// AggregateWorkflowExecutions computes a time bucketed histogram and/or percentiles of a numeric
+	// search attribute over the workflow executions matching a visibility query.
+	AggregateWorkflowExecutions(context.Context, *AggregateWorkflowExecutionsRequest) (*AggregateWorkflowExecutionsResponse, error)
+	// StartBatchOperation starts a batch job of a type that is not available in the public
+	// StartBatchOperation API. The job can be described and stopped with the public batch APIs.
+	StartBatchOperation(context.Context, *StartBatchOperationRequest) (*StartBatchOperationResponse, error)
+	// GetBatchOperationReport returns the progress of a batch job and a page of its failure manifest,
+	// the executions the job gave up on with the last error of each.
+	GetBatchOperationReport(context.Context, *GetBatchOperationReportRequest) (*GetBatchOperationReportResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -818,6 +1010,39 @@ func (UnimplementedAdminServiceServer) DescribeTaskQueuePartition(context.Contex
 func (UnimplementedAdminServiceServer) ForceUnloadTaskQueuePartition(context.Context, *ForceUnloadTaskQueuePartitionRequest) (*ForceUnloadTaskQueuePartitionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceUnloadTaskQueuePartition not implemented")
 }
+func (UnimplementedAdminServiceServer) SimulateSchedule(context.Context, *SimulateScheduleRequest) (*SimulateScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateSchedule not implemented")
+}
+func (UnimplementedAdminServiceServer) UpsertBusinessCalendar(context.Context, *UpsertBusinessCalendarRequest) (*UpsertBusinessCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertBusinessCalendar not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteBusinessCalendar(context.Context, *DeleteBusinessCalendarRequest) (*DeleteBusinessCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBusinessCalendar not implemented")
+}
+func (UnimplementedAdminServiceServer) ListBusinessCalendars(context.Context, *ListBusinessCalendarsRequest) (*ListBusinessCalendarsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBusinessCalendars not implemented")
+}
+func (UnimplementedAdminServiceServer) SetScheduleBusinessCalendars(context.Context, *SetScheduleBusinessCalendarsRequest) (*SetScheduleBusinessCalendarsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetScheduleBusinessCalendars not implemented")
+}
+func (UnimplementedAdminServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedAdminServiceServer) MigrateTaskQueueBacklog(context.Context, *MigrateTaskQueueBacklogRequest) (*MigrateTaskQueueBacklogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateTaskQueueBacklog not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateActivityTypeRateLimits(context.Context, *UpdateActivityTypeRateLimitsRequest) (*UpdateActivityTypeRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateActivityTypeRateLimits not implemented")
+}
+func (UnimplementedAdminServiceServer) AggregateWorkflowExecutions(context.Context, *AggregateWorkflowExecutionsRequest) (*AggregateWorkflowExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateWorkflowExecutions not implemented")
+}
+func (UnimplementedAdminServiceServer) StartBatchOperation(context.Context, *StartBatchOperationRequest) (*StartBatchOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartBatchOperation not implemented")
+}
+func (UnimplementedAdminServiceServer) GetBatchOperationReport(context.Context, *GetBatchOperationReportRequest) (*GetBatchOperationReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBatchOperationReport not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 
 // UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1613,6 +1838,204 @@ func _AdminService_ForceUnloadTaskQueuePartition_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_SimulateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SimulateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SimulateSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SimulateSchedule(ctx, req.(*SimulateScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpsertBusinessCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertBusinessCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpsertBusinessCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpsertBusinessCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpsertBusinessCalendar(ctx, req.(*UpsertBusinessCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteBusinessCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBusinessCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteBusinessCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteBusinessCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteBusinessCalendar(ctx, req.(*DeleteBusinessCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListBusinessCalendars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBusinessCalendarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListBusinessCalendars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListBusinessCalendars_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListBusinessCalendars(ctx, req.(*ListBusinessCalendarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetScheduleBusinessCalendars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetScheduleBusinessCalendarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetScheduleBusinessCalendars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetScheduleBusinessCalendars_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetScheduleBusinessCalendars(ctx, req.(*SetScheduleBusinessCalendarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_MigrateTaskQueueBacklog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateTaskQueueBacklogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).MigrateTaskQueueBacklog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_MigrateTaskQueueBacklog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).MigrateTaskQueueBacklog(ctx, req.(*MigrateTaskQueueBacklogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateActivityTypeRateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateActivityTypeRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateActivityTypeRateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateActivityTypeRateLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateActivityTypeRateLimits(ctx, req.(*UpdateActivityTypeRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AggregateWorkflowExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregateWorkflowExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AggregateWorkflowExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AggregateWorkflowExecutions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AggregateWorkflowExecutions(ctx, req.(*AggregateWorkflowExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StartBatchOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartBatchOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StartBatchOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_StartBatchOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StartBatchOperation(ctx, req.(*StartBatchOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetBatchOperationReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBatchOperationReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetBatchOperationReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetBatchOperationReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetBatchOperationReport(ctx, req.(*GetBatchOperationReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1788,6 +2211,50 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ForceUnloadTaskQueuePartition",
 			Handler:    _AdminService_ForceUnloadTaskQueuePartition_Handler,
 		},
+		{
+			MethodName: "SimulateSchedule",
+			Handler:    _AdminService_SimulateSchedule_Handler,
+		},
+		{
+			MethodName: "UpsertBusinessCalendar",
+			Handler:    _AdminService_UpsertBusinessCalendar_Handler,
+		},
+		{
+			MethodName: "DeleteBusinessCalendar",
+			Handler:    _AdminService_DeleteBusinessCalendar_Handler,
+		},
+		{
+			MethodName: "ListBusinessCalendars",
+			Handler:    _AdminService_ListBusinessCalendars_Handler,
+		},
+		{
+			MethodName: "SetScheduleBusinessCalendars",
+			Handler:    _AdminService_SetScheduleBusinessCalendars_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _AdminService_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "MigrateTaskQueueBacklog",
+			Handler:    _AdminService_MigrateTaskQueueBacklog_Handler,
+		},
+		{
+			MethodName: "UpdateActivityTypeRateLimits",
+			Handler:    _AdminService_UpdateActivityTypeRateLimits_Handler,
+		},
+		{
+			MethodName: "AggregateWorkflowExecutions",
+			Handler:    _AdminService_AggregateWorkflowExecutions_Handler,
+		},
+		{
+			MethodName: "StartBatchOperation",
+			Handler:    _AdminService_StartBatchOperation_Handler,
+		},
+		{
+			MethodName: "GetBatchOperationReport",
+			Handler:    _AdminService_GetBatchOperationReport_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -127,6 +127,26 @@ func (mr *MockAdminServiceClientMockRecorder) AddTasks(ctx, in any, opts ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).AddTasks), varargs...)
 }
 
+// AggregateWorkflowExecutions mocks base method.
+func (m *MockAdminServiceClient) AggregateWorkflowExecutions(ctx context.Context, in *adminservice.AggregateWorkflowExecutionsRequest, opts ...grpc.CallOption) (*adminservice.AggregateWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AggregateWorkflowExecutions", varargs...)
+	ret0, _ := ret[0].(*adminservice.AggregateWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AggregateWorkflowExecutions indicates an expected call of AggregateWorkflowExecutions.
+func (mr *MockAdminServiceClientMockRecorder) AggregateWorkflowExecutions(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateWorkflowExecutions", reflect.TypeOf((*MockAdminServiceClient)(nil).AggregateWorkflowExecutions), varargs...)
+}
+
 // CancelDLQJob mocks base method.
 func (m *MockAdminServiceClient) CancelDLQJob(ctx context.Context, in *adminservice.CancelDLQJobRequest, opts ...grpc.CallOption) (*adminservice.CancelDLQJobResponse, error) {
 	m.ctrl.T.Helper()
@@ -187,6 +207,26 @@ func (mr *MockAdminServiceClientMockRecorder) DeepHealthCheck(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeepHealthCheck", reflect.TypeOf((*MockAdminServiceClient)(nil).DeepHealthCheck), varargs...)
 }
 
+// DeleteBusinessCalendar mocks base method.
+func (m *MockAdminServiceClient) DeleteBusinessCalendar(ctx context.Context, in *adminservice.DeleteBusinessCalendarRequest, opts ...grpc.CallOption) (*adminservice.DeleteBusinessCalendarResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteBusinessCalendar", varargs...)
+	ret0, _ := ret[0].(*adminservice.DeleteBusinessCalendarResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBusinessCalendar indicates an expected call of DeleteBusinessCalendar.
+func (mr *MockAdminServiceClientMockRecorder) DeleteBusinessCalendar(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBusinessCalendar", reflect.TypeOf((*MockAdminServiceClient)(nil).DeleteBusinessCalendar), varargs...)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *adminservice.DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -347,6 +387,26 @@ func (mr *MockAdminServiceClientMockRecorder) GenerateLastHistoryReplicationTask
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateLastHistoryReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).GenerateLastHistoryReplicationTasks), varargs...)
 }
 
+// GetBatchOperationReport mocks base method.
+func (m *MockAdminServiceClient) GetBatchOperationReport(ctx context.Context, in *adminservice.GetBatchOperationReportRequest, opts ...grpc.CallOption) (*adminservice.GetBatchOperationReportResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBatchOperationReport", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetBatchOperationReportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBatchOperationReport indicates an expected call of GetBatchOperationReport.
+func (mr *MockAdminServiceClientMockRecorder) GetBatchOperationReport(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBatchOperationReport", reflect.TypeOf((*MockAdminServiceClient)(nil).GetBatchOperationReport), varargs...)
+}
+
 // GetDLQMessages mocks base method.
 func (m *MockAdminServiceClient) GetDLQMessages(ctx context.Context, in *adminservice.GetDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -587,6 +647,46 @@ func (mr *MockAdminServiceClientMockRecorder) ImportWorkflowExecution(ctx, in an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).ImportWorkflowExecution), varargs...)
 }
 
+// ListAuditEvents mocks base method.
+func (m *MockAdminServiceClient) ListAuditEvents(ctx context.Context, in *adminservice.ListAuditEventsRequest, opts ...grpc.CallOption) (*adminservice.ListAuditEventsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAuditEvents", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListAuditEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditEvents indicates an expected call of ListAuditEvents.
+func (mr *MockAdminServiceClientMockRecorder) ListAuditEvents(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditEvents", reflect.TypeOf((*MockAdminServiceClient)(nil).ListAuditEvents), varargs...)
+}
+
+// ListBusinessCalendars mocks base method.
+func (m *MockAdminServiceClient) ListBusinessCalendars(ctx context.Context, in *adminservice.ListBusinessCalendarsRequest, opts ...grpc.CallOption) (*adminservice.ListBusinessCalendarsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBusinessCalendars", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListBusinessCalendarsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBusinessCalendars indicates an expected call of ListBusinessCalendars.
+func (mr *MockAdminServiceClientMockRecorder) ListBusinessCalendars(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBusinessCalendars", reflect.TypeOf((*MockAdminServiceClient)(nil).ListBusinessCalendars), varargs...)
+}
+
 // ListClusterMembers mocks base method.
 func (m *MockAdminServiceClient) ListClusterMembers(ctx context.Context, in *adminservice.ListClusterMembersRequest, opts ...grpc.CallOption) (*adminservice.ListClusterMembersResponse, error) {
 	m.ctrl.T.Helper()
@@ -707,6 +807,26 @@ func (mr *MockAdminServiceClientMockRecorder) MergeDLQTasks(ctx, in any, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).MergeDLQTasks), varargs...)
 }
 
+// MigrateTaskQueueBacklog mocks base method.
+func (m *MockAdminServiceClient) MigrateTaskQueueBacklog(ctx context.Context, in *adminservice.MigrateTaskQueueBacklogRequest, opts ...grpc.CallOption) (*adminservice.MigrateTaskQueueBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MigrateTaskQueueBacklog", varargs...)
+	ret0, _ := ret[0].(*adminservice.MigrateTaskQueueBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateTaskQueueBacklog indicates an expected call of MigrateTaskQueueBacklog.
+func (mr *MockAdminServiceClientMockRecorder) MigrateTaskQueueBacklog(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateTaskQueueBacklog", reflect.TypeOf((*MockAdminServiceClient)(nil).MigrateTaskQueueBacklog), varargs...)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) PurgeDLQMessages(ctx context.Context, in *adminservice.PurgeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -887,6 +1007,66 @@ func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
 }
 
+// SetScheduleBusinessCalendars mocks base method.
+func (m *MockAdminServiceClient) SetScheduleBusinessCalendars(ctx context.Context, in *adminservice.SetScheduleBusinessCalendarsRequest, opts ...grpc.CallOption) (*adminservice.SetScheduleBusinessCalendarsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetScheduleBusinessCalendars", varargs...)
+	ret0, _ := ret[0].(*adminservice.SetScheduleBusinessCalendarsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetScheduleBusinessCalendars indicates an expected call of SetScheduleBusinessCalendars.
+func (mr *MockAdminServiceClientMockRecorder) SetScheduleBusinessCalendars(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetScheduleBusinessCalendars", reflect.TypeOf((*MockAdminServiceClient)(nil).SetScheduleBusinessCalendars), varargs...)
+}
+
+// SimulateSchedule mocks base method.
+func (m *MockAdminServiceClient) SimulateSchedule(ctx context.Context, in *adminservice.SimulateScheduleRequest, opts ...grpc.CallOption) (*adminservice.SimulateScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SimulateSchedule", varargs...)
+	ret0, _ := ret[0].(*adminservice.SimulateScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SimulateSchedule indicates an expected call of SimulateSchedule.
+func (mr *MockAdminServiceClientMockRecorder) SimulateSchedule(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateSchedule", reflect.TypeOf((*MockAdminServiceClient)(nil).SimulateSchedule), varargs...)
+}
+
+// StartBatchOperation mocks base method.
+func (m *MockAdminServiceClient) StartBatchOperation(ctx context.Context, in *adminservice.StartBatchOperationRequest, opts ...grpc.CallOption) (*adminservice.StartBatchOperationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartBatchOperation", varargs...)
+	ret0, _ := ret[0].(*adminservice.StartBatchOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartBatchOperation indicates an expected call of StartBatchOperation.
+func (mr *MockAdminServiceClientMockRecorder) StartBatchOperation(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartBatchOperation", reflect.TypeOf((*MockAdminServiceClient)(nil).StartBatchOperation), varargs...)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceClient) StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (adminservice.AdminService_StreamWorkflowReplicationMessagesClient, error) {
 	m.ctrl.T.Helper()
@@ -927,6 +1107,46 @@ func (mr *MockAdminServiceClientMockRecorder) SyncWorkflowState(ctx, in any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkflowState", reflect.TypeOf((*MockAdminServiceClient)(nil).SyncWorkflowState), varargs...)
 }
 
+// UpdateActivityTypeRateLimits mocks base method.
+func (m *MockAdminServiceClient) UpdateActivityTypeRateLimits(ctx context.Context, in *adminservice.UpdateActivityTypeRateLimitsRequest, opts ...grpc.CallOption) (*adminservice.UpdateActivityTypeRateLimitsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateActivityTypeRateLimits", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateActivityTypeRateLimitsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateActivityTypeRateLimits indicates an expected call of UpdateActivityTypeRateLimits.
+func (mr *MockAdminServiceClientMockRecorder) UpdateActivityTypeRateLimits(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityTypeRateLimits", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateActivityTypeRateLimits), varargs...)
+}
+
+// UpsertBusinessCalendar mocks base method.
+func (m *MockAdminServiceClient) UpsertBusinessCalendar(ctx context.Context, in *adminservice.UpsertBusinessCalendarRequest, opts ...grpc.CallOption) (*adminservice.UpsertBusinessCalendarResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpsertBusinessCalendar", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpsertBusinessCalendarResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertBusinessCalendar indicates an expected call of UpsertBusinessCalendar.
+func (mr *MockAdminServiceClientMockRecorder) UpsertBusinessCalendar(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertBusinessCalendar", reflect.TypeOf((*MockAdminServiceClient)(nil).UpsertBusinessCalendar), varargs...)
+}
+
 // MockAdminService_StreamWorkflowReplicationMessagesClient is a mock of AdminService_StreamWorkflowReplicationMessagesClient interface.
 type MockAdminService_StreamWorkflowReplicationMessagesClient struct {
 	ctrl     *gomock.Controller
@@ -1134,6 +1354,21 @@ func (mr *MockAdminServiceServerMockRecorder) AddTasks(arg0, arg1 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).AddTasks), arg0, arg1)
 }
 
+// AggregateWorkflowExecutions mocks base method.
+func (m *MockAdminServiceServer) AggregateWorkflowExecutions(arg0 context.Context, arg1 *adminservice.AggregateWorkflowExecutionsRequest) (*adminservice.AggregateWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AggregateWorkflowExecutions", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.AggregateWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AggregateWorkflowExecutions indicates an expected call of AggregateWorkflowExecutions.
+func (mr *MockAdminServiceServerMockRecorder) AggregateWorkflowExecutions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateWorkflowExecutions", reflect.TypeOf((*MockAdminServiceServer)(nil).AggregateWorkflowExecutions), arg0, arg1)
+}
+
 // CancelDLQJob mocks base method.
 func (m *MockAdminServiceServer) CancelDLQJob(arg0 context.Context, arg1 *adminservice.CancelDLQJobRequest) (*adminservice.CancelDLQJobResponse, error) {
 	m.ctrl.T.Helper()
@@ -1179,6 +1414,21 @@ func (mr *MockAdminServiceServerMockRecorder) DeepHealthCheck(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeepHealthCheck", reflect.TypeOf((*MockAdminServiceServer)(nil).DeepHealthCheck), arg0, arg1)
 }
 
+// DeleteBusinessCalendar mocks base method.
+func (m *MockAdminServiceServer) DeleteBusinessCalendar(arg0 context.Context, arg1 *adminservice.DeleteBusinessCalendarRequest) (*adminservice.DeleteBusinessCalendarResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBusinessCalendar", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DeleteBusinessCalendarResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBusinessCalendar indicates an expected call of DeleteBusinessCalendar.
+func (mr *MockAdminServiceServerMockRecorder) DeleteBusinessCalendar(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBusinessCalendar", reflect.TypeOf((*MockAdminServiceServer)(nil).DeleteBusinessCalendar), arg0, arg1)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceServer) DeleteWorkflowExecution(arg0 context.Context, arg1 *adminservice.DeleteWorkflowExecutionRequest) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1299,6 +1549,21 @@ func (mr *MockAdminServiceServerMockRecorder) GenerateLastHistoryReplicationTask
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateLastHistoryReplicationTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).GenerateLastHistoryReplicationTasks), arg0, arg1)
 }
 
+// GetBatchOperationReport mocks base method.
+func (m *MockAdminServiceServer) GetBatchOperationReport(arg0 context.Context, arg1 *adminservice.GetBatchOperationReportRequest) (*adminservice.GetBatchOperationReportResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBatchOperationReport", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetBatchOperationReportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBatchOperationReport indicates an expected call of GetBatchOperationReport.
+func (mr *MockAdminServiceServerMockRecorder) GetBatchOperationReport(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBatchOperationReport", reflect.TypeOf((*MockAdminServiceServer)(nil).GetBatchOperationReport), arg0, arg1)
+}
+
 // GetDLQMessages mocks base method.
 func (m *MockAdminServiceServer) GetDLQMessages(arg0 context.Context, arg1 *adminservice.GetDLQMessagesRequest) (*adminservice.GetDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1479,6 +1744,36 @@ func (mr *MockAdminServiceServerMockRecorder) ImportWorkflowExecution(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).ImportWorkflowExecution), arg0, arg1)
 }
 
+// ListAuditEvents mocks base method.
+func (m *MockAdminServiceServer) ListAuditEvents(arg0 context.Context, arg1 *adminservice.ListAuditEventsRequest) (*adminservice.ListAuditEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditEvents", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListAuditEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditEvents indicates an expected call of ListAuditEvents.
+func (mr *MockAdminServiceServerMockRecorder) ListAuditEvents(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditEvents", reflect.TypeOf((*MockAdminServiceServer)(nil).ListAuditEvents), arg0, arg1)
+}
+
+// ListBusinessCalendars mocks base method.
+func (m *MockAdminServiceServer) ListBusinessCalendars(arg0 context.Context, arg1 *adminservice.ListBusinessCalendarsRequest) (*adminservice.ListBusinessCalendarsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBusinessCalendars", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListBusinessCalendarsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBusinessCalendars indicates an expected call of ListBusinessCalendars.
+func (mr *MockAdminServiceServerMockRecorder) ListBusinessCalendars(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBusinessCalendars", reflect.TypeOf((*MockAdminServiceServer)(nil).ListBusinessCalendars), arg0, arg1)
+}
+
 // ListClusterMembers mocks base method.
 func (m *MockAdminServiceServer) ListClusterMembers(arg0 context.Context, arg1 *adminservice.ListClusterMembersRequest) (*adminservice.ListClusterMembersResponse, error) {
 	m.ctrl.T.Helper()
@@ -1569,6 +1864,21 @@ func (mr *MockAdminServiceServerMockRecorder) MergeDLQTasks(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).MergeDLQTasks), arg0, arg1)
 }
 
+// MigrateTaskQueueBacklog mocks base method.
+func (m *MockAdminServiceServer) MigrateTaskQueueBacklog(arg0 context.Context, arg1 *adminservice.MigrateTaskQueueBacklogRequest) (*adminservice.MigrateTaskQueueBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateTaskQueueBacklog", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.MigrateTaskQueueBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateTaskQueueBacklog indicates an expected call of MigrateTaskQueueBacklog.
+func (mr *MockAdminServiceServerMockRecorder) MigrateTaskQueueBacklog(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateTaskQueueBacklog", reflect.TypeOf((*MockAdminServiceServer)(nil).MigrateTaskQueueBacklog), arg0, arg1)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) PurgeDLQMessages(arg0 context.Context, arg1 *adminservice.PurgeDLQMessagesRequest) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1704,6 +2014,51 @@ func (mr *MockAdminServiceServerMockRecorder) ResendReplicationTasks(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ResendReplicationTasks), arg0, arg1)
 }
 
+// SetScheduleBusinessCalendars mocks base method.
+func (m *MockAdminServiceServer) SetScheduleBusinessCalendars(arg0 context.Context, arg1 *adminservice.SetScheduleBusinessCalendarsRequest) (*adminservice.SetScheduleBusinessCalendarsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetScheduleBusinessCalendars", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.SetScheduleBusinessCalendarsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetScheduleBusinessCalendars indicates an expected call of SetScheduleBusinessCalendars.
+func (mr *MockAdminServiceServerMockRecorder) SetScheduleBusinessCalendars(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetScheduleBusinessCalendars", reflect.TypeOf((*MockAdminServiceServer)(nil).SetScheduleBusinessCalendars), arg0, arg1)
+}
+
+// SimulateSchedule mocks base method.
+func (m *MockAdminServiceServer) SimulateSchedule(arg0 context.Context, arg1 *adminservice.SimulateScheduleRequest) (*adminservice.SimulateScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SimulateSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.SimulateScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SimulateSchedule indicates an expected call of SimulateSchedule.
+func (mr *MockAdminServiceServerMockRecorder) SimulateSchedule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateSchedule", reflect.TypeOf((*MockAdminServiceServer)(nil).SimulateSchedule), arg0, arg1)
+}
+
+// StartBatchOperation mocks base method.
+func (m *MockAdminServiceServer) StartBatchOperation(arg0 context.Context, arg1 *adminservice.StartBatchOperationRequest) (*adminservice.StartBatchOperationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartBatchOperation", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.StartBatchOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartBatchOperation indicates an expected call of StartBatchOperation.
+func (mr *MockAdminServiceServerMockRecorder) StartBatchOperation(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartBatchOperation", reflect.TypeOf((*MockAdminServiceServer)(nil).StartBatchOperation), arg0, arg1)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceServer) StreamWorkflowReplicationMessages(arg0 adminservice.AdminService_StreamWorkflowReplicationMessagesServer) error {
 	m.ctrl.T.Helper()
@@ -1733,6 +2088,36 @@ func (mr *MockAdminServiceServerMockRecorder) SyncWorkflowState(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkflowState", reflect.TypeOf((*MockAdminServiceServer)(nil).SyncWorkflowState), arg0, arg1)
 }
 
+// UpdateActivityTypeRateLimits mocks base method.
+func (m *MockAdminServiceServer) UpdateActivityTypeRateLimits(arg0 context.Context, arg1 *adminservice.UpdateActivityTypeRateLimitsRequest) (*adminservice.UpdateActivityTypeRateLimitsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateActivityTypeRateLimits", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateActivityTypeRateLimitsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateActivityTypeRateLimits indicates an expected call of UpdateActivityTypeRateLimits.
+func (mr *MockAdminServiceServerMockRecorder) UpdateActivityTypeRateLimits(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityTypeRateLimits", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateActivityTypeRateLimits), arg0, arg1)
+}
+
+// UpsertBusinessCalendar mocks base method.
+func (m *MockAdminServiceServer) UpsertBusinessCalendar(arg0 context.Context, arg1 *adminservice.UpsertBusinessCalendarRequest) (*adminservice.UpsertBusinessCalendarResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertBusinessCalendar", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpsertBusinessCalendarResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertBusinessCalendar indicates an expected call of UpsertBusinessCalendar.
+func (mr *MockAdminServiceServerMockRecorder) UpsertBusinessCalendar(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertBusinessCalendar", reflect.TypeOf((*MockAdminServiceServer)(nil).UpsertBusinessCalendar), arg0, arg1)
+}
+
 // mustEmbedUnimplementedAdminServiceServer mocks base method.
 func (m *MockAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {
 	m.ctrl.T.Helper()
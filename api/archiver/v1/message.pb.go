@@ -36,6 +36,7 @@ import (
 	v12 "go.temporal.io/api/common/v1"
 	v11 "go.temporal.io/api/enums/v1"
 	v1 "go.temporal.io/api/history/v1"
+	v13 "go.temporal.io/server/api/persistence/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
@@ -234,8 +235,10 @@ type VisibilityRecord struct {
 	SearchAttributes   map[string]string           `protobuf:"bytes,12,rep,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	HistoryArchivalUri string                      `protobuf:"bytes,13,opt,name=history_archival_uri,json=historyArchivalUri,proto3" json:"history_archival_uri,omitempty"`
 	ExecutionDuration  *durationpb.Duration        `protobuf:"bytes,14,opt,name=execution_duration,json=executionDuration,proto3" json:"execution_duration,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// Final state of a closed CHASM entity, keyed by encoded node path. It's empty for workflows.
+	ChasmNodes    map[string]*v13.ChasmNode `protobuf:"bytes,15,rep,name=chasm_nodes,json=chasmNodes,proto3" json:"chasm_nodes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *VisibilityRecord) Reset() {
@@ -366,11 +369,18 @@ func (x *VisibilityRecord) GetExecutionDuration() *durationpb.Duration {
 	return nil
 }
 
+func (x *VisibilityRecord) GetChasmNodes() map[string]*v13.ChasmNode {
+	if x != nil {
+		return x.ChasmNodes
+	}
+	return nil
+}
+
 var File_temporal_server_api_archiver_v1_message_proto protoreflect.FileDescriptor
 
 const file_temporal_server_api_archiver_v1_message_proto_rawDesc = "" +
 	"\n" +
-	"-temporal/server/api/archiver/v1/message.proto\x12\x1ftemporal.server.api.archiver.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$temporal/api/common/v1/message.proto\x1a%temporal/api/history/v1/message.proto\x1a$temporal/api/enums/v1/workflow.proto\"\xfa\x02\n" +
+	"-temporal/server/api/archiver/v1/message.proto\x12\x1ftemporal.server.api.archiver.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$temporal/api/common/v1/message.proto\x1a%temporal/api/history/v1/message.proto\x1a$temporal/api/enums/v1/workflow.proto\x1a.temporal/server/api/persistence/v1/chasm.proto\"\xfa\x02\n" +
 	"\x11HistoryBlobHeader\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12!\n" +
 	"\fnamespace_id\x18\x02 \x01(\tR\vnamespaceId\x12\x1f\n" +
@@ -387,7 +397,7 @@ const file_temporal_server_api_archiver_v1_message_proto_rawDesc = "" +
 	"eventCount\"\x8f\x01\n" +
 	"\vHistoryBlob\x12J\n" +
 	"\x06header\x18\x01 \x01(\v22.temporal.server.api.archiver.v1.HistoryBlobHeaderR\x06header\x124\n" +
-	"\x04body\x18\x02 \x03(\v2 .temporal.api.history.v1.HistoryR\x04body\"\xca\x06\n" +
+	"\x04body\x18\x02 \x03(\v2 .temporal.api.history.v1.HistoryR\x04body\"\x9c\b\n" +
 	"\x10VisibilityRecord\x12!\n" +
 	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1c\n" +
 	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x1f\n" +
@@ -406,10 +416,15 @@ const file_temporal_server_api_archiver_v1_message_proto_rawDesc = "" +
 	"\x04memo\x18\v \x01(\v2\x1c.temporal.api.common.v1.MemoR\x04memo\x12t\n" +
 	"\x11search_attributes\x18\f \x03(\v2G.temporal.server.api.archiver.v1.VisibilityRecord.SearchAttributesEntryR\x10searchAttributes\x120\n" +
 	"\x14history_archival_uri\x18\r \x01(\tR\x12historyArchivalUri\x12H\n" +
-	"\x12execution_duration\x18\x0e \x01(\v2\x19.google.protobuf.DurationR\x11executionDuration\x1aC\n" +
+	"\x12execution_duration\x18\x0e \x01(\v2\x19.google.protobuf.DurationR\x11executionDuration\x12b\n" +
+	"\vchasm_nodes\x18\x0f \x03(\v2A.temporal.server.api.archiver.v1.VisibilityRecord.ChasmNodesEntryR\n" +
+	"chasmNodes\x1aC\n" +
 	"\x15SearchAttributesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B0Z.go.temporal.io/server/api/archiver/v1;archiverb\x06proto3"
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1al\n" +
+	"\x0fChasmNodesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12C\n" +
+	"\x05value\x18\x02 \x01(\v2-.temporal.server.api.persistence.v1.ChasmNodeR\x05value:\x028\x01B0Z.go.temporal.io/server/api/archiver/v1;archiverb\x06proto3"
 
 var (
 	file_temporal_server_api_archiver_v1_message_proto_rawDescOnce sync.Once
@@ -423,33 +438,37 @@ func file_temporal_server_api_archiver_v1_message_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_archiver_v1_message_proto_rawDescData
 }
 
-var file_temporal_server_api_archiver_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_temporal_server_api_archiver_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_temporal_server_api_archiver_v1_message_proto_goTypes = []any{
 	(*HistoryBlobHeader)(nil),        // 0: temporal.server.api.archiver.v1.HistoryBlobHeader
 	(*HistoryBlob)(nil),              // 1: temporal.server.api.archiver.v1.HistoryBlob
 	(*VisibilityRecord)(nil),         // 2: temporal.server.api.archiver.v1.VisibilityRecord
 	nil,                              // 3: temporal.server.api.archiver.v1.VisibilityRecord.SearchAttributesEntry
-	(*v1.History)(nil),               // 4: temporal.api.history.v1.History
-	(*timestamppb.Timestamp)(nil),    // 5: google.protobuf.Timestamp
-	(v11.WorkflowExecutionStatus)(0), // 6: temporal.api.enums.v1.WorkflowExecutionStatus
-	(*v12.Memo)(nil),                 // 7: temporal.api.common.v1.Memo
-	(*durationpb.Duration)(nil),      // 8: google.protobuf.Duration
+	nil,                              // 4: temporal.server.api.archiver.v1.VisibilityRecord.ChasmNodesEntry
+	(*v1.History)(nil),               // 5: temporal.api.history.v1.History
+	(*timestamppb.Timestamp)(nil),    // 6: google.protobuf.Timestamp
+	(v11.WorkflowExecutionStatus)(0), // 7: temporal.api.enums.v1.WorkflowExecutionStatus
+	(*v12.Memo)(nil),                 // 8: temporal.api.common.v1.Memo
+	(*durationpb.Duration)(nil),      // 9: google.protobuf.Duration
+	(*v13.ChasmNode)(nil),            // 10: temporal.server.api.persistence.v1.ChasmNode
 }
 var file_temporal_server_api_archiver_v1_message_proto_depIdxs = []int32{
-	0, // 0: temporal.server.api.archiver.v1.HistoryBlob.header:type_name -> temporal.server.api.archiver.v1.HistoryBlobHeader
-	4, // 1: temporal.server.api.archiver.v1.HistoryBlob.body:type_name -> temporal.api.history.v1.History
-	5, // 2: temporal.server.api.archiver.v1.VisibilityRecord.start_time:type_name -> google.protobuf.Timestamp
-	5, // 3: temporal.server.api.archiver.v1.VisibilityRecord.execution_time:type_name -> google.protobuf.Timestamp
-	5, // 4: temporal.server.api.archiver.v1.VisibilityRecord.close_time:type_name -> google.protobuf.Timestamp
-	6, // 5: temporal.server.api.archiver.v1.VisibilityRecord.status:type_name -> temporal.api.enums.v1.WorkflowExecutionStatus
-	7, // 6: temporal.server.api.archiver.v1.VisibilityRecord.memo:type_name -> temporal.api.common.v1.Memo
-	3, // 7: temporal.server.api.archiver.v1.VisibilityRecord.search_attributes:type_name -> temporal.server.api.archiver.v1.VisibilityRecord.SearchAttributesEntry
-	8, // 8: temporal.server.api.archiver.v1.VisibilityRecord.execution_duration:type_name -> google.protobuf.Duration
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	0,  // 0: temporal.server.api.archiver.v1.HistoryBlob.header:type_name -> temporal.server.api.archiver.v1.HistoryBlobHeader
+	5,  // 1: temporal.server.api.archiver.v1.HistoryBlob.body:type_name -> temporal.api.history.v1.History
+	6,  // 2: temporal.server.api.archiver.v1.VisibilityRecord.start_time:type_name -> google.protobuf.Timestamp
+	6,  // 3: temporal.server.api.archiver.v1.VisibilityRecord.execution_time:type_name -> google.protobuf.Timestamp
+	6,  // 4: temporal.server.api.archiver.v1.VisibilityRecord.close_time:type_name -> google.protobuf.Timestamp
+	7,  // 5: temporal.server.api.archiver.v1.VisibilityRecord.status:type_name -> temporal.api.enums.v1.WorkflowExecutionStatus
+	8,  // 6: temporal.server.api.archiver.v1.VisibilityRecord.memo:type_name -> temporal.api.common.v1.Memo
+	3,  // 7: temporal.server.api.archiver.v1.VisibilityRecord.search_attributes:type_name -> temporal.server.api.archiver.v1.VisibilityRecord.SearchAttributesEntry
+	9,  // 8: temporal.server.api.archiver.v1.VisibilityRecord.execution_duration:type_name -> google.protobuf.Duration
+	4,  // 9: temporal.server.api.archiver.v1.VisibilityRecord.chasm_nodes:type_name -> temporal.server.api.archiver.v1.VisibilityRecord.ChasmNodesEntry
+	10, // 10: temporal.server.api.archiver.v1.VisibilityRecord.ChasmNodesEntry.value:type_name -> temporal.server.api.persistence.v1.ChasmNode
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_archiver_v1_message_proto_init() }
@@ -463,7 +482,7 @@ func file_temporal_server_api_archiver_v1_message_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_archiver_v1_message_proto_rawDesc), len(file_temporal_server_api_archiver_v1_message_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
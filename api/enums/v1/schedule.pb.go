@@ -0,0 +1,270 @@
+// The MIT License
+//
+// Copyright (c) 2025 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// plugins:
+// 	protoc-gen-go
+// 	protoc
+// source: temporal/server/api/enums/v1/schedule.proto
+
+package enums
+
+import (
+	reflect "reflect"
+	"strconv"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Outcome of a scheduled action in a schedule simulation.
+type SimulatedScheduleActionOutcome int32
+
+const (
+	SIMULATED_SCHEDULE_ACTION_OUTCOME_UNSPECIFIED SimulatedScheduleActionOutcome = 0
+	// The action was started, possibly after being buffered behind a running workflow.
+	SIMULATED_SCHEDULE_ACTION_OUTCOME_STARTED SimulatedScheduleActionOutcome = 1
+	// The action was still buffered at the end of the simulated time range.
+	SIMULATED_SCHEDULE_ACTION_OUTCOME_BUFFERED SimulatedScheduleActionOutcome = 2
+	// The action was skipped by the overlap policy.
+	SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_OVERLAP SimulatedScheduleActionOutcome = 3
+	// The action was skipped because the schedule is paused.
+	SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_PAUSED SimulatedScheduleActionOutcome = 4
+	// The action was skipped because the schedule ran out of remaining actions.
+	SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_NO_REMAINING_ACTIONS SimulatedScheduleActionOutcome = 5
+	// The action was due while the scheduler was unavailable, longer ago than the catchup window.
+	SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_CATCHUP_WINDOW SimulatedScheduleActionOutcome = 6
+	// The action was dropped because the buffer was full.
+	SIMULATED_SCHEDULE_ACTION_OUTCOME_DROPPED_BUFFER_FULL SimulatedScheduleActionOutcome = 7
+)
+
+// Enum value maps for SimulatedScheduleActionOutcome.
+var (
+	SimulatedScheduleActionOutcome_name = map[int32]string{
+		0: "SIMULATED_SCHEDULE_ACTION_OUTCOME_UNSPECIFIED",
+		1: "SIMULATED_SCHEDULE_ACTION_OUTCOME_STARTED",
+		2: "SIMULATED_SCHEDULE_ACTION_OUTCOME_BUFFERED",
+		3: "SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_OVERLAP",
+		4: "SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_PAUSED",
+		5: "SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_NO_REMAINING_ACTIONS",
+		6: "SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_CATCHUP_WINDOW",
+		7: "SIMULATED_SCHEDULE_ACTION_OUTCOME_DROPPED_BUFFER_FULL",
+	}
+	SimulatedScheduleActionOutcome_value = map[string]int32{
+		"SIMULATED_SCHEDULE_ACTION_OUTCOME_UNSPECIFIED":                  0,
+		"SIMULATED_SCHEDULE_ACTION_OUTCOME_STARTED":                      1,
+		"SIMULATED_SCHEDULE_ACTION_OUTCOME_BUFFERED":                     2,
+		"SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_OVERLAP":              3,
+		"SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_PAUSED":               4,
+		"SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_NO_REMAINING_ACTIONS": 5,
+		"SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_CATCHUP_WINDOW":       6,
+		"SIMULATED_SCHEDULE_ACTION_OUTCOME_DROPPED_BUFFER_FULL":          7,
+	}
+)
+
+func (x SimulatedScheduleActionOutcome) Enum() *SimulatedScheduleActionOutcome {
+	p := new(SimulatedScheduleActionOutcome)
+	*p = x
+	return p
+}
+
+func (x SimulatedScheduleActionOutcome) String() string {
+	switch x {
+	case SIMULATED_SCHEDULE_ACTION_OUTCOME_UNSPECIFIED:
+		return "Unspecified"
+	case SIMULATED_SCHEDULE_ACTION_OUTCOME_STARTED:
+		return "Started"
+	case SIMULATED_SCHEDULE_ACTION_OUTCOME_BUFFERED:
+		return "Buffered"
+	case SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_OVERLAP:
+		return "SkippedOverlap"
+	case SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_PAUSED:
+		return "SkippedPaused"
+	case SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_NO_REMAINING_ACTIONS:
+		return "SkippedNoRemainingActions"
+	case SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_CATCHUP_WINDOW:
+
+		// Deprecated: Use SimulatedScheduleActionOutcome.Descriptor instead.
+		return "SkippedCatchupWindow"
+	case SIMULATED_SCHEDULE_ACTION_OUTCOME_DROPPED_BUFFER_FULL:
+		return "DroppedBufferFull"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (SimulatedScheduleActionOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_schedule_proto_enumTypes[0].Descriptor()
+}
+
+func (SimulatedScheduleActionOutcome) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_schedule_proto_enumTypes[0]
+}
+
+func (x SimulatedScheduleActionOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+func (SimulatedScheduleActionOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_schedule_proto_rawDescGZIP(), []int{0}
+}
+
+// What a schedule does with an action that falls on a non-business day of a business calendar.
+type BusinessCalendarAction int32
+
+const (
+	// Treated as BUSINESS_CALENDAR_ACTION_SKIP.
+	BUSINESS_CALENDAR_ACTION_UNSPECIFIED BusinessCalendarAction = 0
+	// The action is skipped.
+	BUSINESS_CALENDAR_ACTION_SKIP BusinessCalendarAction = 1
+	// The action is taken at the same local time on the next business day.
+	BUSINESS_CALENDAR_ACTION_SHIFT_NEXT_BUSINESS_DAY BusinessCalendarAction = 2
+)
+
+// Enum value maps for BusinessCalendarAction.
+var (
+	BusinessCalendarAction_name = map[int32]string{
+		0: "BUSINESS_CALENDAR_ACTION_UNSPECIFIED",
+		1: "BUSINESS_CALENDAR_ACTION_SKIP",
+		2: "BUSINESS_CALENDAR_ACTION_SHIFT_NEXT_BUSINESS_DAY",
+	}
+	BusinessCalendarAction_value = map[string]int32{
+		"BUSINESS_CALENDAR_ACTION_UNSPECIFIED":             0,
+		"BUSINESS_CALENDAR_ACTION_SKIP":                    1,
+		"BUSINESS_CALENDAR_ACTION_SHIFT_NEXT_BUSINESS_DAY": 2,
+	}
+)
+
+func (x BusinessCalendarAction) Enum() *BusinessCalendarAction {
+	p := new(BusinessCalendarAction)
+	*p = x
+	return p
+}
+
+func (x BusinessCalendarAction) String() string {
+	switch x {
+	case BUSINESS_CALENDAR_ACTION_UNSPECIFIED:
+		return "Unspecified"
+	case BUSINESS_CALENDAR_ACTION_SKIP:
+		return "Skip"
+	case BUSINESS_CALENDAR_ACTION_SHIFT_NEXT_BUSINESS_DAY:
+		return "ShiftNextBusinessDay"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (BusinessCalendarAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_schedule_proto_enumTypes[1].Descriptor()
+}
+
+func (BusinessCalendarAction) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_schedule_proto_enumTypes[1]
+}
+
+func (x BusinessCalendarAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BusinessCalendarAction.Descriptor instead.
+func (BusinessCalendarAction) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_schedule_proto_rawDescGZIP(), []int{1}
+}
+
+var File_temporal_server_api_enums_v1_schedule_proto protoreflect.FileDescriptor
+
+const file_temporal_server_api_enums_v1_schedule_proto_rawDesc = "" +
+	"\n" +
+	"+temporal/server/api/enums/v1/schedule.proto\x12\x1ctemporal.server.api.enums.v1*\xdc\x03\n" +
+	"\x1eSimulatedScheduleActionOutcome\x121\n" +
+	"-SIMULATED_SCHEDULE_ACTION_OUTCOME_UNSPECIFIED\x10\x00\x12-\n" +
+	")SIMULATED_SCHEDULE_ACTION_OUTCOME_STARTED\x10\x01\x12.\n" +
+	"*SIMULATED_SCHEDULE_ACTION_OUTCOME_BUFFERED\x10\x02\x125\n" +
+	"1SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_OVERLAP\x10\x03\x124\n" +
+	"0SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_PAUSED\x10\x04\x12B\n" +
+	">SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_NO_REMAINING_ACTIONS\x10\x05\x12<\n" +
+	"8SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_CATCHUP_WINDOW\x10\x06\x129\n" +
+	"5SIMULATED_SCHEDULE_ACTION_OUTCOME_DROPPED_BUFFER_FULL\x10\a*\x9b\x01\n" +
+	"\x16BusinessCalendarAction\x12(\n" +
+	"$BUSINESS_CALENDAR_ACTION_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dBUSINESS_CALENDAR_ACTION_SKIP\x10\x01\x124\n" +
+	"0BUSINESS_CALENDAR_ACTION_SHIFT_NEXT_BUSINESS_DAY\x10\x02B*Z(go.temporal.io/server/api/enums/v1;enumsb\x06proto3"
+
+var (
+	file_temporal_server_api_enums_v1_schedule_proto_rawDescOnce sync.Once
+	file_temporal_server_api_enums_v1_schedule_proto_rawDescData []byte
+)
+
+func file_temporal_server_api_enums_v1_schedule_proto_rawDescGZIP() []byte {
+	file_temporal_server_api_enums_v1_schedule_proto_rawDescOnce.Do(func() {
+		file_temporal_server_api_enums_v1_schedule_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_temporal_server_api_enums_v1_schedule_proto_rawDesc), len(file_temporal_server_api_enums_v1_schedule_proto_rawDesc)))
+	})
+	return file_temporal_server_api_enums_v1_schedule_proto_rawDescData
+}
+
+var file_temporal_server_api_enums_v1_schedule_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_temporal_server_api_enums_v1_schedule_proto_goTypes = []any{
+	(SimulatedScheduleActionOutcome)(0), // 0: temporal.server.api.enums.v1.SimulatedScheduleActionOutcome
+	(BusinessCalendarAction)(0),         // 1: temporal.server.api.enums.v1.BusinessCalendarAction
+}
+var file_temporal_server_api_enums_v1_schedule_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_temporal_server_api_enums_v1_schedule_proto_init() }
+func file_temporal_server_api_enums_v1_schedule_proto_init() {
+	if File_temporal_server_api_enums_v1_schedule_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_enums_v1_schedule_proto_rawDesc), len(file_temporal_server_api_enums_v1_schedule_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_temporal_server_api_enums_v1_schedule_proto_goTypes,
+		DependencyIndexes: file_temporal_server_api_enums_v1_schedule_proto_depIdxs,
+		EnumInfos:         file_temporal_server_api_enums_v1_schedule_proto_enumTypes,
+	}.Build()
+	File_temporal_server_api_enums_v1_schedule_proto = out.File
+	file_temporal_server_api_enums_v1_schedule_proto_goTypes = nil
+	file_temporal_server_api_enums_v1_schedule_proto_depIdxs = nil
+}
@@ -5578,3 +5578,77 @@ func (this *UpdateWorkflowExecutionOptionsResponse) Equal(that interface{}) bool
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type UpsertWorkflowExecutionPropertiesRequest to the protobuf v3 wire format
+func (val *UpsertWorkflowExecutionPropertiesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpsertWorkflowExecutionPropertiesRequest from the protobuf v3 wire format
+func (val *UpsertWorkflowExecutionPropertiesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpsertWorkflowExecutionPropertiesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpsertWorkflowExecutionPropertiesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpsertWorkflowExecutionPropertiesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpsertWorkflowExecutionPropertiesRequest
+	switch t := that.(type) {
+	case *UpsertWorkflowExecutionPropertiesRequest:
+		that1 = t
+	case UpsertWorkflowExecutionPropertiesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpsertWorkflowExecutionPropertiesResponse to the protobuf v3 wire format
+func (val *UpsertWorkflowExecutionPropertiesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpsertWorkflowExecutionPropertiesResponse from the protobuf v3 wire format
+func (val *UpsertWorkflowExecutionPropertiesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpsertWorkflowExecutionPropertiesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpsertWorkflowExecutionPropertiesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpsertWorkflowExecutionPropertiesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpsertWorkflowExecutionPropertiesResponse
+	switch t := that.(type) {
+	case *UpsertWorkflowExecutionPropertiesResponse:
+		that1 = t
+	case UpsertWorkflowExecutionPropertiesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
@@ -113,6 +113,7 @@ const (
 	HistoryService_PauseActivity_FullMethodName                          = "/temporal.server.api.historyservice.v1.HistoryService/PauseActivity"
 	HistoryService_UnpauseActivity_FullMethodName                        = "/temporal.server.api.historyservice.v1.HistoryService/UnpauseActivity"
 	HistoryService_ResetActivity_FullMethodName                          = "/temporal.server.api.historyservice.v1.HistoryService/ResetActivity"
+	HistoryService_UpsertWorkflowExecutionProperties_FullMethodName      = "/temporal.server.api.historyservice.v1.HistoryService/UpsertWorkflowExecutionProperties"
 )
 
 // HistoryServiceClient is the client API for HistoryService service.
@@ -391,6 +392,12 @@ type HistoryServiceClient interface {
 	// (-- api-linter: core::0134::method-signature=disabled
 	// (-- api-linter: core::0134::response-message-name=disabled
 	ResetActivity(ctx context.Context, in *ResetActivityRequest, opts ...grpc.CallOption) (*ResetActivityResponse, error)
+	// UpsertWorkflowExecutionProperties merges search attributes and memo into a workflow execution
+	// and updates its visibility record. Used by batch operations to backfill visibility.
+	// Memo of a running execution is recorded with a WorkflowPropertiesModifiedExternally event, and
+	// its search attributes are rejected with FailedPrecondition since only the workflow can upsert
+	// them in history. Closed executions are updated without a history event.
+	UpsertWorkflowExecutionProperties(ctx context.Context, in *UpsertWorkflowExecutionPropertiesRequest, opts ...grpc.CallOption) (*UpsertWorkflowExecutionPropertiesResponse, error)
 }
 
 type historyServiceClient struct {
@@ -1062,6 +1069,15 @@ func (c *historyServiceClient) ResetActivity(ctx context.Context, in *ResetActiv
 	return out, nil
 }
 
+func (c *historyServiceClient) UpsertWorkflowExecutionProperties(ctx context.Context, in *UpsertWorkflowExecutionPropertiesRequest, opts ...grpc.CallOption) (*UpsertWorkflowExecutionPropertiesResponse, error) {
+	out := new(UpsertWorkflowExecutionPropertiesResponse)
+	err := c.cc.Invoke(ctx, HistoryService_UpsertWorkflowExecutionProperties_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HistoryServiceServer is the server API for HistoryService service.
 // All implementations must embed UnimplementedHistoryServiceServer
 // for forward compatibility
@@ -1338,6 +1354,12 @@ type HistoryServiceServer interface {
 	// (-- api-linter: core::0134::method-signature=disabled
 	// (-- api-linter: core::0134::response-message-name=disabled
 	ResetActivity(context.Context, *ResetActivityRequest) (*ResetActivityResponse, error)
+	// UpsertWorkflowExecutionProperties merges search attributes and memo into a workflow execution
+	// and updates its visibility record. Used by batch operations to backfill visibility.
+	// Memo of a running execution is recorded with a WorkflowPropertiesModifiedExternally event, and
+	// its search attributes are rejected with FailedPrecondition since only the workflow can upsert
+	// them in history. Closed executions are updated without a history event.
+	UpsertWorkflowExecutionProperties(context.Context, *UpsertWorkflowExecutionPropertiesRequest) (*UpsertWorkflowExecutionPropertiesResponse, error)
 	mustEmbedUnimplementedHistoryServiceServer()
 }
 
@@ -1558,6 +1580,9 @@ func (UnimplementedHistoryServiceServer) UnpauseActivity(context.Context, *Unpau
 func (UnimplementedHistoryServiceServer) ResetActivity(context.Context, *ResetActivityRequest) (*ResetActivityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetActivity not implemented")
 }
+func (UnimplementedHistoryServiceServer) UpsertWorkflowExecutionProperties(context.Context, *UpsertWorkflowExecutionPropertiesRequest) (*UpsertWorkflowExecutionPropertiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertWorkflowExecutionProperties not implemented")
+}
 func (UnimplementedHistoryServiceServer) mustEmbedUnimplementedHistoryServiceServer() {}
 
 // UnsafeHistoryServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -2857,6 +2882,24 @@ func _HistoryService_ResetActivity_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_UpsertWorkflowExecutionProperties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertWorkflowExecutionPropertiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UpsertWorkflowExecutionProperties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_UpsertWorkflowExecutionProperties_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UpsertWorkflowExecutionProperties(ctx, req.(*UpsertWorkflowExecutionPropertiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HistoryService_ServiceDesc is the grpc.ServiceDesc for HistoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -3144,6 +3187,10 @@ var HistoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetActivity",
 			Handler:    _HistoryService_ResetActivity_Handler,
 		},
+		{
+			MethodName: "UpsertWorkflowExecutionProperties",
+			Handler:    _HistoryService_UpsertWorkflowExecutionProperties_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1447,6 +1447,26 @@ func (mr *MockHistoryServiceClientMockRecorder) UpdateWorkflowExecutionOptions(c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecutionOptions", reflect.TypeOf((*MockHistoryServiceClient)(nil).UpdateWorkflowExecutionOptions), varargs...)
 }
 
+// UpsertWorkflowExecutionProperties mocks base method.
+func (m *MockHistoryServiceClient) UpsertWorkflowExecutionProperties(ctx context.Context, in *historyservice.UpsertWorkflowExecutionPropertiesRequest, opts ...grpc.CallOption) (*historyservice.UpsertWorkflowExecutionPropertiesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpsertWorkflowExecutionProperties", varargs...)
+	ret0, _ := ret[0].(*historyservice.UpsertWorkflowExecutionPropertiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertWorkflowExecutionProperties indicates an expected call of UpsertWorkflowExecutionProperties.
+func (mr *MockHistoryServiceClientMockRecorder) UpsertWorkflowExecutionProperties(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkflowExecutionProperties", reflect.TypeOf((*MockHistoryServiceClient)(nil).UpsertWorkflowExecutionProperties), varargs...)
+}
+
 // VerifyChildExecutionCompletionRecorded mocks base method.
 func (m *MockHistoryServiceClient) VerifyChildExecutionCompletionRecorded(ctx context.Context, in *historyservice.VerifyChildExecutionCompletionRecordedRequest, opts ...grpc.CallOption) (*historyservice.VerifyChildExecutionCompletionRecordedResponse, error) {
 	m.ctrl.T.Helper()
@@ -2683,6 +2703,21 @@ func (mr *MockHistoryServiceServerMockRecorder) UpdateWorkflowExecutionOptions(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecutionOptions", reflect.TypeOf((*MockHistoryServiceServer)(nil).UpdateWorkflowExecutionOptions), arg0, arg1)
 }
 
+// UpsertWorkflowExecutionProperties mocks base method.
+func (m *MockHistoryServiceServer) UpsertWorkflowExecutionProperties(arg0 context.Context, arg1 *historyservice.UpsertWorkflowExecutionPropertiesRequest) (*historyservice.UpsertWorkflowExecutionPropertiesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertWorkflowExecutionProperties", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.UpsertWorkflowExecutionPropertiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertWorkflowExecutionProperties indicates an expected call of UpsertWorkflowExecutionProperties.
+func (mr *MockHistoryServiceServerMockRecorder) UpsertWorkflowExecutionProperties(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkflowExecutionProperties", reflect.TypeOf((*MockHistoryServiceServer)(nil).UpsertWorkflowExecutionProperties), arg0, arg1)
+}
+
 // VerifyChildExecutionCompletionRecorded mocks base method.
 func (m *MockHistoryServiceServer) VerifyChildExecutionCompletionRecorded(arg0 context.Context, arg1 *historyservice.VerifyChildExecutionCompletionRecordedRequest) (*historyservice.VerifyChildExecutionCompletionRecordedResponse, error) {
 	m.ctrl.T.Helper()
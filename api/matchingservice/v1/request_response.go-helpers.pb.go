@@ -991,6 +991,80 @@ func (this *UpdateWorkerVersioningRulesResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type UpdateActivityTypeRateLimitsRequest to the protobuf v3 wire format
+func (val *UpdateActivityTypeRateLimitsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateActivityTypeRateLimitsRequest from the protobuf v3 wire format
+func (val *UpdateActivityTypeRateLimitsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateActivityTypeRateLimitsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateActivityTypeRateLimitsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateActivityTypeRateLimitsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateActivityTypeRateLimitsRequest
+	switch t := that.(type) {
+	case *UpdateActivityTypeRateLimitsRequest:
+		that1 = t
+	case UpdateActivityTypeRateLimitsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateActivityTypeRateLimitsResponse to the protobuf v3 wire format
+func (val *UpdateActivityTypeRateLimitsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateActivityTypeRateLimitsResponse from the protobuf v3 wire format
+func (val *UpdateActivityTypeRateLimitsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateActivityTypeRateLimitsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateActivityTypeRateLimitsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateActivityTypeRateLimitsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateActivityTypeRateLimitsResponse
+	switch t := that.(type) {
+	case *UpdateActivityTypeRateLimitsResponse:
+		that1 = t
+	case UpdateActivityTypeRateLimitsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type GetWorkerBuildIdCompatibilityRequest to the protobuf v3 wire format
 func (val *GetWorkerBuildIdCompatibilityRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -1583,6 +1657,80 @@ func (this *ForceUnloadTaskQueuePartitionResponse) Equal(that interface{}) bool
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type MigrateTaskQueueBacklogRequest to the protobuf v3 wire format
+func (val *MigrateTaskQueueBacklogRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MigrateTaskQueueBacklogRequest from the protobuf v3 wire format
+func (val *MigrateTaskQueueBacklogRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MigrateTaskQueueBacklogRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MigrateTaskQueueBacklogRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MigrateTaskQueueBacklogRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MigrateTaskQueueBacklogRequest
+	switch t := that.(type) {
+	case *MigrateTaskQueueBacklogRequest:
+		that1 = t
+	case MigrateTaskQueueBacklogRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type MigrateTaskQueueBacklogResponse to the protobuf v3 wire format
+func (val *MigrateTaskQueueBacklogResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MigrateTaskQueueBacklogResponse from the protobuf v3 wire format
+func (val *MigrateTaskQueueBacklogResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MigrateTaskQueueBacklogResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MigrateTaskQueueBacklogResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MigrateTaskQueueBacklogResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MigrateTaskQueueBacklogResponse
+	switch t := that.(type) {
+	case *MigrateTaskQueueBacklogResponse:
+		that1 = t
+	case MigrateTaskQueueBacklogResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type UpdateTaskQueueUserDataRequest to the protobuf v3 wire format
 func (val *UpdateTaskQueueUserDataRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
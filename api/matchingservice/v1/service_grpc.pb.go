@@ -61,12 +61,14 @@ const (
 	MatchingService_GetTaskQueueUserData_FullMethodName                   = "/temporal.server.api.matchingservice.v1.MatchingService/GetTaskQueueUserData"
 	MatchingService_UpdateWorkerVersioningRules_FullMethodName            = "/temporal.server.api.matchingservice.v1.MatchingService/UpdateWorkerVersioningRules"
 	MatchingService_GetWorkerVersioningRules_FullMethodName               = "/temporal.server.api.matchingservice.v1.MatchingService/GetWorkerVersioningRules"
+	MatchingService_UpdateActivityTypeRateLimits_FullMethodName           = "/temporal.server.api.matchingservice.v1.MatchingService/UpdateActivityTypeRateLimits"
 	MatchingService_SyncDeploymentUserData_FullMethodName                 = "/temporal.server.api.matchingservice.v1.MatchingService/SyncDeploymentUserData"
 	MatchingService_ApplyTaskQueueUserDataReplicationEvent_FullMethodName = "/temporal.server.api.matchingservice.v1.MatchingService/ApplyTaskQueueUserDataReplicationEvent"
 	MatchingService_GetBuildIdTaskQueueMapping_FullMethodName             = "/temporal.server.api.matchingservice.v1.MatchingService/GetBuildIdTaskQueueMapping"
 	MatchingService_ForceLoadTaskQueuePartition_FullMethodName            = "/temporal.server.api.matchingservice.v1.MatchingService/ForceLoadTaskQueuePartition"
 	MatchingService_ForceUnloadTaskQueue_FullMethodName                   = "/temporal.server.api.matchingservice.v1.MatchingService/ForceUnloadTaskQueue"
 	MatchingService_ForceUnloadTaskQueuePartition_FullMethodName          = "/temporal.server.api.matchingservice.v1.MatchingService/ForceUnloadTaskQueuePartition"
+	MatchingService_MigrateTaskQueueBacklog_FullMethodName                = "/temporal.server.api.matchingservice.v1.MatchingService/MigrateTaskQueueBacklog"
 	MatchingService_UpdateTaskQueueUserData_FullMethodName                = "/temporal.server.api.matchingservice.v1.MatchingService/UpdateTaskQueueUserData"
 	MatchingService_ReplicateTaskQueueUserData_FullMethodName             = "/temporal.server.api.matchingservice.v1.MatchingService/ReplicateTaskQueueUserData"
 	MatchingService_CheckTaskQueueUserDataPropagation_FullMethodName      = "/temporal.server.api.matchingservice.v1.MatchingService/CheckTaskQueueUserDataPropagation"
@@ -148,6 +150,19 @@ type MatchingServiceClient interface {
 	//
 	//	aip.dev/not-precedent: GetWorkerVersioningRulesRequest RPC doesn't follow Google API format. --)
 	GetWorkerVersioningRules(ctx context.Context, in *GetWorkerVersioningRulesRequest, opts ...grpc.CallOption) (*GetWorkerVersioningRulesResponse, error)
+	// Adds, replaces or removes per-activity-type dispatch rate limits for a task queue. The limits
+	// are stored in task queue user data and enforced by the root partition of the activity task queue.
+	// An empty request returns the current limits.
+	// Only the new matcher enforces the limits: setting limits fails with FailedPrecondition unless
+	// matching.useNewMatcher is enabled for the activity task queue.
+	// (-- api-linter: core::0134::response-message-name=disabled
+	//
+	//	aip.dev/not-precedent: UpdateActivityTypeRateLimits RPC doesn't follow Google API format. --)
+	//
+	// (-- api-linter: core::0134::method-signature=disabled
+	//
+	//	aip.dev/not-precedent: UpdateActivityTypeRateLimits RPC doesn't follow Google API format. --)
+	UpdateActivityTypeRateLimits(ctx context.Context, in *UpdateActivityTypeRateLimitsRequest, opts ...grpc.CallOption) (*UpdateActivityTypeRateLimitsResponse, error)
 	// This request should always be routed to the node holding the root partition of the workflow task queue.
 	SyncDeploymentUserData(ctx context.Context, in *SyncDeploymentUserDataRequest, opts ...grpc.CallOption) (*SyncDeploymentUserDataResponse, error)
 	// Apply a user data replication event.
@@ -164,6 +179,9 @@ type MatchingServiceClient interface {
 	ForceUnloadTaskQueue(ctx context.Context, in *ForceUnloadTaskQueueRequest, opts ...grpc.CallOption) (*ForceUnloadTaskQueueResponse, error)
 	// Force unloading a task queue partition.
 	ForceUnloadTaskQueuePartition(ctx context.Context, in *ForceUnloadTaskQueuePartitionRequest, opts ...grpc.CallOption) (*ForceUnloadTaskQueuePartitionResponse, error)
+	// Moves or copies a batch of backlog tasks of a task queue partition to another task queue.
+	// Must be called repeatedly with the returned position until done.
+	MigrateTaskQueueBacklog(ctx context.Context, in *MigrateTaskQueueBacklogRequest, opts ...grpc.CallOption) (*MigrateTaskQueueBacklogResponse, error)
 	// Update task queue user data in owning node for all updates in namespace.
 	// All user data updates must first go through the task queue owner using the `UpdateWorkerBuildIdCompatibility`
 	// API.
@@ -394,6 +412,15 @@ func (c *matchingServiceClient) GetWorkerVersioningRules(ctx context.Context, in
 	return out, nil
 }
 
+func (c *matchingServiceClient) UpdateActivityTypeRateLimits(ctx context.Context, in *UpdateActivityTypeRateLimitsRequest, opts ...grpc.CallOption) (*UpdateActivityTypeRateLimitsResponse, error) {
+	out := new(UpdateActivityTypeRateLimitsResponse)
+	err := c.cc.Invoke(ctx, MatchingService_UpdateActivityTypeRateLimits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *matchingServiceClient) SyncDeploymentUserData(ctx context.Context, in *SyncDeploymentUserDataRequest, opts ...grpc.CallOption) (*SyncDeploymentUserDataResponse, error) {
 	out := new(SyncDeploymentUserDataResponse)
 	err := c.cc.Invoke(ctx, MatchingService_SyncDeploymentUserData_FullMethodName, in, out, opts...)
@@ -448,6 +475,15 @@ func (c *matchingServiceClient) ForceUnloadTaskQueuePartition(ctx context.Contex
 	return out, nil
 }
 
+func (c *matchingServiceClient) MigrateTaskQueueBacklog(ctx context.Context, in *MigrateTaskQueueBacklogRequest, opts ...grpc.CallOption) (*MigrateTaskQueueBacklogResponse, error) {
+	out := new(MigrateTaskQueueBacklogResponse)
+	err := c.cc.Invoke(ctx, MatchingService_MigrateTaskQueueBacklog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *matchingServiceClient) UpdateTaskQueueUserData(ctx context.Context, in *UpdateTaskQueueUserDataRequest, opts ...grpc.CallOption) (*UpdateTaskQueueUserDataResponse, error) {
 	out := new(UpdateTaskQueueUserDataResponse)
 	err := c.cc.Invoke(ctx, MatchingService_UpdateTaskQueueUserData_FullMethodName, in, out, opts...)
@@ -583,6 +619,19 @@ type MatchingServiceServer interface {
 	//
 	//	aip.dev/not-precedent: GetWorkerVersioningRulesRequest RPC doesn't follow Google API format. --)
 	GetWorkerVersioningRules(context.Context, *GetWorkerVersioningRulesRequest) (*GetWorkerVersioningRulesResponse, error)
+	// Adds, replaces or removes per-activity-type dispatch rate limits for a task queue. The limits
+	// are stored in task queue user data and enforced by the root partition of the activity task queue.
+	// An empty request returns the current limits.
+	// Only the new matcher enforces the limits: setting limits fails with FailedPrecondition unless
+	// matching.useNewMatcher is enabled for the activity task queue.
+	// (-- api-linter: core::0134::response-message-name=disabled
+	//
+	//	aip.dev/not-precedent: UpdateActivityTypeRateLimits RPC doesn't follow Google API format. --)
+	//
+	// (-- api-linter: core::0134::method-signature=disabled
+	//
+	//	aip.dev/not-precedent: UpdateActivityTypeRateLimits RPC doesn't follow Google API format. --)
+	UpdateActivityTypeRateLimits(context.Context, *UpdateActivityTypeRateLimitsRequest) (*UpdateActivityTypeRateLimitsResponse, error)
 	// This request should always be routed to the node holding the root partition of the workflow task queue.
 	SyncDeploymentUserData(context.Context, *SyncDeploymentUserDataRequest) (*SyncDeploymentUserDataResponse, error)
 	// Apply a user data replication event.
@@ -599,6 +648,9 @@ type MatchingServiceServer interface {
 	ForceUnloadTaskQueue(context.Context, *ForceUnloadTaskQueueRequest) (*ForceUnloadTaskQueueResponse, error)
 	// Force unloading a task queue partition.
 	ForceUnloadTaskQueuePartition(context.Context, *ForceUnloadTaskQueuePartitionRequest) (*ForceUnloadTaskQueuePartitionResponse, error)
+	// Moves or copies a batch of backlog tasks of a task queue partition to another task queue.
+	// Must be called repeatedly with the returned position until done.
+	MigrateTaskQueueBacklog(context.Context, *MigrateTaskQueueBacklogRequest) (*MigrateTaskQueueBacklogResponse, error)
 	// Update task queue user data in owning node for all updates in namespace.
 	// All user data updates must first go through the task queue owner using the `UpdateWorkerBuildIdCompatibility`
 	// API.
@@ -712,6 +764,9 @@ func (UnimplementedMatchingServiceServer) UpdateWorkerVersioningRules(context.Co
 func (UnimplementedMatchingServiceServer) GetWorkerVersioningRules(context.Context, *GetWorkerVersioningRulesRequest) (*GetWorkerVersioningRulesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerVersioningRules not implemented")
 }
+func (UnimplementedMatchingServiceServer) UpdateActivityTypeRateLimits(context.Context, *UpdateActivityTypeRateLimitsRequest) (*UpdateActivityTypeRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateActivityTypeRateLimits not implemented")
+}
 func (UnimplementedMatchingServiceServer) SyncDeploymentUserData(context.Context, *SyncDeploymentUserDataRequest) (*SyncDeploymentUserDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncDeploymentUserData not implemented")
 }
@@ -730,6 +785,9 @@ func (UnimplementedMatchingServiceServer) ForceUnloadTaskQueue(context.Context,
 func (UnimplementedMatchingServiceServer) ForceUnloadTaskQueuePartition(context.Context, *ForceUnloadTaskQueuePartitionRequest) (*ForceUnloadTaskQueuePartitionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceUnloadTaskQueuePartition not implemented")
 }
+func (UnimplementedMatchingServiceServer) MigrateTaskQueueBacklog(context.Context, *MigrateTaskQueueBacklogRequest) (*MigrateTaskQueueBacklogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateTaskQueueBacklog not implemented")
+}
 func (UnimplementedMatchingServiceServer) UpdateTaskQueueUserData(context.Context, *UpdateTaskQueueUserDataRequest) (*UpdateTaskQueueUserDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaskQueueUserData not implemented")
 }
@@ -1106,6 +1164,24 @@ func _MatchingService_GetWorkerVersioningRules_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MatchingService_UpdateActivityTypeRateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateActivityTypeRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).UpdateActivityTypeRateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_UpdateActivityTypeRateLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).UpdateActivityTypeRateLimits(ctx, req.(*UpdateActivityTypeRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MatchingService_SyncDeploymentUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SyncDeploymentUserDataRequest)
 	if err := dec(in); err != nil {
@@ -1214,6 +1290,24 @@ func _MatchingService_ForceUnloadTaskQueuePartition_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MatchingService_MigrateTaskQueueBacklog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateTaskQueueBacklogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).MigrateTaskQueueBacklog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_MigrateTaskQueueBacklog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).MigrateTaskQueueBacklog(ctx, req.(*MigrateTaskQueueBacklogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MatchingService_UpdateTaskQueueUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateTaskQueueUserDataRequest)
 	if err := dec(in); err != nil {
@@ -1423,6 +1517,10 @@ var MatchingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetWorkerVersioningRules",
 			Handler:    _MatchingService_GetWorkerVersioningRules_Handler,
 		},
+		{
+			MethodName: "UpdateActivityTypeRateLimits",
+			Handler:    _MatchingService_UpdateActivityTypeRateLimits_Handler,
+		},
 		{
 			MethodName: "SyncDeploymentUserData",
 			Handler:    _MatchingService_SyncDeploymentUserData_Handler,
@@ -1447,6 +1545,10 @@ var MatchingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ForceUnloadTaskQueuePartition",
 			Handler:    _MatchingService_ForceUnloadTaskQueuePartition_Handler,
 		},
+		{
+			MethodName: "MigrateTaskQueueBacklog",
+			Handler:    _MatchingService_MigrateTaskQueueBacklog_Handler,
+		},
 		{
 			MethodName: "UpdateTaskQueueUserData",
 			Handler:    _MatchingService_UpdateTaskQueueUserData_Handler,
@@ -446,6 +446,26 @@ func (mr *MockMatchingServiceClientMockRecorder) ListTaskQueuePartitions(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueuePartitions", reflect.TypeOf((*MockMatchingServiceClient)(nil).ListTaskQueuePartitions), varargs...)
 }
 
+// MigrateTaskQueueBacklog mocks base method.
+func (m *MockMatchingServiceClient) MigrateTaskQueueBacklog(ctx context.Context, in *matchingservice.MigrateTaskQueueBacklogRequest, opts ...grpc.CallOption) (*matchingservice.MigrateTaskQueueBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MigrateTaskQueueBacklog", varargs...)
+	ret0, _ := ret[0].(*matchingservice.MigrateTaskQueueBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateTaskQueueBacklog indicates an expected call of MigrateTaskQueueBacklog.
+func (mr *MockMatchingServiceClientMockRecorder) MigrateTaskQueueBacklog(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateTaskQueueBacklog", reflect.TypeOf((*MockMatchingServiceClient)(nil).MigrateTaskQueueBacklog), varargs...)
+}
+
 // PollActivityTaskQueue mocks base method.
 func (m *MockMatchingServiceClient) PollActivityTaskQueue(ctx context.Context, in *matchingservice.PollActivityTaskQueueRequest, opts ...grpc.CallOption) (*matchingservice.PollActivityTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -626,6 +646,26 @@ func (mr *MockMatchingServiceClientMockRecorder) SyncDeploymentUserData(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncDeploymentUserData", reflect.TypeOf((*MockMatchingServiceClient)(nil).SyncDeploymentUserData), varargs...)
 }
 
+// UpdateActivityTypeRateLimits mocks base method.
+func (m *MockMatchingServiceClient) UpdateActivityTypeRateLimits(ctx context.Context, in *matchingservice.UpdateActivityTypeRateLimitsRequest, opts ...grpc.CallOption) (*matchingservice.UpdateActivityTypeRateLimitsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateActivityTypeRateLimits", varargs...)
+	ret0, _ := ret[0].(*matchingservice.UpdateActivityTypeRateLimitsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateActivityTypeRateLimits indicates an expected call of UpdateActivityTypeRateLimits.
+func (mr *MockMatchingServiceClientMockRecorder) UpdateActivityTypeRateLimits(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityTypeRateLimits", reflect.TypeOf((*MockMatchingServiceClient)(nil).UpdateActivityTypeRateLimits), varargs...)
+}
+
 // UpdateNexusEndpoint mocks base method.
 func (m *MockMatchingServiceClient) UpdateNexusEndpoint(ctx context.Context, in *matchingservice.UpdateNexusEndpointRequest, opts ...grpc.CallOption) (*matchingservice.UpdateNexusEndpointResponse, error) {
 	m.ctrl.T.Helper()
@@ -1015,6 +1055,21 @@ func (mr *MockMatchingServiceServerMockRecorder) ListTaskQueuePartitions(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueuePartitions", reflect.TypeOf((*MockMatchingServiceServer)(nil).ListTaskQueuePartitions), arg0, arg1)
 }
 
+// MigrateTaskQueueBacklog mocks base method.
+func (m *MockMatchingServiceServer) MigrateTaskQueueBacklog(arg0 context.Context, arg1 *matchingservice.MigrateTaskQueueBacklogRequest) (*matchingservice.MigrateTaskQueueBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateTaskQueueBacklog", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.MigrateTaskQueueBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateTaskQueueBacklog indicates an expected call of MigrateTaskQueueBacklog.
+func (mr *MockMatchingServiceServerMockRecorder) MigrateTaskQueueBacklog(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateTaskQueueBacklog", reflect.TypeOf((*MockMatchingServiceServer)(nil).MigrateTaskQueueBacklog), arg0, arg1)
+}
+
 // PollActivityTaskQueue mocks base method.
 func (m *MockMatchingServiceServer) PollActivityTaskQueue(arg0 context.Context, arg1 *matchingservice.PollActivityTaskQueueRequest) (*matchingservice.PollActivityTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -1150,6 +1205,21 @@ func (mr *MockMatchingServiceServerMockRecorder) SyncDeploymentUserData(arg0, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncDeploymentUserData", reflect.TypeOf((*MockMatchingServiceServer)(nil).SyncDeploymentUserData), arg0, arg1)
 }
 
+// UpdateActivityTypeRateLimits mocks base method.
+func (m *MockMatchingServiceServer) UpdateActivityTypeRateLimits(arg0 context.Context, arg1 *matchingservice.UpdateActivityTypeRateLimitsRequest) (*matchingservice.UpdateActivityTypeRateLimitsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateActivityTypeRateLimits", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.UpdateActivityTypeRateLimitsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateActivityTypeRateLimits indicates an expected call of UpdateActivityTypeRateLimits.
+func (mr *MockMatchingServiceServerMockRecorder) UpdateActivityTypeRateLimits(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityTypeRateLimits", reflect.TypeOf((*MockMatchingServiceServer)(nil).UpdateActivityTypeRateLimits), arg0, arg1)
+}
+
 // UpdateNexusEndpoint mocks base method.
 func (m *MockMatchingServiceServer) UpdateNexusEndpoint(arg0 context.Context, arg1 *matchingservice.UpdateNexusEndpointRequest) (*matchingservice.UpdateNexusEndpointResponse, error) {
 	m.ctrl.T.Helper()
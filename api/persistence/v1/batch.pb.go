@@ -0,0 +1,250 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// plugins:
+// 	protoc-gen-go
+// 	protoc
+// source: temporal/server/api/persistence/v1/batch.proto
+
+package persistence
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	v1 "go.temporal.io/api/common/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// BatchFailureManifestEntry holds the executions a batch job gave up on in one page of its
+// target executions.
+type BatchFailureManifestEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Workflow id of the batch job.
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// Index of the page of target executions. The batch activity records a page again when it
+	// is retried, and the last entry recorded for a page replaces the earlier ones.
+	Page     int64           `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Failures []*BatchFailure `protobuf:"bytes,3,rep,name=failures,proto3" json:"failures,omitempty"`
+	// Set if some failures of the page were not recorded because the manifest is full.
+	Truncated     bool `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchFailureManifestEntry) Reset() {
+	*x = BatchFailureManifestEntry{}
+	mi := &file_temporal_server_api_persistence_v1_batch_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchFailureManifestEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchFailureManifestEntry) ProtoMessage() {}
+
+func (x *BatchFailureManifestEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_batch_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchFailureManifestEntry.ProtoReflect.Descriptor instead.
+func (*BatchFailureManifestEntry) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_batch_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BatchFailureManifestEntry) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *BatchFailureManifestEntry) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *BatchFailureManifestEntry) GetFailures() []*BatchFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+func (x *BatchFailureManifestEntry) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// BatchFailure is an execution a batch job gave up on.
+type BatchFailure struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Execution *v1.WorkflowExecution  `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
+	// Last error of the execution.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Number of attempts made to process the execution.
+	Attempts      int32 `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchFailure) Reset() {
+	*x = BatchFailure{}
+	mi := &file_temporal_server_api_persistence_v1_batch_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchFailure) ProtoMessage() {}
+
+func (x *BatchFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_batch_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchFailure.ProtoReflect.Descriptor instead.
+func (*BatchFailure) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_batch_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BatchFailure) GetExecution() *v1.WorkflowExecution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *BatchFailure) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BatchFailure) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+var File_temporal_server_api_persistence_v1_batch_proto protoreflect.FileDescriptor
+
+const file_temporal_server_api_persistence_v1_batch_proto_rawDesc = "" +
+	"\n" +
+	".temporal/server/api/persistence/v1/batch.proto\x12\"temporal.server.api.persistence.v1\x1a$temporal/api/common/v1/message.proto\"\xb2\x01\n" +
+	"\x19BatchFailureManifestEntry\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x03R\x04page\x12L\n" +
+	"\bfailures\x18\x03 \x03(\v20.temporal.server.api.persistence.v1.BatchFailureR\bfailures\x12\x1c\n" +
+	"\ttruncated\x18\x04 \x01(\bR\ttruncated\"\x89\x01\n" +
+	"\fBatchFailure\x12G\n" +
+	"\texecution\x18\x01 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x1a\n" +
+	"\battempts\x18\x03 \x01(\x05R\battemptsB6Z4go.temporal.io/server/api/persistence/v1;persistenceb\x06proto3"
+
+var (
+	file_temporal_server_api_persistence_v1_batch_proto_rawDescOnce sync.Once
+	file_temporal_server_api_persistence_v1_batch_proto_rawDescData []byte
+)
+
+func file_temporal_server_api_persistence_v1_batch_proto_rawDescGZIP() []byte {
+	file_temporal_server_api_persistence_v1_batch_proto_rawDescOnce.Do(func() {
+		file_temporal_server_api_persistence_v1_batch_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_temporal_server_api_persistence_v1_batch_proto_rawDesc), len(file_temporal_server_api_persistence_v1_batch_proto_rawDesc)))
+	})
+	return file_temporal_server_api_persistence_v1_batch_proto_rawDescData
+}
+
+var file_temporal_server_api_persistence_v1_batch_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_temporal_server_api_persistence_v1_batch_proto_goTypes = []any{
+	(*BatchFailureManifestEntry)(nil), // 0: temporal.server.api.persistence.v1.BatchFailureManifestEntry
+	(*BatchFailure)(nil),              // 1: temporal.server.api.persistence.v1.BatchFailure
+	(*v1.WorkflowExecution)(nil),      // 2: temporal.api.common.v1.WorkflowExecution
+}
+var file_temporal_server_api_persistence_v1_batch_proto_depIdxs = []int32{
+	1, // 0: temporal.server.api.persistence.v1.BatchFailureManifestEntry.failures:type_name -> temporal.server.api.persistence.v1.BatchFailure
+	2, // 1: temporal.server.api.persistence.v1.BatchFailure.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_temporal_server_api_persistence_v1_batch_proto_init() }
+func file_temporal_server_api_persistence_v1_batch_proto_init() {
+	if File_temporal_server_api_persistence_v1_batch_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_persistence_v1_batch_proto_rawDesc), len(file_temporal_server_api_persistence_v1_batch_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_temporal_server_api_persistence_v1_batch_proto_goTypes,
+		DependencyIndexes: file_temporal_server_api_persistence_v1_batch_proto_depIdxs,
+		MessageInfos:      file_temporal_server_api_persistence_v1_batch_proto_msgTypes,
+	}.Build()
+	File_temporal_server_api_persistence_v1_batch_proto = out.File
+	file_temporal_server_api_persistence_v1_batch_proto_goTypes = nil
+	file_temporal_server_api_persistence_v1_batch_proto_depIdxs = nil
+}
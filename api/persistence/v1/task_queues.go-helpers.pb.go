@@ -288,6 +288,80 @@ func (this *TaskQueueTypeUserData) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type TaskQueuePartitionCounts to the protobuf v3 wire format
+func (val *TaskQueuePartitionCounts) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TaskQueuePartitionCounts from the protobuf v3 wire format
+func (val *TaskQueuePartitionCounts) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TaskQueuePartitionCounts) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TaskQueuePartitionCounts values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TaskQueuePartitionCounts) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TaskQueuePartitionCounts
+	switch t := that.(type) {
+	case *TaskQueuePartitionCounts:
+		that1 = t
+	case TaskQueuePartitionCounts:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ActivityTypeRateLimit to the protobuf v3 wire format
+func (val *ActivityTypeRateLimit) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ActivityTypeRateLimit from the protobuf v3 wire format
+func (val *ActivityTypeRateLimit) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ActivityTypeRateLimit) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ActivityTypeRateLimit values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ActivityTypeRateLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ActivityTypeRateLimit
+	switch t := that.(type) {
+	case *ActivityTypeRateLimit:
+		that1 = t
+	case ActivityTypeRateLimit:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type TaskQueueUserData to the protobuf v3 wire format
 func (val *TaskQueueUserData) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
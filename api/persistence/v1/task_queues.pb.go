@@ -547,8 +547,15 @@ func (x *DeploymentData) GetUnversionedRampData() *v12.DeploymentVersionData {
 type TaskQueueTypeUserData struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	DeploymentData *DeploymentData        `protobuf:"bytes,1,opt,name=deployment_data,json=deploymentData,proto3" json:"deployment_data,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Per-activity-type dispatch rate limits, keyed by activity type name. Only used for the
+	// activity task queue type. Enforced by the root partition; child partitions forward tasks
+	// of limited activity types to the root.
+	ActivityTypeRateLimits map[string]*ActivityTypeRateLimit `protobuf:"bytes,2,rep,name=activity_type_rate_limits,json=activityTypeRateLimits,proto3" json:"activity_type_rate_limits,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Partition counts chosen by partition autoscaling. When set, they take precedence over the
+	// configured partition counts.
+	PartitionCounts *TaskQueuePartitionCounts `protobuf:"bytes,3,opt,name=partition_counts,json=partitionCounts,proto3" json:"partition_counts,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *TaskQueueTypeUserData) Reset() {
@@ -588,6 +595,140 @@ func (x *TaskQueueTypeUserData) GetDeploymentData() *DeploymentData {
 	return nil
 }
 
+func (x *TaskQueueTypeUserData) GetActivityTypeRateLimits() map[string]*ActivityTypeRateLimit {
+	if x != nil {
+		return x.ActivityTypeRateLimits
+	}
+	return nil
+}
+
+func (x *TaskQueueTypeUserData) GetPartitionCounts() *TaskQueuePartitionCounts {
+	if x != nil {
+		return x.PartitionCounts
+	}
+	return nil
+}
+
+// Number of partitions of a task queue of a single type.
+type TaskQueuePartitionCounts struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of partitions that pollers are spread over. It is at least write_partitions; it
+	// exceeds it while the backlog of partitions that stopped receiving tasks drains.
+	ReadPartitions int32 `protobuf:"varint,1,opt,name=read_partitions,json=readPartitions,proto3" json:"read_partitions,omitempty"`
+	// Number of partitions that new tasks are spread over.
+	WritePartitions int32 `protobuf:"varint,2,opt,name=write_partitions,json=writePartitions,proto3" json:"write_partitions,omitempty"`
+	// HLC timestamp of the last update to the counts.
+	UpdateTime    *v1.HybridLogicalClock `protobuf:"bytes,3,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskQueuePartitionCounts) Reset() {
+	*x = TaskQueuePartitionCounts{}
+	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskQueuePartitionCounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskQueuePartitionCounts) ProtoMessage() {}
+
+func (x *TaskQueuePartitionCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskQueuePartitionCounts.ProtoReflect.Descriptor instead.
+func (*TaskQueuePartitionCounts) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_task_queues_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TaskQueuePartitionCounts) GetReadPartitions() int32 {
+	if x != nil {
+		return x.ReadPartitions
+	}
+	return 0
+}
+
+func (x *TaskQueuePartitionCounts) GetWritePartitions() int32 {
+	if x != nil {
+		return x.WritePartitions
+	}
+	return 0
+}
+
+func (x *TaskQueuePartitionCounts) GetUpdateTime() *v1.HybridLogicalClock {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// Dispatch rate limit for tasks of a single activity type on a task queue.
+type ActivityTypeRateLimit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maximum dispatch rate across all partitions, in tasks per second.
+	RequestsPerSecond float64 `protobuf:"fixed64,1,opt,name=requests_per_second,json=requestsPerSecond,proto3" json:"requests_per_second,omitempty"`
+	// HLC timestamp of the last update to this limit.
+	UpdateTime    *v1.HybridLogicalClock `protobuf:"bytes,2,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityTypeRateLimit) Reset() {
+	*x = ActivityTypeRateLimit{}
+	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityTypeRateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityTypeRateLimit) ProtoMessage() {}
+
+func (x *ActivityTypeRateLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityTypeRateLimit.ProtoReflect.Descriptor instead.
+func (*ActivityTypeRateLimit) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_task_queues_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ActivityTypeRateLimit) GetRequestsPerSecond() float64 {
+	if x != nil {
+		return x.RequestsPerSecond
+	}
+	return 0
+}
+
+func (x *ActivityTypeRateLimit) GetUpdateTime() *v1.HybridLogicalClock {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
 // Container for all persistent user provided data for a task queue family.
 // "Task queue" as a named concept here is a task queue family, i.e. the set of task queues
 // that share a name, at most one of each type (workflow, activity, etc.).
@@ -609,7 +750,7 @@ type TaskQueueUserData struct {
 
 func (x *TaskQueueUserData) Reset() {
 	*x = TaskQueueUserData{}
-	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[7]
+	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -621,7 +762,7 @@ func (x *TaskQueueUserData) String() string {
 func (*TaskQueueUserData) ProtoMessage() {}
 
 func (x *TaskQueueUserData) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[7]
+	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -634,7 +775,7 @@ func (x *TaskQueueUserData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskQueueUserData.ProtoReflect.Descriptor instead.
 func (*TaskQueueUserData) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_persistence_v1_task_queues_proto_rawDescGZIP(), []int{7}
+	return file_temporal_server_api_persistence_v1_task_queues_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *TaskQueueUserData) GetClock() *v1.HybridLogicalClock {
@@ -669,7 +810,7 @@ type VersionedTaskQueueUserData struct {
 
 func (x *VersionedTaskQueueUserData) Reset() {
 	*x = VersionedTaskQueueUserData{}
-	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[8]
+	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -681,7 +822,7 @@ func (x *VersionedTaskQueueUserData) String() string {
 func (*VersionedTaskQueueUserData) ProtoMessage() {}
 
 func (x *VersionedTaskQueueUserData) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[8]
+	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -694,7 +835,7 @@ func (x *VersionedTaskQueueUserData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VersionedTaskQueueUserData.ProtoReflect.Descriptor instead.
 func (*VersionedTaskQueueUserData) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_persistence_v1_task_queues_proto_rawDescGZIP(), []int{8}
+	return file_temporal_server_api_persistence_v1_task_queues_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *VersionedTaskQueueUserData) GetData() *TaskQueueUserData {
@@ -722,7 +863,7 @@ type DeploymentData_DeploymentDataItem struct {
 
 func (x *DeploymentData_DeploymentDataItem) Reset() {
 	*x = DeploymentData_DeploymentDataItem{}
-	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[9]
+	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -734,7 +875,7 @@ func (x *DeploymentData_DeploymentDataItem) String() string {
 func (*DeploymentData_DeploymentDataItem) ProtoMessage() {}
 
 func (x *DeploymentData_DeploymentDataItem) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[9]
+	mi := &file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -802,9 +943,23 @@ const file_temporal_server_api_persistence_v1_task_queues_proto_rawDesc = "" +
 	"\n" +
 	"deployment\x18\x01 \x01(\v2&.temporal.api.deployment.v1.DeploymentR\n" +
 	"deployment\x12D\n" +
-	"\x04data\x18\x02 \x01(\v20.temporal.server.api.deployment.v1.TaskQueueDataR\x04data\"t\n" +
+	"\x04data\x18\x02 \x01(\v20.temporal.server.api.deployment.v1.TaskQueueDataR\x04data\"\xf7\x03\n" +
 	"\x15TaskQueueTypeUserData\x12[\n" +
-	"\x0fdeployment_data\x18\x01 \x01(\v22.temporal.server.api.persistence.v1.DeploymentDataR\x0edeploymentData\"\x8e\x03\n" +
+	"\x0fdeployment_data\x18\x01 \x01(\v22.temporal.server.api.persistence.v1.DeploymentDataR\x0edeploymentData\x12\x90\x01\n" +
+	"\x19activity_type_rate_limits\x18\x02 \x03(\v2U.temporal.server.api.persistence.v1.TaskQueueTypeUserData.ActivityTypeRateLimitsEntryR\x16activityTypeRateLimits\x12g\n" +
+	"\x10partition_counts\x18\x03 \x01(\v2<.temporal.server.api.persistence.v1.TaskQueuePartitionCountsR\x0fpartitionCounts\x1a\x84\x01\n" +
+	"\x1bActivityTypeRateLimitsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12O\n" +
+	"\x05value\x18\x02 \x01(\v29.temporal.server.api.persistence.v1.ActivityTypeRateLimitR\x05value:\x028\x01\"\xc1\x01\n" +
+	"\x18TaskQueuePartitionCounts\x12'\n" +
+	"\x0fread_partitions\x18\x01 \x01(\x05R\x0ereadPartitions\x12)\n" +
+	"\x10write_partitions\x18\x02 \x01(\x05R\x0fwritePartitions\x12Q\n" +
+	"\vupdate_time\x18\x03 \x01(\v20.temporal.server.api.clock.v1.HybridLogicalClockR\n" +
+	"updateTime\"\x9a\x01\n" +
+	"\x15ActivityTypeRateLimit\x12.\n" +
+	"\x13requests_per_second\x18\x01 \x01(\x01R\x11requestsPerSecond\x12Q\n" +
+	"\vupdate_time\x18\x02 \x01(\v20.temporal.server.api.clock.v1.HybridLogicalClockR\n" +
+	"updateTime\"\x8e\x03\n" +
 	"\x11TaskQueueUserData\x12F\n" +
 	"\x05clock\x18\x01 \x01(\v20.temporal.server.api.clock.v1.HybridLogicalClockR\x05clock\x12[\n" +
 	"\x0fversioning_data\x18\x02 \x01(\v22.temporal.server.api.persistence.v1.VersioningDataR\x0eversioningData\x12]\n" +
@@ -829,7 +984,7 @@ func file_temporal_server_api_persistence_v1_task_queues_proto_rawDescGZIP() []b
 }
 
 var file_temporal_server_api_persistence_v1_task_queues_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_temporal_server_api_persistence_v1_task_queues_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_temporal_server_api_persistence_v1_task_queues_proto_goTypes = []any{
 	(BuildId_State)(0),                        // 0: temporal.server.api.persistence.v1.BuildId.State
 	(*BuildId)(nil),                           // 1: temporal.server.api.persistence.v1.BuildId
@@ -839,48 +994,56 @@ var file_temporal_server_api_persistence_v1_task_queues_proto_goTypes = []any{
 	(*VersioningData)(nil),                    // 5: temporal.server.api.persistence.v1.VersioningData
 	(*DeploymentData)(nil),                    // 6: temporal.server.api.persistence.v1.DeploymentData
 	(*TaskQueueTypeUserData)(nil),             // 7: temporal.server.api.persistence.v1.TaskQueueTypeUserData
-	(*TaskQueueUserData)(nil),                 // 8: temporal.server.api.persistence.v1.TaskQueueUserData
-	(*VersionedTaskQueueUserData)(nil),        // 9: temporal.server.api.persistence.v1.VersionedTaskQueueUserData
-	(*DeploymentData_DeploymentDataItem)(nil), // 10: temporal.server.api.persistence.v1.DeploymentData.DeploymentDataItem
-	nil,                               // 11: temporal.server.api.persistence.v1.TaskQueueUserData.PerTypeEntry
-	(*v1.HybridLogicalClock)(nil),     // 12: temporal.server.api.clock.v1.HybridLogicalClock
-	(*v11.BuildIdAssignmentRule)(nil), // 13: temporal.api.taskqueue.v1.BuildIdAssignmentRule
-	(*v11.CompatibleBuildIdRedirectRule)(nil), // 14: temporal.api.taskqueue.v1.CompatibleBuildIdRedirectRule
-	(*v12.DeploymentVersionData)(nil),         // 15: temporal.server.api.deployment.v1.DeploymentVersionData
-	(*v13.Deployment)(nil),                    // 16: temporal.api.deployment.v1.Deployment
-	(*v12.TaskQueueData)(nil),                 // 17: temporal.server.api.deployment.v1.TaskQueueData
+	(*TaskQueuePartitionCounts)(nil),          // 8: temporal.server.api.persistence.v1.TaskQueuePartitionCounts
+	(*ActivityTypeRateLimit)(nil),             // 9: temporal.server.api.persistence.v1.ActivityTypeRateLimit
+	(*TaskQueueUserData)(nil),                 // 10: temporal.server.api.persistence.v1.TaskQueueUserData
+	(*VersionedTaskQueueUserData)(nil),        // 11: temporal.server.api.persistence.v1.VersionedTaskQueueUserData
+	(*DeploymentData_DeploymentDataItem)(nil), // 12: temporal.server.api.persistence.v1.DeploymentData.DeploymentDataItem
+	nil,                               // 13: temporal.server.api.persistence.v1.TaskQueueTypeUserData.ActivityTypeRateLimitsEntry
+	nil,                               // 14: temporal.server.api.persistence.v1.TaskQueueUserData.PerTypeEntry
+	(*v1.HybridLogicalClock)(nil),     // 15: temporal.server.api.clock.v1.HybridLogicalClock
+	(*v11.BuildIdAssignmentRule)(nil), // 16: temporal.api.taskqueue.v1.BuildIdAssignmentRule
+	(*v11.CompatibleBuildIdRedirectRule)(nil), // 17: temporal.api.taskqueue.v1.CompatibleBuildIdRedirectRule
+	(*v12.DeploymentVersionData)(nil),         // 18: temporal.server.api.deployment.v1.DeploymentVersionData
+	(*v13.Deployment)(nil),                    // 19: temporal.api.deployment.v1.Deployment
+	(*v12.TaskQueueData)(nil),                 // 20: temporal.server.api.deployment.v1.TaskQueueData
 }
 var file_temporal_server_api_persistence_v1_task_queues_proto_depIdxs = []int32{
 	0,  // 0: temporal.server.api.persistence.v1.BuildId.state:type_name -> temporal.server.api.persistence.v1.BuildId.State
-	12, // 1: temporal.server.api.persistence.v1.BuildId.state_update_timestamp:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
-	12, // 2: temporal.server.api.persistence.v1.BuildId.became_default_timestamp:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
+	15, // 1: temporal.server.api.persistence.v1.BuildId.state_update_timestamp:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
+	15, // 2: temporal.server.api.persistence.v1.BuildId.became_default_timestamp:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
 	1,  // 3: temporal.server.api.persistence.v1.CompatibleVersionSet.build_ids:type_name -> temporal.server.api.persistence.v1.BuildId
-	12, // 4: temporal.server.api.persistence.v1.CompatibleVersionSet.became_default_timestamp:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
-	13, // 5: temporal.server.api.persistence.v1.AssignmentRule.rule:type_name -> temporal.api.taskqueue.v1.BuildIdAssignmentRule
-	12, // 6: temporal.server.api.persistence.v1.AssignmentRule.create_timestamp:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
-	12, // 7: temporal.server.api.persistence.v1.AssignmentRule.delete_timestamp:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
-	14, // 8: temporal.server.api.persistence.v1.RedirectRule.rule:type_name -> temporal.api.taskqueue.v1.CompatibleBuildIdRedirectRule
-	12, // 9: temporal.server.api.persistence.v1.RedirectRule.create_timestamp:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
-	12, // 10: temporal.server.api.persistence.v1.RedirectRule.delete_timestamp:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
+	15, // 4: temporal.server.api.persistence.v1.CompatibleVersionSet.became_default_timestamp:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
+	16, // 5: temporal.server.api.persistence.v1.AssignmentRule.rule:type_name -> temporal.api.taskqueue.v1.BuildIdAssignmentRule
+	15, // 6: temporal.server.api.persistence.v1.AssignmentRule.create_timestamp:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
+	15, // 7: temporal.server.api.persistence.v1.AssignmentRule.delete_timestamp:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
+	17, // 8: temporal.server.api.persistence.v1.RedirectRule.rule:type_name -> temporal.api.taskqueue.v1.CompatibleBuildIdRedirectRule
+	15, // 9: temporal.server.api.persistence.v1.RedirectRule.create_timestamp:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
+	15, // 10: temporal.server.api.persistence.v1.RedirectRule.delete_timestamp:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
 	2,  // 11: temporal.server.api.persistence.v1.VersioningData.version_sets:type_name -> temporal.server.api.persistence.v1.CompatibleVersionSet
 	3,  // 12: temporal.server.api.persistence.v1.VersioningData.assignment_rules:type_name -> temporal.server.api.persistence.v1.AssignmentRule
 	4,  // 13: temporal.server.api.persistence.v1.VersioningData.redirect_rules:type_name -> temporal.server.api.persistence.v1.RedirectRule
-	10, // 14: temporal.server.api.persistence.v1.DeploymentData.deployments:type_name -> temporal.server.api.persistence.v1.DeploymentData.DeploymentDataItem
-	15, // 15: temporal.server.api.persistence.v1.DeploymentData.versions:type_name -> temporal.server.api.deployment.v1.DeploymentVersionData
-	15, // 16: temporal.server.api.persistence.v1.DeploymentData.unversioned_ramp_data:type_name -> temporal.server.api.deployment.v1.DeploymentVersionData
+	12, // 14: temporal.server.api.persistence.v1.DeploymentData.deployments:type_name -> temporal.server.api.persistence.v1.DeploymentData.DeploymentDataItem
+	18, // 15: temporal.server.api.persistence.v1.DeploymentData.versions:type_name -> temporal.server.api.deployment.v1.DeploymentVersionData
+	18, // 16: temporal.server.api.persistence.v1.DeploymentData.unversioned_ramp_data:type_name -> temporal.server.api.deployment.v1.DeploymentVersionData
 	6,  // 17: temporal.server.api.persistence.v1.TaskQueueTypeUserData.deployment_data:type_name -> temporal.server.api.persistence.v1.DeploymentData
-	12, // 18: temporal.server.api.persistence.v1.TaskQueueUserData.clock:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
-	5,  // 19: temporal.server.api.persistence.v1.TaskQueueUserData.versioning_data:type_name -> temporal.server.api.persistence.v1.VersioningData
-	11, // 20: temporal.server.api.persistence.v1.TaskQueueUserData.per_type:type_name -> temporal.server.api.persistence.v1.TaskQueueUserData.PerTypeEntry
-	8,  // 21: temporal.server.api.persistence.v1.VersionedTaskQueueUserData.data:type_name -> temporal.server.api.persistence.v1.TaskQueueUserData
-	16, // 22: temporal.server.api.persistence.v1.DeploymentData.DeploymentDataItem.deployment:type_name -> temporal.api.deployment.v1.Deployment
-	17, // 23: temporal.server.api.persistence.v1.DeploymentData.DeploymentDataItem.data:type_name -> temporal.server.api.deployment.v1.TaskQueueData
-	7,  // 24: temporal.server.api.persistence.v1.TaskQueueUserData.PerTypeEntry.value:type_name -> temporal.server.api.persistence.v1.TaskQueueTypeUserData
-	25, // [25:25] is the sub-list for method output_type
-	25, // [25:25] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	13, // 18: temporal.server.api.persistence.v1.TaskQueueTypeUserData.activity_type_rate_limits:type_name -> temporal.server.api.persistence.v1.TaskQueueTypeUserData.ActivityTypeRateLimitsEntry
+	8,  // 19: temporal.server.api.persistence.v1.TaskQueueTypeUserData.partition_counts:type_name -> temporal.server.api.persistence.v1.TaskQueuePartitionCounts
+	15, // 20: temporal.server.api.persistence.v1.TaskQueuePartitionCounts.update_time:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
+	15, // 21: temporal.server.api.persistence.v1.ActivityTypeRateLimit.update_time:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
+	15, // 22: temporal.server.api.persistence.v1.TaskQueueUserData.clock:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
+	5,  // 23: temporal.server.api.persistence.v1.TaskQueueUserData.versioning_data:type_name -> temporal.server.api.persistence.v1.VersioningData
+	14, // 24: temporal.server.api.persistence.v1.TaskQueueUserData.per_type:type_name -> temporal.server.api.persistence.v1.TaskQueueUserData.PerTypeEntry
+	10, // 25: temporal.server.api.persistence.v1.VersionedTaskQueueUserData.data:type_name -> temporal.server.api.persistence.v1.TaskQueueUserData
+	19, // 26: temporal.server.api.persistence.v1.DeploymentData.DeploymentDataItem.deployment:type_name -> temporal.api.deployment.v1.Deployment
+	20, // 27: temporal.server.api.persistence.v1.DeploymentData.DeploymentDataItem.data:type_name -> temporal.server.api.deployment.v1.TaskQueueData
+	9,  // 28: temporal.server.api.persistence.v1.TaskQueueTypeUserData.ActivityTypeRateLimitsEntry.value:type_name -> temporal.server.api.persistence.v1.ActivityTypeRateLimit
+	7,  // 29: temporal.server.api.persistence.v1.TaskQueueUserData.PerTypeEntry.value:type_name -> temporal.server.api.persistence.v1.TaskQueueTypeUserData
+	30, // [30:30] is the sub-list for method output_type
+	30, // [30:30] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_persistence_v1_task_queues_proto_init() }
@@ -894,7 +1057,7 @@ func file_temporal_server_api_persistence_v1_task_queues_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_persistence_v1_task_queues_proto_rawDesc), len(file_temporal_server_api_persistence_v1_task_queues_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   11,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -177,6 +177,43 @@ func (this *SubqueueInfo) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type MigratedTaskRange to the protobuf v3 wire format
+func (val *MigratedTaskRange) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MigratedTaskRange from the protobuf v3 wire format
+func (val *MigratedTaskRange) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MigratedTaskRange) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MigratedTaskRange values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MigratedTaskRange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MigratedTaskRange
+	switch t := that.(type) {
+	case *MigratedTaskRange:
+		that1 = t
+	case MigratedTaskRange:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type SubqueueKey to the protobuf v3 wire format
 func (val *SubqueueKey) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
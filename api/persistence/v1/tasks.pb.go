@@ -115,8 +115,13 @@ type TaskInfo struct {
 	// TaskVersionDirective, which is unversioned.)
 	VersionDirective *v11.TaskVersionDirective `protobuf:"bytes,8,opt,name=version_directive,json=versionDirective,proto3" json:"version_directive,omitempty"`
 	// Stamp field allows to differentiate between different instances of the same task
-	Stamp         int32         `protobuf:"varint,9,opt,name=stamp,proto3" json:"stamp,omitempty"`
-	Priority      *v12.Priority `protobuf:"bytes,10,opt,name=priority,proto3" json:"priority,omitempty"`
+	Stamp    int32         `protobuf:"varint,9,opt,name=stamp,proto3" json:"stamp,omitempty"`
+	Priority *v12.Priority `protobuf:"bytes,10,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Tasks with different fairness keys at the same priority are dispatched in weighted
+	// round-robin order. Empty means the default key.
+	FairnessKey string `protobuf:"bytes,11,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
+	// Activity type of an activity task. Used to apply per-activity-type dispatch rate limits.
+	ActivityType  string `protobuf:"bytes,12,opt,name=activity_type,json=activityType,proto3" json:"activity_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -221,6 +226,20 @@ func (x *TaskInfo) GetPriority() *v12.Priority {
 	return nil
 }
 
+func (x *TaskInfo) GetFairnessKey() string {
+	if x != nil {
+		return x.FairnessKey
+	}
+	return ""
+}
+
+func (x *TaskInfo) GetActivityType() string {
+	if x != nil {
+		return x.ActivityType
+	}
+	return ""
+}
+
 // task_queue column
 type TaskQueueInfo struct {
 	state       protoimpl.MessageState `protogen:"open.v1"`
@@ -350,8 +369,12 @@ type SubqueueInfo struct {
 	// The rest are mutable state for the subqueue:
 	AckLevel                int64 `protobuf:"varint,2,opt,name=ack_level,json=ackLevel,proto3" json:"ack_level,omitempty"`
 	ApproximateBacklogCount int64 `protobuf:"varint,3,opt,name=approximate_backlog_count,json=approximateBacklogCount,proto3" json:"approximate_backlog_count,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+	// Task id ranges of tasks that were moved to another task queue and must be dropped
+	// instead of dispatched. Sorted and non-overlapping. Ranges at or below the ack level
+	// are removed.
+	MigratedTaskRanges []*MigratedTaskRange `protobuf:"bytes,4,rep,name=migrated_task_ranges,json=migratedTaskRanges,proto3" json:"migrated_task_ranges,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *SubqueueInfo) Reset() {
@@ -405,17 +428,80 @@ func (x *SubqueueInfo) GetApproximateBacklogCount() int64 {
 	return 0
 }
 
+func (x *SubqueueInfo) GetMigratedTaskRanges() []*MigratedTaskRange {
+	if x != nil {
+		return x.MigratedTaskRanges
+	}
+	return nil
+}
+
+// MigratedTaskRange covers all tasks of a subqueue with ids in [first_task_id, last_task_id].
+type MigratedTaskRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FirstTaskId   int64                  `protobuf:"varint,1,opt,name=first_task_id,json=firstTaskId,proto3" json:"first_task_id,omitempty"`
+	LastTaskId    int64                  `protobuf:"varint,2,opt,name=last_task_id,json=lastTaskId,proto3" json:"last_task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MigratedTaskRange) Reset() {
+	*x = MigratedTaskRange{}
+	mi := &file_temporal_server_api_persistence_v1_tasks_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigratedTaskRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigratedTaskRange) ProtoMessage() {}
+
+func (x *MigratedTaskRange) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_tasks_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigratedTaskRange.ProtoReflect.Descriptor instead.
+func (*MigratedTaskRange) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_tasks_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MigratedTaskRange) GetFirstTaskId() int64 {
+	if x != nil {
+		return x.FirstTaskId
+	}
+	return 0
+}
+
+func (x *MigratedTaskRange) GetLastTaskId() int64 {
+	if x != nil {
+		return x.LastTaskId
+	}
+	return 0
+}
+
 type SubqueueKey struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Each subqueue contains tasks from only one priority level.
-	Priority      int32 `protobuf:"varint,1,opt,name=priority,proto3" json:"priority,omitempty"`
+	Priority int32 `protobuf:"varint,1,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Additionally, tasks may be split by fairness key, so that the backlog of each key
+	// is tracked and read separately. Empty means the default key.
+	FairnessKey   string `protobuf:"bytes,2,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SubqueueKey) Reset() {
 	*x = SubqueueKey{}
-	mi := &file_temporal_server_api_persistence_v1_tasks_proto_msgTypes[4]
+	mi := &file_temporal_server_api_persistence_v1_tasks_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -427,7 +513,7 @@ func (x *SubqueueKey) String() string {
 func (*SubqueueKey) ProtoMessage() {}
 
 func (x *SubqueueKey) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_persistence_v1_tasks_proto_msgTypes[4]
+	mi := &file_temporal_server_api_persistence_v1_tasks_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -440,7 +526,7 @@ func (x *SubqueueKey) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubqueueKey.ProtoReflect.Descriptor instead.
 func (*SubqueueKey) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_persistence_v1_tasks_proto_rawDescGZIP(), []int{4}
+	return file_temporal_server_api_persistence_v1_tasks_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SubqueueKey) GetPriority() int32 {
@@ -450,6 +536,13 @@ func (x *SubqueueKey) GetPriority() int32 {
 	return 0
 }
 
+func (x *SubqueueKey) GetFairnessKey() string {
+	if x != nil {
+		return x.FairnessKey
+	}
+	return ""
+}
+
 type TaskKey struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FireTime      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=fire_time,json=fireTime,proto3" json:"fire_time,omitempty"`
@@ -460,7 +553,7 @@ type TaskKey struct {
 
 func (x *TaskKey) Reset() {
 	*x = TaskKey{}
-	mi := &file_temporal_server_api_persistence_v1_tasks_proto_msgTypes[5]
+	mi := &file_temporal_server_api_persistence_v1_tasks_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -472,7 +565,7 @@ func (x *TaskKey) String() string {
 func (*TaskKey) ProtoMessage() {}
 
 func (x *TaskKey) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_persistence_v1_tasks_proto_msgTypes[5]
+	mi := &file_temporal_server_api_persistence_v1_tasks_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -485,7 +578,7 @@ func (x *TaskKey) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskKey.ProtoReflect.Descriptor instead.
 func (*TaskKey) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_persistence_v1_tasks_proto_rawDescGZIP(), []int{5}
+	return file_temporal_server_api_persistence_v1_tasks_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *TaskKey) GetFireTime() *timestamppb.Timestamp {
@@ -509,7 +602,7 @@ const file_temporal_server_api_persistence_v1_tasks_proto_rawDesc = "" +
 	".temporal/server/api/persistence/v1/tasks.proto\x12\"temporal.server.api.persistence.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$temporal/api/common/v1/message.proto\x1a&temporal/api/enums/v1/task_queue.proto\x1a*temporal/server/api/clock/v1/message.proto\x1a.temporal/server/api/taskqueue/v1/message.proto\"n\n" +
 	"\x11AllocatedTaskInfo\x12@\n" +
 	"\x04data\x18\x01 \x01(\v2,.temporal.server.api.persistence.v1.TaskInfoR\x04data\x12\x17\n" +
-	"\atask_id\x18\x02 \x01(\x03R\x06taskId\"\x87\x04\n" +
+	"\atask_id\x18\x02 \x01(\x03R\x06taskId\"\xcf\x04\n" +
 	"\bTaskInfo\x12!\n" +
 	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1f\n" +
 	"\vworkflow_id\x18\x02 \x01(\tR\n" +
@@ -524,7 +617,9 @@ const file_temporal_server_api_persistence_v1_tasks_proto_rawDesc = "" +
 	"\x11version_directive\x18\b \x01(\v26.temporal.server.api.taskqueue.v1.TaskVersionDirectiveR\x10versionDirective\x12\x14\n" +
 	"\x05stamp\x18\t \x01(\x05R\x05stamp\x12<\n" +
 	"\bpriority\x18\n" +
-	" \x01(\v2 .temporal.api.common.v1.PriorityR\bpriority\"\xef\x03\n" +
+	" \x01(\v2 .temporal.api.common.v1.PriorityR\bpriority\x12!\n" +
+	"\ffairness_key\x18\v \x01(\tR\vfairnessKey\x12#\n" +
+	"\ractivity_type\x18\f \x01(\tR\factivityType\"\xef\x03\n" +
 	"\rTaskQueueInfo\x12!\n" +
 	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12A\n" +
@@ -535,13 +630,19 @@ const file_temporal_server_api_persistence_v1_tasks_proto_rawDesc = "" +
 	"expiryTime\x12D\n" +
 	"\x10last_update_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0elastUpdateTime\x12:\n" +
 	"\x19approximate_backlog_count\x18\b \x01(\x03R\x17approximateBacklogCount\x12N\n" +
-	"\tsubqueues\x18\t \x03(\v20.temporal.server.api.persistence.v1.SubqueueInfoR\tsubqueues\"\xaa\x01\n" +
+	"\tsubqueues\x18\t \x03(\v20.temporal.server.api.persistence.v1.SubqueueInfoR\tsubqueues\"\x93\x02\n" +
 	"\fSubqueueInfo\x12A\n" +
 	"\x03key\x18\x01 \x01(\v2/.temporal.server.api.persistence.v1.SubqueueKeyR\x03key\x12\x1b\n" +
 	"\tack_level\x18\x02 \x01(\x03R\backLevel\x12:\n" +
-	"\x19approximate_backlog_count\x18\x03 \x01(\x03R\x17approximateBacklogCount\")\n" +
+	"\x19approximate_backlog_count\x18\x03 \x01(\x03R\x17approximateBacklogCount\x12g\n" +
+	"\x14migrated_task_ranges\x18\x04 \x03(\v25.temporal.server.api.persistence.v1.MigratedTaskRangeR\x12migratedTaskRanges\"Y\n" +
+	"\x11MigratedTaskRange\x12\"\n" +
+	"\rfirst_task_id\x18\x01 \x01(\x03R\vfirstTaskId\x12 \n" +
+	"\flast_task_id\x18\x02 \x01(\x03R\n" +
+	"lastTaskId\"L\n" +
 	"\vSubqueueKey\x12\x1a\n" +
-	"\bpriority\x18\x01 \x01(\x05R\bpriority\"[\n" +
+	"\bpriority\x18\x01 \x01(\x05R\bpriority\x12!\n" +
+	"\ffairness_key\x18\x02 \x01(\tR\vfairnessKey\"[\n" +
 	"\aTaskKey\x127\n" +
 	"\tfire_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\bfireTime\x12\x17\n" +
 	"\atask_id\x18\x02 \x01(\x03R\x06taskIdB6Z4go.temporal.io/server/api/persistence/v1;persistenceb\x06proto3"
@@ -558,40 +659,42 @@ func file_temporal_server_api_persistence_v1_tasks_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_persistence_v1_tasks_proto_rawDescData
 }
 
-var file_temporal_server_api_persistence_v1_tasks_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_temporal_server_api_persistence_v1_tasks_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_temporal_server_api_persistence_v1_tasks_proto_goTypes = []any{
 	(*AllocatedTaskInfo)(nil),        // 0: temporal.server.api.persistence.v1.AllocatedTaskInfo
 	(*TaskInfo)(nil),                 // 1: temporal.server.api.persistence.v1.TaskInfo
 	(*TaskQueueInfo)(nil),            // 2: temporal.server.api.persistence.v1.TaskQueueInfo
 	(*SubqueueInfo)(nil),             // 3: temporal.server.api.persistence.v1.SubqueueInfo
-	(*SubqueueKey)(nil),              // 4: temporal.server.api.persistence.v1.SubqueueKey
-	(*TaskKey)(nil),                  // 5: temporal.server.api.persistence.v1.TaskKey
-	(*timestamppb.Timestamp)(nil),    // 6: google.protobuf.Timestamp
-	(*v1.VectorClock)(nil),           // 7: temporal.server.api.clock.v1.VectorClock
-	(*v11.TaskVersionDirective)(nil), // 8: temporal.server.api.taskqueue.v1.TaskVersionDirective
-	(*v12.Priority)(nil),             // 9: temporal.api.common.v1.Priority
-	(v13.TaskQueueType)(0),           // 10: temporal.api.enums.v1.TaskQueueType
-	(v13.TaskQueueKind)(0),           // 11: temporal.api.enums.v1.TaskQueueKind
+	(*MigratedTaskRange)(nil),        // 4: temporal.server.api.persistence.v1.MigratedTaskRange
+	(*SubqueueKey)(nil),              // 5: temporal.server.api.persistence.v1.SubqueueKey
+	(*TaskKey)(nil),                  // 6: temporal.server.api.persistence.v1.TaskKey
+	(*timestamppb.Timestamp)(nil),    // 7: google.protobuf.Timestamp
+	(*v1.VectorClock)(nil),           // 8: temporal.server.api.clock.v1.VectorClock
+	(*v11.TaskVersionDirective)(nil), // 9: temporal.server.api.taskqueue.v1.TaskVersionDirective
+	(*v12.Priority)(nil),             // 10: temporal.api.common.v1.Priority
+	(v13.TaskQueueType)(0),           // 11: temporal.api.enums.v1.TaskQueueType
+	(v13.TaskQueueKind)(0),           // 12: temporal.api.enums.v1.TaskQueueKind
 }
 var file_temporal_server_api_persistence_v1_tasks_proto_depIdxs = []int32{
 	1,  // 0: temporal.server.api.persistence.v1.AllocatedTaskInfo.data:type_name -> temporal.server.api.persistence.v1.TaskInfo
-	6,  // 1: temporal.server.api.persistence.v1.TaskInfo.create_time:type_name -> google.protobuf.Timestamp
-	6,  // 2: temporal.server.api.persistence.v1.TaskInfo.expiry_time:type_name -> google.protobuf.Timestamp
-	7,  // 3: temporal.server.api.persistence.v1.TaskInfo.clock:type_name -> temporal.server.api.clock.v1.VectorClock
-	8,  // 4: temporal.server.api.persistence.v1.TaskInfo.version_directive:type_name -> temporal.server.api.taskqueue.v1.TaskVersionDirective
-	9,  // 5: temporal.server.api.persistence.v1.TaskInfo.priority:type_name -> temporal.api.common.v1.Priority
-	10, // 6: temporal.server.api.persistence.v1.TaskQueueInfo.task_type:type_name -> temporal.api.enums.v1.TaskQueueType
-	11, // 7: temporal.server.api.persistence.v1.TaskQueueInfo.kind:type_name -> temporal.api.enums.v1.TaskQueueKind
-	6,  // 8: temporal.server.api.persistence.v1.TaskQueueInfo.expiry_time:type_name -> google.protobuf.Timestamp
-	6,  // 9: temporal.server.api.persistence.v1.TaskQueueInfo.last_update_time:type_name -> google.protobuf.Timestamp
+	7,  // 1: temporal.server.api.persistence.v1.TaskInfo.create_time:type_name -> google.protobuf.Timestamp
+	7,  // 2: temporal.server.api.persistence.v1.TaskInfo.expiry_time:type_name -> google.protobuf.Timestamp
+	8,  // 3: temporal.server.api.persistence.v1.TaskInfo.clock:type_name -> temporal.server.api.clock.v1.VectorClock
+	9,  // 4: temporal.server.api.persistence.v1.TaskInfo.version_directive:type_name -> temporal.server.api.taskqueue.v1.TaskVersionDirective
+	10, // 5: temporal.server.api.persistence.v1.TaskInfo.priority:type_name -> temporal.api.common.v1.Priority
+	11, // 6: temporal.server.api.persistence.v1.TaskQueueInfo.task_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	12, // 7: temporal.server.api.persistence.v1.TaskQueueInfo.kind:type_name -> temporal.api.enums.v1.TaskQueueKind
+	7,  // 8: temporal.server.api.persistence.v1.TaskQueueInfo.expiry_time:type_name -> google.protobuf.Timestamp
+	7,  // 9: temporal.server.api.persistence.v1.TaskQueueInfo.last_update_time:type_name -> google.protobuf.Timestamp
 	3,  // 10: temporal.server.api.persistence.v1.TaskQueueInfo.subqueues:type_name -> temporal.server.api.persistence.v1.SubqueueInfo
-	4,  // 11: temporal.server.api.persistence.v1.SubqueueInfo.key:type_name -> temporal.server.api.persistence.v1.SubqueueKey
-	6,  // 12: temporal.server.api.persistence.v1.TaskKey.fire_time:type_name -> google.protobuf.Timestamp
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	5,  // 11: temporal.server.api.persistence.v1.SubqueueInfo.key:type_name -> temporal.server.api.persistence.v1.SubqueueKey
+	4,  // 12: temporal.server.api.persistence.v1.SubqueueInfo.migrated_task_ranges:type_name -> temporal.server.api.persistence.v1.MigratedTaskRange
+	7,  // 13: temporal.server.api.persistence.v1.TaskKey.fire_time:type_name -> google.protobuf.Timestamp
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_persistence_v1_tasks_proto_init() }
@@ -605,7 +708,7 @@ func file_temporal_server_api_persistence_v1_tasks_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_persistence_v1_tasks_proto_rawDesc), len(file_temporal_server_api_persistence_v1_tasks_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -214,6 +214,117 @@ func (this *DescribeResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type ResolveBusinessCalendarsRequest to the protobuf v3 wire format
+func (val *ResolveBusinessCalendarsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ResolveBusinessCalendarsRequest from the protobuf v3 wire format
+func (val *ResolveBusinessCalendarsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ResolveBusinessCalendarsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ResolveBusinessCalendarsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ResolveBusinessCalendarsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ResolveBusinessCalendarsRequest
+	switch t := that.(type) {
+	case *ResolveBusinessCalendarsRequest:
+		that1 = t
+	case ResolveBusinessCalendarsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ResolveBusinessCalendarsResponse to the protobuf v3 wire format
+func (val *ResolveBusinessCalendarsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ResolveBusinessCalendarsResponse from the protobuf v3 wire format
+func (val *ResolveBusinessCalendarsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ResolveBusinessCalendarsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ResolveBusinessCalendarsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ResolveBusinessCalendarsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ResolveBusinessCalendarsResponse
+	switch t := that.(type) {
+	case *ResolveBusinessCalendarsResponse:
+		that1 = t
+	case ResolveBusinessCalendarsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SetBusinessCalendarsRequest to the protobuf v3 wire format
+func (val *SetBusinessCalendarsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SetBusinessCalendarsRequest from the protobuf v3 wire format
+func (val *SetBusinessCalendarsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SetBusinessCalendarsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SetBusinessCalendarsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SetBusinessCalendarsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SetBusinessCalendarsRequest
+	switch t := that.(type) {
+	case *SetBusinessCalendarsRequest:
+		that1 = t
+	case SetBusinessCalendarsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type WatchWorkflowRequest to the protobuf v3 wire format
 func (val *WatchWorkflowRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -620,3 +731,151 @@ func (this *BackfillerInternal) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type SimulatedScheduleAction to the protobuf v3 wire format
+func (val *SimulatedScheduleAction) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SimulatedScheduleAction from the protobuf v3 wire format
+func (val *SimulatedScheduleAction) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SimulatedScheduleAction) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SimulatedScheduleAction values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SimulatedScheduleAction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SimulatedScheduleAction
+	switch t := that.(type) {
+	case *SimulatedScheduleAction:
+		that1 = t
+	case SimulatedScheduleAction:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type BusinessCalendar to the protobuf v3 wire format
+func (val *BusinessCalendar) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type BusinessCalendar from the protobuf v3 wire format
+func (val *BusinessCalendar) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *BusinessCalendar) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two BusinessCalendar values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *BusinessCalendar) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *BusinessCalendar
+	switch t := that.(type) {
+	case *BusinessCalendar:
+		that1 = t
+	case BusinessCalendar:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type BusinessCalendarDate to the protobuf v3 wire format
+func (val *BusinessCalendarDate) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type BusinessCalendarDate from the protobuf v3 wire format
+func (val *BusinessCalendarDate) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *BusinessCalendarDate) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two BusinessCalendarDate values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *BusinessCalendarDate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *BusinessCalendarDate
+	switch t := that.(type) {
+	case *BusinessCalendarDate:
+		that1 = t
+	case BusinessCalendarDate:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ScheduleBusinessCalendar to the protobuf v3 wire format
+func (val *ScheduleBusinessCalendar) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ScheduleBusinessCalendar from the protobuf v3 wire format
+func (val *ScheduleBusinessCalendar) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ScheduleBusinessCalendar) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ScheduleBusinessCalendar values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ScheduleBusinessCalendar) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ScheduleBusinessCalendar
+	switch t := that.(type) {
+	case *ScheduleBusinessCalendar:
+		that1 = t
+	case ScheduleBusinessCalendar:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
@@ -158,8 +158,10 @@ type InternalState struct {
 	// conflict token is implemented as simple sequence number
 	ConflictToken int64 `protobuf:"varint,7,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
 	NeedRefresh   bool  `protobuf:"varint,9,opt,name=need_refresh,json=needRefresh,proto3" json:"need_refresh,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Business calendars referenced by the schedule.
+	BusinessCalendars []*ScheduleBusinessCalendar `protobuf:"bytes,11,rep,name=business_calendars,json=businessCalendars,proto3" json:"business_calendars,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *InternalState) Reset() {
@@ -262,6 +264,13 @@ func (x *InternalState) GetNeedRefresh() bool {
 	return false
 }
 
+func (x *InternalState) GetBusinessCalendars() []*ScheduleBusinessCalendar {
+	if x != nil {
+		return x.BusinessCalendars
+	}
+	return nil
+}
+
 type StartScheduleArgs struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Schedule      *v11.Schedule          `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
@@ -450,6 +459,139 @@ func (x *DescribeResponse) GetConflictToken() int64 {
 	return 0
 }
 
+type ResolveBusinessCalendarsRequest struct {
+	state             protoimpl.MessageState      `protogen:"open.v1"`
+	BusinessCalendars []*ScheduleBusinessCalendar `protobuf:"bytes,1,rep,name=business_calendars,json=businessCalendars,proto3" json:"business_calendars,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ResolveBusinessCalendarsRequest) Reset() {
+	*x = ResolveBusinessCalendarsRequest{}
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveBusinessCalendarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveBusinessCalendarsRequest) ProtoMessage() {}
+
+func (x *ResolveBusinessCalendarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveBusinessCalendarsRequest.ProtoReflect.Descriptor instead.
+func (*ResolveBusinessCalendarsRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ResolveBusinessCalendarsRequest) GetBusinessCalendars() []*ScheduleBusinessCalendar {
+	if x != nil {
+		return x.BusinessCalendars
+	}
+	return nil
+}
+
+type ResolveBusinessCalendarsResponse struct {
+	state             protoimpl.MessageState      `protogen:"open.v1"`
+	BusinessCalendars []*ScheduleBusinessCalendar `protobuf:"bytes,1,rep,name=business_calendars,json=businessCalendars,proto3" json:"business_calendars,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ResolveBusinessCalendarsResponse) Reset() {
+	*x = ResolveBusinessCalendarsResponse{}
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveBusinessCalendarsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveBusinessCalendarsResponse) ProtoMessage() {}
+
+func (x *ResolveBusinessCalendarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveBusinessCalendarsResponse.ProtoReflect.Descriptor instead.
+func (*ResolveBusinessCalendarsResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ResolveBusinessCalendarsResponse) GetBusinessCalendars() []*ScheduleBusinessCalendar {
+	if x != nil {
+		return x.BusinessCalendars
+	}
+	return nil
+}
+
+// Replaces the business calendars that a schedule references.
+type SetBusinessCalendarsRequest struct {
+	state             protoimpl.MessageState      `protogen:"open.v1"`
+	BusinessCalendars []*ScheduleBusinessCalendar `protobuf:"bytes,1,rep,name=business_calendars,json=businessCalendars,proto3" json:"business_calendars,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SetBusinessCalendarsRequest) Reset() {
+	*x = SetBusinessCalendarsRequest{}
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBusinessCalendarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBusinessCalendarsRequest) ProtoMessage() {}
+
+func (x *SetBusinessCalendarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBusinessCalendarsRequest.ProtoReflect.Descriptor instead.
+func (*SetBusinessCalendarsRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SetBusinessCalendarsRequest) GetBusinessCalendars() []*ScheduleBusinessCalendar {
+	if x != nil {
+		return x.BusinessCalendars
+	}
+	return nil
+}
+
 type WatchWorkflowRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Note: this will be sent to the activity with empty execution.run_id, and
@@ -463,7 +605,7 @@ type WatchWorkflowRequest struct {
 
 func (x *WatchWorkflowRequest) Reset() {
 	*x = WatchWorkflowRequest{}
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[5]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -475,7 +617,7 @@ func (x *WatchWorkflowRequest) String() string {
 func (*WatchWorkflowRequest) ProtoMessage() {}
 
 func (x *WatchWorkflowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[5]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -488,7 +630,7 @@ func (x *WatchWorkflowRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchWorkflowRequest.ProtoReflect.Descriptor instead.
 func (*WatchWorkflowRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{5}
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *WatchWorkflowRequest) GetExecution() *v12.WorkflowExecution {
@@ -528,7 +670,7 @@ type WatchWorkflowResponse struct {
 
 func (x *WatchWorkflowResponse) Reset() {
 	*x = WatchWorkflowResponse{}
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[6]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -540,7 +682,7 @@ func (x *WatchWorkflowResponse) String() string {
 func (*WatchWorkflowResponse) ProtoMessage() {}
 
 func (x *WatchWorkflowResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[6]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -553,7 +695,7 @@ func (x *WatchWorkflowResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchWorkflowResponse.ProtoReflect.Descriptor instead.
 func (*WatchWorkflowResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{6}
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *WatchWorkflowResponse) GetStatus() v1.WorkflowExecutionStatus {
@@ -621,7 +763,7 @@ type StartWorkflowRequest struct {
 
 func (x *StartWorkflowRequest) Reset() {
 	*x = StartWorkflowRequest{}
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[7]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -633,7 +775,7 @@ func (x *StartWorkflowRequest) String() string {
 func (*StartWorkflowRequest) ProtoMessage() {}
 
 func (x *StartWorkflowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[7]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -646,7 +788,7 @@ func (x *StartWorkflowRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartWorkflowRequest.ProtoReflect.Descriptor instead.
 func (*StartWorkflowRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{7}
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *StartWorkflowRequest) GetRequest() *v14.StartWorkflowExecutionRequest {
@@ -673,7 +815,7 @@ type StartWorkflowResponse struct {
 
 func (x *StartWorkflowResponse) Reset() {
 	*x = StartWorkflowResponse{}
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[8]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -685,7 +827,7 @@ func (x *StartWorkflowResponse) String() string {
 func (*StartWorkflowResponse) ProtoMessage() {}
 
 func (x *StartWorkflowResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[8]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -698,7 +840,7 @@ func (x *StartWorkflowResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartWorkflowResponse.ProtoReflect.Descriptor instead.
 func (*StartWorkflowResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{8}
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *StartWorkflowResponse) GetRunId() string {
@@ -728,7 +870,7 @@ type CancelWorkflowRequest struct {
 
 func (x *CancelWorkflowRequest) Reset() {
 	*x = CancelWorkflowRequest{}
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[9]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -740,7 +882,7 @@ func (x *CancelWorkflowRequest) String() string {
 func (*CancelWorkflowRequest) ProtoMessage() {}
 
 func (x *CancelWorkflowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[9]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -753,7 +895,7 @@ func (x *CancelWorkflowRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelWorkflowRequest.ProtoReflect.Descriptor instead.
 func (*CancelWorkflowRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{9}
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *CancelWorkflowRequest) GetRequestId() string {
@@ -797,7 +939,7 @@ type TerminateWorkflowRequest struct {
 
 func (x *TerminateWorkflowRequest) Reset() {
 	*x = TerminateWorkflowRequest{}
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[10]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -809,7 +951,7 @@ func (x *TerminateWorkflowRequest) String() string {
 func (*TerminateWorkflowRequest) ProtoMessage() {}
 
 func (x *TerminateWorkflowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[10]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -822,7 +964,7 @@ func (x *TerminateWorkflowRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TerminateWorkflowRequest.ProtoReflect.Descriptor instead.
 func (*TerminateWorkflowRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{10}
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *TerminateWorkflowRequest) GetRequestId() string {
@@ -872,7 +1014,7 @@ type NextTimeCache struct {
 
 func (x *NextTimeCache) Reset() {
 	*x = NextTimeCache{}
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[11]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -884,7 +1026,7 @@ func (x *NextTimeCache) String() string {
 func (*NextTimeCache) ProtoMessage() {}
 
 func (x *NextTimeCache) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[11]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -897,7 +1039,7 @@ func (x *NextTimeCache) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NextTimeCache.ProtoReflect.Descriptor instead.
 func (*NextTimeCache) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{11}
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *NextTimeCache) GetVersion() int64 {
@@ -949,13 +1091,15 @@ type SchedulerInternal struct {
 	// Implemented as a sequence number. Used for optimistic locking against
 	// update requests.
 	ConflictToken int64 `protobuf:"varint,8,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Business calendars referenced by the schedule.
+	BusinessCalendars []*ScheduleBusinessCalendar `protobuf:"bytes,9,rep,name=business_calendars,json=businessCalendars,proto3" json:"business_calendars,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *SchedulerInternal) Reset() {
 	*x = SchedulerInternal{}
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[12]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -967,7 +1111,7 @@ func (x *SchedulerInternal) String() string {
 func (*SchedulerInternal) ProtoMessage() {}
 
 func (x *SchedulerInternal) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[12]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -980,7 +1124,7 @@ func (x *SchedulerInternal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SchedulerInternal.ProtoReflect.Descriptor instead.
 func (*SchedulerInternal) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{12}
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *SchedulerInternal) GetSchedule() *v11.Schedule {
@@ -1032,6 +1176,13 @@ func (x *SchedulerInternal) GetConflictToken() int64 {
 	return 0
 }
 
+func (x *SchedulerInternal) GetBusinessCalendars() []*ScheduleBusinessCalendar {
+	if x != nil {
+		return x.BusinessCalendars
+	}
+	return nil
+}
+
 // State machine scheduler's Generator internal state.
 type GeneratorInternal struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1045,7 +1196,7 @@ type GeneratorInternal struct {
 
 func (x *GeneratorInternal) Reset() {
 	*x = GeneratorInternal{}
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[13]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1057,7 +1208,7 @@ func (x *GeneratorInternal) String() string {
 func (*GeneratorInternal) ProtoMessage() {}
 
 func (x *GeneratorInternal) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[13]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1070,7 +1221,7 @@ func (x *GeneratorInternal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GeneratorInternal.ProtoReflect.Descriptor instead.
 func (*GeneratorInternal) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{13}
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GeneratorInternal) GetNextInvocationTime() *timestamppb.Timestamp {
@@ -1102,7 +1253,7 @@ type ExecutorInternal struct {
 
 func (x *ExecutorInternal) Reset() {
 	*x = ExecutorInternal{}
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[14]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1114,7 +1265,7 @@ func (x *ExecutorInternal) String() string {
 func (*ExecutorInternal) ProtoMessage() {}
 
 func (x *ExecutorInternal) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[14]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1127,7 +1278,7 @@ func (x *ExecutorInternal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecutorInternal.ProtoReflect.Descriptor instead.
 func (*ExecutorInternal) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{14}
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ExecutorInternal) GetState() v15.SchedulerExecutorState {
@@ -1164,7 +1315,7 @@ type BackfillerInternal struct {
 
 func (x *BackfillerInternal) Reset() {
 	*x = BackfillerInternal{}
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[15]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1176,7 +1327,7 @@ func (x *BackfillerInternal) String() string {
 func (*BackfillerInternal) ProtoMessage() {}
 
 func (x *BackfillerInternal) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[15]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1189,7 +1340,7 @@ func (x *BackfillerInternal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BackfillerInternal.ProtoReflect.Descriptor instead.
 func (*BackfillerInternal) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{15}
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *BackfillerInternal) GetRequest() *v11.BackfillRequest {
@@ -1206,11 +1357,330 @@ func (x *BackfillerInternal) GetNextInvocationTime() *timestamppb.Timestamp {
 	return nil
 }
 
+// A scheduled action projected by a schedule simulation.
+type SimulatedScheduleAction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Nominal (pre-jitter) and Actual (post-jitter) time of action
+	NominalTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=nominal_time,json=nominalTime,proto3" json:"nominal_time,omitempty"`
+	ActualTime  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=actual_time,json=actualTime,proto3" json:"actual_time,omitempty"`
+	// Time at which the action was started. This is later than actual_time if the start was
+	// buffered behind a running workflow. Unset if the action was not started.
+	StartTime *timestamppb.Timestamp             `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	Outcome   v15.SimulatedScheduleActionOutcome `protobuf:"varint,4,opt,name=outcome,proto3,enum=temporal.server.api.enums.v1.SimulatedScheduleActionOutcome" json:"outcome,omitempty"`
+	// Resolved overlap policy of the action.
+	OverlapPolicy v1.ScheduleOverlapPolicy `protobuf:"varint,5,opt,name=overlap_policy,json=overlapPolicy,proto3,enum=temporal.api.enums.v1.ScheduleOverlapPolicy" json:"overlap_policy,omitempty"`
+	// Whether starting this action cancels or terminates the running workflow.
+	CancelsRunning    bool `protobuf:"varint,6,opt,name=cancels_running,json=cancelsRunning,proto3" json:"cancels_running,omitempty"`
+	TerminatesRunning bool `protobuf:"varint,7,opt,name=terminates_running,json=terminatesRunning,proto3" json:"terminates_running,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SimulatedScheduleAction) Reset() {
+	*x = SimulatedScheduleAction{}
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulatedScheduleAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulatedScheduleAction) ProtoMessage() {}
+
+func (x *SimulatedScheduleAction) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulatedScheduleAction.ProtoReflect.Descriptor instead.
+func (*SimulatedScheduleAction) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SimulatedScheduleAction) GetNominalTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NominalTime
+	}
+	return nil
+}
+
+func (x *SimulatedScheduleAction) GetActualTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActualTime
+	}
+	return nil
+}
+
+func (x *SimulatedScheduleAction) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *SimulatedScheduleAction) GetOutcome() v15.SimulatedScheduleActionOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return v15.SimulatedScheduleActionOutcome(0)
+}
+
+func (x *SimulatedScheduleAction) GetOverlapPolicy() v1.ScheduleOverlapPolicy {
+	if x != nil {
+		return x.OverlapPolicy
+	}
+	return v1.ScheduleOverlapPolicy(0)
+}
+
+func (x *SimulatedScheduleAction) GetCancelsRunning() bool {
+	if x != nil {
+		return x.CancelsRunning
+	}
+	return false
+}
+
+func (x *SimulatedScheduleAction) GetTerminatesRunning() bool {
+	if x != nil {
+		return x.TerminatesRunning
+	}
+	return false
+}
+
+// A named calendar of business days, defined per namespace and referenced by schedules.
+type BusinessCalendar struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Time zone in which holidays and weekdays are evaluated. Defaults to the time zone of the
+	// spec of the referencing schedule.
+	TimezoneName string `protobuf:"bytes,3,opt,name=timezone_name,json=timezoneName,proto3" json:"timezone_name,omitempty"`
+	// Days of the week that are not business days, from 0 (Sunday) to 6 (Saturday).
+	NonBusinessWeekdays []int32                 `protobuf:"varint,4,rep,packed,name=non_business_weekdays,json=nonBusinessWeekdays,proto3" json:"non_business_weekdays,omitempty"`
+	Holidays            []*BusinessCalendarDate `protobuf:"bytes,5,rep,name=holidays,proto3" json:"holidays,omitempty"`
+	UpdateTime          *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *BusinessCalendar) Reset() {
+	*x = BusinessCalendar{}
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BusinessCalendar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BusinessCalendar) ProtoMessage() {}
+
+func (x *BusinessCalendar) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BusinessCalendar.ProtoReflect.Descriptor instead.
+func (*BusinessCalendar) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BusinessCalendar) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BusinessCalendar) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *BusinessCalendar) GetTimezoneName() string {
+	if x != nil {
+		return x.TimezoneName
+	}
+	return ""
+}
+
+func (x *BusinessCalendar) GetNonBusinessWeekdays() []int32 {
+	if x != nil {
+		return x.NonBusinessWeekdays
+	}
+	return nil
+}
+
+func (x *BusinessCalendar) GetHolidays() []*BusinessCalendarDate {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+func (x *BusinessCalendar) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// A holiday of a business calendar.
+type BusinessCalendarDate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Zero matches every year.
+	Year          int32  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Month         int32  `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	Day           int32  `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`
+	Comment       string `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BusinessCalendarDate) Reset() {
+	*x = BusinessCalendarDate{}
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BusinessCalendarDate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BusinessCalendarDate) ProtoMessage() {}
+
+func (x *BusinessCalendarDate) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BusinessCalendarDate.ProtoReflect.Descriptor instead.
+func (*BusinessCalendarDate) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BusinessCalendarDate) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *BusinessCalendarDate) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *BusinessCalendarDate) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *BusinessCalendarDate) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+// A reference from a schedule to a business calendar of its namespace.
+type ScheduleBusinessCalendar struct {
+	state  protoimpl.MessageState     `protogen:"open.v1"`
+	Name   string                     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Action v15.BusinessCalendarAction `protobuf:"varint,2,opt,name=action,proto3,enum=temporal.server.api.enums.v1.BusinessCalendarAction" json:"action,omitempty"`
+	// Definition of the calendar, resolved from the namespace when the reference is set and
+	// refreshed by the scheduler.
+	Calendar      *BusinessCalendar `protobuf:"bytes,3,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleBusinessCalendar) Reset() {
+	*x = ScheduleBusinessCalendar{}
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleBusinessCalendar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleBusinessCalendar) ProtoMessage() {}
+
+func (x *ScheduleBusinessCalendar) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleBusinessCalendar.ProtoReflect.Descriptor instead.
+func (*ScheduleBusinessCalendar) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ScheduleBusinessCalendar) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScheduleBusinessCalendar) GetAction() v15.BusinessCalendarAction {
+	if x != nil {
+		return x.Action
+	}
+	return v15.BusinessCalendarAction(0)
+}
+
+func (x *ScheduleBusinessCalendar) GetCalendar() *BusinessCalendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return nil
+}
+
 var File_temporal_server_api_schedule_v1_message_proto protoreflect.FileDescriptor
 
 const file_temporal_server_api_schedule_v1_message_proto_rawDesc = "" +
 	"\n" +
-	"-temporal/server/api/schedule/v1/message.proto\x12\x1ftemporal.server.api.schedule.v1\x1a$temporal/api/common/v1/message.proto\x1a$temporal/api/enums/v1/schedule.proto\x1a$temporal/api/enums/v1/workflow.proto\x1a%temporal/api/failure/v1/message.proto\x1a&temporal/api/schedule/v1/message.proto\x1a6temporal/api/workflowservice/v1/request_response.proto\x1a)temporal/server/api/enums/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd6\x02\n" +
+	"-temporal/server/api/schedule/v1/message.proto\x12\x1ftemporal.server.api.schedule.v1\x1a$temporal/api/common/v1/message.proto\x1a$temporal/api/enums/v1/schedule.proto\x1a$temporal/api/enums/v1/workflow.proto\x1a%temporal/api/failure/v1/message.proto\x1a&temporal/api/schedule/v1/message.proto\x1a6temporal/api/workflowservice/v1/request_response.proto\x1a)temporal/server/api/enums/v1/common.proto\x1a+temporal/server/api/enums/v1/schedule.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd6\x02\n" +
 	"\rBufferedStart\x12=\n" +
 	"\fnominal_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vnominalTime\x12;\n" +
 	"\vactual_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
@@ -1219,7 +1689,7 @@ const file_temporal_server_api_schedule_v1_message_proto_rawDesc = "" +
 	"\x0eoverlap_policy\x18\x03 \x01(\x0e2,.temporal.api.enums.v1.ScheduleOverlapPolicyR\roverlapPolicy\x12\x16\n" +
 	"\x06manual\x18\x04 \x01(\bR\x06manual\x12\x1d\n" +
 	"\n" +
-	"request_id\x18\x06 \x01(\tR\trequestId\"\xdf\x04\n" +
+	"request_id\x18\x06 \x01(\tR\trequestId\"\xc9\x05\n" +
 	"\rInternalState\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12!\n" +
 	"\fnamespace_id\x18\x02 \x01(\tR\vnamespaceId\x12\x1f\n" +
@@ -1232,7 +1702,8 @@ const file_temporal_server_api_schedule_v1_message_proto_rawDesc = "" +
 	"\x16last_completion_result\x18\x05 \x01(\v2 .temporal.api.common.v1.PayloadsR\x14lastCompletionResult\x12M\n" +
 	"\x11continued_failure\x18\x06 \x01(\v2 .temporal.api.failure.v1.FailureR\x10continuedFailure\x12%\n" +
 	"\x0econflict_token\x18\a \x01(\x03R\rconflictToken\x12!\n" +
-	"\fneed_refresh\x18\t \x01(\bR\vneedRefresh\"\xa3\x02\n" +
+	"\fneed_refresh\x18\t \x01(\bR\vneedRefresh\x12h\n" +
+	"\x12business_calendars\x18\v \x03(\v29.temporal.server.api.schedule.v1.ScheduleBusinessCalendarR\x11businessCalendars\"\xa3\x02\n" +
 	"\x11StartScheduleArgs\x12>\n" +
 	"\bschedule\x18\x01 \x01(\v2\".temporal.api.schedule.v1.ScheduleR\bschedule\x12:\n" +
 	"\x04info\x18\x02 \x01(\v2&.temporal.api.schedule.v1.ScheduleInfoR\x04info\x12L\n" +
@@ -1245,7 +1716,13 @@ const file_temporal_server_api_schedule_v1_message_proto_rawDesc = "" +
 	"\x10DescribeResponse\x12>\n" +
 	"\bschedule\x18\x01 \x01(\v2\".temporal.api.schedule.v1.ScheduleR\bschedule\x12:\n" +
 	"\x04info\x18\x02 \x01(\v2&.temporal.api.schedule.v1.ScheduleInfoR\x04info\x12%\n" +
-	"\x0econflict_token\x18\x03 \x01(\x03R\rconflictToken\"\xb1\x01\n" +
+	"\x0econflict_token\x18\x03 \x01(\x03R\rconflictToken\"\x8b\x01\n" +
+	"\x1fResolveBusinessCalendarsRequest\x12h\n" +
+	"\x12business_calendars\x18\x01 \x03(\v29.temporal.server.api.schedule.v1.ScheduleBusinessCalendarR\x11businessCalendars\"\x8c\x01\n" +
+	" ResolveBusinessCalendarsResponse\x12h\n" +
+	"\x12business_calendars\x18\x01 \x03(\v29.temporal.server.api.schedule.v1.ScheduleBusinessCalendarR\x11businessCalendars\"\x87\x01\n" +
+	"\x1bSetBusinessCalendarsRequest\x12h\n" +
+	"\x12business_calendars\x18\x01 \x03(\v29.temporal.server.api.schedule.v1.ScheduleBusinessCalendarR\x11businessCalendars\"\xb1\x01\n" +
 	"\x14WatchWorkflowRequest\x12G\n" +
 	"\texecution\x18\x03 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\x123\n" +
 	"\x16first_execution_run_id\x18\x04 \x01(\tR\x13firstExecutionRunId\x12\x1b\n" +
@@ -1282,7 +1759,7 @@ const file_temporal_server_api_schedule_v1_message_proto_rawDesc = "" +
 	"\n" +
 	"next_times\x18\x03 \x03(\x03R\tnextTimes\x12#\n" +
 	"\rnominal_times\x18\x04 \x03(\x03R\fnominalTimes\x12\x1c\n" +
-	"\tcompleted\x18\x05 \x01(\bR\tcompleted\"\xe6\x02\n" +
+	"\tcompleted\x18\x05 \x01(\bR\tcompleted\"\xd0\x03\n" +
 	"\x11SchedulerInternal\x12>\n" +
 	"\bschedule\x18\x02 \x01(\v2\".temporal.api.schedule.v1.ScheduleR\bschedule\x12:\n" +
 	"\x04info\x18\x03 \x01(\v2&.temporal.api.schedule.v1.ScheduleInfoR\x04info\x12L\n" +
@@ -1291,7 +1768,8 @@ const file_temporal_server_api_schedule_v1_message_proto_rawDesc = "" +
 	"\fnamespace_id\x18\x06 \x01(\tR\vnamespaceId\x12\x1f\n" +
 	"\vschedule_id\x18\a \x01(\tR\n" +
 	"scheduleId\x12%\n" +
-	"\x0econflict_token\x18\b \x01(\x03R\rconflictToken\"\xad\x01\n" +
+	"\x0econflict_token\x18\b \x01(\x03R\rconflictToken\x12h\n" +
+	"\x12business_calendars\x18\t \x03(\v29.temporal.server.api.schedule.v1.ScheduleBusinessCalendarR\x11businessCalendars\"\xad\x01\n" +
 	"\x11GeneratorInternal\x12L\n" +
 	"\x14next_invocation_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x12nextInvocationTime\x12J\n" +
 	"\x13last_processed_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x11lastProcessedTime\"\x85\x02\n" +
@@ -1301,7 +1779,34 @@ const file_temporal_server_api_schedule_v1_message_proto_rawDesc = "" +
 	"\x0fbuffered_starts\x18\x03 \x03(\v2..temporal.server.api.schedule.v1.BufferedStartR\x0ebufferedStarts\"\xa7\x01\n" +
 	"\x12BackfillerInternal\x12C\n" +
 	"\arequest\x18\x01 \x01(\v2).temporal.api.schedule.v1.BackfillRequestR\arequest\x12L\n" +
-	"\x14next_invocation_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x12nextInvocationTimeB0Z.go.temporal.io/server/api/schedule/v1;scheduleb\x06proto3"
+	"\x14next_invocation_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x12nextInvocationTime\"\xd5\x03\n" +
+	"\x17SimulatedScheduleAction\x12=\n" +
+	"\fnominal_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vnominalTime\x12;\n" +
+	"\vactual_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"actualTime\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x12V\n" +
+	"\aoutcome\x18\x04 \x01(\x0e2<.temporal.server.api.enums.v1.SimulatedScheduleActionOutcomeR\aoutcome\x12S\n" +
+	"\x0eoverlap_policy\x18\x05 \x01(\x0e2,.temporal.api.enums.v1.ScheduleOverlapPolicyR\roverlapPolicy\x12'\n" +
+	"\x0fcancels_running\x18\x06 \x01(\bR\x0ecancelsRunning\x12-\n" +
+	"\x12terminates_running\x18\a \x01(\bR\x11terminatesRunning\"\xb1\x02\n" +
+	"\x10BusinessCalendar\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12#\n" +
+	"\rtimezone_name\x18\x03 \x01(\tR\ftimezoneName\x122\n" +
+	"\x15non_business_weekdays\x18\x04 \x03(\x05R\x13nonBusinessWeekdays\x12Q\n" +
+	"\bholidays\x18\x05 \x03(\v25.temporal.server.api.schedule.v1.BusinessCalendarDateR\bholidays\x12;\n" +
+	"\vupdate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"l\n" +
+	"\x14BusinessCalendarDate\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05month\x18\x02 \x01(\x05R\x05month\x12\x10\n" +
+	"\x03day\x18\x03 \x01(\x05R\x03day\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\"\xcb\x01\n" +
+	"\x18ScheduleBusinessCalendar\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12L\n" +
+	"\x06action\x18\x02 \x01(\x0e24.temporal.server.api.enums.v1.BusinessCalendarActionR\x06action\x12M\n" +
+	"\bcalendar\x18\x03 \x01(\v21.temporal.server.api.schedule.v1.BusinessCalendarR\bcalendarB0Z.go.temporal.io/server/api/schedule/v1;scheduleb\x06proto3"
 
 var (
 	file_temporal_server_api_schedule_v1_message_proto_rawDescOnce sync.Once
@@ -1315,81 +1820,104 @@ func file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_schedule_v1_message_proto_rawDescData
 }
 
-var file_temporal_server_api_schedule_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_temporal_server_api_schedule_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_temporal_server_api_schedule_v1_message_proto_goTypes = []any{
 	(*BufferedStart)(nil),                     // 0: temporal.server.api.schedule.v1.BufferedStart
 	(*InternalState)(nil),                     // 1: temporal.server.api.schedule.v1.InternalState
 	(*StartScheduleArgs)(nil),                 // 2: temporal.server.api.schedule.v1.StartScheduleArgs
 	(*FullUpdateRequest)(nil),                 // 3: temporal.server.api.schedule.v1.FullUpdateRequest
 	(*DescribeResponse)(nil),                  // 4: temporal.server.api.schedule.v1.DescribeResponse
-	(*WatchWorkflowRequest)(nil),              // 5: temporal.server.api.schedule.v1.WatchWorkflowRequest
-	(*WatchWorkflowResponse)(nil),             // 6: temporal.server.api.schedule.v1.WatchWorkflowResponse
-	(*StartWorkflowRequest)(nil),              // 7: temporal.server.api.schedule.v1.StartWorkflowRequest
-	(*StartWorkflowResponse)(nil),             // 8: temporal.server.api.schedule.v1.StartWorkflowResponse
-	(*CancelWorkflowRequest)(nil),             // 9: temporal.server.api.schedule.v1.CancelWorkflowRequest
-	(*TerminateWorkflowRequest)(nil),          // 10: temporal.server.api.schedule.v1.TerminateWorkflowRequest
-	(*NextTimeCache)(nil),                     // 11: temporal.server.api.schedule.v1.NextTimeCache
-	(*SchedulerInternal)(nil),                 // 12: temporal.server.api.schedule.v1.SchedulerInternal
-	(*GeneratorInternal)(nil),                 // 13: temporal.server.api.schedule.v1.GeneratorInternal
-	(*ExecutorInternal)(nil),                  // 14: temporal.server.api.schedule.v1.ExecutorInternal
-	(*BackfillerInternal)(nil),                // 15: temporal.server.api.schedule.v1.BackfillerInternal
-	(*timestamppb.Timestamp)(nil),             // 16: google.protobuf.Timestamp
-	(v1.ScheduleOverlapPolicy)(0),             // 17: temporal.api.enums.v1.ScheduleOverlapPolicy
-	(*v11.BackfillRequest)(nil),               // 18: temporal.api.schedule.v1.BackfillRequest
-	(*v12.Payloads)(nil),                      // 19: temporal.api.common.v1.Payloads
-	(*v13.Failure)(nil),                       // 20: temporal.api.failure.v1.Failure
-	(*v11.Schedule)(nil),                      // 21: temporal.api.schedule.v1.Schedule
-	(*v11.ScheduleInfo)(nil),                  // 22: temporal.api.schedule.v1.ScheduleInfo
-	(*v11.SchedulePatch)(nil),                 // 23: temporal.api.schedule.v1.SchedulePatch
-	(*v12.SearchAttributes)(nil),              // 24: temporal.api.common.v1.SearchAttributes
-	(*v12.WorkflowExecution)(nil),             // 25: temporal.api.common.v1.WorkflowExecution
-	(v1.WorkflowExecutionStatus)(0),           // 26: temporal.api.enums.v1.WorkflowExecutionStatus
-	(*v14.StartWorkflowExecutionRequest)(nil), // 27: temporal.api.workflowservice.v1.StartWorkflowExecutionRequest
-	(v15.SchedulerExecutorState)(0),           // 28: temporal.server.api.enums.v1.SchedulerExecutorState
+	(*ResolveBusinessCalendarsRequest)(nil),   // 5: temporal.server.api.schedule.v1.ResolveBusinessCalendarsRequest
+	(*ResolveBusinessCalendarsResponse)(nil),  // 6: temporal.server.api.schedule.v1.ResolveBusinessCalendarsResponse
+	(*SetBusinessCalendarsRequest)(nil),       // 7: temporal.server.api.schedule.v1.SetBusinessCalendarsRequest
+	(*WatchWorkflowRequest)(nil),              // 8: temporal.server.api.schedule.v1.WatchWorkflowRequest
+	(*WatchWorkflowResponse)(nil),             // 9: temporal.server.api.schedule.v1.WatchWorkflowResponse
+	(*StartWorkflowRequest)(nil),              // 10: temporal.server.api.schedule.v1.StartWorkflowRequest
+	(*StartWorkflowResponse)(nil),             // 11: temporal.server.api.schedule.v1.StartWorkflowResponse
+	(*CancelWorkflowRequest)(nil),             // 12: temporal.server.api.schedule.v1.CancelWorkflowRequest
+	(*TerminateWorkflowRequest)(nil),          // 13: temporal.server.api.schedule.v1.TerminateWorkflowRequest
+	(*NextTimeCache)(nil),                     // 14: temporal.server.api.schedule.v1.NextTimeCache
+	(*SchedulerInternal)(nil),                 // 15: temporal.server.api.schedule.v1.SchedulerInternal
+	(*GeneratorInternal)(nil),                 // 16: temporal.server.api.schedule.v1.GeneratorInternal
+	(*ExecutorInternal)(nil),                  // 17: temporal.server.api.schedule.v1.ExecutorInternal
+	(*BackfillerInternal)(nil),                // 18: temporal.server.api.schedule.v1.BackfillerInternal
+	(*SimulatedScheduleAction)(nil),           // 19: temporal.server.api.schedule.v1.SimulatedScheduleAction
+	(*BusinessCalendar)(nil),                  // 20: temporal.server.api.schedule.v1.BusinessCalendar
+	(*BusinessCalendarDate)(nil),              // 21: temporal.server.api.schedule.v1.BusinessCalendarDate
+	(*ScheduleBusinessCalendar)(nil),          // 22: temporal.server.api.schedule.v1.ScheduleBusinessCalendar
+	(*timestamppb.Timestamp)(nil),             // 23: google.protobuf.Timestamp
+	(v1.ScheduleOverlapPolicy)(0),             // 24: temporal.api.enums.v1.ScheduleOverlapPolicy
+	(*v11.BackfillRequest)(nil),               // 25: temporal.api.schedule.v1.BackfillRequest
+	(*v12.Payloads)(nil),                      // 26: temporal.api.common.v1.Payloads
+	(*v13.Failure)(nil),                       // 27: temporal.api.failure.v1.Failure
+	(*v11.Schedule)(nil),                      // 28: temporal.api.schedule.v1.Schedule
+	(*v11.ScheduleInfo)(nil),                  // 29: temporal.api.schedule.v1.ScheduleInfo
+	(*v11.SchedulePatch)(nil),                 // 30: temporal.api.schedule.v1.SchedulePatch
+	(*v12.SearchAttributes)(nil),              // 31: temporal.api.common.v1.SearchAttributes
+	(*v12.WorkflowExecution)(nil),             // 32: temporal.api.common.v1.WorkflowExecution
+	(v1.WorkflowExecutionStatus)(0),           // 33: temporal.api.enums.v1.WorkflowExecutionStatus
+	(*v14.StartWorkflowExecutionRequest)(nil), // 34: temporal.api.workflowservice.v1.StartWorkflowExecutionRequest
+	(v15.SchedulerExecutorState)(0),           // 35: temporal.server.api.enums.v1.SchedulerExecutorState
+	(v15.SimulatedScheduleActionOutcome)(0),   // 36: temporal.server.api.enums.v1.SimulatedScheduleActionOutcome
+	(v15.BusinessCalendarAction)(0),           // 37: temporal.server.api.enums.v1.BusinessCalendarAction
 }
 var file_temporal_server_api_schedule_v1_message_proto_depIdxs = []int32{
-	16, // 0: temporal.server.api.schedule.v1.BufferedStart.nominal_time:type_name -> google.protobuf.Timestamp
-	16, // 1: temporal.server.api.schedule.v1.BufferedStart.actual_time:type_name -> google.protobuf.Timestamp
-	16, // 2: temporal.server.api.schedule.v1.BufferedStart.desired_time:type_name -> google.protobuf.Timestamp
-	17, // 3: temporal.server.api.schedule.v1.BufferedStart.overlap_policy:type_name -> temporal.api.enums.v1.ScheduleOverlapPolicy
-	16, // 4: temporal.server.api.schedule.v1.InternalState.last_processed_time:type_name -> google.protobuf.Timestamp
+	23, // 0: temporal.server.api.schedule.v1.BufferedStart.nominal_time:type_name -> google.protobuf.Timestamp
+	23, // 1: temporal.server.api.schedule.v1.BufferedStart.actual_time:type_name -> google.protobuf.Timestamp
+	23, // 2: temporal.server.api.schedule.v1.BufferedStart.desired_time:type_name -> google.protobuf.Timestamp
+	24, // 3: temporal.server.api.schedule.v1.BufferedStart.overlap_policy:type_name -> temporal.api.enums.v1.ScheduleOverlapPolicy
+	23, // 4: temporal.server.api.schedule.v1.InternalState.last_processed_time:type_name -> google.protobuf.Timestamp
 	0,  // 5: temporal.server.api.schedule.v1.InternalState.buffered_starts:type_name -> temporal.server.api.schedule.v1.BufferedStart
-	18, // 6: temporal.server.api.schedule.v1.InternalState.ongoing_backfills:type_name -> temporal.api.schedule.v1.BackfillRequest
-	19, // 7: temporal.server.api.schedule.v1.InternalState.last_completion_result:type_name -> temporal.api.common.v1.Payloads
-	20, // 8: temporal.server.api.schedule.v1.InternalState.continued_failure:type_name -> temporal.api.failure.v1.Failure
-	21, // 9: temporal.server.api.schedule.v1.StartScheduleArgs.schedule:type_name -> temporal.api.schedule.v1.Schedule
-	22, // 10: temporal.server.api.schedule.v1.StartScheduleArgs.info:type_name -> temporal.api.schedule.v1.ScheduleInfo
-	23, // 11: temporal.server.api.schedule.v1.StartScheduleArgs.initial_patch:type_name -> temporal.api.schedule.v1.SchedulePatch
-	1,  // 12: temporal.server.api.schedule.v1.StartScheduleArgs.state:type_name -> temporal.server.api.schedule.v1.InternalState
-	21, // 13: temporal.server.api.schedule.v1.FullUpdateRequest.schedule:type_name -> temporal.api.schedule.v1.Schedule
-	24, // 14: temporal.server.api.schedule.v1.FullUpdateRequest.search_attributes:type_name -> temporal.api.common.v1.SearchAttributes
-	21, // 15: temporal.server.api.schedule.v1.DescribeResponse.schedule:type_name -> temporal.api.schedule.v1.Schedule
-	22, // 16: temporal.server.api.schedule.v1.DescribeResponse.info:type_name -> temporal.api.schedule.v1.ScheduleInfo
-	25, // 17: temporal.server.api.schedule.v1.WatchWorkflowRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	26, // 18: temporal.server.api.schedule.v1.WatchWorkflowResponse.status:type_name -> temporal.api.enums.v1.WorkflowExecutionStatus
-	19, // 19: temporal.server.api.schedule.v1.WatchWorkflowResponse.result:type_name -> temporal.api.common.v1.Payloads
-	20, // 20: temporal.server.api.schedule.v1.WatchWorkflowResponse.failure:type_name -> temporal.api.failure.v1.Failure
-	16, // 21: temporal.server.api.schedule.v1.WatchWorkflowResponse.close_time:type_name -> google.protobuf.Timestamp
-	27, // 22: temporal.server.api.schedule.v1.StartWorkflowRequest.request:type_name -> temporal.api.workflowservice.v1.StartWorkflowExecutionRequest
-	16, // 23: temporal.server.api.schedule.v1.StartWorkflowResponse.real_start_time:type_name -> google.protobuf.Timestamp
-	25, // 24: temporal.server.api.schedule.v1.CancelWorkflowRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	25, // 25: temporal.server.api.schedule.v1.TerminateWorkflowRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	16, // 26: temporal.server.api.schedule.v1.NextTimeCache.start_time:type_name -> google.protobuf.Timestamp
-	21, // 27: temporal.server.api.schedule.v1.SchedulerInternal.schedule:type_name -> temporal.api.schedule.v1.Schedule
-	22, // 28: temporal.server.api.schedule.v1.SchedulerInternal.info:type_name -> temporal.api.schedule.v1.ScheduleInfo
-	23, // 29: temporal.server.api.schedule.v1.SchedulerInternal.initial_patch:type_name -> temporal.api.schedule.v1.SchedulePatch
-	16, // 30: temporal.server.api.schedule.v1.GeneratorInternal.next_invocation_time:type_name -> google.protobuf.Timestamp
-	16, // 31: temporal.server.api.schedule.v1.GeneratorInternal.last_processed_time:type_name -> google.protobuf.Timestamp
-	28, // 32: temporal.server.api.schedule.v1.ExecutorInternal.state:type_name -> temporal.server.api.enums.v1.SchedulerExecutorState
-	16, // 33: temporal.server.api.schedule.v1.ExecutorInternal.next_invocation_time:type_name -> google.protobuf.Timestamp
-	0,  // 34: temporal.server.api.schedule.v1.ExecutorInternal.buffered_starts:type_name -> temporal.server.api.schedule.v1.BufferedStart
-	18, // 35: temporal.server.api.schedule.v1.BackfillerInternal.request:type_name -> temporal.api.schedule.v1.BackfillRequest
-	16, // 36: temporal.server.api.schedule.v1.BackfillerInternal.next_invocation_time:type_name -> google.protobuf.Timestamp
-	37, // [37:37] is the sub-list for method output_type
-	37, // [37:37] is the sub-list for method input_type
-	37, // [37:37] is the sub-list for extension type_name
-	37, // [37:37] is the sub-list for extension extendee
-	0,  // [0:37] is the sub-list for field type_name
+	25, // 6: temporal.server.api.schedule.v1.InternalState.ongoing_backfills:type_name -> temporal.api.schedule.v1.BackfillRequest
+	26, // 7: temporal.server.api.schedule.v1.InternalState.last_completion_result:type_name -> temporal.api.common.v1.Payloads
+	27, // 8: temporal.server.api.schedule.v1.InternalState.continued_failure:type_name -> temporal.api.failure.v1.Failure
+	22, // 9: temporal.server.api.schedule.v1.InternalState.business_calendars:type_name -> temporal.server.api.schedule.v1.ScheduleBusinessCalendar
+	28, // 10: temporal.server.api.schedule.v1.StartScheduleArgs.schedule:type_name -> temporal.api.schedule.v1.Schedule
+	29, // 11: temporal.server.api.schedule.v1.StartScheduleArgs.info:type_name -> temporal.api.schedule.v1.ScheduleInfo
+	30, // 12: temporal.server.api.schedule.v1.StartScheduleArgs.initial_patch:type_name -> temporal.api.schedule.v1.SchedulePatch
+	1,  // 13: temporal.server.api.schedule.v1.StartScheduleArgs.state:type_name -> temporal.server.api.schedule.v1.InternalState
+	28, // 14: temporal.server.api.schedule.v1.FullUpdateRequest.schedule:type_name -> temporal.api.schedule.v1.Schedule
+	31, // 15: temporal.server.api.schedule.v1.FullUpdateRequest.search_attributes:type_name -> temporal.api.common.v1.SearchAttributes
+	28, // 16: temporal.server.api.schedule.v1.DescribeResponse.schedule:type_name -> temporal.api.schedule.v1.Schedule
+	29, // 17: temporal.server.api.schedule.v1.DescribeResponse.info:type_name -> temporal.api.schedule.v1.ScheduleInfo
+	22, // 18: temporal.server.api.schedule.v1.ResolveBusinessCalendarsRequest.business_calendars:type_name -> temporal.server.api.schedule.v1.ScheduleBusinessCalendar
+	22, // 19: temporal.server.api.schedule.v1.ResolveBusinessCalendarsResponse.business_calendars:type_name -> temporal.server.api.schedule.v1.ScheduleBusinessCalendar
+	22, // 20: temporal.server.api.schedule.v1.SetBusinessCalendarsRequest.business_calendars:type_name -> temporal.server.api.schedule.v1.ScheduleBusinessCalendar
+	32, // 21: temporal.server.api.schedule.v1.WatchWorkflowRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	33, // 22: temporal.server.api.schedule.v1.WatchWorkflowResponse.status:type_name -> temporal.api.enums.v1.WorkflowExecutionStatus
+	26, // 23: temporal.server.api.schedule.v1.WatchWorkflowResponse.result:type_name -> temporal.api.common.v1.Payloads
+	27, // 24: temporal.server.api.schedule.v1.WatchWorkflowResponse.failure:type_name -> temporal.api.failure.v1.Failure
+	23, // 25: temporal.server.api.schedule.v1.WatchWorkflowResponse.close_time:type_name -> google.protobuf.Timestamp
+	34, // 26: temporal.server.api.schedule.v1.StartWorkflowRequest.request:type_name -> temporal.api.workflowservice.v1.StartWorkflowExecutionRequest
+	23, // 27: temporal.server.api.schedule.v1.StartWorkflowResponse.real_start_time:type_name -> google.protobuf.Timestamp
+	32, // 28: temporal.server.api.schedule.v1.CancelWorkflowRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	32, // 29: temporal.server.api.schedule.v1.TerminateWorkflowRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	23, // 30: temporal.server.api.schedule.v1.NextTimeCache.start_time:type_name -> google.protobuf.Timestamp
+	28, // 31: temporal.server.api.schedule.v1.SchedulerInternal.schedule:type_name -> temporal.api.schedule.v1.Schedule
+	29, // 32: temporal.server.api.schedule.v1.SchedulerInternal.info:type_name -> temporal.api.schedule.v1.ScheduleInfo
+	30, // 33: temporal.server.api.schedule.v1.SchedulerInternal.initial_patch:type_name -> temporal.api.schedule.v1.SchedulePatch
+	22, // 34: temporal.server.api.schedule.v1.SchedulerInternal.business_calendars:type_name -> temporal.server.api.schedule.v1.ScheduleBusinessCalendar
+	23, // 35: temporal.server.api.schedule.v1.GeneratorInternal.next_invocation_time:type_name -> google.protobuf.Timestamp
+	23, // 36: temporal.server.api.schedule.v1.GeneratorInternal.last_processed_time:type_name -> google.protobuf.Timestamp
+	35, // 37: temporal.server.api.schedule.v1.ExecutorInternal.state:type_name -> temporal.server.api.enums.v1.SchedulerExecutorState
+	23, // 38: temporal.server.api.schedule.v1.ExecutorInternal.next_invocation_time:type_name -> google.protobuf.Timestamp
+	0,  // 39: temporal.server.api.schedule.v1.ExecutorInternal.buffered_starts:type_name -> temporal.server.api.schedule.v1.BufferedStart
+	25, // 40: temporal.server.api.schedule.v1.BackfillerInternal.request:type_name -> temporal.api.schedule.v1.BackfillRequest
+	23, // 41: temporal.server.api.schedule.v1.BackfillerInternal.next_invocation_time:type_name -> google.protobuf.Timestamp
+	23, // 42: temporal.server.api.schedule.v1.SimulatedScheduleAction.nominal_time:type_name -> google.protobuf.Timestamp
+	23, // 43: temporal.server.api.schedule.v1.SimulatedScheduleAction.actual_time:type_name -> google.protobuf.Timestamp
+	23, // 44: temporal.server.api.schedule.v1.SimulatedScheduleAction.start_time:type_name -> google.protobuf.Timestamp
+	36, // 45: temporal.server.api.schedule.v1.SimulatedScheduleAction.outcome:type_name -> temporal.server.api.enums.v1.SimulatedScheduleActionOutcome
+	24, // 46: temporal.server.api.schedule.v1.SimulatedScheduleAction.overlap_policy:type_name -> temporal.api.enums.v1.ScheduleOverlapPolicy
+	21, // 47: temporal.server.api.schedule.v1.BusinessCalendar.holidays:type_name -> temporal.server.api.schedule.v1.BusinessCalendarDate
+	23, // 48: temporal.server.api.schedule.v1.BusinessCalendar.update_time:type_name -> google.protobuf.Timestamp
+	37, // 49: temporal.server.api.schedule.v1.ScheduleBusinessCalendar.action:type_name -> temporal.server.api.enums.v1.BusinessCalendarAction
+	20, // 50: temporal.server.api.schedule.v1.ScheduleBusinessCalendar.calendar:type_name -> temporal.server.api.schedule.v1.BusinessCalendar
+	51, // [51:51] is the sub-list for method output_type
+	51, // [51:51] is the sub-list for method input_type
+	51, // [51:51] is the sub-list for extension type_name
+	51, // [51:51] is the sub-list for extension extendee
+	0,  // [0:51] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_schedule_v1_message_proto_init() }
@@ -1397,7 +1925,7 @@ func file_temporal_server_api_schedule_v1_message_proto_init() {
 	if File_temporal_server_api_schedule_v1_message_proto != nil {
 		return
 	}
-	file_temporal_server_api_schedule_v1_message_proto_msgTypes[6].OneofWrappers = []any{
+	file_temporal_server_api_schedule_v1_message_proto_msgTypes[9].OneofWrappers = []any{
 		(*WatchWorkflowResponse_Result)(nil),
 		(*WatchWorkflowResponse_Failure)(nil),
 	}
@@ -1407,7 +1935,7 @@ func file_temporal_server_api_schedule_v1_message_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_schedule_v1_message_proto_rawDesc), len(file_temporal_server_api_schedule_v1_message_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -71,6 +71,12 @@ const (
 	LifecycleStateUnspecified = LifecycleState(0)
 )
 
+// IsClosed returns true if the component reached a final state. A closed root component
+// closes the whole entity, which is then subject to retention and optionally archival.
+func (s LifecycleState) IsClosed() bool {
+	return s&(LifecycleStateCompleted|LifecycleStateFailed) != 0
+}
+
 type OperationIntent int
 
 const (
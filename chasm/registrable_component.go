@@ -36,7 +36,11 @@ type (
 
 		ephemeral     bool
 		singleCluster bool
+		archival      bool
 		shardingFn    func(EntityKey) string
+
+		searchAttributes []*SearchAttribute
+		memoFields       []*MemoField
 	}
 
 	RegistrableComponentOption func(*RegistrableComponent)
@@ -70,6 +74,15 @@ func WithSingleCluster() RegistrableComponentOption {
 	}
 }
 
+// WithArchival archives closed entities, whose root component is of this type, before they are deleted
+// by retention. The final state of the entity is archived with its visibility record.
+// Archival only happens if it's enabled for the cluster and the namespace.
+func WithArchival() RegistrableComponentOption {
+	return func(rc *RegistrableComponent) {
+		rc.archival = true
+	}
+}
+
 func WithShardingFn(
 	shardingFn func(EntityKey) string,
 ) RegistrableComponentOption {
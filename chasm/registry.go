@@ -115,6 +115,9 @@ func (r *Registry) registerComponent(
 	if _, ok := r.componentByGoType[rc.goType]; ok {
 		return fmt.Errorf("component type %s is already registered", rc.goType.String())
 	}
+	if err := rc.validateVisibility(); err != nil {
+		return err
+	}
 
 	rc.library = lib
 	r.componentByType[fqn] = rc
@@ -28,6 +28,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/server/chasm"
 	"go.uber.org/mock/gomock"
 )
@@ -210,6 +211,69 @@ func TestRegistry_RegisterComponents_Error(t *testing.T) {
 		require.Contains(t, err.Error(), "must be struct or pointer to struct")
 	})
 
+	keywordFn := func(*chasm.MockComponent) any { return "value" }
+	visibilityTestCases := []struct {
+		name        string
+		option      chasm.RegistrableComponentOption
+		expectedErr string
+	}{
+		{
+			name: "search attribute name must not be empty",
+			option: chasm.WithSearchAttributes(
+				chasm.NewSearchAttribute[*chasm.MockComponent]("", enumspb.INDEXED_VALUE_TYPE_KEYWORD, keywordFn),
+			),
+			expectedErr: "search attribute name of component Component1 must not be empty",
+		},
+		{
+			name: "search attribute must not be a system search attribute",
+			option: chasm.WithSearchAttributes(
+				chasm.NewSearchAttribute[*chasm.MockComponent]("WorkflowId", enumspb.INDEXED_VALUE_TYPE_KEYWORD, keywordFn),
+			),
+			expectedErr: "is a system search attribute",
+		},
+		{
+			name: "search attribute must be declared once",
+			option: chasm.WithSearchAttributes(
+				chasm.NewSearchAttribute[*chasm.MockComponent]("Attr", enumspb.INDEXED_VALUE_TYPE_KEYWORD, keywordFn),
+				chasm.NewSearchAttribute[*chasm.MockComponent]("Attr", enumspb.INDEXED_VALUE_TYPE_TEXT, keywordFn),
+			),
+			expectedErr: "is declared more than once",
+		},
+		{
+			name: "search attribute must have a type",
+			option: chasm.WithSearchAttributes(
+				chasm.NewSearchAttribute[*chasm.MockComponent]("Attr", enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED, keywordFn),
+			),
+			expectedErr: "has invalid type",
+		},
+		{
+			name: "search attribute must be declared for the component type",
+			option: chasm.WithSearchAttributes(
+				chasm.NewSearchAttribute[chasm.Component]("Attr", enumspb.INDEXED_VALUE_TYPE_KEYWORD, func(chasm.Component) any { return nil }),
+			),
+			expectedErr: "not for component type",
+		},
+		{
+			name: "memo field must be declared once",
+			option: chasm.WithMemo(
+				chasm.NewMemoField[*chasm.MockComponent]("Field", keywordFn),
+				chasm.NewMemoField[*chasm.MockComponent]("Field", keywordFn),
+			),
+			expectedErr: "memo field Field of component Component1 is declared more than once",
+		},
+	}
+	for _, tc := range visibilityTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			lib.EXPECT().Components().Return([]*chasm.RegistrableComponent{
+				chasm.NewRegistrableComponent[*chasm.MockComponent]("Component1", tc.option),
+			})
+			r := chasm.NewRegistry()
+
+			err := r.Register(lib)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.expectedErr)
+		})
+	}
 }
 
 func TestRegistry_RegisterTasks_Error(t *testing.T) {
@@ -69,6 +69,14 @@ type (
 	}
 )
 
+// LifecycleState of TestComponent is closed once cancel is requested.
+func (tc *TestComponent) LifecycleState() LifecycleState {
+	if tc.ComponentData.GetCancelRequested() {
+		return LifecycleStateCompleted
+	}
+	return LifecycleStateRunning
+}
+
 func setTestComponentFields(c *TestComponent) {
 	c.ComponentData = &protoMessageType{
 		ActivityId: "component-data",
@@ -24,6 +24,10 @@
 
 package chasm
 
+import (
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
 type TestLibrary struct {
 	UnimplementedLibrary
 }
@@ -38,7 +42,23 @@ func (l *TestLibrary) Name() string {
 
 func (l *TestLibrary) Components() []*RegistrableComponent {
 	return []*RegistrableComponent{
-		NewRegistrableComponent[*TestComponent]("test_component"),
+		NewRegistrableComponent[*TestComponent](
+			"test_component",
+			WithArchival(),
+			WithSearchAttributes(
+				NewSearchAttribute[*TestComponent]("TestActivityId", enumspb.INDEXED_VALUE_TYPE_KEYWORD, func(c *TestComponent) any {
+					return c.ComponentData.GetActivityId()
+				}),
+			),
+			WithMemo(
+				NewMemoField[*TestComponent]("TestAttempt", func(c *TestComponent) any {
+					if c.ComponentData.GetAttempt() == 0 {
+						return nil
+					}
+					return c.ComponentData.GetAttempt()
+				}),
+			),
+		),
 		NewRegistrableComponent[*TestSubComponent1]("test_sub_component_1"),
 		NewRegistrableComponent[*TestSubComponent11]("test_sub_component_11"),
 		NewRegistrableComponent[*TestSubComponent2]("test_sub_component_2"),
@@ -92,6 +92,11 @@ type (
 		logger      log.Logger
 		// Mutations accumulated so far in this transaction.
 		mutation NodesMutation
+		// Visibility attributes of the root component when it was loaded or last sent to visibility.
+		// It's nil for new entities, which always need a visibility task.
+		visibility *visibilityAttributes
+		// rootClosed is true if the root component was already closed when it was loaded or closed in an earlier transaction.
+		rootClosed bool
 	}
 
 	// NodesMutation is a set of mutations for all nodes rooted at a given node n,
@@ -119,6 +124,19 @@ type (
 		// TODO: Add methods needed from MutateState here.
 		GetCurrentVersion() int64
 		NextTransitionCount() int64
+		// GenerateUpsertVisibilityTask is called when the search attributes or memo
+		// declared by the root component changed in the current transaction.
+		GenerateUpsertVisibilityTask() error
+		// CloseEntity is called when the root component is closed in the current transaction.
+		CloseEntity(entityClose EntityClose) error
+	}
+
+	// EntityClose describes an entity whose root component was closed in the current transaction.
+	EntityClose struct {
+		CloseTime      time.Time
+		LifecycleState LifecycleState
+		// Archive is true if the entity should be archived before it's deleted by retention.
+		Archive bool
 	}
 
 	// NodePathEncoder is an interface for encoding and decoding node paths.
@@ -636,6 +654,11 @@ func (n *Node) deserializeComponentNode(
 
 	n.value = valueV.Interface()
 	n.valueState = valueStateSynced
+
+	if n.parent == nil {
+		// Root state as persisted, so that close transaction can tell what changed.
+		return n.loadRootState()
+	}
 	return nil
 }
 
@@ -698,10 +721,129 @@ func (n *Node) AddTask(
 func (n *Node) CloseTransaction() (NodesMutation, error) {
 	defer n.cleanupTransaction()
 
+	if err := n.closeTransactionHandleRootLifecycle(); err != nil {
+		return NodesMutation{}, err
+	}
+	if err := n.closeTransactionUpdateVisibility(); err != nil {
+		return NodesMutation{}, err
+	}
+
 	panic("not implemented")
 	// return n.mutation, nil
 }
 
+// closeTransactionHandleRootLifecycle closes the entity if the root component was closed in the current transaction.
+func (n *Node) closeTransactionHandleRootLifecycle() error {
+	if n.valueState != valueStateNeedSerialize || n.rootClosed {
+		return nil
+	}
+	component, ok := n.value.(Component)
+	if !ok {
+		return nil
+	}
+	lifecycleState := component.LifecycleState()
+	if !lifecycleState.IsClosed() {
+		return nil
+	}
+	rc, ok := n.registry.componentFor(component)
+	if !ok {
+		return serviceerror.NewInternal(fmt.Sprintf("component type not registered: %v", reflect.TypeOf(component)))
+	}
+	if err := n.backend.CloseEntity(EntityClose{
+		CloseTime:      n.timeSource.Now(),
+		LifecycleState: lifecycleState,
+		Archive:        rc.archival,
+	}); err != nil {
+		return err
+	}
+	n.rootClosed = true
+	return nil
+}
+
+// closeTransactionUpdateVisibility asks the backend for a visibility task
+// if the visibility attributes of the root component were changed in the current transaction.
+func (n *Node) closeTransactionUpdateVisibility() error {
+	if n.valueState != valueStateNeedSerialize {
+		return nil
+	}
+	rc, component, ok := n.visibilityComponent()
+	if !ok {
+		return nil
+	}
+	attributes, err := rc.visibility(component)
+	if err != nil {
+		return err
+	}
+	if n.visibility != nil && n.visibility.equal(attributes) {
+		return nil
+	}
+	if err := n.backend.GenerateUpsertVisibilityTask(); err != nil {
+		return err
+	}
+	n.visibility = attributes
+	return nil
+}
+
+// VisibilityAttributes returns the search attributes and memo declared by the root component of the tree.
+// Both are nil if the root component doesn't declare any.
+func (n *Node) VisibilityAttributes() (
+	searchAttributes map[string]*commonpb.Payload,
+	memo map[string]*commonpb.Payload,
+	err error,
+) {
+	componentAttr := n.serializedNode.GetMetadata().GetComponentAttributes()
+	if componentAttr == nil {
+		return nil, nil, nil
+	}
+	rc, ok := n.registry.component(componentAttr.GetType())
+	if !ok || !rc.hasVisibility() {
+		return nil, nil, nil
+	}
+	if err := n.deserialize(rc.goType); err != nil {
+		return nil, nil, fmt.Errorf("failed to deserialize component: %w", err)
+	}
+	component, ok := n.value.(Component)
+	if !ok {
+		return nil, nil, serviceerror.NewInternal(
+			fmt.Sprintf("component value is not of type Component: %v", reflect.TypeOf(n.value)),
+		)
+	}
+	attributes, err := rc.visibility(component)
+	if err != nil {
+		return nil, nil, err
+	}
+	return attributes.searchAttributes, attributes.memo, nil
+}
+
+func (n *Node) loadRootState() error {
+	if component, ok := n.value.(Component); ok {
+		n.rootClosed = component.LifecycleState().IsClosed()
+	}
+	rc, component, ok := n.visibilityComponent()
+	if !ok {
+		return nil
+	}
+	attributes, err := rc.visibility(component)
+	if err != nil {
+		return err
+	}
+	n.visibility = attributes
+	return nil
+}
+
+// visibilityComponent returns the root component if it declares visibility attributes.
+func (n *Node) visibilityComponent() (*RegistrableComponent, Component, bool) {
+	component, ok := n.value.(Component)
+	if !ok {
+		return nil, nil, false
+	}
+	rc, ok := n.registry.componentFor(component)
+	if !ok || !rc.hasVisibility() {
+		return nil, nil, false
+	}
+	return rc, component, true
+}
+
 func (n *Node) cleanupTransaction() {
 	n.mutation = NodesMutation{
 		UpdatedNodes: make(map[string]*persistencespb.ChasmNode),
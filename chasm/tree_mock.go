@@ -63,6 +63,34 @@ func (m *MockNodeBackend) EXPECT() *MockNodeBackendMockRecorder {
 	return m.recorder
 }
 
+// CloseEntity mocks base method.
+func (m *MockNodeBackend) CloseEntity(entityClose EntityClose) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseEntity", entityClose)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseEntity indicates an expected call of CloseEntity.
+func (mr *MockNodeBackendMockRecorder) CloseEntity(entityClose any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseEntity", reflect.TypeOf((*MockNodeBackend)(nil).CloseEntity), entityClose)
+}
+
+// GenerateUpsertVisibilityTask mocks base method.
+func (m *MockNodeBackend) GenerateUpsertVisibilityTask() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateUpsertVisibilityTask")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GenerateUpsertVisibilityTask indicates an expected call of GenerateUpsertVisibilityTask.
+func (mr *MockNodeBackendMockRecorder) GenerateUpsertVisibilityTask() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateUpsertVisibilityTask", reflect.TypeOf((*MockNodeBackend)(nil).GenerateUpsertVisibilityTask))
+}
+
 // GetCurrentVersion mocks base method.
 func (m *MockNodeBackend) GetCurrentVersion() int64 {
 	m.ctrl.T.Helper()
@@ -35,6 +35,8 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/testing/protoassert"
 	"go.temporal.io/server/common/testing/protorequire"
 	"go.temporal.io/server/common/testing/testlogger"
@@ -716,6 +718,139 @@ func (s *nodeSuite) TestComponent() {
 	}
 }
 
+func (s *nodeSuite) TestVisibilityAttributes() {
+	root, err := NewTree(
+		testComponentSerializedNodes(),
+		s.registry,
+		s.timeSource,
+		s.nodeBackend,
+		s.nodePathEncoder,
+		s.logger,
+	)
+	s.NoError(err)
+
+	searchAttributes, memo, err := root.VisibilityAttributes()
+	s.NoError(err)
+	s.Len(searchAttributes, 1)
+	var activityID string
+	s.NoError(payload.Decode(searchAttributes["TestActivityId"], &activityID))
+	s.Equal("component-data", activityID)
+	s.Equal("Keyword", string(searchAttributes["TestActivityId"].GetMetadata()[searchattribute.MetadataType]))
+	s.Empty(memo)
+
+	emptyRoot := NewEmptyTree(s.registry, s.timeSource, s.nodeBackend, s.nodePathEncoder)
+	searchAttributes, memo, err = emptyRoot.VisibilityAttributes()
+	s.NoError(err)
+	s.Nil(searchAttributes)
+	s.Nil(memo)
+}
+
+func (s *nodeSuite) TestCloseTransaction_UpdateVisibility() {
+	newRoot := func() (*Node, *TestComponent) {
+		root, err := NewTree(
+			testComponentSerializedNodes(),
+			s.registry,
+			s.timeSource,
+			s.nodeBackend,
+			s.nodePathEncoder,
+			s.logger,
+		)
+		s.NoError(err)
+		component, err := root.Component(NewMutableContext(context.Background(), root), ComponentRef{componentPath: []string{}})
+		s.NoError(err)
+		return root, component.(*TestComponent)
+	}
+
+	// Readonly access never generates a visibility task.
+	root, err := NewTree(
+		testComponentSerializedNodes(),
+		s.registry,
+		s.timeSource,
+		s.nodeBackend,
+		s.nodePathEncoder,
+		s.logger,
+	)
+	s.NoError(err)
+	_, err = root.Component(NewContext(context.Background(), root), ComponentRef{componentPath: []string{}})
+	s.NoError(err)
+	s.NoError(root.closeTransactionUpdateVisibility())
+
+	// Mutable access without visibility changes.
+	root, _ = newRoot()
+	s.NoError(root.closeTransactionUpdateVisibility())
+
+	// Search attribute change.
+	root, component := newRoot()
+	component.ComponentData.ActivityId = "new-activity-id"
+	s.nodeBackend.EXPECT().GenerateUpsertVisibilityTask().Return(nil).Times(1)
+	s.NoError(root.closeTransactionUpdateVisibility())
+	// Already sent to visibility.
+	s.NoError(root.closeTransactionUpdateVisibility())
+
+	// Memo change.
+	component.ComponentData.Attempt = 2
+	s.nodeBackend.EXPECT().GenerateUpsertVisibilityTask().Return(nil).Times(1)
+	s.NoError(root.closeTransactionUpdateVisibility())
+
+	// Backend failure is returned and retried in the next transaction.
+	root, component = newRoot()
+	component.ComponentData.ActivityId = "new-activity-id"
+	errBackend := errors.New("some random backend error")
+	s.nodeBackend.EXPECT().GenerateUpsertVisibilityTask().Return(errBackend).Times(1)
+	s.ErrorIs(root.closeTransactionUpdateVisibility(), errBackend)
+	s.nodeBackend.EXPECT().GenerateUpsertVisibilityTask().Return(nil).Times(1)
+	s.NoError(root.closeTransactionUpdateVisibility())
+}
+
+func (s *nodeSuite) TestCloseTransaction_HandleRootLifecycle() {
+	newRoot := func(serializedNodes map[string]*persistencespb.ChasmNode) (*Node, *TestComponent) {
+		root, err := NewTree(
+			serializedNodes,
+			s.registry,
+			s.timeSource,
+			s.nodeBackend,
+			s.nodePathEncoder,
+			s.logger,
+		)
+		s.NoError(err)
+		component, err := root.Component(NewMutableContext(context.Background(), root), ComponentRef{componentPath: []string{}})
+		s.NoError(err)
+		return root, component.(*TestComponent)
+	}
+
+	// Running root component doesn't close the entity.
+	root, component := newRoot(testComponentSerializedNodes())
+	s.NoError(root.closeTransactionHandleRootLifecycle())
+
+	// Closing the root component closes the entity once.
+	component.ComponentData.CancelRequested = true
+	s.nodeBackend.EXPECT().CloseEntity(EntityClose{
+		CloseTime:      s.timeSource.Now(),
+		LifecycleState: LifecycleStateCompleted,
+		Archive:        true,
+	}).Return(nil).Times(1)
+	s.NoError(root.closeTransactionHandleRootLifecycle())
+	s.NoError(root.closeTransactionHandleRootLifecycle())
+
+	// Entity which was already closed when loaded is not closed again.
+	s.nodeBackend.EXPECT().NextTransitionCount().Return(int64(3)).AnyTimes()
+	s.nodeBackend.EXPECT().GetCurrentVersion().Return(int64(2)).AnyTimes()
+	s.NoError(root.serialize())
+	serializedNodes := testComponentSerializedNodes()
+	serializedNodes[""] = root.serializedNode
+	root, _ = newRoot(serializedNodes)
+	s.NoError(root.closeTransactionHandleRootLifecycle())
+
+	// Backend failure is returned and retried in the next transaction.
+	root, component = newRoot(testComponentSerializedNodes())
+	component.ComponentData.CancelRequested = true
+	errBackend := errors.New("some random backend error")
+	s.nodeBackend.EXPECT().CloseEntity(gomock.Any()).Return(errBackend).Times(1)
+	s.ErrorIs(root.closeTransactionHandleRootLifecycle(), errBackend)
+	s.nodeBackend.EXPECT().CloseEntity(gomock.Any()).Return(nil).Times(1)
+	s.NoError(root.closeTransactionHandleRootLifecycle())
+}
+
 func (s *nodeSuite) preorderAndAssertParent(
 	n *Node,
 	parent *Node,
@@ -0,0 +1,204 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package chasm
+
+import (
+	"fmt"
+	"reflect"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/searchattribute"
+	"google.golang.org/protobuf/proto"
+)
+
+type (
+	// SearchAttribute declares a search attribute exposed by a component to the visibility store.
+	// The value is read from the root component of an entity when a transition closes,
+	// a nil value leaves the search attribute unset.
+	SearchAttribute struct {
+		name      string
+		valueType enumspb.IndexedValueType
+		goType    reflect.Type
+		valueFn   func(Component) any
+	}
+
+	// MemoField declares a memo field exposed by a component to the visibility store.
+	// The value is read the same way as SearchAttribute values.
+	MemoField struct {
+		name    string
+		goType  reflect.Type
+		valueFn func(Component) any
+	}
+
+	// visibilityAttributes are the encoded search attributes and memo of an entity.
+	visibilityAttributes struct {
+		searchAttributes map[string]*commonpb.Payload
+		memo             map[string]*commonpb.Payload
+	}
+)
+
+func NewSearchAttribute[C Component](
+	name string,
+	valueType enumspb.IndexedValueType,
+	valueFn func(C) any,
+) *SearchAttribute {
+	return &SearchAttribute{
+		name:      name,
+		valueType: valueType,
+		goType:    reflect.TypeFor[C](),
+		valueFn: func(c Component) any {
+			return valueFn(c.(C))
+		},
+	}
+}
+
+func NewMemoField[C Component](
+	name string,
+	valueFn func(C) any,
+) *MemoField {
+	return &MemoField{
+		name:   name,
+		goType: reflect.TypeFor[C](),
+		valueFn: func(c Component) any {
+			return valueFn(c.(C))
+		},
+	}
+}
+
+// WithSearchAttributes declares the search attributes of the component.
+// They are only used when the component is the root component of an entity.
+func WithSearchAttributes(
+	searchAttributes ...*SearchAttribute,
+) RegistrableComponentOption {
+	return func(rc *RegistrableComponent) {
+		rc.searchAttributes = append(rc.searchAttributes, searchAttributes...)
+	}
+}
+
+// WithMemo declares the memo fields of the component.
+// They are only used when the component is the root component of an entity.
+func WithMemo(
+	memoFields ...*MemoField,
+) RegistrableComponentOption {
+	return func(rc *RegistrableComponent) {
+		rc.memoFields = append(rc.memoFields, memoFields...)
+	}
+}
+
+func (rc *RegistrableComponent) hasVisibility() bool {
+	return len(rc.searchAttributes) > 0 || len(rc.memoFields) > 0
+}
+
+func (rc *RegistrableComponent) validateVisibility() error {
+	searchAttributeNames := make(map[string]struct{}, len(rc.searchAttributes))
+	for _, sa := range rc.searchAttributes {
+		if sa.name == "" {
+			return fmt.Errorf("search attribute name of component %s must not be empty", rc.componentType)
+		}
+		if searchattribute.IsSystem(sa.name) {
+			return fmt.Errorf("search attribute %s of component %s is a system search attribute", sa.name, rc.componentType)
+		}
+		if _, ok := searchAttributeNames[sa.name]; ok {
+			return fmt.Errorf("search attribute %s of component %s is declared more than once", sa.name, rc.componentType)
+		}
+		searchAttributeNames[sa.name] = struct{}{}
+		if _, ok := enumspb.IndexedValueType_name[int32(sa.valueType)]; !ok || sa.valueType == enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED {
+			return fmt.Errorf("search attribute %s of component %s has invalid type %v", sa.name, rc.componentType, sa.valueType)
+		}
+		if sa.goType != rc.goType {
+			return fmt.Errorf("search attribute %s is declared for %s, not for component type %s", sa.name, sa.goType.String(), rc.goType.String())
+		}
+	}
+
+	memoNames := make(map[string]struct{}, len(rc.memoFields))
+	for _, field := range rc.memoFields {
+		if field.name == "" {
+			return fmt.Errorf("memo field name of component %s must not be empty", rc.componentType)
+		}
+		if _, ok := memoNames[field.name]; ok {
+			return fmt.Errorf("memo field %s of component %s is declared more than once", field.name, rc.componentType)
+		}
+		memoNames[field.name] = struct{}{}
+		if field.goType != rc.goType {
+			return fmt.Errorf("memo field %s is declared for %s, not for component type %s", field.name, field.goType.String(), rc.goType.String())
+		}
+	}
+	return nil
+}
+
+// visibility reads and encodes the declared search attributes and memo from the component.
+func (rc *RegistrableComponent) visibility(
+	component Component,
+) (*visibilityAttributes, error) {
+	attributes := &visibilityAttributes{
+		searchAttributes: make(map[string]*commonpb.Payload, len(rc.searchAttributes)),
+		memo:             make(map[string]*commonpb.Payload, len(rc.memoFields)),
+	}
+	for _, sa := range rc.searchAttributes {
+		value := sa.valueFn(component)
+		if value == nil {
+			continue
+		}
+		encoded, err := searchattribute.EncodeValue(value, sa.valueType)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode search attribute %s: %w", sa.name, err)
+		}
+		attributes.searchAttributes[sa.name] = encoded
+	}
+	for _, field := range rc.memoFields {
+		value := field.valueFn(component)
+		if value == nil {
+			continue
+		}
+		encoded, err := payload.Encode(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode memo field %s: %w", field.name, err)
+		}
+		attributes.memo[field.name] = encoded
+	}
+	return attributes, nil
+}
+
+func (a *visibilityAttributes) equal(other *visibilityAttributes) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	return payloadMapsEqual(a.searchAttributes, other.searchAttributes) &&
+		payloadMapsEqual(a.memo, other.memo)
+}
+
+func payloadMapsEqual(a, b map[string]*commonpb.Payload) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if !proto.Equal(v, b[k]) {
+			return false
+		}
+	}
+	return true
+}
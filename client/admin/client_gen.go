@@ -63,6 +63,16 @@ func (c *clientImpl) AddTasks(
 	return c.client.AddTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.AggregateWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.AggregateWorkflowExecutionsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.AggregateWorkflowExecutions(ctx, request, opts...)
+}
+
 func (c *clientImpl) CancelDLQJob(
 	ctx context.Context,
 	request *adminservice.CancelDLQJobRequest,
@@ -93,6 +103,16 @@ func (c *clientImpl) DeepHealthCheck(
 	return c.client.DeepHealthCheck(ctx, request, opts...)
 }
 
+func (c *clientImpl) DeleteBusinessCalendar(
+	ctx context.Context,
+	request *adminservice.DeleteBusinessCalendarRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteBusinessCalendarResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DeleteBusinessCalendar(ctx, request, opts...)
+}
+
 func (c *clientImpl) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -173,6 +193,16 @@ func (c *clientImpl) GenerateLastHistoryReplicationTasks(
 	return c.client.GenerateLastHistoryReplicationTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetBatchOperationReport(
+	ctx context.Context,
+	request *adminservice.GetBatchOperationReportRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetBatchOperationReportResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetBatchOperationReport(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetDLQMessages(
 	ctx context.Context,
 	request *adminservice.GetDLQMessagesRequest,
@@ -293,6 +323,26 @@ func (c *clientImpl) ImportWorkflowExecution(
 	return c.client.ImportWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListAuditEvents(
+	ctx context.Context,
+	request *adminservice.ListAuditEventsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListAuditEventsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListAuditEvents(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListBusinessCalendars(
+	ctx context.Context,
+	request *adminservice.ListBusinessCalendarsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListBusinessCalendarsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListBusinessCalendars(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListClusterMembers(
 	ctx context.Context,
 	request *adminservice.ListClusterMembersRequest,
@@ -353,6 +403,16 @@ func (c *clientImpl) MergeDLQTasks(
 	return c.client.MergeDLQTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) MigrateTaskQueueBacklog(
+	ctx context.Context,
+	request *adminservice.MigrateTaskQueueBacklogRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.MigrateTaskQueueBacklogResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.MigrateTaskQueueBacklog(ctx, request, opts...)
+}
+
 func (c *clientImpl) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -443,6 +503,36 @@ func (c *clientImpl) ResendReplicationTasks(
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) SetScheduleBusinessCalendars(
+	ctx context.Context,
+	request *adminservice.SetScheduleBusinessCalendarsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetScheduleBusinessCalendarsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.SetScheduleBusinessCalendars(ctx, request, opts...)
+}
+
+func (c *clientImpl) SimulateSchedule(
+	ctx context.Context,
+	request *adminservice.SimulateScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SimulateScheduleResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.SimulateSchedule(ctx, request, opts...)
+}
+
+func (c *clientImpl) StartBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartBatchOperationResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.StartBatchOperation(ctx, request, opts...)
+}
+
 func (c *clientImpl) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -452,3 +542,23 @@ func (c *clientImpl) SyncWorkflowState(
 	defer cancel()
 	return c.client.SyncWorkflowState(ctx, request, opts...)
 }
+
+func (c *clientImpl) UpdateActivityTypeRateLimits(
+	ctx context.Context,
+	request *adminservice.UpdateActivityTypeRateLimitsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateActivityTypeRateLimitsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateActivityTypeRateLimits(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpsertBusinessCalendar(
+	ctx context.Context,
+	request *adminservice.UpsertBusinessCalendarRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpsertBusinessCalendarResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpsertBusinessCalendar(ctx, request, opts...)
+}
@@ -75,6 +75,20 @@ func (c *metricClient) AddTasks(
 	return c.client.AddTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.AggregateWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.AggregateWorkflowExecutionsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientAggregateWorkflowExecutions")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.AggregateWorkflowExecutions(ctx, request, opts...)
+}
+
 func (c *metricClient) CancelDLQJob(
 	ctx context.Context,
 	request *adminservice.CancelDLQJobRequest,
@@ -117,6 +131,20 @@ func (c *metricClient) DeepHealthCheck(
 	return c.client.DeepHealthCheck(ctx, request, opts...)
 }
 
+func (c *metricClient) DeleteBusinessCalendar(
+	ctx context.Context,
+	request *adminservice.DeleteBusinessCalendarRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DeleteBusinessCalendarResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDeleteBusinessCalendar")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DeleteBusinessCalendar(ctx, request, opts...)
+}
+
 func (c *metricClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -229,6 +257,20 @@ func (c *metricClient) GenerateLastHistoryReplicationTasks(
 	return c.client.GenerateLastHistoryReplicationTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) GetBatchOperationReport(
+	ctx context.Context,
+	request *adminservice.GetBatchOperationReportRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetBatchOperationReportResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientGetBatchOperationReport")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetBatchOperationReport(ctx, request, opts...)
+}
+
 func (c *metricClient) GetDLQMessages(
 	ctx context.Context,
 	request *adminservice.GetDLQMessagesRequest,
@@ -397,6 +439,34 @@ func (c *metricClient) ImportWorkflowExecution(
 	return c.client.ImportWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *metricClient) ListAuditEvents(
+	ctx context.Context,
+	request *adminservice.ListAuditEventsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListAuditEventsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListAuditEvents")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListAuditEvents(ctx, request, opts...)
+}
+
+func (c *metricClient) ListBusinessCalendars(
+	ctx context.Context,
+	request *adminservice.ListBusinessCalendarsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListBusinessCalendarsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListBusinessCalendars")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListBusinessCalendars(ctx, request, opts...)
+}
+
 func (c *metricClient) ListClusterMembers(
 	ctx context.Context,
 	request *adminservice.ListClusterMembersRequest,
@@ -481,6 +551,20 @@ func (c *metricClient) MergeDLQTasks(
 	return c.client.MergeDLQTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) MigrateTaskQueueBacklog(
+	ctx context.Context,
+	request *adminservice.MigrateTaskQueueBacklogRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.MigrateTaskQueueBacklogResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientMigrateTaskQueueBacklog")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.MigrateTaskQueueBacklog(ctx, request, opts...)
+}
+
 func (c *metricClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -607,6 +691,48 @@ func (c *metricClient) ResendReplicationTasks(
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) SetScheduleBusinessCalendars(
+	ctx context.Context,
+	request *adminservice.SetScheduleBusinessCalendarsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.SetScheduleBusinessCalendarsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientSetScheduleBusinessCalendars")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.SetScheduleBusinessCalendars(ctx, request, opts...)
+}
+
+func (c *metricClient) SimulateSchedule(
+	ctx context.Context,
+	request *adminservice.SimulateScheduleRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.SimulateScheduleResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientSimulateSchedule")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.SimulateSchedule(ctx, request, opts...)
+}
+
+func (c *metricClient) StartBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.StartBatchOperationResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientStartBatchOperation")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.StartBatchOperation(ctx, request, opts...)
+}
+
 func (c *metricClient) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -620,3 +746,31 @@ func (c *metricClient) SyncWorkflowState(
 
 	return c.client.SyncWorkflowState(ctx, request, opts...)
 }
+
+func (c *metricClient) UpdateActivityTypeRateLimits(
+	ctx context.Context,
+	request *adminservice.UpdateActivityTypeRateLimitsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateActivityTypeRateLimitsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUpdateActivityTypeRateLimits")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateActivityTypeRateLimits(ctx, request, opts...)
+}
+
+func (c *metricClient) UpsertBusinessCalendar(
+	ctx context.Context,
+	request *adminservice.UpsertBusinessCalendarRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpsertBusinessCalendarResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUpsertBusinessCalendar")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpsertBusinessCalendar(ctx, request, opts...)
+}
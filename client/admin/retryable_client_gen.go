@@ -80,6 +80,21 @@ func (c *retryableClient) AddTasks(
 	return resp, err
 }
 
+func (c *retryableClient) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.AggregateWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.AggregateWorkflowExecutionsResponse, error) {
+	var resp *adminservice.AggregateWorkflowExecutionsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.AggregateWorkflowExecutions(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) CancelDLQJob(
 	ctx context.Context,
 	request *adminservice.CancelDLQJobRequest,
@@ -125,6 +140,21 @@ func (c *retryableClient) DeepHealthCheck(
 	return resp, err
 }
 
+func (c *retryableClient) DeleteBusinessCalendar(
+	ctx context.Context,
+	request *adminservice.DeleteBusinessCalendarRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteBusinessCalendarResponse, error) {
+	var resp *adminservice.DeleteBusinessCalendarResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DeleteBusinessCalendar(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -245,6 +275,21 @@ func (c *retryableClient) GenerateLastHistoryReplicationTasks(
 	return resp, err
 }
 
+func (c *retryableClient) GetBatchOperationReport(
+	ctx context.Context,
+	request *adminservice.GetBatchOperationReportRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetBatchOperationReportResponse, error) {
+	var resp *adminservice.GetBatchOperationReportResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetBatchOperationReport(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetDLQMessages(
 	ctx context.Context,
 	request *adminservice.GetDLQMessagesRequest,
@@ -425,6 +470,36 @@ func (c *retryableClient) ImportWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) ListAuditEvents(
+	ctx context.Context,
+	request *adminservice.ListAuditEventsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListAuditEventsResponse, error) {
+	var resp *adminservice.ListAuditEventsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListAuditEvents(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListBusinessCalendars(
+	ctx context.Context,
+	request *adminservice.ListBusinessCalendarsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListBusinessCalendarsResponse, error) {
+	var resp *adminservice.ListBusinessCalendarsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListBusinessCalendars(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListClusterMembers(
 	ctx context.Context,
 	request *adminservice.ListClusterMembersRequest,
@@ -515,6 +590,21 @@ func (c *retryableClient) MergeDLQTasks(
 	return resp, err
 }
 
+func (c *retryableClient) MigrateTaskQueueBacklog(
+	ctx context.Context,
+	request *adminservice.MigrateTaskQueueBacklogRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.MigrateTaskQueueBacklogResponse, error) {
+	var resp *adminservice.MigrateTaskQueueBacklogResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.MigrateTaskQueueBacklog(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -650,6 +740,51 @@ func (c *retryableClient) ResendReplicationTasks(
 	return resp, err
 }
 
+func (c *retryableClient) SetScheduleBusinessCalendars(
+	ctx context.Context,
+	request *adminservice.SetScheduleBusinessCalendarsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetScheduleBusinessCalendarsResponse, error) {
+	var resp *adminservice.SetScheduleBusinessCalendarsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.SetScheduleBusinessCalendars(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) SimulateSchedule(
+	ctx context.Context,
+	request *adminservice.SimulateScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SimulateScheduleResponse, error) {
+	var resp *adminservice.SimulateScheduleResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.SimulateSchedule(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StartBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartBatchOperationResponse, error) {
+	var resp *adminservice.StartBatchOperationResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.StartBatchOperation(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -664,3 +799,33 @@ func (c *retryableClient) SyncWorkflowState(
 	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) UpdateActivityTypeRateLimits(
+	ctx context.Context,
+	request *adminservice.UpdateActivityTypeRateLimitsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateActivityTypeRateLimitsResponse, error) {
+	var resp *adminservice.UpdateActivityTypeRateLimitsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateActivityTypeRateLimits(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpsertBusinessCalendar(
+	ctx context.Context,
+	request *adminservice.UpsertBusinessCalendarRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpsertBusinessCalendarResponse, error) {
+	var resp *adminservice.UpsertBusinessCalendarResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpsertBusinessCalendar(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
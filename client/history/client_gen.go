@@ -1348,6 +1348,26 @@ func (c *clientImpl) UpdateWorkflowExecutionOptions(
 	return response, nil
 }
 
+func (c *clientImpl) UpsertWorkflowExecutionProperties(
+	ctx context.Context,
+	request *historyservice.UpsertWorkflowExecutionPropertiesRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UpsertWorkflowExecutionPropertiesResponse, error) {
+	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
+	var response *historyservice.UpsertWorkflowExecutionPropertiesResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.UpsertWorkflowExecutionProperties(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) VerifyChildExecutionCompletionRecorded(
 	ctx context.Context,
 	request *historyservice.VerifyChildExecutionCompletionRecordedRequest,
@@ -985,6 +985,20 @@ func (c *metricClient) UpdateWorkflowExecutionOptions(
 	return c.client.UpdateWorkflowExecutionOptions(ctx, request, opts...)
 }
 
+func (c *metricClient) UpsertWorkflowExecutionProperties(
+	ctx context.Context,
+	request *historyservice.UpsertWorkflowExecutionPropertiesRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.UpsertWorkflowExecutionPropertiesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientUpsertWorkflowExecutionProperties")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpsertWorkflowExecutionProperties(ctx, request, opts...)
+}
+
 func (c *metricClient) VerifyChildExecutionCompletionRecorded(
 	ctx context.Context,
 	request *historyservice.VerifyChildExecutionCompletionRecordedRequest,
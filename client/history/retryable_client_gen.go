@@ -1055,6 +1055,21 @@ func (c *retryableClient) UpdateWorkflowExecutionOptions(
 	return resp, err
 }
 
+func (c *retryableClient) UpsertWorkflowExecutionProperties(
+	ctx context.Context,
+	request *historyservice.UpsertWorkflowExecutionPropertiesRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UpsertWorkflowExecutionPropertiesResponse, error) {
+	var resp *historyservice.UpsertWorkflowExecutionPropertiesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpsertWorkflowExecutionProperties(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) VerifyChildExecutionCompletionRecorded(
 	ctx context.Context,
 	request *historyservice.VerifyChildExecutionCompletionRecordedRequest,
@@ -34,6 +34,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/debug"
 	"go.temporal.io/server/common/log"
@@ -94,7 +95,11 @@ func (c *clientImpl) AddActivityTask(
 	}
 	ctx, cancel := c.createContext(ctx)
 	defer cancel()
-	return client.AddActivityTask(ctx, request, opts...)
+	resp, err := client.AddActivityTask(ctx, request, opts...)
+	if err == nil {
+		c.updatePartitionCounts(request.GetTaskQueue(), request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_ACTIVITY, resp.GetPartitionCounts())
+	}
+	return resp, err
 }
 
 func (c *clientImpl) AddWorkflowTask(
@@ -111,7 +116,11 @@ func (c *clientImpl) AddWorkflowTask(
 	}
 	ctx, cancel := c.createContext(ctx)
 	defer cancel()
-	return client.AddWorkflowTask(ctx, request, opts...)
+	resp, err := client.AddWorkflowTask(ctx, request, opts...)
+	if err == nil {
+		c.updatePartitionCounts(request.GetTaskQueue(), request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_WORKFLOW, resp.GetPartitionCounts())
+	}
+	return resp, err
 }
 
 func (c *clientImpl) PollActivityTaskQueue(
@@ -131,7 +140,11 @@ func (c *clientImpl) PollActivityTaskQueue(
 	}
 	ctx, cancel := c.createLongPollContext(ctx)
 	defer cancel()
-	return client.PollActivityTaskQueue(ctx, request, opts...)
+	resp, err := client.PollActivityTaskQueue(ctx, request, opts...)
+	if err == nil {
+		c.updatePartitionCounts(request.GetPollRequest().GetTaskQueue(), request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_ACTIVITY, resp.GetPartitionCounts())
+	}
+	return resp, err
 }
 
 func (c *clientImpl) PollWorkflowTaskQueue(
@@ -151,7 +164,11 @@ func (c *clientImpl) PollWorkflowTaskQueue(
 	}
 	ctx, cancel := c.createLongPollContext(ctx)
 	defer cancel()
-	return client.PollWorkflowTaskQueue(ctx, request, opts...)
+	resp, err := client.PollWorkflowTaskQueue(ctx, request, opts...)
+	if err == nil {
+		c.updatePartitionCounts(request.GetPollRequest().GetTaskQueue(), request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_WORKFLOW, resp.GetPartitionCounts())
+	}
+	return resp, err
 }
 
 func (c *clientImpl) QueryWorkflow(ctx context.Context, request *matchingservice.QueryWorkflowRequest, opts ...grpc.CallOption) (*matchingservice.QueryWorkflowResponse, error) {
@@ -210,6 +227,19 @@ func (c *clientImpl) pickClientForRead(proto *taskqueuepb.TaskQueue, nsid string
 	return client, release, err
 }
 
+// updatePartitionCounts passes partition counts returned by matching on to the load balancer.
+// The proto name has already been rewritten to the partition that served the call.
+func (c *clientImpl) updatePartitionCounts(proto *taskqueuepb.TaskQueue, nsid string, taskType enumspb.TaskQueueType, counts *persistencespb.TaskQueuePartitionCounts) {
+	if proto.GetKind() == enumspb.TASK_QUEUE_KIND_STICKY {
+		return
+	}
+	partition, err := tqid.NormalPartitionFromRpcName(proto.GetName(), nsid, taskType)
+	if err != nil {
+		return
+	}
+	c.loadBalancer.UpdatePartitionCounts(partition.TaskQueue(), counts)
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	return context.WithTimeout(parent, c.timeout)
 }
@@ -368,6 +368,23 @@ func (c *clientImpl) ListTaskQueuePartitions(
 	return client.ListTaskQueuePartitions(ctx, request, opts...)
 }
 
+func (c *clientImpl) MigrateTaskQueueBacklog(
+	ctx context.Context,
+	request *matchingservice.MigrateTaskQueueBacklogRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.MigrateTaskQueueBacklogResponse, error) {
+
+	p := tqid.PartitionFromPartitionProto(request.GetTaskQueuePartition(), request.GetNamespaceId())
+
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.MigrateTaskQueueBacklog(ctx, request, opts...)
+}
+
 func (c *clientImpl) PollNexusTaskQueue(
 	ctx context.Context,
 	request *matchingservice.PollNexusTaskQueueRequest,
@@ -488,6 +505,26 @@ func (c *clientImpl) SyncDeploymentUserData(
 	return client.SyncDeploymentUserData(ctx, request, opts...)
 }
 
+func (c *clientImpl) UpdateActivityTypeRateLimits(
+	ctx context.Context,
+	request *matchingservice.UpdateActivityTypeRateLimitsRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.UpdateActivityTypeRateLimitsResponse, error) {
+
+	p, err := tqid.NormalPartitionFromRpcName(request.GetTaskQueue(), request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateActivityTypeRateLimits(ctx, request, opts...)
+}
+
 func (c *clientImpl) UpdateNexusEndpoint(
 	ctx context.Context,
 	request *matchingservice.UpdateNexusEndpointRequest,
@@ -27,7 +27,11 @@ package matching
 import (
 	"math/rand"
 	"sync"
+	"time"
 
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/testing/testhooks"
@@ -55,12 +59,23 @@ type (
 		PickReadPartition(
 			taskQueue *tqid.TaskQueue,
 		) *pollToken
+
+		// UpdatePartitionCounts records the partition counts chosen by partition autoscaling,
+		// as returned by matching. Nil counts clear the recorded counts. Recorded counts are used
+		// for adding tasks for matching.partitionCountsCacheTTL after they were returned.
+		UpdatePartitionCounts(
+			taskQueue *tqid.TaskQueue,
+			counts *persistencespb.TaskQueuePartitionCounts,
+		)
 	}
 
 	defaultLoadBalancer struct {
 		namespaceIDToName func(id namespace.ID) (namespace.Name, error)
 		nReadPartitions   dynamicconfig.IntPropertyFnWithTaskQueueFilter
 		nWritePartitions  dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		enableAutoscaling dynamicconfig.BoolPropertyFnWithTaskQueueFilter
+		countsTTL         dynamicconfig.DurationPropertyFnWithTaskQueueFilter
+		timeSource        clock.TimeSource
 		testHooks         testhooks.TestHooks
 
 		lock         sync.RWMutex
@@ -69,9 +84,11 @@ type (
 
 	// Keeps track of polls per partition. Sends a poll to the partition with the fewest polls
 	tqLoadBalancer struct {
-		taskQueue    *tqid.TaskQueue
-		pollerCounts []int // keep track of poller count of each partition
-		lock         sync.Mutex
+		taskQueue       *tqid.TaskQueue
+		pollerCounts    []int // keep track of poller count of each partition
+		partitionCounts *persistencespb.TaskQueuePartitionCounts
+		countsReceived  time.Time // when partitionCounts were last returned by matching
+		lock            sync.Mutex
 	}
 
 	pollToken struct {
@@ -91,6 +108,9 @@ func NewLoadBalancer(
 		namespaceIDToName: namespaceIDToName,
 		nReadPartitions:   dynamicconfig.MatchingNumTaskqueueReadPartitions.Get(dc),
 		nWritePartitions:  dynamicconfig.MatchingNumTaskqueueWritePartitions.Get(dc),
+		enableAutoscaling: dynamicconfig.MatchingEnablePartitionAutoscaling.Get(dc),
+		countsTTL:         dynamicconfig.MatchingPartitionCountsCacheTTL.Get(dc),
+		timeSource:        clock.NewRealTimeSource(),
 		testHooks:         testHooks,
 		taskQueueLBs:      make(map[tqid.TaskQueue]*tqLoadBalancer),
 	}
@@ -110,6 +130,16 @@ func (lb *defaultLoadBalancer) PickWritePartition(
 	}
 
 	n := max(1, lb.nWritePartitions(nsName.String(), taskQueue.Name(), taskQueue.TaskType()))
+	if lb.autoscalingEnabled(nsName.String(), taskQueue) {
+		// Partitions beyond the current write count may not be read anymore. Without recent
+		// counts, add to the root partition, which is always read, and learn the counts from
+		// its response.
+		counts, received := lb.getTaskQueueLoadBalancer(taskQueue).getPartitionCounts()
+		if counts == nil || lb.timeSource.Since(received) > lb.countsTTL(nsName.String(), taskQueue.Name(), taskQueue.TaskType()) {
+			return taskQueue.RootPartition()
+		}
+		n = max(1, int(counts.GetWritePartitions()))
+	}
 	return taskQueue.NormalPartition(rand.Intn(n))
 }
 
@@ -127,6 +157,11 @@ func (lb *defaultLoadBalancer) PickReadPartition(
 	namespaceName, err := lb.namespaceIDToName(namespace.ID(taskQueue.NamespaceId()))
 	if err == nil {
 		partitionCount = lb.nReadPartitions(string(namespaceName), taskQueue.Name(), taskQueue.TaskType())
+		if lb.autoscalingEnabled(string(namespaceName), taskQueue) {
+			if counts, _ := tqlb.getPartitionCounts(); counts != nil {
+				partitionCount = max(1, int(counts.GetReadPartitions()))
+			}
+		}
 	}
 
 	if n, ok := testhooks.Get[int](lb.testHooks, testhooks.MatchingLBForceWritePartition); ok {
@@ -136,6 +171,26 @@ func (lb *defaultLoadBalancer) PickReadPartition(
 	return tqlb.pickReadPartition(partitionCount)
 }
 
+func (lb *defaultLoadBalancer) UpdatePartitionCounts(
+	taskQueue *tqid.TaskQueue,
+	counts *persistencespb.TaskQueuePartitionCounts,
+) {
+	if counts == nil {
+		lb.lock.RLock()
+		tqlb, ok := lb.taskQueueLBs[*taskQueue]
+		lb.lock.RUnlock()
+		if ok {
+			tqlb.setPartitionCounts(nil, time.Time{})
+		}
+		return
+	}
+	lb.getTaskQueueLoadBalancer(taskQueue).setPartitionCounts(counts, lb.timeSource.Now())
+}
+
+func (lb *defaultLoadBalancer) autoscalingEnabled(nsName string, taskQueue *tqid.TaskQueue) bool {
+	return lb.enableAutoscaling != nil && lb.enableAutoscaling(nsName, taskQueue.Name(), taskQueue.TaskType())
+}
+
 func (lb *defaultLoadBalancer) getTaskQueueLoadBalancer(tq *tqid.TaskQueue) *tqLoadBalancer {
 	lb.lock.RLock()
 	tqlb, ok := lb.taskQueueLBs[*tq]
@@ -223,6 +278,27 @@ func (b *tqLoadBalancer) ensurePartitionCountLocked(partitionCount int) {
 	}
 }
 
+// setPartitionCounts replaces the partition counts unless the given counts are older. Responses
+// from partitions that have not yet seen the latest user data may carry older counts.
+func (b *tqLoadBalancer) setPartitionCounts(counts *persistencespb.TaskQueuePartitionCounts, now time.Time) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if counts != nil && b.partitionCounts != nil &&
+		hlc.Less(counts.GetUpdateTime(), b.partitionCounts.GetUpdateTime()) {
+		return
+	}
+	b.partitionCounts = counts
+	b.countsReceived = now
+}
+
+// getPartitionCounts returns the partition counts last returned by matching and when they were
+// received.
+func (b *tqLoadBalancer) getPartitionCounts() (*persistencespb.TaskQueuePartitionCounts, time.Time) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.partitionCounts, b.countsReceived
+}
+
 func (b *tqLoadBalancer) Release(partitionID int) {
 	b.lock.Lock()
 	defer b.lock.Unlock()
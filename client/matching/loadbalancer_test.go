@@ -27,9 +27,15 @@ package matching
 import (
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	enumspb "go.temporal.io/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/tqid"
 )
 
@@ -178,6 +184,89 @@ func TestLoadBalancer_ReducedPartitionCount(t *testing.T) {
 	assert.Equal(t, 2, maxPollerCount(tqlb))
 }
 
+func TestLoadBalancer_AutoscaledPartitionCounts(t *testing.T) {
+	enabled := true
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	lb := &defaultLoadBalancer{
+		namespaceIDToName: func(id namespace.ID) (namespace.Name, error) { return "fake-namespace", nil },
+		nReadPartitions:   dynamicconfig.GetIntPropertyFnFilteredByTaskQueue(4),
+		nWritePartitions:  dynamicconfig.GetIntPropertyFnFilteredByTaskQueue(4),
+		enableAutoscaling: func(string, string, enumspb.TaskQueueType) bool { return enabled },
+		countsTTL:         dynamicconfig.GetDurationPropertyFnFilteredByTaskQueue(time.Minute),
+		timeSource:        timeSource,
+		taskQueueLBs:      make(map[tqid.TaskQueue]*tqLoadBalancer),
+	}
+	f, err := tqid.NewTaskQueueFamily("fake-namespace-id", "fake-taskqueue")
+	assert.NoError(t, err)
+	taskQueue := f.TaskQueue(enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+
+	pickPartitions := func() (maxRead, maxWrite int) {
+		for i := 0; i < 200; i++ {
+			maxWrite = max(maxWrite, lb.PickWritePartition(taskQueue).PartitionId())
+			token := lb.PickReadPartition(taskQueue)
+			maxRead = max(maxRead, token.TQPartition.PartitionId())
+			token.Release()
+		}
+		return maxRead, maxWrite
+	}
+
+	// no counts reported: configured counts apply for reads, tasks are added to the root
+	maxRead, maxWrite := pickPartitions()
+	assert.Equal(t, 3, maxRead)
+	assert.Equal(t, 0, maxWrite)
+
+	updateTime := hlc.Zero(1)
+	updateTime.WallClock = 100
+	lb.UpdatePartitionCounts(taskQueue, &persistencespb.TaskQueuePartitionCounts{
+		ReadPartitions:  8,
+		WritePartitions: 6,
+		UpdateTime:      updateTime,
+	})
+	maxRead, maxWrite = pickPartitions()
+	assert.Equal(t, 7, maxRead)
+	assert.Equal(t, 5, maxWrite)
+
+	// older counts are ignored
+	olderClock := hlc.Zero(1)
+	olderClock.WallClock = 50
+	lb.UpdatePartitionCounts(taskQueue, &persistencespb.TaskQueuePartitionCounts{
+		ReadPartitions:  2,
+		WritePartitions: 2,
+		UpdateTime:      olderClock,
+	})
+	maxRead, maxWrite = pickPartitions()
+	assert.Equal(t, 7, maxRead)
+	assert.Equal(t, 5, maxWrite)
+
+	// expired counts are still used for reads, tasks are added to the root until counts are
+	// returned again
+	timeSource.Advance(2 * time.Minute)
+	maxRead, maxWrite = pickPartitions()
+	assert.Equal(t, 7, maxRead)
+	assert.Equal(t, 0, maxWrite)
+	lb.UpdatePartitionCounts(taskQueue, &persistencespb.TaskQueuePartitionCounts{
+		ReadPartitions:  8,
+		WritePartitions: 6,
+		UpdateTime:      updateTime,
+	})
+	maxRead, maxWrite = pickPartitions()
+	assert.Equal(t, 7, maxRead)
+	assert.Equal(t, 5, maxWrite)
+
+	// disabling autoscaling falls back to configured counts
+	enabled = false
+	maxRead, maxWrite = pickPartitions()
+	assert.Equal(t, 3, maxRead)
+	assert.Equal(t, 3, maxWrite)
+
+	// nil counts clear the reported counts
+	enabled = true
+	lb.UpdatePartitionCounts(taskQueue, nil)
+	maxRead, maxWrite = pickPartitions()
+	assert.Equal(t, 3, maxRead)
+	assert.Equal(t, 0, maxWrite)
+}
+
 func maxPollerCount(tqlb *tqLoadBalancer) int {
 	res := -1
 	for _, c := range tqlb.pollerCounts {
@@ -271,6 +271,20 @@ func (c *metricClient) ListTaskQueuePartitions(
 	return c.client.ListTaskQueuePartitions(ctx, request, opts...)
 }
 
+func (c *metricClient) MigrateTaskQueueBacklog(
+	ctx context.Context,
+	request *matchingservice.MigrateTaskQueueBacklogRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.MigrateTaskQueueBacklogResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientMigrateTaskQueueBacklog")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.MigrateTaskQueueBacklog(ctx, request, opts...)
+}
+
 func (c *metricClient) PollNexusTaskQueue(
 	ctx context.Context,
 	request *matchingservice.PollNexusTaskQueueRequest,
@@ -355,6 +369,20 @@ func (c *metricClient) SyncDeploymentUserData(
 	return c.client.SyncDeploymentUserData(ctx, request, opts...)
 }
 
+func (c *metricClient) UpdateActivityTypeRateLimits(
+	ctx context.Context,
+	request *matchingservice.UpdateActivityTypeRateLimitsRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.UpdateActivityTypeRateLimitsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientUpdateActivityTypeRateLimits")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateActivityTypeRateLimits(ctx, request, opts...)
+}
+
 func (c *metricClient) UpdateNexusEndpoint(
 	ctx context.Context,
 	request *matchingservice.UpdateNexusEndpointRequest,
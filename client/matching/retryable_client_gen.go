@@ -320,6 +320,21 @@ func (c *retryableClient) ListTaskQueuePartitions(
 	return resp, err
 }
 
+func (c *retryableClient) MigrateTaskQueueBacklog(
+	ctx context.Context,
+	request *matchingservice.MigrateTaskQueueBacklogRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.MigrateTaskQueueBacklogResponse, error) {
+	var resp *matchingservice.MigrateTaskQueueBacklogResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.MigrateTaskQueueBacklog(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PollActivityTaskQueue(
 	ctx context.Context,
 	request *matchingservice.PollActivityTaskQueueRequest,
@@ -455,6 +470,21 @@ func (c *retryableClient) SyncDeploymentUserData(
 	return resp, err
 }
 
+func (c *retryableClient) UpdateActivityTypeRateLimits(
+	ctx context.Context,
+	request *matchingservice.UpdateActivityTypeRateLimitsRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.UpdateActivityTypeRateLimitsResponse, error) {
+	var resp *matchingservice.UpdateActivityTypeRateLimitsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateActivityTypeRateLimits(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) UpdateNexusEndpoint(
 	ctx context.Context,
 	request *matchingservice.UpdateNexusEndpointRequest,
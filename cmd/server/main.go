@@ -201,19 +201,23 @@ func buildCLI() *cli.App {
 					tag.NewBoolTag("debug-mode", debug.Enabled),
 				)
 
-				var dynamicConfigClient dynamicconfig.Client
-				if cfg.DynamicConfigClient != nil {
-					dynamicConfigClient, err = dynamicconfig.NewFileBasedClient(cfg.DynamicConfigClient, logger, temporal.InterruptCh())
-					if err != nil {
-						return cli.Exit(fmt.Sprintf("Unable to create dynamic config client. Error: %v", err), 1)
-					}
-				} else {
+				dynamicConfigClient, err := dynamicconfig.NewClientFromConfig(
+					cfg.DynamicConfigClient,
+					cfg.RemoteDynamicConfigClient,
+					logger,
+					temporal.InterruptCh(),
+				)
+				if err != nil {
+					return cli.Exit(fmt.Sprintf("Unable to create dynamic config client. Error: %v", err), 1)
+				}
+				if dynamicConfigClient == nil {
 					dynamicConfigClient = dynamicconfig.NewNoopClient()
 					logger.Info("Dynamic config client is not configured. Using noop client.")
 				}
 
-				authorizer, err := authorization.GetAuthorizerFromConfig(
+				authorizer, err := authorization.NewAuthorizerFromConfig(
 					&cfg.Global.Authorization,
+					logger,
 				)
 				if err != nil {
 					return cli.Exit(fmt.Sprintf("Unable to instantiate authorizer. Error: %v", err), 1)
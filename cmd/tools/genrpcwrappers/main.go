@@ -349,7 +349,8 @@ func makeGetMatchingClient(reqType reflect.Type) string {
 		"CheckTaskQueueUserDataPropagationRequest",
 		"ApplyTaskQueueUserDataReplicationEventRequest",
 		"GetWorkerVersioningRulesRequest",
-		"UpdateWorkerVersioningRulesRequest":
+		"UpdateWorkerVersioningRulesRequest",
+		"UpdateActivityTypeRateLimitsRequest":
 		tq = findOneNestedField(t, "TaskQueue", "request", 2)
 		tqt = fieldWithPath{path: "enumspb.TASK_QUEUE_TYPE_WORKFLOW"}
 		nsID = findOneNestedField(t, "NamespaceId", "request", 1)
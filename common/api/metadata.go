@@ -178,6 +178,13 @@ var (
 		"GetNexusEndpoint":         {Scope: ScopeCluster, Access: AccessAdmin},
 		"ListNexusEndpoints":       {Scope: ScopeCluster, Access: AccessAdmin},
 	}
+	// AdminService methods are cluster scoped admin operations, except for the ones listed here.
+	adminServiceMetadata = map[string]MethodMetadata{
+		// Schedule simulation is a pure computation, exposed to namespace users through the HTTP API.
+		"SimulateSchedule": {Scope: ScopeNamespace, Access: AccessReadOnly},
+		// Setting the business calendars of a schedule is a schedule update.
+		"SetScheduleBusinessCalendars": {Scope: ScopeNamespace, Access: AccessWrite},
+	}
 	nexusServiceMetadata = map[string]MethodMetadata{
 		"DispatchNexusTask":               {Scope: ScopeNamespace, Access: AccessWrite},
 		"DispatchByNamespaceAndTaskQueue": {Scope: ScopeNamespace, Access: AccessWrite},
@@ -197,6 +204,9 @@ func GetMethodMetadata(fullApiName string) MethodMetadata {
 	case strings.HasPrefix(fullApiName, NexusServicePrefix):
 		return nexusServiceMetadata[MethodName(fullApiName)]
 	case strings.HasPrefix(fullApiName, AdminServicePrefix):
+		if md, ok := adminServiceMetadata[MethodName(fullApiName)]; ok {
+			return md
+		}
 		return MethodMetadata{Scope: ScopeCluster, Access: AccessAdmin}
 	default:
 		return MethodMetadata{Scope: ScopeUnknown, Access: AccessUnknown}
@@ -0,0 +1,171 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+var (
+	errInvalidKey = errors.New("invalid blob key")
+)
+
+type (
+	filesystemStore struct {
+		rootDir  string
+		fileMode os.FileMode
+		dirMode  os.FileMode
+	}
+)
+
+var _ Store = (*filesystemStore)(nil)
+
+// NewFilesystemStore returns a Store which keeps every blob in a separate file below rootDir.
+// Keys are mapped to relative paths below rootDir, intermediate directories are created on demand.
+func NewFilesystemStore(rootDir string, fileMode os.FileMode, dirMode os.FileMode) Store {
+	return &filesystemStore{
+		rootDir:  rootDir,
+		fileMode: fileMode,
+		dirMode:  dirMode,
+	}
+}
+
+func (f *filesystemStore) Put(_ context.Context, key string, data []byte) error {
+	filePath, err := f.filePath(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(filePath), f.dirMode); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so readers never observe a partially written blob.
+	tmpFile, err := os.CreateTemp(filepath.Dir(filePath), "."+filepath.Base(filePath)+".tmp*")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmpFile.Name()
+	defer func() { _ = os.Remove(tmpPath) }()
+
+	if _, err := tmpFile.Write(data); err != nil {
+		_ = tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Chmod(f.fileMode); err != nil {
+		_ = tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, filePath)
+}
+
+func (f *filesystemStore) Get(_ context.Context, key string) ([]byte, error) {
+	filePath, err := f.filePath(key)
+	if err != nil {
+		return nil, err
+	}
+	// #nosec G304 -- filePath is validated to be below rootDir
+	data, err := os.ReadFile(filePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrBlobNotFound, key)
+	}
+	return data, err
+}
+
+func (f *filesystemStore) List(_ context.Context, prefix string) ([]string, error) {
+	// Only walk the deepest directory which is fully covered by the prefix.
+	dirKey := prefix
+	if !strings.HasSuffix(dirKey, "/") {
+		dirKey = path.Dir(dirKey)
+	}
+	dirPath, err := f.filePath(dirKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	err = filepath.WalkDir(dirPath, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			return nil
+		}
+		key, err := f.key(filePath)
+		if err != nil {
+			return err
+		}
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	slices.Sort(keys)
+	return keys, nil
+}
+
+func (f *filesystemStore) Delete(_ context.Context, key string) error {
+	filePath, err := f.filePath(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(filePath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (f *filesystemStore) filePath(key string) (string, error) {
+	// Cleaning a rooted path drops any leading "..", so the result can never escape rootDir.
+	cleanKey := path.Clean("/" + key)
+	if cleanKey == "/" && key != "" && key != "." && key != "/" {
+		return "", fmt.Errorf("%w: %s", errInvalidKey, key)
+	}
+	return filepath.Join(f.rootDir, filepath.FromSlash(cleanKey)), nil
+}
+
+func (f *filesystemStore) key(filePath string) (string, error) {
+	relPath, err := filepath.Rel(filepath.Join(f.rootDir, string(filepath.Separator)), filePath)
+	if err != nil {
+		return "", err
+	}
+	return filepath.ToSlash(relPath), nil
+}
@@ -0,0 +1,287 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Blobstore History Archiver archives workflow histories to any object store implementing Store.
+
+// Each Archive() request results in a blob with key
+// uriPrefix/hash(namespaceID)hash(workflowID)hash(runID)_version.history being written.
+// Workflow histories stored in that blob are encoded in JSON format.
+
+// The Get() method retrieves the archived histories for the URI. It optionally takes in a
+// NextPageToken which specifies the workflow close failover version and the index of the first
+// history batch that should be returned. Instead of NextPageToken, caller can also provide a close
+// failover version, in which case, Get() method will return history batches starting from the
+// beginning of that history version. If neither of NextPageToken or close failover version is
+// specified, the highest close failover version will be picked.
+
+package blobstore
+
+import (
+	"context"
+	"errors"
+
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	// URIScheme is the scheme for the blobstore implementation
+	URIScheme = "blobstore"
+
+	errEncodeHistory = "failed to encode history batches"
+	errWriteBlob     = "failed to write history blob"
+
+	targetHistoryBlobSize = 2 * 1024 * 1024 // 2MB
+)
+
+type (
+	historyArchiver struct {
+		container *archiver.HistoryBootstrapContainer
+		store     Store
+		scheme    string
+
+		// only set in test code
+		historyIterator archiver.HistoryIterator
+	}
+
+	getHistoryToken struct {
+		CloseFailoverVersion int64
+		NextBatchIdx         int
+	}
+)
+
+// NewHistoryArchiver creates a new archiver.HistoryArchiver which writes to the given store.
+// scheme is the URI scheme accepted by the archiver, backends registered under a different
+// scheme than URIScheme can reuse the same archiver.
+func NewHistoryArchiver(
+	container *archiver.HistoryBootstrapContainer,
+	store Store,
+	scheme string,
+) archiver.HistoryArchiver {
+	return newHistoryArchiver(container, store, scheme, nil)
+}
+
+func newHistoryArchiver(
+	container *archiver.HistoryBootstrapContainer,
+	store Store,
+	scheme string,
+	historyIterator archiver.HistoryIterator,
+) *historyArchiver {
+	return &historyArchiver{
+		container:       container,
+		store:           store,
+		scheme:          scheme,
+		historyIterator: historyIterator,
+	}
+}
+
+func (h *historyArchiver) Archive(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.ArchiveHistoryRequest,
+	opts ...archiver.ArchiveOption,
+) (err error) {
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	defer func() {
+		if err != nil && !common.IsPersistenceTransientError(err) && featureCatalog.NonRetryableError != nil {
+			err = featureCatalog.NonRetryableError()
+		}
+	}()
+
+	logger := archiver.TagLoggerWithArchiveHistoryRequestAndURI(h.container.Logger, request, URI.String())
+
+	if err := h.ValidateURI(URI); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidURI), tag.Error(err))
+		return err
+	}
+
+	if err := archiver.ValidateHistoryArchiveRequest(request); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidArchiveRequest), tag.Error(err))
+		return err
+	}
+
+	historyIterator := h.historyIterator
+	if historyIterator == nil { // will only be set by testing code
+		historyIterator = archiver.NewHistoryIterator(request, h.container.ExecutionManager, targetHistoryBlobSize)
+	}
+
+	var historyBatches []*historypb.History
+	for historyIterator.HasNext() {
+		historyBlob, err := historyIterator.Next(ctx)
+		if err != nil {
+			if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
+				// workflow history no longer exists, may due to duplicated archival signal
+				// this may happen even in the middle of iterating history as two archival signals
+				// can be processed concurrently.
+				logger.Info(archiver.ArchiveSkippedInfoMsg)
+				return nil
+			}
+
+			logger = log.With(logger, tag.ArchivalArchiveFailReason(archiver.ErrReasonReadHistory), tag.Error(err))
+			if !common.IsPersistenceTransientError(err) {
+				logger.Error(archiver.ArchiveNonRetryableErrorMsg)
+			} else {
+				logger.Error(archiver.ArchiveTransientErrorMsg)
+			}
+			return err
+		}
+
+		if historyMutated(request, historyBlob.Body, historyBlob.Header.IsLast) {
+			logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonHistoryMutated))
+			return archiver.ErrHistoryMutated
+		}
+
+		historyBatches = append(historyBatches, historyBlob.Body...)
+	}
+
+	encodedHistoryBatches, err := encodeHistories(historyBatches)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+		return err
+	}
+
+	key := constructHistoryKey(keyPrefix(URI), request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
+	if err := h.store.Put(ctx, key, encodedHistoryBatches); err != nil {
+		logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteBlob), tag.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (h *historyArchiver) Get(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.GetHistoryRequest,
+) (*archiver.GetHistoryResponse, error) {
+	if err := h.ValidateURI(URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
+	}
+
+	if err := archiver.ValidateGetRequest(request); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidGetHistoryRequest.Error())
+	}
+
+	prefix := keyPrefix(URI)
+	var token *getHistoryToken
+	if request.NextPageToken != nil {
+		var err error
+		token, err = deserializeGetHistoryToken(request.NextPageToken)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(archiver.ErrNextPageTokenCorrupted.Error())
+		}
+	} else if request.CloseFailoverVersion != nil {
+		token = &getHistoryToken{
+			CloseFailoverVersion: *request.CloseFailoverVersion,
+			NextBatchIdx:         0,
+		}
+	} else {
+		highestVersion, err := h.getHighestVersion(ctx, prefix, request)
+		if err != nil {
+			if errors.Is(err, archiver.ErrHistoryNotExist) {
+				return nil, serviceerror.NewNotFound(archiver.ErrHistoryNotExist.Error())
+			}
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		token = &getHistoryToken{
+			CloseFailoverVersion: highestVersion,
+			NextBatchIdx:         0,
+		}
+	}
+
+	key := constructHistoryKey(prefix, request.NamespaceID, request.WorkflowID, request.RunID, token.CloseFailoverVersion)
+	encodedHistoryBatches, err := h.store.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, ErrBlobNotFound) {
+			return nil, serviceerror.NewNotFound(archiver.ErrHistoryNotExist.Error())
+		}
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+
+	historyBatches, err := decodeHistories(encodedHistoryBatches)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+	if token.NextBatchIdx > len(historyBatches) {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrNextPageTokenCorrupted.Error())
+	}
+	historyBatches = historyBatches[token.NextBatchIdx:]
+
+	response := &archiver.GetHistoryResponse{}
+	numOfEvents := 0
+	numOfBatches := 0
+	for _, batch := range historyBatches {
+		response.HistoryBatches = append(response.HistoryBatches, batch)
+		numOfBatches++
+		numOfEvents += len(batch.Events)
+		if numOfEvents >= request.PageSize {
+			break
+		}
+	}
+
+	if numOfBatches < len(historyBatches) {
+		token.NextBatchIdx += numOfBatches
+		nextToken, err := serializeToken(token)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		response.NextPageToken = nextToken
+	}
+
+	return response, nil
+}
+
+func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
+	return validateURI(URI, h.scheme)
+}
+
+func (h *historyArchiver) getHighestVersion(
+	ctx context.Context,
+	prefix string,
+	request *archiver.GetHistoryRequest,
+) (int64, error) {
+	keys, err := h.store.List(ctx, constructHistoryKeyPrefix(prefix, request.NamespaceID, request.WorkflowID, request.RunID)+"_")
+	if err != nil {
+		return 0, err
+	}
+
+	var highestVersion *int64
+	for _, key := range keys {
+		version, err := extractCloseFailoverVersion(key)
+		if err != nil {
+			continue
+		}
+		if highestVersion == nil || version > *highestVersion {
+			highestVersion = &version
+		}
+	}
+	if highestVersion == nil {
+		return 0, archiver.ErrHistoryNotExist
+	}
+	return *highestVersion, nil
+}
@@ -0,0 +1,290 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/testing/protorequire"
+	"go.temporal.io/server/common/util"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	testNamespaceID          = "test-namespace-id"
+	testNamespace            = "test-namespace"
+	testWorkflowID           = "test-workflow-id"
+	testRunID                = "test-run-id"
+	testNextEventID          = 1800
+	testCloseFailoverVersion = int64(100)
+	testPageSize             = 100
+)
+
+var (
+	testBranchToken = []byte{1, 2, 3}
+)
+
+type historyArchiverSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	controller      *gomock.Controller
+	container       *archiver.HistoryBootstrapContainer
+	store           Store
+	testArchivalURI archiver.URI
+	historyBatches  []*historypb.History
+}
+
+func TestHistoryArchiverSuite(t *testing.T) {
+	suite.Run(t, new(historyArchiverSuite))
+}
+
+func (s *historyArchiverSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.container = &archiver.HistoryBootstrapContainer{
+		Logger: log.NewNoopLogger(),
+	}
+	s.store = NewMemoryStore()
+
+	var err error
+	s.testArchivalURI, err = archiver.NewURI("blobstore://bucket/a/b")
+	s.NoError(err)
+
+	now := timestamppb.New(time.Date(2020, 8, 22, 1, 2, 3, 4, time.UTC))
+	s.historyBatches = []*historypb.History{
+		{
+			Events: []*historypb.HistoryEvent{
+				{EventId: common.FirstEventID, EventTime: now, Version: testCloseFailoverVersion},
+				{EventId: common.FirstEventID + 1, EventTime: now, Version: testCloseFailoverVersion},
+			},
+		},
+		{
+			Events: []*historypb.HistoryEvent{
+				{EventId: testNextEventID - 1, EventTime: now, Version: testCloseFailoverVersion},
+			},
+		},
+	}
+}
+
+func (s *historyArchiverSuite) TestValidateURI() {
+	testCases := []struct {
+		URI         string
+		expectedErr error
+	}{
+		{
+			URI:         "wrongscheme:///a/b/c",
+			expectedErr: archiver.ErrURISchemeMismatch,
+		},
+		{
+			URI:         "blobstore:///",
+			expectedErr: errEmptyURIPath,
+		},
+		{
+			URI:         "blobstore:///a/b/c",
+			expectedErr: nil,
+		},
+		{
+			URI:         "blobstore://bucket",
+			expectedErr: nil,
+		},
+	}
+
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	for _, tc := range testCases {
+		URI, err := archiver.NewURI(tc.URI)
+		s.NoError(err)
+		s.Equal(tc.expectedErr, historyArchiver.ValidateURI(URI))
+	}
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_HistoryMutated() {
+	historyIterator := archiver.NewMockHistoryIterator(s.controller)
+	historyBlob := &archiverspb.HistoryBlob{
+		Header: &archiverspb.HistoryBlobHeader{
+			IsLast: true,
+		},
+		Body: []*historypb.History{
+			{
+				Events: []*historypb.HistoryEvent{
+					{EventId: common.FirstEventID + 1, Version: testCloseFailoverVersion + 1},
+				},
+			},
+		},
+	}
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(historyBlob, nil),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	err := historyArchiver.Archive(context.Background(), s.testArchivalURI, s.newArchiveRequest())
+	s.ErrorIs(err, archiver.ErrHistoryMutated)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_StoreError() {
+	store := NewMockStore(s.controller)
+	store.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("some random error"))
+
+	historyArchiver := newHistoryArchiver(s.container, store, URIScheme, s.newHistoryIterator(s.historyBatches))
+	err := historyArchiver.Archive(context.Background(), s.testArchivalURI, s.newArchiveRequest())
+	s.Error(err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Skip() {
+	historyIterator := archiver.NewMockHistoryIterator(s.controller)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(nil, serviceerror.NewNotFound("workflow not found")),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	err := historyArchiver.Archive(context.Background(), s.testArchivalURI, s.newArchiveRequest())
+	s.NoError(err)
+
+	keys, err := s.store.List(context.Background(), "")
+	s.NoError(err)
+	s.Empty(keys)
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_NotExist() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	_, err := historyArchiver.Get(context.Background(), s.testArchivalURI, s.newGetRequest())
+	var notFound *serviceerror.NotFound
+	s.ErrorAs(err, &notFound)
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_InvalidToken() {
+	s.archive(s.historyBatches, testCloseFailoverVersion)
+
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := s.newGetRequest()
+	request.NextPageToken = []byte{'r', 'a', 'n', 'd', 'o', 'm'}
+	_, err := historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+	var invalidArg *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArg)
+}
+
+func (s *historyArchiverSuite) TestArchiveAndGet_PickHighestVersion() {
+	olderBatches := []*historypb.History{
+		{
+			Events: []*historypb.HistoryEvent{
+				{EventId: testNextEventID - 1, Version: 1},
+			},
+		},
+	}
+	s.archive(olderBatches, 1)
+	s.archive(s.historyBatches, testCloseFailoverVersion)
+
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	response, err := historyArchiver.Get(context.Background(), s.testArchivalURI, s.newGetRequest())
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	protorequire.ProtoSliceEqual(s.T(), s.historyBatches, response.HistoryBatches)
+
+	request := s.newGetRequest()
+	request.CloseFailoverVersion = util.Ptr(int64(1))
+	response, err = historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+	protorequire.ProtoSliceEqual(s.T(), olderBatches, response.HistoryBatches)
+}
+
+func (s *historyArchiverSuite) TestArchiveAndGet_SmallPageSize() {
+	s.archive(s.historyBatches, testCloseFailoverVersion)
+
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := s.newGetRequest()
+	request.PageSize = 1
+
+	var batches []*historypb.History
+	for {
+		response, err := historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+		s.NoError(err)
+		s.Len(response.HistoryBatches, 1)
+		batches = append(batches, response.HistoryBatches...)
+		if response.NextPageToken == nil {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+	protorequire.ProtoSliceEqual(s.T(), s.historyBatches, batches)
+}
+
+func (s *historyArchiverSuite) archive(historyBatches []*historypb.History, version int64) {
+	historyArchiver := s.newTestHistoryArchiver(s.newHistoryIterator(historyBatches))
+	request := s.newArchiveRequest()
+	request.CloseFailoverVersion = version
+	s.NoError(historyArchiver.Archive(context.Background(), s.testArchivalURI, request))
+}
+
+func (s *historyArchiverSuite) newHistoryIterator(historyBatches []*historypb.History) archiver.HistoryIterator {
+	historyIterator := archiver.NewMockHistoryIterator(s.controller)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(&archiverspb.HistoryBlob{
+			Header: &archiverspb.HistoryBlobHeader{IsLast: false},
+			Body:   historyBatches,
+		}, nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+	return historyIterator
+}
+
+func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
+	return newHistoryArchiver(s.container, s.store, URIScheme, historyIterator)
+}
+
+func (s *historyArchiverSuite) newArchiveRequest() *archiver.ArchiveHistoryRequest {
+	return &archiver.ArchiveHistoryRequest{
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+}
+
+func (s *historyArchiverSuite) newGetRequest() *archiver.GetHistoryRequest {
+	return &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		PageSize:    testPageSize,
+	}
+}
@@ -0,0 +1,91 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package blobstore
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+type (
+	memoryStore struct {
+		sync.RWMutex
+		blobs map[string][]byte
+	}
+)
+
+var _ Store = (*memoryStore)(nil)
+
+// NewMemoryStore returns a Store which keeps all blobs in memory.
+// It is meant for tests and for local development only.
+func NewMemoryStore() Store {
+	return &memoryStore{
+		blobs: make(map[string][]byte),
+	}
+}
+
+func (m *memoryStore) Put(_ context.Context, key string, data []byte) error {
+	m.Lock()
+	defer m.Unlock()
+
+	m.blobs[key] = slices.Clone(data)
+	return nil
+}
+
+func (m *memoryStore) Get(_ context.Context, key string) ([]byte, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	data, ok := m.blobs[key]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrBlobNotFound, key)
+	}
+	return slices.Clone(data), nil
+}
+
+func (m *memoryStore) List(_ context.Context, prefix string) ([]string, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	var keys []string
+	for key := range m.blobs {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	return keys, nil
+}
+
+func (m *memoryStore) Delete(_ context.Context, key string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	delete(m.blobs, key)
+	return nil
+}
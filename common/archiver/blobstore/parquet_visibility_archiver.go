@@ -0,0 +1,638 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/parquet-go/parquet-go"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/visibilityquery"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// DefaultParquetBatchSize is the default max number of visibility records written into one parquet file.
+	DefaultParquetBatchSize = 1000
+	// DefaultParquetFlushInterval is the default max time a visibility record is buffered before it is written.
+	DefaultParquetFlushInterval = 5 * time.Second
+
+	parquetKeySuffix       = ".parquet"
+	parquetDatePartition   = "2006-01-02"
+	parquetFlushTimeout    = time.Minute
+	errConvertParquetRow   = "failed to convert visibility record to parquet row"
+	errWriteParquetRecords = "failed to write parquet visibility records"
+)
+
+type (
+	// parquetVisibilityArchiver batches visibility records into parquet files. Records archived to the same URI
+	// are group committed: Archive only returns once the file containing the record has been written, so no
+	// record acknowledged to the caller is ever held only in memory.
+	parquetVisibilityArchiver struct {
+		container     *archiver.VisibilityBootstrapContainer
+		store         Store
+		scheme        string
+		queryParser   visibilityquery.Parser
+		timeSource    clock.TimeSource
+		batchSize     int
+		flushInterval time.Duration
+
+		sync.Mutex
+		// Key for the pending batch is the store prefix of the URI
+		pending map[string]*parquetBatch
+	}
+
+	parquetBatch struct {
+		prefix string
+		rows   []*parquetVisibilityRow
+		timer  clock.Timer
+		done   chan struct{}
+		err    error
+	}
+
+	// parquetVisibilityRow is the schema of archived visibility parquet files. Timestamps and durations are
+	// stored in nanoseconds, a zero value means the field was not set. CHASM nodes of archived CHASM entities
+	// are stored as proto encoded persistence ChasmNode, keyed by node path.
+	parquetVisibilityRow struct {
+		NamespaceID        string            `parquet:"namespace_id,dict"`
+		Namespace          string            `parquet:"namespace,dict"`
+		WorkflowID         string            `parquet:"workflow_id"`
+		RunID              string            `parquet:"run_id"`
+		WorkflowTypeName   string            `parquet:"workflow_type_name,dict"`
+		StartTime          int64             `parquet:"start_time,timestamp(nanosecond)"`
+		ExecutionTime      int64             `parquet:"execution_time,timestamp(nanosecond)"`
+		CloseTime          int64             `parquet:"close_time,timestamp(nanosecond)"`
+		ExecutionDuration  int64             `parquet:"execution_duration"`
+		Status             string            `parquet:"status,dict"`
+		HistoryLength      int64             `parquet:"history_length"`
+		Memo               []byte            `parquet:"memo"`
+		SearchAttributes   map[string]string `parquet:"search_attributes"`
+		HistoryArchivalURI string            `parquet:"history_archival_uri,dict"`
+		ChasmNodes         map[string][]byte `parquet:"chasm_nodes"`
+	}
+
+	// parsedParquetKey describes the close time range of the records in a parquet file,
+	// which is encoded in its key as prefix/namespaceID/closeDate/maxCloseTime_minCloseTime_uuid.parquet.
+	parsedParquetKey struct {
+		key          string
+		maxCloseTime time.Time
+		minCloseTime time.Time
+	}
+)
+
+// NewParquetVisibilityArchiver creates a new archiver.VisibilityArchiver which batches records into parquet files
+// partitioned by namespace and close date. A batch is written once it has batchSize records or flushInterval
+// after its first record was added, whichever happens first.
+func NewParquetVisibilityArchiver(
+	container *archiver.VisibilityBootstrapContainer,
+	store Store,
+	scheme string,
+	batchSize int,
+	flushInterval time.Duration,
+) archiver.VisibilityArchiver {
+	return newParquetVisibilityArchiver(container, store, scheme, batchSize, flushInterval, clock.NewRealTimeSource())
+}
+
+func newParquetVisibilityArchiver(
+	container *archiver.VisibilityBootstrapContainer,
+	store Store,
+	scheme string,
+	batchSize int,
+	flushInterval time.Duration,
+	timeSource clock.TimeSource,
+) *parquetVisibilityArchiver {
+	if batchSize <= 0 {
+		batchSize = DefaultParquetBatchSize
+	}
+	if flushInterval <= 0 {
+		flushInterval = DefaultParquetFlushInterval
+	}
+	return &parquetVisibilityArchiver{
+		container:     container,
+		store:         store,
+		scheme:        scheme,
+		queryParser:   visibilityquery.NewParser(),
+		timeSource:    timeSource,
+		batchSize:     batchSize,
+		flushInterval: flushInterval,
+		pending:       make(map[string]*parquetBatch),
+	}
+}
+
+func (v *parquetVisibilityArchiver) Archive(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiverspb.VisibilityRecord,
+	opts ...archiver.ArchiveOption,
+) (err error) {
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	defer func() {
+		if err != nil && featureCatalog.NonRetryableError != nil {
+			err = featureCatalog.NonRetryableError()
+		}
+	}()
+
+	logger := archiver.TagLoggerWithArchiveVisibilityRequestAndURI(v.container.Logger, request, URI.String())
+
+	if err := v.ValidateURI(URI); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidURI), tag.Error(err))
+		return err
+	}
+
+	if err := archiver.ValidateVisibilityArchivalRequest(request); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidArchiveRequest), tag.Error(err))
+		return err
+	}
+
+	row, err := newParquetVisibilityRow(request)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errConvertParquetRow), tag.Error(err))
+		return err
+	}
+
+	batch := v.add(keyPrefix(URI), row)
+	select {
+	case <-batch.done:
+		if batch.err != nil {
+			logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteParquetRecords), tag.Error(batch.err))
+			// Archive is retried by the caller, so the row is written again as part of a new batch.
+			return batch.err
+		}
+		return nil
+	case <-ctx.Done():
+		// The row may still be written by the pending batch, duplicates are removed at query time.
+		return ctx.Err()
+	}
+}
+
+// add appends the row to the pending batch of the prefix and returns the batch.
+// If the batch is full it is flushed before add returns.
+func (v *parquetVisibilityArchiver) add(prefix string, row *parquetVisibilityRow) *parquetBatch {
+	v.Lock()
+	batch, ok := v.pending[prefix]
+	if !ok {
+		batch = &parquetBatch{
+			prefix: prefix,
+			done:   make(chan struct{}),
+		}
+		v.pending[prefix] = batch
+		batch.timer = v.timeSource.AfterFunc(v.flushInterval, func() {
+			if v.detach(batch) {
+				v.flush(batch)
+			}
+		})
+	}
+	batch.rows = append(batch.rows, row)
+	full := len(batch.rows) >= v.batchSize
+	if full {
+		delete(v.pending, prefix)
+		batch.timer.Stop()
+	}
+	v.Unlock()
+
+	if full {
+		v.flush(batch)
+	}
+	return batch
+}
+
+// detach removes the batch from pending batches, it returns false if the batch was already removed.
+func (v *parquetVisibilityArchiver) detach(batch *parquetBatch) bool {
+	v.Lock()
+	defer v.Unlock()
+
+	if v.pending[batch.prefix] != batch {
+		return false
+	}
+	delete(v.pending, batch.prefix)
+	return true
+}
+
+func (v *parquetVisibilityArchiver) flush(batch *parquetBatch) {
+	defer close(batch.done)
+
+	ctx, cancel := context.WithTimeout(context.Background(), parquetFlushTimeout)
+	defer cancel()
+
+	for key, rows := range partitionParquetRows(batch.prefix, batch.rows) {
+		data, err := encodeParquetRows(rows)
+		if err != nil {
+			batch.err = err
+			return
+		}
+		if err := v.store.Put(ctx, key, data); err != nil {
+			batch.err = err
+			return
+		}
+	}
+}
+
+func (v *parquetVisibilityArchiver) Query(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.QueryVisibilityRequest,
+	saTypeMap searchattribute.NameTypeMap,
+) (*archiver.QueryVisibilityResponse, error) {
+	if err := v.ValidateURI(URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
+	}
+
+	if err := archiver.ValidateQueryRequest(request); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidQueryVisibilityRequest.Error())
+	}
+
+	parsedQuery, err := v.queryParser.Parse(request.Query)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+
+	if parsedQuery.EmptyResult {
+		return &archiver.QueryVisibilityResponse{}, nil
+	}
+
+	return v.query(
+		ctx,
+		URI,
+		&queryVisibilityRequest{
+			namespaceID:   request.NamespaceID,
+			pageSize:      request.PageSize,
+			nextPageToken: request.NextPageToken,
+			parsedQuery:   parsedQuery,
+		},
+		saTypeMap,
+	)
+}
+
+func (v *parquetVisibilityArchiver) query(
+	ctx context.Context,
+	URI archiver.URI,
+	request *queryVisibilityRequest,
+	saTypeMap searchattribute.NameTypeMap,
+) (*archiver.QueryVisibilityResponse, error) {
+	var token *queryVisibilityToken
+	if request.nextPageToken != nil {
+		var err error
+		token, err = deserializeQueryVisibilityToken(request.nextPageToken)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(archiver.ErrNextPageTokenCorrupted.Error())
+		}
+	}
+
+	keys, err := v.store.List(ctx, constructVisibilityKeyPrefix(keyPrefix(URI), request.namespaceID))
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+
+	latestCloseTime := request.parsedQuery.LatestCloseTime
+	if token != nil && token.LastCloseTime.Before(latestCloseTime) {
+		latestCloseTime = token.LastCloseTime
+	}
+	parsedKeys, err := sortAndFilterParquetKeys(keys, request.parsedQuery.EarliestCloseTime, latestCloseTime)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+
+	// Files are visited by their max close time in descending order. Once a full page has been collected,
+	// files whose records all closed before the last record of the page can't change the page.
+	var records []*archiverspb.VisibilityRecord
+	seen := make(map[string]struct{})
+	hasMore := false
+	for _, parsedKey := range parsedKeys {
+		if len(records) >= request.pageSize {
+			sortVisibilityRecords(records)
+			if parsedKey.maxCloseTime.Before(records[request.pageSize-1].CloseTime.AsTime()) {
+				hasMore = true
+				break
+			}
+		}
+
+		data, err := v.store.Get(ctx, parsedKey.key)
+		if err != nil {
+			if errors.Is(err, ErrBlobNotFound) {
+				// file was deleted after it was listed
+				continue
+			}
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		rows, err := decodeParquetRows(data)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+
+		for _, row := range rows {
+			record, err := row.toVisibilityRecord()
+			if err != nil {
+				return nil, serviceerror.NewInternal(err.Error())
+			}
+			if !request.parsedQuery.Match(record) || !afterToken(record, token) {
+				continue
+			}
+			// The same record can be archived more than once, e.g. when Archive is retried.
+			dedupKey := record.GetRunId() + strconv.FormatInt(record.CloseTime.AsTime().UnixNano(), 10)
+			if _, ok := seen[dedupKey]; ok {
+				continue
+			}
+			seen[dedupKey] = struct{}{}
+			records = append(records, record)
+		}
+	}
+	sortVisibilityRecords(records)
+
+	if len(records) > request.pageSize {
+		records = records[:request.pageSize]
+		hasMore = true
+	}
+
+	response := &archiver.QueryVisibilityResponse{}
+	for _, record := range records {
+		executionInfo, err := convertToExecutionInfo(record, saTypeMap)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		response.Executions = append(response.Executions, executionInfo)
+	}
+	if hasMore && len(records) == request.pageSize {
+		lastRecord := records[len(records)-1]
+		encodedToken, err := serializeToken(&queryVisibilityToken{
+			LastCloseTime: timestamp.TimeValue(lastRecord.CloseTime),
+			LastRunID:     lastRecord.GetRunId(),
+		})
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		response.NextPageToken = encodedToken
+	}
+
+	return response, nil
+}
+
+func (v *parquetVisibilityArchiver) ValidateURI(URI archiver.URI) error {
+	return validateURI(URI, v.scheme)
+}
+
+// partitionParquetRows groups rows into parquet files by namespace and close date, it returns a map from file key to rows.
+func partitionParquetRows(prefix string, rows []*parquetVisibilityRow) map[string][]*parquetVisibilityRow {
+	type partition struct {
+		namespaceID string
+		closeDate   string
+	}
+	partitions := make(map[partition][]*parquetVisibilityRow)
+	for _, row := range rows {
+		p := partition{
+			namespaceID: row.NamespaceID,
+			closeDate:   time.Unix(0, row.CloseTime).UTC().Format(parquetDatePartition),
+		}
+		partitions[p] = append(partitions[p], row)
+	}
+
+	files := make(map[string][]*parquetVisibilityRow, len(partitions))
+	for p, rows := range partitions {
+		minCloseTime, maxCloseTime := rows[0].CloseTime, rows[0].CloseTime
+		for _, row := range rows[1:] {
+			minCloseTime = min(minCloseTime, row.CloseTime)
+			maxCloseTime = max(maxCloseTime, row.CloseTime)
+		}
+		key := fmt.Sprintf(
+			"%s%s/%v_%v_%s%s",
+			constructVisibilityKeyPrefix(prefix, p.namespaceID),
+			p.closeDate,
+			maxCloseTime,
+			minCloseTime,
+			uuid.NewString(),
+			parquetKeySuffix,
+		)
+		files[key] = rows
+	}
+	return files
+}
+
+// sortAndFilterParquetKeys sorts parquet file keys by max close time (desc) and drops files
+// which can't contain records closed within [earliestCloseTime, latestCloseTime].
+// Keys not written by the parquet archiver are ignored.
+func sortAndFilterParquetKeys(keys []string, earliestCloseTime, latestCloseTime time.Time) ([]*parsedParquetKey, error) {
+	parsedKeys := make([]*parsedParquetKey, 0, len(keys))
+	for _, key := range keys {
+		if !strings.HasSuffix(key, parquetKeySuffix) {
+			continue
+		}
+		parsedKey, err := parseParquetKey(key)
+		if err != nil {
+			return nil, err
+		}
+		if parsedKey.maxCloseTime.Before(earliestCloseTime) || parsedKey.minCloseTime.After(latestCloseTime) {
+			continue
+		}
+		parsedKeys = append(parsedKeys, parsedKey)
+	}
+
+	sort.Slice(parsedKeys, func(i, j int) bool {
+		return parsedKeys[i].maxCloseTime.After(parsedKeys[j].maxCloseTime)
+	})
+	return parsedKeys, nil
+}
+
+func parseParquetKey(key string) (*parsedParquetKey, error) {
+	name := strings.TrimSuffix(path.Base(key), parquetKeySuffix)
+	pieces := strings.Split(name, "_")
+	if len(pieces) != 3 {
+		return nil, fmt.Errorf("failed to parse parquet visibility key %s", key)
+	}
+	maxCloseTime, err := strconv.ParseInt(pieces[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse parquet visibility key %s", key)
+	}
+	minCloseTime, err := strconv.ParseInt(pieces[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse parquet visibility key %s", key)
+	}
+	return &parsedParquetKey{
+		key:          key,
+		maxCloseTime: timestamp.UnixOrZeroTime(maxCloseTime),
+		minCloseTime: timestamp.UnixOrZeroTime(minCloseTime),
+	}, nil
+}
+
+// sortVisibilityRecords sorts records by close time (desc) and uses the hashed runID to break ties,
+// which is the same order the JSON visibility archiver returns records in.
+func sortVisibilityRecords(records []*archiverspb.VisibilityRecord) {
+	sort.Slice(records, func(i, j int) bool {
+		closeTimeI, closeTimeJ := records[i].CloseTime.AsTime(), records[j].CloseTime.AsTime()
+		if closeTimeI.Equal(closeTimeJ) {
+			return hash(records[i].GetRunId()) > hash(records[j].GetRunId())
+		}
+		return closeTimeI.After(closeTimeJ)
+	})
+}
+
+// afterToken returns true if the record comes after the last record returned in the previous page.
+func afterToken(record *archiverspb.VisibilityRecord, token *queryVisibilityToken) bool {
+	if token == nil {
+		return true
+	}
+	closeTime := record.CloseTime.AsTime()
+	if closeTime.Equal(token.LastCloseTime) {
+		return hash(record.GetRunId()) < hash(token.LastRunID)
+	}
+	return closeTime.Before(token.LastCloseTime)
+}
+
+func encodeParquetRows(rows []*parquetVisibilityRow) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := parquet.NewGenericWriter[*parquetVisibilityRow](&buf, parquet.Compression(&parquet.Zstd))
+	if _, err := writer.Write(rows); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeParquetRows(data []byte) ([]*parquetVisibilityRow, error) {
+	rows, err := parquet.Read[*parquetVisibilityRow](bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func newParquetVisibilityRow(record *archiverspb.VisibilityRecord) (*parquetVisibilityRow, error) {
+	var memo []byte
+	if record.Memo != nil {
+		var err error
+		if memo, err = proto.Marshal(record.Memo); err != nil {
+			return nil, err
+		}
+	}
+	var chasmNodes map[string][]byte
+	if len(record.ChasmNodes) > 0 {
+		chasmNodes = make(map[string][]byte, len(record.ChasmNodes))
+		for path, node := range record.ChasmNodes {
+			data, err := proto.Marshal(node)
+			if err != nil {
+				return nil, err
+			}
+			chasmNodes[path] = data
+		}
+	}
+	return &parquetVisibilityRow{
+		NamespaceID:        record.GetNamespaceId(),
+		Namespace:          record.GetNamespace(),
+		WorkflowID:         record.GetWorkflowId(),
+		RunID:              record.GetRunId(),
+		WorkflowTypeName:   record.GetWorkflowTypeName(),
+		StartTime:          timestampToUnixNano(record.StartTime),
+		ExecutionTime:      timestampToUnixNano(record.ExecutionTime),
+		CloseTime:          timestampToUnixNano(record.CloseTime),
+		ExecutionDuration:  record.GetExecutionDuration().AsDuration().Nanoseconds(),
+		Status:             record.GetStatus().String(),
+		HistoryLength:      record.GetHistoryLength(),
+		Memo:               memo,
+		SearchAttributes:   record.GetSearchAttributes(),
+		HistoryArchivalURI: record.GetHistoryArchivalUri(),
+		ChasmNodes:         chasmNodes,
+	}, nil
+}
+
+func (r *parquetVisibilityRow) toVisibilityRecord() (*archiverspb.VisibilityRecord, error) {
+	status, err := enumspb.WorkflowExecutionStatusFromString(r.Status)
+	if err != nil {
+		return nil, err
+	}
+	var memo *commonpb.Memo
+	if len(r.Memo) > 0 {
+		memo = &commonpb.Memo{}
+		if err := proto.Unmarshal(r.Memo, memo); err != nil {
+			return nil, err
+		}
+	}
+	var chasmNodes map[string]*persistencespb.ChasmNode
+	if len(r.ChasmNodes) > 0 {
+		chasmNodes = make(map[string]*persistencespb.ChasmNode, len(r.ChasmNodes))
+		for path, data := range r.ChasmNodes {
+			node := &persistencespb.ChasmNode{}
+			if err := proto.Unmarshal(data, node); err != nil {
+				return nil, err
+			}
+			chasmNodes[path] = node
+		}
+	}
+	var executionDuration *durationpb.Duration
+	if r.ExecutionDuration != 0 {
+		executionDuration = durationpb.New(time.Duration(r.ExecutionDuration))
+	}
+	return &archiverspb.VisibilityRecord{
+		NamespaceId:        r.NamespaceID,
+		Namespace:          r.Namespace,
+		WorkflowId:         r.WorkflowID,
+		RunId:              r.RunID,
+		WorkflowTypeName:   r.WorkflowTypeName,
+		StartTime:          unixNanoToTimestamp(r.StartTime),
+		ExecutionTime:      unixNanoToTimestamp(r.ExecutionTime),
+		CloseTime:          unixNanoToTimestamp(r.CloseTime),
+		ExecutionDuration:  executionDuration,
+		Status:             status,
+		HistoryLength:      r.HistoryLength,
+		Memo:               memo,
+		SearchAttributes:   r.SearchAttributes,
+		HistoryArchivalUri: r.HistoryArchivalURI,
+		ChasmNodes:         chasmNodes,
+	}, nil
+}
+
+func timestampToUnixNano(t *timestamppb.Timestamp) int64 {
+	if t == nil {
+		return 0
+	}
+	return t.AsTime().UnixNano()
+}
+
+func unixNanoToTimestamp(nanos int64) *timestamppb.Timestamp {
+	if nanos == 0 {
+		return nil
+	}
+	return timestamppb.New(time.Unix(0, nanos))
+}
@@ -0,0 +1,336 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/searchattribute"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type parquetVisibilityArchiverSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	container       *archiver.VisibilityBootstrapContainer
+	testArchivalURI archiver.URI
+	baseTime        time.Time
+	timeSource      *clock.EventTimeSource
+}
+
+func TestParquetVisibilityArchiverSuite(t *testing.T) {
+	suite.Run(t, new(parquetVisibilityArchiverSuite))
+}
+
+func (s *parquetVisibilityArchiverSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.container = &archiver.VisibilityBootstrapContainer{
+		Logger: log.NewNoopLogger(),
+	}
+
+	var err error
+	s.testArchivalURI, err = archiver.NewURI("blobstore:///visibility")
+	s.NoError(err)
+	s.baseTime = time.Date(2020, 8, 22, 20, 2, 3, 0, time.UTC)
+	s.timeSource = clock.NewEventTimeSource()
+}
+
+func (s *parquetVisibilityArchiverSuite) TestArchive_Fail_InvalidRequest() {
+	visibilityArchiver := s.newArchiver(NewMemoryStore(), 10)
+	err := visibilityArchiver.Archive(context.Background(), s.testArchivalURI, &archiverspb.VisibilityRecord{})
+	s.Error(err)
+}
+
+func (s *parquetVisibilityArchiverSuite) TestArchive_FlushOnBatchSize() {
+	store := NewMemoryStore()
+	visibilityArchiver := s.newArchiver(store, 4)
+
+	// records close on two different days, so the batch is written into two files
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			s.NoError(visibilityArchiver.Archive(context.Background(), s.testArchivalURI, s.newRecord(i*2, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)))
+		}(i)
+	}
+	wg.Wait()
+
+	keys, err := store.List(context.Background(), "visibility/")
+	s.NoError(err)
+	s.Len(keys, 2)
+	s.True(strings.HasPrefix(keys[0], "visibility/"+testNamespaceID+"/2020-08-22/"))
+	s.True(strings.HasPrefix(keys[1], "visibility/"+testNamespaceID+"/2020-08-23/"))
+	s.Empty(visibilityArchiver.pending)
+}
+
+func (s *parquetVisibilityArchiverSuite) TestArchive_FlushOnInterval() {
+	store := NewMemoryStore()
+	visibilityArchiver := s.newArchiver(store, 10)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- visibilityArchiver.Archive(context.Background(), s.testArchivalURI, s.newRecord(0, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED))
+	}()
+	s.Eventually(func() bool {
+		visibilityArchiver.Lock()
+		defer visibilityArchiver.Unlock()
+		return len(visibilityArchiver.pending) == 1
+	}, time.Second, time.Millisecond)
+
+	keys, err := store.List(context.Background(), "visibility/")
+	s.NoError(err)
+	s.Empty(keys)
+
+	s.timeSource.Advance(time.Second)
+	s.NoError(<-errCh)
+
+	keys, err = store.List(context.Background(), "visibility/")
+	s.NoError(err)
+	s.Len(keys, 1)
+}
+
+func (s *parquetVisibilityArchiverSuite) TestArchive_ContextCanceled() {
+	visibilityArchiver := s.newArchiver(NewMemoryStore(), 10)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := visibilityArchiver.Archive(ctx, s.testArchivalURI, s.newRecord(0, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED))
+	s.ErrorIs(err, context.Canceled)
+}
+
+func (s *parquetVisibilityArchiverSuite) TestArchiveAndQuery() {
+	backends := map[string]Store{
+		BackendMemory:     NewMemoryStore(),
+		BackendFilesystem: NewFilesystemStore(s.T().TempDir(), 0666, 0766),
+	}
+	for name, store := range backends {
+		s.Run(name, func() {
+			visibilityArchiver := s.newArchiver(store, 1)
+			for i := 0; i < 5; i++ {
+				status := enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+				if i%2 == 1 {
+					status = enumspb.WORKFLOW_EXECUTION_STATUS_FAILED
+				}
+				s.NoError(visibilityArchiver.Archive(context.Background(), s.testArchivalURI, s.newRecord(i, status)))
+			}
+			// archiving the same record again must not return it twice
+			s.NoError(visibilityArchiver.Archive(context.Background(), s.testArchivalURI, s.newRecord(2, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)))
+			// record of a different namespace must never be returned
+			otherRecord := s.newRecord(10, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+			otherRecord.NamespaceId = "other-namespace-id"
+			s.NoError(visibilityArchiver.Archive(context.Background(), s.testArchivalURI, otherRecord))
+
+			// all records are returned in descending close time order across pages
+			var runIDs []string
+			request := &archiver.QueryVisibilityRequest{
+				NamespaceID: testNamespaceID,
+				PageSize:    2,
+			}
+			for {
+				response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request, searchattribute.TestNameTypeMap)
+				s.NoError(err)
+				for _, execution := range response.Executions {
+					runIDs = append(runIDs, execution.GetExecution().GetRunId())
+				}
+				if response.NextPageToken == nil {
+					break
+				}
+				request.NextPageToken = response.NextPageToken
+			}
+			s.Equal([]string{"run-4", "run-3", "run-2", "run-1", "run-0"}, runIDs)
+
+			response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
+				NamespaceID: testNamespaceID,
+				PageSize:    10,
+				Query: fmt.Sprintf(
+					"ExecutionStatus = 'Failed' AND CloseTime <= '%s'",
+					s.baseTime.Add(2*time.Hour).Format(time.RFC3339),
+				),
+			}, searchattribute.TestNameTypeMap)
+			s.NoError(err)
+			s.Len(response.Executions, 1)
+			execution := response.Executions[0]
+			s.Equal("run-1", execution.GetExecution().GetRunId())
+			s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED, execution.GetStatus())
+			s.Equal(s.baseTime.Add(time.Hour), execution.GetCloseTime().AsTime())
+			s.Equal(time.Minute, execution.GetExecutionDuration().AsDuration())
+			s.Equal(int64(1), execution.GetHistoryLength())
+			s.Equal(`"memo-1"`, string(execution.GetMemo().GetFields()["key"].GetData()))
+		})
+	}
+}
+
+func (s *parquetVisibilityArchiverSuite) TestParquetRowRoundTrip() {
+	record := s.newRecord(1, enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED)
+	record.SearchAttributes = map[string]string{"CustomKeywordField": `"keyword"`}
+	record.HistoryArchivalUri = "blobstore:///history"
+
+	row, err := newParquetVisibilityRow(record)
+	s.NoError(err)
+	data, err := encodeParquetRows([]*parquetVisibilityRow{row})
+	s.NoError(err)
+	rows, err := decodeParquetRows(data)
+	s.NoError(err)
+	s.Len(rows, 1)
+
+	decoded, err := rows[0].toVisibilityRecord()
+	s.NoError(err)
+	s.Equal(record.String(), decoded.String())
+}
+
+func (s *parquetVisibilityArchiverSuite) TestParquetRowRoundTrip_ChasmNodes() {
+	record := s.newRecord(1, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+	record.ChasmNodes = map[string]*persistencespb.ChasmNode{
+		"": {
+			Metadata: &persistencespb.ChasmNodeMetadata{InitialVersionedTransition: &persistencespb.VersionedTransition{TransitionCount: 1}},
+			Data:     &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("root")},
+		},
+		"child": {
+			Data: &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("child")},
+		},
+	}
+
+	visibilityArchiver := s.newArchiver(NewMemoryStore(), 1)
+	s.NoError(visibilityArchiver.Archive(context.Background(), s.testArchivalURI, record))
+	rows := s.readParquetRows(visibilityArchiver.store)
+	s.Len(rows, 1)
+	decoded, err := rows[0].toVisibilityRecord()
+	s.NoError(err)
+	s.Len(decoded.ChasmNodes, 2)
+	s.Equal(record.String(), decoded.String())
+}
+
+func (s *parquetVisibilityArchiverSuite) TestDecodeParquetRows_WithoutChasmNodesColumn() {
+	// Files written before CHASM nodes were archived don't have the chasm_nodes column.
+	type legacyParquetVisibilityRow struct {
+		NamespaceID string `parquet:"namespace_id,dict"`
+		RunID       string `parquet:"run_id"`
+		CloseTime   int64  `parquet:"close_time,timestamp(nanosecond)"`
+		Status      string `parquet:"status,dict"`
+	}
+	var buf bytes.Buffer
+	writer := parquet.NewGenericWriter[*legacyParquetVisibilityRow](&buf)
+	_, err := writer.Write([]*legacyParquetVisibilityRow{{
+		NamespaceID: testNamespaceID,
+		RunID:       "run-0",
+		CloseTime:   s.baseTime.UnixNano(),
+		Status:      enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED.String(),
+	}})
+	s.NoError(err)
+	s.NoError(writer.Close())
+
+	rows, err := decodeParquetRows(buf.Bytes())
+	s.NoError(err)
+	s.Len(rows, 1)
+	decoded, err := rows[0].toVisibilityRecord()
+	s.NoError(err)
+	s.Equal("run-0", decoded.GetRunId())
+	s.Nil(decoded.ChasmNodes)
+}
+
+func (s *parquetVisibilityArchiverSuite) TestSortAndFilterParquetKeys() {
+	keys := []string{
+		fmt.Sprintf("visibility/ns/2020-08-22/%v_%v_a.parquet", s.baseTime.Add(time.Hour).UnixNano(), s.baseTime.UnixNano()),
+		fmt.Sprintf("visibility/ns/2020-08-23/%v_%v_b.parquet", s.baseTime.Add(5*time.Hour).UnixNano(), s.baseTime.Add(4*time.Hour).UnixNano()),
+		fmt.Sprintf("visibility/ns/2020-08-23/%v_%v_c.parquet", s.baseTime.Add(8*time.Hour).UnixNano(), s.baseTime.Add(6*time.Hour).UnixNano()),
+		constructVisibilityKey("visibility", "ns", s.baseTime, "run-0"),
+	}
+
+	parsedKeys, err := sortAndFilterParquetKeys(keys, time.Time{}, s.baseTime.Add(24*time.Hour))
+	s.NoError(err)
+	s.Len(parsedKeys, 3)
+	s.Equal(keys[2], parsedKeys[0].key)
+	s.Equal(keys[1], parsedKeys[1].key)
+	s.Equal(keys[0], parsedKeys[2].key)
+
+	parsedKeys, err = sortAndFilterParquetKeys(keys, s.baseTime.Add(2*time.Hour), s.baseTime.Add(5*time.Hour))
+	s.NoError(err)
+	s.Len(parsedKeys, 1)
+	s.Equal(keys[1], parsedKeys[0].key)
+
+	_, err = sortAndFilterParquetKeys([]string{"visibility/ns/invalid.parquet"}, time.Time{}, s.baseTime)
+	s.Error(err)
+}
+
+func (s *parquetVisibilityArchiverSuite) readParquetRows(store Store) []*parquetVisibilityRow {
+	keys, err := store.List(context.Background(), "")
+	s.NoError(err)
+	var rows []*parquetVisibilityRow
+	for _, key := range keys {
+		if !strings.HasSuffix(key, parquetKeySuffix) {
+			continue
+		}
+		data, err := store.Get(context.Background(), key)
+		s.NoError(err)
+		fileRows, err := decodeParquetRows(data)
+		s.NoError(err)
+		rows = append(rows, fileRows...)
+	}
+	return rows
+}
+
+func (s *parquetVisibilityArchiverSuite) newArchiver(store Store, batchSize int) *parquetVisibilityArchiver {
+	return newParquetVisibilityArchiver(s.container, store, URIScheme, batchSize, time.Second, s.timeSource)
+}
+
+func (s *parquetVisibilityArchiverSuite) newRecord(i int, status enumspb.WorkflowExecutionStatus) *archiverspb.VisibilityRecord {
+	closeTime := s.baseTime.Add(time.Duration(i) * time.Hour)
+	return &archiverspb.VisibilityRecord{
+		NamespaceId:       testNamespaceID,
+		Namespace:         testNamespace,
+		WorkflowId:        testWorkflowID,
+		RunId:             fmt.Sprintf("run-%d", i),
+		WorkflowTypeName:  "test-workflow-type",
+		StartTime:         timestamppb.New(closeTime.Add(-time.Minute)),
+		ExecutionTime:     timestamppb.New(closeTime.Add(-time.Minute)),
+		CloseTime:         timestamppb.New(closeTime),
+		ExecutionDuration: durationpb.New(time.Minute),
+		Status:            status,
+		HistoryLength:     int64(i),
+		Memo: &commonpb.Memo{
+			Fields: map[string]*commonpb.Payload{"key": payload.EncodeString(fmt.Sprintf("memo-%d", i))},
+		},
+	}
+}
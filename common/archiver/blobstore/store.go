@@ -0,0 +1,59 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../../LICENSE -package $GOPACKAGE -source $GOFILE -destination store_mock.go
+
+package blobstore
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	// ErrBlobNotFound is returned by Store.Get when no blob exists for the given key
+	ErrBlobNotFound = errors.New("blob not found")
+)
+
+type (
+	// Store is the minimal object store abstraction the blobstore archivers are built on.
+	// Keys are slash separated paths without a leading slash, e.g. "archive/namespace-id/file".
+	// Implementations must be safe for concurrent use.
+	//
+	// Adding a new backend (e.g. Azure Blob Storage or a MinIO compatible endpoint) only
+	// requires implementing this interface, URI handling, object layout and query
+	// processing are shared by all backends.
+	Store interface {
+		// Put writes data under the given key, replacing any existing blob.
+		Put(ctx context.Context, key string, data []byte) error
+		// Get reads the blob stored under the given key. ErrBlobNotFound is returned
+		// if the key does not exist.
+		Get(ctx context.Context, key string) ([]byte, error)
+		// List returns all keys starting with the given prefix in lexicographical order.
+		List(ctx context.Context, prefix string) ([]string, error)
+		// Delete removes the blob stored under the given key. Deleting a key which
+		// does not exist is not an error.
+		Delete(ctx context.Context, key string) error
+	}
+)
@@ -0,0 +1,91 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package blobstore
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	// BackendFilesystem stores every blob as a file on local disk
+	BackendFilesystem = "filesystem"
+	// BackendMemory keeps all blobs in memory, meant for tests and local development
+	BackendMemory = "memory"
+
+	// VisibilityFormatJSON archives every visibility record as its own JSON blob
+	VisibilityFormatJSON = "json"
+	// VisibilityFormatParquet batches visibility records into parquet files partitioned by namespace and close date
+	VisibilityFormatParquet = "parquet"
+)
+
+var (
+	errUnknownBackend  = errors.New("unknown blobstore backend")
+	errInvalidFileMode = errors.New("invalid file mode")
+	errInvalidDirMode  = errors.New("invalid directory mode")
+
+	errUnknownVisibilityFormat = errors.New("unknown blobstore visibility format")
+)
+
+// NewStoreFromConfig creates the Store backend described by the archiver config.
+func NewStoreFromConfig(cfg *config.BlobstoreArchiver) (Store, error) {
+	switch cfg.Backend {
+	case "", BackendFilesystem:
+		fileMode, err := strconv.ParseUint(cfg.FileMode, 0, 32)
+		if err != nil {
+			return nil, errInvalidFileMode
+		}
+		dirMode, err := strconv.ParseUint(cfg.DirMode, 0, 32)
+		if err != nil {
+			return nil, errInvalidDirMode
+		}
+		return NewFilesystemStore(cfg.RootDir, os.FileMode(fileMode), os.FileMode(dirMode)), nil
+	case BackendMemory:
+		return NewMemoryStore(), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownBackend, cfg.Backend)
+	}
+}
+
+// NewVisibilityArchiverFromConfig creates the visibility archiver for the format described by the archiver config.
+func NewVisibilityArchiverFromConfig(
+	container *archiver.VisibilityBootstrapContainer,
+	store Store,
+	cfg *config.BlobstoreArchiver,
+) (archiver.VisibilityArchiver, error) {
+	switch cfg.VisibilityFormat {
+	case "", VisibilityFormatJSON:
+		return NewVisibilityArchiver(container, store, URIScheme), nil
+	case VisibilityFormatParquet:
+		return NewParquetVisibilityArchiver(container, store, URIScheme, cfg.ParquetBatchSize, cfg.ParquetFlushInterval), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownVisibilityFormat, cfg.VisibilityFormat)
+	}
+}
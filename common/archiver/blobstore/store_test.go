@@ -0,0 +1,134 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package blobstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+)
+
+func TestStore(t *testing.T) {
+	testCases := map[string]func(t *testing.T) Store{
+		BackendMemory: func(t *testing.T) Store {
+			return NewMemoryStore()
+		},
+		BackendFilesystem: func(t *testing.T) Store {
+			return NewFilesystemStore(t.TempDir(), 0666, 0766)
+		},
+	}
+
+	for name, newStore := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			store := newStore(t)
+
+			_, err := store.Get(ctx, "a/b/missing")
+			require.ErrorIs(t, err, ErrBlobNotFound)
+
+			require.NoError(t, store.Put(ctx, "a/b/1", []byte("one")))
+			require.NoError(t, store.Put(ctx, "a/b/2", []byte("two")))
+			require.NoError(t, store.Put(ctx, "a/c/3", []byte("three")))
+			require.NoError(t, store.Put(ctx, "a/b/1", []byte("uno")))
+
+			data, err := store.Get(ctx, "a/b/1")
+			require.NoError(t, err)
+			require.Equal(t, []byte("uno"), data)
+
+			keys, err := store.List(ctx, "a/b/")
+			require.NoError(t, err)
+			require.Equal(t, []string{"a/b/1", "a/b/2"}, keys)
+
+			keys, err = store.List(ctx, "a/")
+			require.NoError(t, err)
+			require.Equal(t, []string{"a/b/1", "a/b/2", "a/c/3"}, keys)
+
+			keys, err = store.List(ctx, "a/b/2")
+			require.NoError(t, err)
+			require.Equal(t, []string{"a/b/2"}, keys)
+
+			keys, err = store.List(ctx, "x/")
+			require.NoError(t, err)
+			require.Empty(t, keys)
+
+			require.NoError(t, store.Delete(ctx, "a/b/1"))
+			require.NoError(t, store.Delete(ctx, "a/b/1"))
+			_, err = store.Get(ctx, "a/b/1")
+			require.ErrorIs(t, err, ErrBlobNotFound)
+		})
+	}
+}
+
+func TestFilesystemStore_KeyCannotEscapeRoot(t *testing.T) {
+	ctx := context.Background()
+	rootDir := t.TempDir()
+	store := NewFilesystemStore(rootDir, 0666, 0766)
+
+	require.NoError(t, store.Put(ctx, "../../escaped", []byte("data")))
+	keys, err := store.List(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"escaped"}, keys)
+}
+
+func TestNewStoreFromConfig(t *testing.T) {
+	store, err := NewStoreFromConfig(&config.BlobstoreArchiver{Backend: BackendMemory})
+	require.NoError(t, err)
+	require.IsType(t, &memoryStore{}, store)
+
+	store, err = NewStoreFromConfig(&config.BlobstoreArchiver{
+		RootDir:  t.TempDir(),
+		FileMode: "0666",
+		DirMode:  "0766",
+	})
+	require.NoError(t, err)
+	require.IsType(t, &filesystemStore{}, store)
+
+	_, err = NewStoreFromConfig(&config.BlobstoreArchiver{Backend: BackendFilesystem, FileMode: "abc", DirMode: "0766"})
+	require.ErrorIs(t, err, errInvalidFileMode)
+
+	_, err = NewStoreFromConfig(&config.BlobstoreArchiver{Backend: "azure"})
+	require.ErrorIs(t, err, errUnknownBackend)
+}
+
+func TestNewVisibilityArchiverFromConfig(t *testing.T) {
+	container := &archiver.VisibilityBootstrapContainer{Logger: log.NewNoopLogger()}
+	store := NewMemoryStore()
+
+	jsonArchiver, err := NewVisibilityArchiverFromConfig(container, store, &config.BlobstoreArchiver{})
+	require.NoError(t, err)
+	require.IsType(t, &visibilityArchiver{}, jsonArchiver)
+
+	parquetArchiver, err := NewVisibilityArchiverFromConfig(container, store, &config.BlobstoreArchiver{VisibilityFormat: VisibilityFormatParquet})
+	require.NoError(t, err)
+	require.IsType(t, &parquetVisibilityArchiver{}, parquetArchiver)
+	require.Equal(t, DefaultParquetBatchSize, parquetArchiver.(*parquetVisibilityArchiver).batchSize)
+
+	_, err = NewVisibilityArchiverFromConfig(container, store, &config.BlobstoreArchiver{VisibilityFormat: "avro"})
+	require.ErrorIs(t, err, errUnknownVisibilityFormat)
+}
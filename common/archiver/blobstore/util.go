@@ -0,0 +1,184 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package blobstore
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dgryski/go-farm"
+	historypb "go.temporal.io/api/history/v1"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	historyKeySuffix    = ".history"
+	visibilityKeySuffix = ".visibility"
+)
+
+var (
+	errEmptyURIPath = errors.New("URI path is empty")
+)
+
+// Key construction
+
+// keyPrefix maps an archival URI to the key prefix used in the store. The URI host (if any) is
+// treated as the first path segment so both "blobstore:///archive" and "blobstore://bucket/archive"
+// are supported.
+func keyPrefix(URI archiver.URI) string {
+	return strings.Trim(path.Join(URI.Hostname(), URI.Path()), "/")
+}
+
+func validateURI(URI archiver.URI, scheme string) error {
+	if URI.Scheme() != scheme {
+		return archiver.ErrURISchemeMismatch
+	}
+	if keyPrefix(URI) == "" {
+		return errEmptyURIPath
+	}
+	return nil
+}
+
+func constructHistoryKey(prefix, namespaceID, workflowID, runID string, version int64) string {
+	return fmt.Sprintf("%s_%v%s", constructHistoryKeyPrefix(prefix, namespaceID, workflowID, runID), version, historyKeySuffix)
+}
+
+func constructHistoryKeyPrefix(prefix, namespaceID, workflowID, runID string) string {
+	return path.Join(prefix, hash(namespaceID)+hash(workflowID)+hash(runID))
+}
+
+func constructVisibilityKeyPrefix(prefix, namespaceID string) string {
+	return path.Join(prefix, namespaceID) + "/"
+}
+
+// constructVisibilityKey returns a key in the format of prefix/namespaceID/closeTimestamp_hash(runID).visibility,
+// which allows records to be sorted by close time without reading their content.
+func constructVisibilityKey(prefix, namespaceID string, closeTime time.Time, runID string) string {
+	return fmt.Sprintf("%s%v_%s%s", constructVisibilityKeyPrefix(prefix, namespaceID), closeTime.UnixNano(), hash(runID), visibilityKeySuffix)
+}
+
+func hash(s string) string {
+	return fmt.Sprintf("%v", farm.Fingerprint64([]byte(s)))
+}
+
+func extractCloseFailoverVersion(key string) (int64, error) {
+	name := strings.TrimSuffix(path.Base(key), historyKeySuffix)
+	idx := strings.LastIndex(name, "_")
+	if idx == -1 {
+		return 0, fmt.Errorf("unknown history key structure: %s", key)
+	}
+	return strconv.ParseInt(name[idx+1:], 10, 64)
+}
+
+type parsedVisibilityKey struct {
+	key         string
+	closeTime   time.Time
+	hashedRunID string
+}
+
+func parseVisibilityKey(key string) (*parsedVisibilityKey, error) {
+	name := strings.TrimSuffix(path.Base(key), visibilityKeySuffix)
+	pieces := strings.Split(name, "_")
+	if len(pieces) != 2 {
+		return nil, fmt.Errorf("failed to parse visibility key %s", key)
+	}
+	closeTime, err := strconv.ParseInt(pieces[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse visibility key %s", key)
+	}
+	return &parsedVisibilityKey{
+		key:         key,
+		closeTime:   timestamp.UnixOrZeroTime(closeTime),
+		hashedRunID: pieces[1],
+	}, nil
+}
+
+// Encoding & decoding
+
+func encodeHistories(histories []*historypb.History) ([]byte, error) {
+	encoder := codec.NewJSONPBEncoder()
+	return encoder.EncodeHistories(histories)
+}
+
+func decodeHistories(data []byte) ([]*historypb.History, error) {
+	encoder := codec.NewJSONPBEncoder()
+	return encoder.DecodeHistories(data)
+}
+
+func encodeVisibilityRecord(record *archiverspb.VisibilityRecord) ([]byte, error) {
+	return codec.NewJSONPBEncoder().Encode(record)
+}
+
+func decodeVisibilityRecord(data []byte) (*archiverspb.VisibilityRecord, error) {
+	record := &archiverspb.VisibilityRecord{}
+	if err := codec.NewJSONPBEncoder().Decode(data, record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func serializeToken(token interface{}) ([]byte, error) {
+	if token == nil {
+		return nil, nil
+	}
+	return json.Marshal(token)
+}
+
+func deserializeGetHistoryToken(bytes []byte) (*getHistoryToken, error) {
+	token := &getHistoryToken{}
+	err := json.Unmarshal(bytes, token)
+	return token, err
+}
+
+func deserializeQueryVisibilityToken(bytes []byte) (*queryVisibilityToken, error) {
+	token := &queryVisibilityToken{}
+	err := json.Unmarshal(bytes, token)
+	return token, err
+}
+
+// Misc.
+
+func historyMutated(request *archiver.ArchiveHistoryRequest, historyBatches []*historypb.History, isLast bool) bool {
+	lastBatch := historyBatches[len(historyBatches)-1].Events
+	lastEvent := lastBatch[len(lastBatch)-1]
+	lastFailoverVersion := lastEvent.GetVersion()
+	if lastFailoverVersion > request.CloseFailoverVersion {
+		return true
+	}
+
+	if !isLast {
+		return false
+	}
+	lastEventID := lastEvent.GetEventId()
+	return lastFailoverVersion != request.CloseFailoverVersion || lastEventID+1 != request.NextEventID
+}
@@ -0,0 +1,302 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/visibilityquery"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+const (
+	errEncodeVisibilityRecord = "failed to encode visibility record"
+	errWriteVisibilityRecord  = "failed to write visibility record"
+)
+
+type (
+	visibilityArchiver struct {
+		container   *archiver.VisibilityBootstrapContainer
+		store       Store
+		scheme      string
+		queryParser visibilityquery.Parser
+	}
+
+	queryVisibilityToken struct {
+		LastCloseTime time.Time
+		LastRunID     string
+	}
+
+	queryVisibilityRequest struct {
+		namespaceID   string
+		pageSize      int
+		nextPageToken []byte
+		parsedQuery   *visibilityquery.ParsedQuery
+	}
+)
+
+// NewVisibilityArchiver creates a new archiver.VisibilityArchiver which writes to the given store.
+// scheme is the URI scheme accepted by the archiver.
+func NewVisibilityArchiver(
+	container *archiver.VisibilityBootstrapContainer,
+	store Store,
+	scheme string,
+) archiver.VisibilityArchiver {
+	return &visibilityArchiver{
+		container:   container,
+		store:       store,
+		scheme:      scheme,
+		queryParser: visibilityquery.NewParser(),
+	}
+}
+
+func (v *visibilityArchiver) Archive(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiverspb.VisibilityRecord,
+	opts ...archiver.ArchiveOption,
+) (err error) {
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	defer func() {
+		if err != nil && featureCatalog.NonRetryableError != nil {
+			err = featureCatalog.NonRetryableError()
+		}
+	}()
+
+	logger := archiver.TagLoggerWithArchiveVisibilityRequestAndURI(v.container.Logger, request, URI.String())
+
+	if err := v.ValidateURI(URI); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidURI), tag.Error(err))
+		return err
+	}
+
+	if err := archiver.ValidateVisibilityArchivalRequest(request); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidArchiveRequest), tag.Error(err))
+		return err
+	}
+
+	encodedVisibilityRecord, err := encodeVisibilityRecord(request)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeVisibilityRecord), tag.Error(err))
+		return err
+	}
+
+	key := constructVisibilityKey(keyPrefix(URI), request.GetNamespaceId(), request.CloseTime.AsTime(), request.GetRunId())
+	if err := v.store.Put(ctx, key, encodedVisibilityRecord); err != nil {
+		logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteVisibilityRecord), tag.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (v *visibilityArchiver) Query(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.QueryVisibilityRequest,
+	saTypeMap searchattribute.NameTypeMap,
+) (*archiver.QueryVisibilityResponse, error) {
+	if err := v.ValidateURI(URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
+	}
+
+	if err := archiver.ValidateQueryRequest(request); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidQueryVisibilityRequest.Error())
+	}
+
+	parsedQuery, err := v.queryParser.Parse(request.Query)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+
+	if parsedQuery.EmptyResult {
+		return &archiver.QueryVisibilityResponse{}, nil
+	}
+
+	return v.query(
+		ctx,
+		URI,
+		&queryVisibilityRequest{
+			namespaceID:   request.NamespaceID,
+			pageSize:      request.PageSize,
+			nextPageToken: request.NextPageToken,
+			parsedQuery:   parsedQuery,
+		},
+		saTypeMap,
+	)
+}
+
+func (v *visibilityArchiver) query(
+	ctx context.Context,
+	URI archiver.URI,
+	request *queryVisibilityRequest,
+	saTypeMap searchattribute.NameTypeMap,
+) (*archiver.QueryVisibilityResponse, error) {
+	var token *queryVisibilityToken
+	if request.nextPageToken != nil {
+		var err error
+		token, err = deserializeQueryVisibilityToken(request.nextPageToken)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(archiver.ErrNextPageTokenCorrupted.Error())
+		}
+	}
+
+	keys, err := v.store.List(ctx, constructVisibilityKeyPrefix(keyPrefix(URI), request.namespaceID))
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+
+	parsedKeys, err := sortAndFilterKeys(keys, token)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+
+	response := &archiver.QueryVisibilityResponse{}
+	for _, parsedKey := range parsedKeys {
+		// Keys are sorted by close time in descending order, so the close time range of the query
+		// can be applied without reading the records.
+		if parsedKey.closeTime.After(request.parsedQuery.LatestCloseTime) {
+			continue
+		}
+		if parsedKey.closeTime.Before(request.parsedQuery.EarliestCloseTime) {
+			break
+		}
+
+		encodedRecord, err := v.store.Get(ctx, parsedKey.key)
+		if err != nil {
+			if errors.Is(err, ErrBlobNotFound) {
+				// record was deleted after it was listed
+				continue
+			}
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+
+		record, err := decodeVisibilityRecord(encodedRecord)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+
+		if !request.parsedQuery.Match(record) {
+			continue
+		}
+
+		executionInfo, err := convertToExecutionInfo(record, saTypeMap)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		response.Executions = append(response.Executions, executionInfo)
+		if len(response.Executions) == request.pageSize {
+			newToken := &queryVisibilityToken{
+				LastCloseTime: timestamp.TimeValue(record.CloseTime),
+				LastRunID:     record.GetRunId(),
+			}
+			encodedToken, err := serializeToken(newToken)
+			if err != nil {
+				return nil, serviceerror.NewInternal(err.Error())
+			}
+			response.NextPageToken = encodedToken
+			break
+		}
+	}
+
+	return response, nil
+}
+
+func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
+	return validateURI(URI, v.scheme)
+}
+
+// sortAndFilterKeys sorts visibility record keys by close timestamp (desc) and uses the hashed runID to break ties.
+// If a nextPageToken is given, only keys after the last returned record are kept.
+// Keys not written by this archiver, e.g. parquet files, are ignored.
+func sortAndFilterKeys(keys []string, token *queryVisibilityToken) ([]*parsedVisibilityKey, error) {
+	parsedKeys := make([]*parsedVisibilityKey, 0, len(keys))
+	for _, key := range keys {
+		if !strings.HasSuffix(key, visibilityKeySuffix) {
+			continue
+		}
+		parsedKey, err := parseVisibilityKey(key)
+		if err != nil {
+			return nil, err
+		}
+		parsedKeys = append(parsedKeys, parsedKey)
+	}
+
+	sort.Slice(parsedKeys, func(i, j int) bool {
+		if parsedKeys[i].closeTime.Equal(parsedKeys[j].closeTime) {
+			return parsedKeys[i].hashedRunID > parsedKeys[j].hashedRunID
+		}
+		return parsedKeys[i].closeTime.After(parsedKeys[j].closeTime)
+	})
+
+	if token == nil {
+		return parsedKeys, nil
+	}
+
+	lastHashedRunID := hash(token.LastRunID)
+	startIdx := sort.Search(len(parsedKeys), func(i int) bool {
+		if parsedKeys[i].closeTime.Equal(token.LastCloseTime) {
+			return parsedKeys[i].hashedRunID < lastHashedRunID
+		}
+		return parsedKeys[i].closeTime.Before(token.LastCloseTime)
+	})
+	return parsedKeys[startIdx:], nil
+}
+
+func convertToExecutionInfo(record *archiverspb.VisibilityRecord, saTypeMap searchattribute.NameTypeMap) (*workflowpb.WorkflowExecutionInfo, error) {
+	searchAttributes, err := searchattribute.Parse(record.SearchAttributes, &saTypeMap)
+	if err != nil {
+		return nil, err
+	}
+
+	return &workflowpb.WorkflowExecutionInfo{
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: record.GetWorkflowId(),
+			RunId:      record.GetRunId(),
+		},
+		Type: &commonpb.WorkflowType{
+			Name: record.WorkflowTypeName,
+		},
+		StartTime:         record.StartTime,
+		ExecutionTime:     record.ExecutionTime,
+		CloseTime:         record.CloseTime,
+		ExecutionDuration: record.ExecutionDuration,
+		Status:            record.Status,
+		HistoryLength:     record.HistoryLength,
+		Memo:              record.Memo,
+		SearchAttributes:  searchAttributes,
+	}, nil
+}
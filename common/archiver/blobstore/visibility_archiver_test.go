@@ -0,0 +1,182 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package blobstore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/searchattribute"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type visibilityArchiverSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	container       *archiver.VisibilityBootstrapContainer
+	testArchivalURI archiver.URI
+	baseTime        time.Time
+}
+
+func TestVisibilityArchiverSuite(t *testing.T) {
+	suite.Run(t, new(visibilityArchiverSuite))
+}
+
+func (s *visibilityArchiverSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.container = &archiver.VisibilityBootstrapContainer{
+		Logger: log.NewNoopLogger(),
+	}
+
+	var err error
+	s.testArchivalURI, err = archiver.NewURI("blobstore:///visibility")
+	s.NoError(err)
+	s.baseTime = time.Date(2020, 8, 22, 1, 2, 3, 0, time.UTC)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_InvalidRequest() {
+	visibilityArchiver := NewVisibilityArchiver(s.container, NewMemoryStore(), URIScheme)
+	err := visibilityArchiver.Archive(context.Background(), s.testArchivalURI, &archiverspb.VisibilityRecord{})
+	s.Error(err)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
+	visibilityArchiver := NewVisibilityArchiver(s.container, NewMemoryStore(), URIScheme)
+	_, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    1,
+		Query:       "some invalid query",
+	}, searchattribute.TestNameTypeMap)
+	var invalidArg *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArg)
+}
+
+func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
+	backends := map[string]Store{
+		BackendMemory:     NewMemoryStore(),
+		BackendFilesystem: NewFilesystemStore(s.T().TempDir(), 0666, 0766),
+	}
+	for name, store := range backends {
+		s.Run(name, func() {
+			visibilityArchiver := NewVisibilityArchiver(s.container, store, URIScheme)
+			for i := 0; i < 5; i++ {
+				status := enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+				if i%2 == 1 {
+					status = enumspb.WORKFLOW_EXECUTION_STATUS_FAILED
+				}
+				s.NoError(visibilityArchiver.Archive(context.Background(), s.testArchivalURI, s.newRecord(i, status)))
+			}
+			// record of a different namespace must never be returned
+			otherRecord := s.newRecord(10, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+			otherRecord.NamespaceId = "other-namespace-id"
+			s.NoError(visibilityArchiver.Archive(context.Background(), s.testArchivalURI, otherRecord))
+
+			// all records are returned in descending close time order across pages
+			var runIDs []string
+			request := &archiver.QueryVisibilityRequest{
+				NamespaceID: testNamespaceID,
+				PageSize:    2,
+			}
+			for {
+				response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request, searchattribute.TestNameTypeMap)
+				s.NoError(err)
+				for _, execution := range response.Executions {
+					runIDs = append(runIDs, execution.GetExecution().GetRunId())
+				}
+				if response.NextPageToken == nil {
+					break
+				}
+				request.NextPageToken = response.NextPageToken
+			}
+			s.Equal([]string{"run-4", "run-3", "run-2", "run-1", "run-0"}, runIDs)
+
+			response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
+				NamespaceID: testNamespaceID,
+				PageSize:    10,
+				Query: fmt.Sprintf(
+					"ExecutionStatus = 'Failed' AND CloseTime <= '%s'",
+					s.baseTime.Add(2*time.Hour).Format(time.RFC3339),
+				),
+			}, searchattribute.TestNameTypeMap)
+			s.NoError(err)
+			s.Len(response.Executions, 1)
+			s.Equal("run-1", response.Executions[0].GetExecution().GetRunId())
+			s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED, response.Executions[0].GetStatus())
+		})
+	}
+}
+
+func (s *visibilityArchiverSuite) TestSortAndFilterKeys() {
+	prefix := constructVisibilityKeyPrefix("visibility", testNamespaceID)
+	keys := []string{
+		constructVisibilityKey("visibility", testNamespaceID, s.baseTime, "run-0"),
+		constructVisibilityKey("visibility", testNamespaceID, s.baseTime.Add(time.Hour), "run-1"),
+		constructVisibilityKey("visibility", testNamespaceID, s.baseTime.Add(2*time.Hour), "run-2"),
+	}
+	s.Equal("visibility/"+testNamespaceID+"/", prefix)
+
+	parsedKeys, err := sortAndFilterKeys(keys, nil)
+	s.NoError(err)
+	s.Len(parsedKeys, 3)
+	s.Equal(keys[2], parsedKeys[0].key)
+	s.Equal(keys[0], parsedKeys[2].key)
+
+	parsedKeys, err = sortAndFilterKeys(keys, &queryVisibilityToken{
+		LastCloseTime: s.baseTime.Add(time.Hour),
+		LastRunID:     "run-1",
+	})
+	s.NoError(err)
+	s.Len(parsedKeys, 1)
+	s.Equal(keys[0], parsedKeys[0].key)
+
+	_, err = sortAndFilterKeys([]string{"visibility/invalid" + visibilityKeySuffix}, nil)
+	s.Error(err)
+}
+
+func (s *visibilityArchiverSuite) newRecord(i int, status enumspb.WorkflowExecutionStatus) *archiverspb.VisibilityRecord {
+	closeTime := s.baseTime.Add(time.Duration(i) * time.Hour)
+	return &archiverspb.VisibilityRecord{
+		NamespaceId:      testNamespaceID,
+		Namespace:        testNamespace,
+		WorkflowId:       testWorkflowID,
+		RunId:            fmt.Sprintf("run-%d", i),
+		WorkflowTypeName: "test-workflow-type",
+		StartTime:        timestamppb.New(closeTime.Add(-time.Minute)),
+		ExecutionTime:    timestamppb.New(closeTime.Add(-time.Minute)),
+		CloseTime:        timestamppb.New(closeTime),
+		Status:           status,
+		HistoryLength:    int64(i),
+	}
+}
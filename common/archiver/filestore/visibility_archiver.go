@@ -39,6 +39,7 @@ import (
 	workflowpb "go.temporal.io/api/workflow/v1"
 	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/visibilityquery"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -54,7 +55,7 @@ type (
 		container   *archiver.VisibilityBootstrapContainer
 		fileMode    os.FileMode
 		dirMode     os.FileMode
-		queryParser QueryParser
+		queryParser visibilityquery.Parser
 	}
 
 	queryVisibilityToken struct {
@@ -66,7 +67,7 @@ type (
 		namespaceID   string
 		pageSize      int
 		nextPageToken []byte
-		parsedQuery   *parsedQuery
+		parsedQuery   *visibilityquery.ParsedQuery
 	}
 )
 
@@ -87,7 +88,7 @@ func NewVisibilityArchiver(
 		container:   container,
 		fileMode:    os.FileMode(fileMode),
 		dirMode:     os.FileMode(dirMode),
-		queryParser: NewQueryParser(),
+		queryParser: visibilityquery.NewParser(),
 	}, nil
 }
 
@@ -158,7 +159,7 @@ func (v *visibilityArchiver) Query(
 		return nil, serviceerror.NewInvalidArgument(err.Error())
 	}
 
-	if parsedQuery.emptyResult {
+	if parsedQuery.EmptyResult {
 		return &archiver.QueryVisibilityResponse{}, nil
 	}
 
@@ -224,11 +225,11 @@ func (v *visibilityArchiver) query(
 			return nil, serviceerror.NewInternal(err.Error())
 		}
 
-		if record.CloseTime.AsTime().Before(request.parsedQuery.earliestCloseTime) {
+		if record.CloseTime.AsTime().Before(request.parsedQuery.EarliestCloseTime) {
 			break
 		}
 
-		if matchQuery(record, request.parsedQuery) {
+		if request.parsedQuery.Match(record) {
 			executionInfo, err := convertToExecutionInfo(record, saTypeMap)
 			if err != nil {
 				return nil, serviceerror.NewInternal(err.Error())
@@ -321,26 +322,6 @@ func sortAndFilterFiles(filenames []string, token *queryVisibilityToken) ([]stri
 	return filteredFilenames, nil
 }
 
-func matchQuery(record *archiverspb.VisibilityRecord, query *parsedQuery) bool {
-	closeTime := record.CloseTime.AsTime()
-	if closeTime.Before(query.earliestCloseTime) || closeTime.After(query.latestCloseTime) {
-		return false
-	}
-	if query.workflowID != nil && record.GetWorkflowId() != *query.workflowID {
-		return false
-	}
-	if query.runID != nil && record.GetRunId() != *query.runID {
-		return false
-	}
-	if query.workflowTypeName != nil && record.WorkflowTypeName != *query.workflowTypeName {
-		return false
-	}
-	if query.status != nil && record.Status != *query.status {
-		return false
-	}
-	return true
-}
-
 func convertToExecutionInfo(record *archiverspb.VisibilityRecord, saTypeMap searchattribute.NameTypeMap) (*workflowpb.WorkflowExecutionInfo, error) {
 	searchAttributes, err := searchattribute.Parse(record.SearchAttributes, &saTypeMap)
 	if err != nil {
@@ -40,6 +40,7 @@ import (
 	workflowpb "go.temporal.io/api/workflow/v1"
 	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/visibilityquery"
 	"go.temporal.io/server/common/codec"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
@@ -208,90 +209,6 @@ func (s *visibilityArchiverSuite) TestArchive_Success() {
 	s.Equal(request, archivedRecord)
 }
 
-func (s *visibilityArchiverSuite) TestMatchQuery() {
-	testCases := []struct {
-		query       *parsedQuery
-		record      *archiverspb.VisibilityRecord
-		shouldMatch bool
-	}{
-		{
-			query: &parsedQuery{
-				earliestCloseTime: time.Unix(0, 1000),
-				latestCloseTime:   time.Unix(0, 12345),
-			},
-			record: &archiverspb.VisibilityRecord{
-				CloseTime: timestamp.UnixOrZeroTimePtr(1999),
-			},
-			shouldMatch: true,
-		},
-		{
-			query: &parsedQuery{
-				earliestCloseTime: time.Unix(0, 1000),
-				latestCloseTime:   time.Unix(0, 12345),
-			},
-			record: &archiverspb.VisibilityRecord{
-				CloseTime: timestamp.UnixOrZeroTimePtr(999),
-			},
-			shouldMatch: false,
-		},
-		{
-			query: &parsedQuery{
-				earliestCloseTime: time.Unix(0, 1000),
-				latestCloseTime:   time.Unix(0, 12345),
-				workflowID:        util.Ptr("random workflowID"),
-			},
-			record: &archiverspb.VisibilityRecord{
-				CloseTime: timestamp.UnixOrZeroTimePtr(2000),
-			},
-			shouldMatch: false,
-		},
-		{
-			query: &parsedQuery{
-				earliestCloseTime: time.Unix(0, 1000),
-				latestCloseTime:   time.Unix(0, 12345),
-				workflowID:        util.Ptr("random workflowID"),
-				runID:             util.Ptr("random runID"),
-			},
-			record: &archiverspb.VisibilityRecord{
-				CloseTime:        timestamp.UnixOrZeroTimePtr(12345),
-				WorkflowId:       "random workflowID",
-				RunId:            "random runID",
-				WorkflowTypeName: "random type name",
-			},
-			shouldMatch: true,
-		},
-		{
-			query: &parsedQuery{
-				earliestCloseTime: time.Unix(0, 1000),
-				latestCloseTime:   time.Unix(0, 12345),
-				workflowTypeName:  util.Ptr("some random type name"),
-			},
-			record: &archiverspb.VisibilityRecord{
-				CloseTime: timestamp.UnixOrZeroTimePtr(12345),
-			},
-			shouldMatch: false,
-		},
-		{
-			query: &parsedQuery{
-				earliestCloseTime: time.Unix(0, 1000),
-				latestCloseTime:   time.Unix(0, 12345),
-				workflowTypeName:  util.Ptr("some random type name"),
-				status:            toWorkflowExecutionStatusPtr(enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW),
-			},
-			record: &archiverspb.VisibilityRecord{
-				CloseTime:        timestamp.UnixOrZeroTimePtr(12345),
-				Status:           enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW,
-				WorkflowTypeName: "some random type name",
-			},
-			shouldMatch: true,
-		},
-	}
-
-	for _, tc := range testCases {
-		s.Equal(tc.shouldMatch, matchQuery(tc.record, tc.query))
-	}
-}
-
 func (s *visibilityArchiverSuite) TestSortAndFilterFiles() {
 	testCases := []struct {
 		filenames      []string
@@ -354,7 +271,7 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidRequest() {
 
 func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
+	mockParser := visibilityquery.NewMockParser(s.controller)
 	mockParser.EXPECT().Parse(gomock.Any()).Return(nil, errors.New("invalid query"))
 	visibilityArchiver.queryParser = mockParser
 	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
@@ -368,10 +285,10 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 
 func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		earliestCloseTime: time.Unix(0, 1),
-		latestCloseTime:   time.Unix(0, 101),
+	mockParser := visibilityquery.NewMockParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&visibilityquery.ParsedQuery{
+		EarliestCloseTime: time.Unix(0, 1),
+		LatestCloseTime:   time.Unix(0, 101),
 	}, nil)
 	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
@@ -388,10 +305,10 @@ func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 
 func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidToken() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		earliestCloseTime: time.Unix(0, 1),
-		latestCloseTime:   time.Unix(0, 101),
+	mockParser := visibilityquery.NewMockParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&visibilityquery.ParsedQuery{
+		EarliestCloseTime: time.Unix(0, 1),
+		LatestCloseTime:   time.Unix(0, 101),
 	}, nil)
 	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
@@ -407,11 +324,11 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidToken() {
 
 func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		earliestCloseTime: time.Unix(0, 1),
-		latestCloseTime:   time.Unix(0, 10001),
-		workflowID:        util.Ptr(testWorkflowID),
+	mockParser := visibilityquery.NewMockParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&visibilityquery.ParsedQuery{
+		EarliestCloseTime: time.Unix(0, 1),
+		LatestCloseTime:   time.Unix(0, 10001),
+		WorkflowID:        util.Ptr(testWorkflowID),
 	}, nil)
 	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
@@ -433,11 +350,11 @@ func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 
 func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		earliestCloseTime: time.Unix(0, 1),
-		latestCloseTime:   time.Unix(0, 10001),
-		status:            toWorkflowExecutionStatusPtr(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
+	mockParser := visibilityquery.NewMockParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&visibilityquery.ParsedQuery{
+		EarliestCloseTime: time.Unix(0, 1),
+		LatestCloseTime:   time.Unix(0, 10001),
+		Status:            toWorkflowExecutionStatusPtr(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
 	}, nil).AnyTimes()
 	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
@@ -474,11 +391,11 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	dir := testutils.MkdirTemp(s.T(), "", "TestArchiveAndQuery")
 
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		earliestCloseTime: time.Unix(0, 10),
-		latestCloseTime:   time.Unix(0, 10001),
-		status:            toWorkflowExecutionStatusPtr(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
+	mockParser := visibilityquery.NewMockParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&visibilityquery.ParsedQuery{
+		EarliestCloseTime: time.Unix(0, 10),
+		LatestCloseTime:   time.Unix(0, 10001),
+		Status:            toWorkflowExecutionStatusPtr(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
 	}, nil).AnyTimes()
 	visibilityArchiver.queryParser = mockParser
 	URI, err := archiver.NewURI("file://" + dir)
@@ -514,11 +431,11 @@ func (s *visibilityArchiverSuite) TestQuery_EmptyQuery_InvalidNamespace() {
 	URI := s.testArchivalURI
 
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		earliestCloseTime: time.Unix(0, 10),
-		latestCloseTime:   time.Unix(0, 10001),
-		status:            toWorkflowExecutionStatusPtr(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
+	mockParser := visibilityquery.NewMockParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&visibilityquery.ParsedQuery{
+		EarliestCloseTime: time.Unix(0, 10),
+		LatestCloseTime:   time.Unix(0, 10001),
+		Status:            toWorkflowExecutionStatusPtr(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
 	}, nil).AnyTimes()
 	visibilityArchiver.queryParser = mockParser
 	req := &archiver.QueryVisibilityRequest{
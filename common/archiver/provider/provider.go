@@ -31,6 +31,7 @@ import (
 	"sync"
 
 	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/blobstore"
 	"go.temporal.io/server/common/archiver/filestore"
 	"go.temporal.io/server/common/archiver/gcloud"
 	"go.temporal.io/server/common/archiver/s3store"
@@ -76,6 +77,10 @@ type (
 		// Key for the archiver is scheme + serviceName
 		historyArchivers    map[string]archiver.HistoryArchiver
 		visibilityArchivers map[string]archiver.VisibilityArchiver
+
+		// Blob stores are shared by all archivers created from the same config,
+		// so that history and visibility archived by different services end up in the same store.
+		blobStores map[*config.BlobstoreArchiver]blobstore.Store
 	}
 )
 
@@ -91,6 +96,7 @@ func NewArchiverProvider(
 		visibilityContainers:      make(map[string]*archiver.VisibilityBootstrapContainer),
 		historyArchivers:          make(map[string]archiver.HistoryArchiver),
 		visibilityArchivers:       make(map[string]archiver.VisibilityArchiver),
+		blobStores:                make(map[*config.BlobstoreArchiver]blobstore.Store),
 	}
 }
 
@@ -156,6 +162,15 @@ func (p *archiverProvider) GetHistoryArchiver(scheme, serviceName string) (histo
 			return nil, ErrArchiverConfigNotFound
 		}
 		historyArchiver, err = s3store.NewHistoryArchiver(container, p.historyArchiverConfigs.S3store)
+
+	case blobstore.URIScheme:
+		if p.historyArchiverConfigs.Blobstore == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		var store blobstore.Store
+		if store, err = p.getBlobStore(p.historyArchiverConfigs.Blobstore); err == nil {
+			historyArchiver = blobstore.NewHistoryArchiver(container, store, blobstore.URIScheme)
+		}
 	default:
 		return nil, ErrUnknownScheme
 	}
@@ -206,6 +221,14 @@ func (p *archiverProvider) GetVisibilityArchiver(scheme, serviceName string) (ar
 			return nil, ErrArchiverConfigNotFound
 		}
 		visibilityArchiver, err = gcloud.NewVisibilityArchiver(container, p.visibilityArchiverConfigs.Gstorage)
+	case blobstore.URIScheme:
+		if p.visibilityArchiverConfigs.Blobstore == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		var store blobstore.Store
+		if store, err = p.getBlobStore(p.visibilityArchiverConfigs.Blobstore); err == nil {
+			visibilityArchiver, err = blobstore.NewVisibilityArchiverFromConfig(container, store, p.visibilityArchiverConfigs.Blobstore)
+		}
 
 	default:
 		return nil, ErrUnknownScheme
@@ -224,6 +247,21 @@ func (p *archiverProvider) GetVisibilityArchiver(scheme, serviceName string) (ar
 
 }
 
+func (p *archiverProvider) getBlobStore(cfg *config.BlobstoreArchiver) (blobstore.Store, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	if store, ok := p.blobStores[cfg]; ok {
+		return store, nil
+	}
+	store, err := blobstore.NewStoreFromConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	p.blobStores[cfg] = store
+	return store, nil
+}
+
 func (p *archiverProvider) getArchiverKey(scheme, serviceName string) string {
 	return scheme + ":" + serviceName
 }
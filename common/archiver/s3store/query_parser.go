@@ -31,8 +31,7 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/temporalio/sqlparser"
-	"go.temporal.io/server/common/sqlquery"
+	"go.temporal.io/server/common/archiver/visibilityquery"
 	"go.temporal.io/server/common/util"
 )
 
@@ -56,33 +55,30 @@ type (
 // All allowed fields for filtering
 const (
 	WorkflowTypeName = "WorkflowTypeName"
-	WorkflowID       = "WorkflowId"
-	StartTime        = "StartTime"
-	CloseTime        = "CloseTime"
-	SearchPrecision  = "SearchPrecision"
+	WorkflowID       = visibilityquery.WorkflowID
+	StartTime        = visibilityquery.StartTime
+	CloseTime        = visibilityquery.CloseTime
+	SearchPrecision  = visibilityquery.SearchPrecision
 )
 
 // Precision specific values
 const (
-	PrecisionDay    = "Day"
-	PrecisionHour   = "Hour"
-	PrecisionMinute = "Minute"
-	PrecisionSecond = "Second"
+	PrecisionDay    = visibilityquery.PrecisionDay
+	PrecisionHour   = visibilityquery.PrecisionHour
+	PrecisionMinute = visibilityquery.PrecisionMinute
+	PrecisionSecond = visibilityquery.PrecisionSecond
 )
 
-// NewQueryParser creates a new query parser for filestore
+// NewQueryParser creates a new query parser for s3store, on top of the shared visibility query engine
 func NewQueryParser() QueryParser {
 	return &queryParser{}
 }
 
 func (p *queryParser) Parse(query string) (*parsedQuery, error) {
-	stmt, err := sqlparser.Parse(fmt.Sprintf(sqlquery.QueryTemplate, query))
-	if err != nil {
-		return nil, err
-	}
-	whereExpr := stmt.(*sqlparser.Select).Where.Expr
 	parsedQuery := &parsedQuery{}
-	if err := p.convertWhereExpr(whereExpr, parsedQuery); err != nil {
+	if err := visibilityquery.WalkComparisons(query, func(comparison visibilityquery.Comparison) error {
+		return p.convertComparison(comparison, parsedQuery)
+	}); err != nil {
 		return nil, err
 	}
 	if parsedQuery.workflowID == nil && parsedQuery.workflowTypeName == nil {
@@ -104,113 +100,49 @@ func (p *queryParser) Parse(query string) (*parsedQuery, error) {
 	return parsedQuery, nil
 }
 
-func (p *queryParser) convertWhereExpr(expr sqlparser.Expr, parsedQuery *parsedQuery) error {
-	if expr == nil {
-		return errors.New("where expression is nil")
-	}
-
-	switch expr := expr.(type) {
-	case *sqlparser.ComparisonExpr:
-		return p.convertComparisonExpr(expr, parsedQuery)
-	case *sqlparser.AndExpr:
-		return p.convertAndExpr(expr, parsedQuery)
-	case *sqlparser.ParenExpr:
-		return p.convertParenExpr(expr, parsedQuery)
-	default:
-		return errors.New("only comparison and \"and\" expression is supported")
-	}
-}
-
-func (p *queryParser) convertParenExpr(parenExpr *sqlparser.ParenExpr, parsedQuery *parsedQuery) error {
-	return p.convertWhereExpr(parenExpr.Expr, parsedQuery)
-}
-
-func (p *queryParser) convertAndExpr(andExpr *sqlparser.AndExpr, parsedQuery *parsedQuery) error {
-	if err := p.convertWhereExpr(andExpr.Left, parsedQuery); err != nil {
-		return err
-	}
-	return p.convertWhereExpr(andExpr.Right, parsedQuery)
-}
-
-func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr, parsedQuery *parsedQuery) error {
-	colName, ok := compExpr.Left.(*sqlparser.ColName)
-	if !ok {
-		return fmt.Errorf("invalid filter name: %s", sqlparser.String(compExpr.Left))
-	}
-	colNameStr := sqlparser.String(colName)
-	op := compExpr.Operator
-	valExpr, ok := compExpr.Right.(*sqlparser.SQLVal)
-	if !ok {
-		return fmt.Errorf("invalid value: %s", sqlparser.String(compExpr.Right))
-	}
-	valStr := sqlparser.String(valExpr)
-
-	switch colNameStr {
+func (p *queryParser) convertComparison(comparison visibilityquery.Comparison, parsedQuery *parsedQuery) error {
+	switch comparison.Field {
 	case WorkflowTypeName:
-		val, err := sqlquery.ExtractStringValue(valStr)
+		val, err := comparison.EqualString()
 		if err != nil {
 			return err
 		}
-		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", WorkflowTypeName)
-		}
 		if parsedQuery.workflowTypeName != nil {
 			return fmt.Errorf("can not query %s multiple times", WorkflowTypeName)
 		}
 		parsedQuery.workflowTypeName = util.Ptr(val)
 	case WorkflowID:
-		val, err := sqlquery.ExtractStringValue(valStr)
+		val, err := comparison.EqualString()
 		if err != nil {
 			return err
 		}
-		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", WorkflowID)
-		}
 		if parsedQuery.workflowID != nil {
 			return fmt.Errorf("can not query %s multiple times", WorkflowID)
 		}
 		parsedQuery.workflowID = util.Ptr(val)
 	case CloseTime:
-		timestamp, err := sqlquery.ConvertToTime(valStr)
+		timestamp, err := comparison.EqualTime()
 		if err != nil {
 			return err
 		}
-		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", CloseTime)
-		}
 		parsedQuery.closeTime = &timestamp
 	case StartTime:
-		timestamp, err := sqlquery.ConvertToTime(valStr)
+		timestamp, err := comparison.EqualTime()
 		if err != nil {
 			return err
 		}
-		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", CloseTime)
-		}
 		parsedQuery.startTime = &timestamp
 	case SearchPrecision:
-		val, err := sqlquery.ExtractStringValue(valStr)
+		val, err := comparison.EqualSearchPrecision()
 		if err != nil {
 			return err
 		}
-		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", SearchPrecision)
-		}
 		if parsedQuery.searchPrecision != nil && *parsedQuery.searchPrecision != val {
 			return fmt.Errorf("only one expression is allowed for %s", SearchPrecision)
 		}
-		switch val {
-		case PrecisionDay:
-		case PrecisionHour:
-		case PrecisionMinute:
-		case PrecisionSecond:
-		default:
-			return fmt.Errorf("invalid value for %s: %s", SearchPrecision, val)
-		}
 		parsedQuery.searchPrecision = util.Ptr(val)
-
 	default:
-		return fmt.Errorf("unknown filter name: %s", colNameStr)
+		return fmt.Errorf("unknown filter name: %s", comparison.Field)
 	}
 
 	return nil
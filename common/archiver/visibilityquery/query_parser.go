@@ -0,0 +1,324 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../../LICENSE -package $GOPACKAGE -source query_parser.go -destination query_parser_mock.go -mock_names Parser=MockParser
+
+// Package visibilityquery is the query engine shared by the visibility archivers. It parses the limited SQL where
+// clause that archivers accept, and matches archived visibility records against the parsed filter.
+package visibilityquery
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/temporalio/sqlparser"
+	enumspb "go.temporal.io/api/enums/v1"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/sqlquery"
+	"go.temporal.io/server/common/util"
+)
+
+type (
+	// Parser parses a limited SQL where clause into a struct
+	Parser interface {
+		Parse(query string) (*ParsedQuery, error)
+	}
+
+	parser struct{}
+
+	// ParsedQuery is the filter of a visibility archiver query, matched against archived visibility records
+	ParsedQuery struct {
+		EarliestCloseTime time.Time
+		LatestCloseTime   time.Time
+		WorkflowID        *string
+		RunID             *string
+		WorkflowTypeName  *string
+		Status            *enumspb.WorkflowExecutionStatus
+		EmptyResult       bool
+	}
+
+	// Comparison is a single "<field> <operator> <value>" filter of a query
+	Comparison struct {
+		Field    string
+		Operator string
+		// Value is the literal as written in the query, string values are still quoted
+		Value string
+	}
+)
+
+// All allowed fields for filtering
+const (
+	WorkflowID   = "WorkflowId"
+	RunID        = "RunId"
+	WorkflowType = "WorkflowType"
+	StartTime    = "StartTime"
+	CloseTime    = "CloseTime"
+	// Field name can't be just "Status" because it is reserved keyword in MySQL parser.
+	ExecutionStatus = "ExecutionStatus"
+	// SearchPrecision is the granularity of a StartTime or CloseTime equality, for archivers that index records by time
+	SearchPrecision = "SearchPrecision"
+)
+
+// Precision specific values
+const (
+	PrecisionDay    = "Day"
+	PrecisionHour   = "Hour"
+	PrecisionMinute = "Minute"
+	PrecisionSecond = "Second"
+)
+
+// NewParser creates a new query parser shared by the file based visibility archivers
+func NewParser() Parser {
+	return &parser{}
+}
+
+func (p *parser) Parse(query string) (*ParsedQuery, error) {
+	parsedQuery := &ParsedQuery{
+		EarliestCloseTime: time.Time{},
+		LatestCloseTime:   time.Now().UTC(),
+	}
+	if strings.TrimSpace(query) == "" {
+		return parsedQuery, nil
+	}
+	if err := WalkComparisons(query, func(comparison Comparison) error {
+		return p.convertComparison(comparison, parsedQuery)
+	}); err != nil {
+		return nil, err
+	}
+	return parsedQuery, nil
+}
+
+func (p *parser) convertComparison(comparison Comparison, parsedQuery *ParsedQuery) error {
+	switch comparison.Field {
+	case WorkflowID:
+		val, err := comparison.EqualString()
+		if err != nil {
+			return err
+		}
+		if parsedQuery.WorkflowID != nil && *parsedQuery.WorkflowID != val {
+			parsedQuery.EmptyResult = true
+			return nil
+		}
+		parsedQuery.WorkflowID = util.Ptr(val)
+	case RunID:
+		val, err := comparison.EqualString()
+		if err != nil {
+			return err
+		}
+		if parsedQuery.RunID != nil && *parsedQuery.RunID != val {
+			parsedQuery.EmptyResult = true
+			return nil
+		}
+		parsedQuery.RunID = util.Ptr(val)
+	case WorkflowType:
+		val, err := comparison.EqualString()
+		if err != nil {
+			return err
+		}
+		if parsedQuery.WorkflowTypeName != nil && *parsedQuery.WorkflowTypeName != val {
+			parsedQuery.EmptyResult = true
+			return nil
+		}
+		parsedQuery.WorkflowTypeName = util.Ptr(val)
+	case ExecutionStatus:
+		val, err := sqlquery.ExtractStringValue(comparison.Value)
+		if err != nil {
+			// if failed to extract string value, it means user input close status as a number
+			val = comparison.Value
+		}
+		if comparison.Operator != "=" {
+			return fmt.Errorf("only operation = is support for %s", ExecutionStatus)
+		}
+		status, err := convertStatusStr(val)
+		if err != nil {
+			return err
+		}
+		if parsedQuery.Status != nil && *parsedQuery.Status != status {
+			parsedQuery.EmptyResult = true
+			return nil
+		}
+		parsedQuery.Status = &status
+	case CloseTime:
+		timestamp, err := sqlquery.ConvertToTime(comparison.Value)
+		if err != nil {
+			return err
+		}
+		return p.convertCloseTime(timestamp, comparison.Operator, parsedQuery)
+	default:
+		return fmt.Errorf("unknown filter name: %s", comparison.Field)
+	}
+
+	return nil
+}
+
+func (p *parser) convertCloseTime(timestamp time.Time, op string, parsedQuery *ParsedQuery) error {
+	switch op {
+	case "=":
+		if err := p.convertCloseTime(timestamp, ">=", parsedQuery); err != nil {
+			return err
+		}
+		if err := p.convertCloseTime(timestamp, "<=", parsedQuery); err != nil {
+			return err
+		}
+	case "<":
+		parsedQuery.LatestCloseTime = util.MinTime(parsedQuery.LatestCloseTime, timestamp.Add(-1*time.Nanosecond))
+	case "<=":
+		parsedQuery.LatestCloseTime = util.MinTime(parsedQuery.LatestCloseTime, timestamp)
+	case ">":
+		parsedQuery.EarliestCloseTime = util.MaxTime(parsedQuery.EarliestCloseTime, timestamp.Add(1*time.Nanosecond))
+	case ">=":
+		parsedQuery.EarliestCloseTime = util.MaxTime(parsedQuery.EarliestCloseTime, timestamp)
+	default:
+		return fmt.Errorf("operator %s is not supported for close time", op)
+	}
+	return nil
+}
+
+// Match reports whether an archived visibility record passes the filter of the query.
+func (q *ParsedQuery) Match(record *archiverspb.VisibilityRecord) bool {
+	closeTime := record.CloseTime.AsTime()
+	if closeTime.Before(q.EarliestCloseTime) || closeTime.After(q.LatestCloseTime) {
+		return false
+	}
+	if q.WorkflowID != nil && record.GetWorkflowId() != *q.WorkflowID {
+		return false
+	}
+	if q.RunID != nil && record.GetRunId() != *q.RunID {
+		return false
+	}
+	if q.WorkflowTypeName != nil && record.WorkflowTypeName != *q.WorkflowTypeName {
+		return false
+	}
+	if q.Status != nil && record.Status != *q.Status {
+		return false
+	}
+	return true
+}
+
+// WalkComparisons parses a where clause made of comparisons joined by "and", and calls convert with every
+// comparison in the order they appear in the query. Archivers with their own filter semantics build on it.
+func WalkComparisons(query string, convert func(Comparison) error) error {
+	stmt, err := sqlparser.Parse(fmt.Sprintf(sqlquery.QueryTemplate, query))
+	if err != nil {
+		return err
+	}
+	return walkWhereExpr(stmt.(*sqlparser.Select).Where.Expr, convert)
+}
+
+func walkWhereExpr(expr sqlparser.Expr, convert func(Comparison) error) error {
+	if expr == nil {
+		return errors.New("where expression is nil")
+	}
+
+	switch expr := expr.(type) {
+	case *sqlparser.ComparisonExpr:
+		return walkComparisonExpr(expr, convert)
+	case *sqlparser.AndExpr:
+		if err := walkWhereExpr(expr.Left, convert); err != nil {
+			return err
+		}
+		return walkWhereExpr(expr.Right, convert)
+	case *sqlparser.ParenExpr:
+		return walkWhereExpr(expr.Expr, convert)
+	default:
+		return errors.New("only comparison and \"and\" expression is supported")
+	}
+}
+
+func walkComparisonExpr(compExpr *sqlparser.ComparisonExpr, convert func(Comparison) error) error {
+	colName, ok := compExpr.Left.(*sqlparser.ColName)
+	if !ok {
+		return fmt.Errorf("invalid filter name: %s", sqlparser.String(compExpr.Left))
+	}
+	valExpr, ok := compExpr.Right.(*sqlparser.SQLVal)
+	if !ok {
+		return fmt.Errorf("invalid value: %s", sqlparser.String(compExpr.Right))
+	}
+	return convert(Comparison{
+		Field:    sqlparser.String(colName),
+		Operator: compExpr.Operator,
+		Value:    sqlparser.String(valExpr),
+	})
+}
+
+// EqualString returns the string value of an equality comparison.
+func (c Comparison) EqualString() (string, error) {
+	val, err := sqlquery.ExtractStringValue(c.Value)
+	if err != nil {
+		return "", err
+	}
+	if c.Operator != "=" {
+		return "", fmt.Errorf("only operation = is support for %s", c.Field)
+	}
+	return val, nil
+}
+
+// EqualTime returns the time value of an equality comparison.
+func (c Comparison) EqualTime() (time.Time, error) {
+	timestamp, err := sqlquery.ConvertToTime(c.Value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if c.Operator != "=" {
+		return time.Time{}, fmt.Errorf("only operation = is support for %s", c.Field)
+	}
+	return timestamp, nil
+}
+
+// EqualSearchPrecision returns the value of a SearchPrecision equality comparison, one of the Precision values.
+func (c Comparison) EqualSearchPrecision() (string, error) {
+	val, err := c.EqualString()
+	if err != nil {
+		return "", err
+	}
+	switch val {
+	case PrecisionDay, PrecisionHour, PrecisionMinute, PrecisionSecond:
+		return val, nil
+	default:
+		return "", fmt.Errorf("invalid value for %s: %s", SearchPrecision, val)
+	}
+}
+
+func convertStatusStr(statusStr string) (enumspb.WorkflowExecutionStatus, error) {
+	statusStr = strings.ToLower(strings.TrimSpace(statusStr))
+	switch statusStr {
+	case "completed", convert.Int32ToString(int32(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)):
+		return enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, nil
+	case "failed", convert.Int32ToString(int32(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED)):
+		return enumspb.WORKFLOW_EXECUTION_STATUS_FAILED, nil
+	case "canceled", convert.Int32ToString(int32(enumspb.WORKFLOW_EXECUTION_STATUS_CANCELED)):
+		return enumspb.WORKFLOW_EXECUTION_STATUS_CANCELED, nil
+	case "terminated", convert.Int32ToString(int32(enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED)):
+		return enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED, nil
+	case "continuedasnew", "continued_as_new", convert.Int32ToString(int32(enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW)):
+		return enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW, nil
+	case "timedout", "timed_out", convert.Int32ToString(int32(enumspb.WORKFLOW_EXECUTION_STATUS_TIMED_OUT)):
+		return enumspb.WORKFLOW_EXECUTION_STATUS_TIMED_OUT, nil
+	default:
+		return 0, fmt.Errorf("unknown workflow close status: %s", statusStr)
+	}
+}
@@ -0,0 +1,480 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilityquery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/util"
+)
+
+type queryParserSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	parser Parser
+}
+
+func TestQueryParserSuite(t *testing.T) {
+	suite.Run(t, new(queryParserSuite))
+}
+
+func (s *queryParserSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.parser = NewParser()
+}
+
+func (s *queryParserSuite) TestParseWorkflowID_RunID_WorkflowType() {
+	testCases := []struct {
+		query       string
+		expectErr   bool
+		parsedQuery *ParsedQuery
+	}{
+		{
+			query:     "WorkflowId = \"random workflowID\"",
+			expectErr: false,
+			parsedQuery: &ParsedQuery{
+				WorkflowID: util.Ptr("random workflowID"),
+			},
+		},
+		{
+			query:     "WorkflowId = \"random workflowID\" and WorkflowId = \"random workflowID\"",
+			expectErr: false,
+			parsedQuery: &ParsedQuery{
+				WorkflowID: util.Ptr("random workflowID"),
+			},
+		},
+		{
+			query:     "RunId = \"random runID\"",
+			expectErr: false,
+			parsedQuery: &ParsedQuery{
+				RunID: util.Ptr("random runID"),
+			},
+		},
+		{
+			query:     "WorkflowType = \"random typeName\"",
+			expectErr: false,
+			parsedQuery: &ParsedQuery{
+				WorkflowTypeName: util.Ptr("random typeName"),
+			},
+		},
+		{
+			query:     "WorkflowId = 'random workflowID'",
+			expectErr: false,
+			parsedQuery: &ParsedQuery{
+				WorkflowID: util.Ptr("random workflowID"),
+			},
+		},
+		{
+			query:     "WorkflowType = 'random typeName' and WorkflowType = \"another typeName\"",
+			expectErr: false,
+			parsedQuery: &ParsedQuery{
+				EmptyResult: true,
+			},
+		},
+		{
+			query:     "WorkflowType = 'random typeName' and (WorkflowId = \"random workflowID\" and RunId='random runID')",
+			expectErr: false,
+			parsedQuery: &ParsedQuery{
+				WorkflowID:       util.Ptr("random workflowID"),
+				RunID:            util.Ptr("random runID"),
+				WorkflowTypeName: util.Ptr("random typeName"),
+			},
+		},
+		{
+			query:     "runId = random workflowID",
+			expectErr: true,
+		},
+		{
+			query:     "WorkflowId = \"random workflowID\" or WorkflowId = \"another workflowID\"",
+			expectErr: true,
+		},
+		{
+			query:     "WorkflowId = \"random workflowID\" or runId = \"random runID\"",
+			expectErr: true,
+		},
+		{
+			query:     "workflowid = \"random workflowID\"",
+			expectErr: true,
+		},
+		{
+			query:     "runId > \"random workflowID\"",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		parsedQuery, err := s.parser.Parse(tc.query)
+		if tc.expectErr {
+			s.Error(err)
+			continue
+		}
+		s.NoError(err)
+		s.Equal(tc.parsedQuery.EmptyResult, parsedQuery.EmptyResult)
+		if !tc.parsedQuery.EmptyResult {
+			s.Equal(tc.parsedQuery.WorkflowID, parsedQuery.WorkflowID)
+			s.Equal(tc.parsedQuery.RunID, parsedQuery.RunID)
+			s.Equal(tc.parsedQuery.WorkflowTypeName, parsedQuery.WorkflowTypeName)
+		}
+	}
+}
+
+func (s *queryParserSuite) TestParseCloseStatus() {
+	testCases := []struct {
+		query       string
+		expectErr   bool
+		parsedQuery *ParsedQuery
+	}{
+		{
+			query:     "ExecutionStatus = \"Completed\"",
+			expectErr: false,
+			parsedQuery: &ParsedQuery{
+				Status: util.Ptr(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED),
+			},
+		},
+		{
+			query:     "ExecutionStatus = \"failed\"",
+			expectErr: false,
+			parsedQuery: &ParsedQuery{
+				Status: util.Ptr(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
+			},
+		},
+		{
+			query:     "ExecutionStatus = \"canceled\"",
+			expectErr: false,
+			parsedQuery: &ParsedQuery{
+				Status: util.Ptr(enumspb.WORKFLOW_EXECUTION_STATUS_CANCELED),
+			},
+		},
+		{
+			query:     "ExecutionStatus = \"terminated\"",
+			expectErr: false,
+			parsedQuery: &ParsedQuery{
+				Status: util.Ptr(enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED),
+			},
+		},
+		{
+			query:     "ExecutionStatus = 'continuedasnew'",
+			expectErr: false,
+			parsedQuery: &ParsedQuery{
+				Status: util.Ptr(enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW),
+			},
+		},
+		{
+			query:     "ExecutionStatus = 'TIMED_OUT'",
+			expectErr: false,
+			parsedQuery: &ParsedQuery{
+				Status: util.Ptr(enumspb.WORKFLOW_EXECUTION_STATUS_TIMED_OUT),
+			},
+		},
+		{
+			query:     "ExecutionStatus = 'Failed' and ExecutionStatus = \"Failed\"",
+			expectErr: false,
+			parsedQuery: &ParsedQuery{
+				Status: util.Ptr(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
+			},
+		},
+		{
+			query:     "(ExecutionStatus = 'Timedout' and ExecutionStatus = \"canceled\")",
+			expectErr: false,
+			parsedQuery: &ParsedQuery{
+				EmptyResult: true,
+			},
+		},
+		{
+			query:     "status = \"Failed\"",
+			expectErr: true,
+		},
+		{
+			query:     "ExecutionStatus = \"Failed\" or ExecutionStatus = \"Failed\"",
+			expectErr: true,
+		},
+		{
+			query:     "ExecutionStatus = \"unknown\"",
+			expectErr: true,
+		},
+		{
+			query:     "ExecutionStatus > \"Failed\"",
+			expectErr: true,
+		},
+		{
+			query:     "ExecutionStatus = 3",
+			expectErr: false,
+			parsedQuery: &ParsedQuery{
+				Status: util.Ptr(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
+			},
+		},
+		{
+			query:     "CloseStatus = 10",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		parsedQuery, err := s.parser.Parse(tc.query)
+		if tc.expectErr {
+			s.Error(err)
+			continue
+		}
+		s.NoError(err)
+		s.Equal(tc.parsedQuery.EmptyResult, parsedQuery.EmptyResult)
+		if !tc.parsedQuery.EmptyResult {
+			s.EqualValues(tc.parsedQuery.Status, parsedQuery.Status)
+		}
+	}
+}
+
+func (s *queryParserSuite) TestParseCloseTime() {
+	testCases := []struct {
+		query       string
+		expectErr   bool
+		parsedQuery *ParsedQuery
+	}{
+		{
+			query:     "CloseTime <= 1000",
+			expectErr: false,
+			parsedQuery: &ParsedQuery{
+				EarliestCloseTime: time.Time{},
+				LatestCloseTime:   time.Unix(0, 1000),
+			},
+		},
+		{
+			query:     "CloseTime < 2000 and CloseTime <= 1000 and CloseTime > 300",
+			expectErr: false,
+			parsedQuery: &ParsedQuery{
+				EarliestCloseTime: time.Unix(0, 301),
+				LatestCloseTime:   time.Unix(0, 1000),
+			},
+		},
+		{
+			query:     "CloseTime = 2000 and (CloseTime > 1000 and CloseTime <= 9999)",
+			expectErr: false,
+			parsedQuery: &ParsedQuery{
+				EarliestCloseTime: time.Unix(0, 2000),
+				LatestCloseTime:   time.Unix(0, 2000),
+			},
+		},
+		{
+			query:     "CloseTime <= \"2019-01-01T11:11:11Z\" and CloseTime >= 1000000",
+			expectErr: false,
+			parsedQuery: &ParsedQuery{
+				EarliestCloseTime: time.Unix(0, 1000000),
+				LatestCloseTime:   time.Date(2019, 01, 01, 11, 11, 11, 0, time.UTC),
+			},
+		},
+		{
+			query:     "closeTime = 2000",
+			expectErr: true,
+		},
+		{
+			query:     "CloseTime > \"2019-01-01 00:00:00\"",
+			expectErr: true,
+		},
+		{
+			query:     "ExecutionStatus > 2000 or ExecutionStatus < 1000",
+			expectErr: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		parsedQuery, err := s.parser.Parse(tc.query)
+		if tc.expectErr {
+			s.Error(err)
+			continue
+		}
+		s.NoError(err, "case %d", i)
+		s.Equal(tc.parsedQuery.EmptyResult, parsedQuery.EmptyResult, "case %d", i)
+		if !tc.parsedQuery.EmptyResult {
+			s.True(tc.parsedQuery.EarliestCloseTime.Equal(parsedQuery.EarliestCloseTime), "case %d", i)
+			s.True(tc.parsedQuery.LatestCloseTime.Equal(parsedQuery.LatestCloseTime), "case %d", i)
+		}
+	}
+}
+
+func (s *queryParserSuite) TestParse() {
+	testCases := []struct {
+		query       string
+		expectErr   bool
+		parsedQuery *ParsedQuery
+	}{
+		{
+			query:     "CloseTime <= \"2019-01-01T11:11:11Z\" and WorkflowId = 'random workflowID'",
+			expectErr: false,
+			parsedQuery: &ParsedQuery{
+				EarliestCloseTime: time.Time{},
+				LatestCloseTime:   time.Date(2019, 01, 01, 11, 11, 11, 0, time.UTC),
+				WorkflowID:        util.Ptr("random workflowID"),
+			},
+		},
+		{
+			query:     "CloseTime > 1999 and CloseTime < 10000 and RunId = 'random runID' and ExecutionStatus = 'Failed'",
+			expectErr: false,
+			parsedQuery: &ParsedQuery{
+				EarliestCloseTime: time.Unix(0, 2000).UTC(),
+				LatestCloseTime:   time.Unix(0, 9999).UTC(),
+				RunID:             util.Ptr("random runID"),
+				Status:            util.Ptr(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
+			},
+		},
+		{
+			query:     "CloseTime > 2001 and CloseTime < 10000 and (RunId = 'random runID') and ExecutionStatus = 'Failed' and (RunId = 'another ID')",
+			expectErr: false,
+			parsedQuery: &ParsedQuery{
+				EmptyResult: true,
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		parsedQuery, err := s.parser.Parse(tc.query)
+		if tc.expectErr {
+			s.Error(err)
+			continue
+		}
+		s.NoError(err, "case %d", i)
+		s.Equal(tc.parsedQuery.EmptyResult, parsedQuery.EmptyResult, "case %d", i)
+		if !tc.parsedQuery.EmptyResult {
+			s.Equal(tc.parsedQuery, parsedQuery, "case %d", i)
+		}
+	}
+}
+
+func (s *queryParserSuite) TestMatchQuery() {
+	testCases := []struct {
+		query       *ParsedQuery
+		record      *archiverspb.VisibilityRecord
+		shouldMatch bool
+	}{
+		{
+			query: &ParsedQuery{
+				EarliestCloseTime: time.Unix(0, 1000),
+				LatestCloseTime:   time.Unix(0, 12345),
+			},
+			record: &archiverspb.VisibilityRecord{
+				CloseTime: timestamp.UnixOrZeroTimePtr(1999),
+			},
+			shouldMatch: true,
+		},
+		{
+			query: &ParsedQuery{
+				EarliestCloseTime: time.Unix(0, 1000),
+				LatestCloseTime:   time.Unix(0, 12345),
+			},
+			record: &archiverspb.VisibilityRecord{
+				CloseTime: timestamp.UnixOrZeroTimePtr(999),
+			},
+			shouldMatch: false,
+		},
+		{
+			query: &ParsedQuery{
+				EarliestCloseTime: time.Unix(0, 1000),
+				LatestCloseTime:   time.Unix(0, 12345),
+				WorkflowID:        util.Ptr("random workflowID"),
+			},
+			record: &archiverspb.VisibilityRecord{
+				CloseTime: timestamp.UnixOrZeroTimePtr(2000),
+			},
+			shouldMatch: false,
+		},
+		{
+			query: &ParsedQuery{
+				EarliestCloseTime: time.Unix(0, 1000),
+				LatestCloseTime:   time.Unix(0, 12345),
+				WorkflowID:        util.Ptr("random workflowID"),
+				RunID:             util.Ptr("random runID"),
+			},
+			record: &archiverspb.VisibilityRecord{
+				CloseTime:        timestamp.UnixOrZeroTimePtr(12345),
+				WorkflowId:       "random workflowID",
+				RunId:            "random runID",
+				WorkflowTypeName: "random type name",
+			},
+			shouldMatch: true,
+		},
+		{
+			query: &ParsedQuery{
+				EarliestCloseTime: time.Unix(0, 1000),
+				LatestCloseTime:   time.Unix(0, 12345),
+				WorkflowTypeName:  util.Ptr("some random type name"),
+			},
+			record: &archiverspb.VisibilityRecord{
+				CloseTime: timestamp.UnixOrZeroTimePtr(12345),
+			},
+			shouldMatch: false,
+		},
+		{
+			query: &ParsedQuery{
+				EarliestCloseTime: time.Unix(0, 1000),
+				LatestCloseTime:   time.Unix(0, 12345),
+				WorkflowTypeName:  util.Ptr("some random type name"),
+				Status:            util.Ptr(enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW),
+			},
+			record: &archiverspb.VisibilityRecord{
+				CloseTime:        timestamp.UnixOrZeroTimePtr(12345),
+				Status:           enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW,
+				WorkflowTypeName: "some random type name",
+			},
+			shouldMatch: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Equal(tc.shouldMatch, tc.query.Match(tc.record))
+	}
+}
+
+func (s *queryParserSuite) TestWalkComparisons() {
+	var comparisons []Comparison
+	err := WalkComparisons("WorkflowId = 'wid' and (StartTime >= 1000 and SearchPrecision = 'Day')", func(comparison Comparison) error {
+		comparisons = append(comparisons, comparison)
+		return nil
+	})
+	s.NoError(err)
+	s.Equal([]Comparison{
+		{Field: WorkflowID, Operator: "=", Value: "'wid'"},
+		{Field: StartTime, Operator: ">=", Value: "1000"},
+		{Field: SearchPrecision, Operator: "=", Value: "'Day'"},
+	}, comparisons)
+
+	val, err := comparisons[0].EqualString()
+	s.NoError(err)
+	s.Equal("wid", val)
+	_, err = comparisons[1].EqualTime()
+	s.Error(err)
+	precision, err := comparisons[2].EqualSearchPrecision()
+	s.NoError(err)
+	s.Equal(PrecisionDay, precision)
+	_, err = Comparison{Field: SearchPrecision, Operator: "=", Value: "'Week'"}.EqualSearchPrecision()
+	s.Error(err)
+
+	s.Error(WalkComparisons("WorkflowId = 'wid' or RunId = 'rid'", func(Comparison) error { return nil }))
+	s.Error(WalkComparisons("WorkflowId = RunId", func(Comparison) error { return nil }))
+}
@@ -32,6 +32,7 @@ import (
 	"strings"
 
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
 )
 
 const (
@@ -83,12 +84,24 @@ type hasNamespace interface {
 }
 
 func GetAuthorizerFromConfig(config *config.Authorization) (Authorizer, error) {
+	return NewAuthorizerFromConfig(config, log.NewNoopLogger())
+}
+
+// NewAuthorizerFromConfig is like GetAuthorizerFromConfig, but authorizers that log, such as
+// the policy authorizer, log to the given logger.
+func NewAuthorizerFromConfig(config *config.Authorization, logger log.Logger) (Authorizer, error) {
 
 	switch strings.ToLower(config.Authorizer) {
 	case "":
 		return NewNoopAuthorizer(), nil
 	case "default":
 		return NewDefaultAuthorizer(), nil
+	case "policy":
+		authorizer, err := NewPolicyAuthorizerFromConfig(&config.Policy, logger)
+		if err != nil {
+			return nil, err
+		}
+		return authorizer, nil
 	}
 	return nil, fmt.Errorf("unknown authorizer: %s", config.Authorizer)
 }
@@ -82,6 +82,61 @@ func (*noopClaimMapper) AuthInfoRequired() bool {
 	return false
 }
 
+// Claim mapper that merges the claims produced by several claim mappers, so that a subject
+// presenting more than one identity (e.g. a JWT over an mTLS connection) gets the union of the
+// roles granted to each of them.
+type compositeClaimMapper struct {
+	mappers []ClaimMapper
+}
+
+var _ ClaimMapper = (*compositeClaimMapper)(nil)
+var _ ClaimMapperWithAuthInfoRequired = (*compositeClaimMapper)(nil)
+
+// NewCompositeClaimMapper returns a ClaimMapper that calls each of the mappers in order. An error
+// from any mapper fails the whole mapping. The subject and extensions are taken from the first
+// mapper that sets them, and roles are combined.
+func NewCompositeClaimMapper(mappers ...ClaimMapper) ClaimMapper {
+	return &compositeClaimMapper{mappers: mappers}
+}
+
+func (c *compositeClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
+	claims := Claims{}
+	for _, mapper := range c.mappers {
+		mapped, err := mapper.GetClaims(authInfo)
+		if err != nil {
+			return nil, err
+		}
+		if mapped == nil {
+			continue
+		}
+		if claims.Subject == "" {
+			claims.Subject = mapped.Subject
+		}
+		if claims.Extensions == nil {
+			claims.Extensions = mapped.Extensions
+		}
+		claims.System |= mapped.System
+		for namespace, role := range mapped.Namespaces {
+			if claims.Namespaces == nil {
+				claims.Namespaces = make(map[string]Role)
+			}
+			claims.Namespaces[namespace] |= role
+		}
+	}
+	return &claims, nil
+}
+
+// Auth info is only optional if it is optional for all of the mappers.
+func (c *compositeClaimMapper) AuthInfoRequired() bool {
+	for _, mapper := range c.mappers {
+		cm, ok := mapper.(ClaimMapperWithAuthInfoRequired)
+		if !ok || cm.AuthInfoRequired() {
+			return true
+		}
+	}
+	return false
+}
+
 func GetClaimMapperFromConfig(config *config.Authorization, logger log.Logger) (ClaimMapper, error) {
 
 	switch strings.ToLower(config.ClaimMapper) {
@@ -89,6 +144,17 @@ func GetClaimMapperFromConfig(config *config.Authorization, logger log.Logger) (
 		return NewNoopClaimMapper(), nil
 	case "default":
 		return NewDefaultJWTClaimMapper(NewDefaultTokenKeyProvider(config, logger), config, logger), nil
+	case "tls":
+		return NewTLSClaimMapper(&config.TLSClaimMapper, logger)
+	case "default+tls":
+		tlsMapper, err := NewTLSClaimMapper(&config.TLSClaimMapper, logger)
+		if err != nil {
+			return nil, err
+		}
+		jwtMapper := NewDefaultJWTClaimMapper(NewDefaultTokenKeyProvider(config, logger), config, logger)
+		return NewCompositeClaimMapper(jwtMapper, tlsMapper), nil
+	case "introspection":
+		return NewIntrospectionClaimMapper(config, logger)
 	}
 	return nil, fmt.Errorf("unknown claim mapper: %s", config.ClaimMapper)
 }
@@ -105,26 +105,34 @@ func (a *defaultJWTClaimMapper) extractPermissions(permissions []interface{}, cl
 			a.logger.Warn(fmt.Sprintf("ignoring permission that is not a string: %v", permission))
 			continue
 		}
-		parts := strings.Split(p, ":")
-		if len(parts) != 2 {
+		if !addPermission(p, claims) {
 			a.logger.Warn(fmt.Sprintf("ignoring permission in unexpected format: %v", permission))
-			continue
-		}
-		namespace := parts[0]
-		if namespace == permissionScopeSystem {
-			claims.System |= permissionToRole(parts[1])
-		} else {
-			if claims.Namespaces == nil {
-				claims.Namespaces = make(map[string]Role)
-			}
-			role := claims.Namespaces[namespace]
-			role |= permissionToRole(parts[1])
-			claims.Namespaces[namespace] = role
 		}
 	}
 	return nil
 }
 
+// addPermission adds the role from a "<namespace>:<role>" permission to claims. Returns false
+// if the permission is not in that format.
+func addPermission(permission string, claims *Claims) bool {
+	parts := strings.Split(permission, ":")
+	if len(parts) != 2 {
+		return false
+	}
+	namespace := parts[0]
+	if namespace == permissionScopeSystem {
+		claims.System |= permissionToRole(parts[1])
+	} else {
+		if claims.Namespaces == nil {
+			claims.Namespaces = make(map[string]Role)
+		}
+		role := claims.Namespaces[namespace]
+		role |= permissionToRole(parts[1])
+		claims.Namespaces[namespace] = role
+	}
+	return true
+}
+
 func parseJWT(tokenString string, keyProvider TokenKeyProvider) (jwt.MapClaims, error) {
 	return parseJWTWithAudience(tokenString, keyProvider, "")
 }
@@ -215,6 +215,12 @@ func (s *defaultClaimMapperSuite) TestGetClaimMapperFromConfigNoop() {
 func (s *defaultClaimMapperSuite) TestGetClaimMapperFromConfigDefault() {
 	s.testGetClaimMapperFromConfig("default", true, reflect.TypeOf(&defaultJWTClaimMapper{}))
 }
+func (s *defaultClaimMapperSuite) TestGetClaimMapperFromConfigTLS() {
+	s.testGetClaimMapperFromConfig("tls", true, reflect.TypeOf(&tlsClaimMapper{}))
+}
+func (s *defaultClaimMapperSuite) TestGetClaimMapperFromConfigDefaultAndTLS() {
+	s.testGetClaimMapperFromConfig("default+tls", true, reflect.TypeOf(&compositeClaimMapper{}))
+}
 
 func (s *defaultClaimMapperSuite) TestGetClaimMapperFromConfigUnknown() {
 	s.testGetClaimMapperFromConfig("foo", false, nil)
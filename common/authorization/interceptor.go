@@ -43,8 +43,15 @@ import (
 )
 
 type (
-	contextKeyMappedClaims struct{}
-	contextKeyAuthHeader   struct{}
+	contextKeyMappedClaims   struct{}
+	contextKeyAuthHeader     struct{}
+	contextKeyClaimsRecorder struct{}
+
+	// claimsRecorder holds the claims mapped by the interceptor for interceptors that run
+	// before it.
+	claimsRecorder struct {
+		claims *Claims
+	}
 )
 
 type (
@@ -73,6 +80,22 @@ var (
 	AuthHeader   contextKeyAuthHeader
 )
 
+// WithClaimsRecorder returns a context in which the interceptor records the claims that it
+// maps. Interceptors that run before the authorization interceptor use it to get the claims
+// of a call after it returns, including calls that were denied.
+func WithClaimsRecorder(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKeyClaimsRecorder{}, &claimsRecorder{})
+}
+
+// RecordedClaims returns the claims recorded in a context returned by WithClaimsRecorder, or
+// nil if none were mapped.
+func RecordedClaims(ctx context.Context) *Claims {
+	if recorder, ok := ctx.Value(contextKeyClaimsRecorder{}).(*claimsRecorder); ok {
+		return recorder.claims
+	}
+	return nil
+}
+
 // TLSInfoFromContext extracts TLS information from the context's peer value.
 func TLSInfoFromContext(ctx context.Context) *credentials.TLSInfo {
 	p, ok := peer.FromContext(ctx)
@@ -222,8 +245,12 @@ func (a *Interceptor) GetClaims(authInfo *AuthInfo) (*Claims, error) {
 	return a.claimMapper.GetClaims(authInfo)
 }
 
-// EnhanceContext returns a new context with [MappedClaims] and [AuthHeader] values.
+// EnhanceContext returns a new context with [MappedClaims] and [AuthHeader] values. It also
+// records the claims if the context was returned by [WithClaimsRecorder].
 func (a *Interceptor) EnhanceContext(ctx context.Context, authInfo *AuthInfo, claims *Claims) context.Context {
+	if recorder, ok := ctx.Value(contextKeyClaimsRecorder{}).(*claimsRecorder); ok {
+		recorder.claims = claims
+	}
 	ctx = context.WithValue(ctx, MappedClaims, claims)
 	if authInfo.AuthToken != "" {
 		ctx = context.WithValue(ctx, AuthHeader, authInfo.AuthToken)
@@ -0,0 +1,303 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+)
+
+const (
+	defaultIntrospectionTimeout   = 10 * time.Second
+	defaultIntrospectionCacheTTL  = time.Minute
+	defaultIntrospectionCacheSize = 10000
+
+	introspectionResponseMaxBytes = 1 << 20
+)
+
+var (
+	errIntrospectionEndpointNotSet = errors.New("token introspection endpoint is not configured")
+	errTokenInactive               = serviceerror.NewPermissionDenied("token is not active", "")
+)
+
+type (
+	// introspectionClaimMapper maps opaque access tokens to claims using an OAuth 2.0 token
+	// introspection endpoint (RFC 7662). Results are cached by a hash of the token.
+	introspectionClaimMapper struct {
+		config               config.TokenIntrospection
+		permissionsClaimName string
+		httpClient           *http.Client
+		cache                cache.Cache
+		timeSource           clock.TimeSource
+		logger               log.Logger
+	}
+
+	// introspectionResult is the cached outcome of introspecting a token. Claims is nil when
+	// the token is not active.
+	introspectionResult struct {
+		claims    *Claims
+		audience  []string
+		expiresAt time.Time
+	}
+
+	// introspectionResponse holds the RFC 7662 response members the mapper uses
+	introspectionResponse struct {
+		Active   bool            `json:"active"`
+		Scope    string          `json:"scope"`
+		Subject  string          `json:"sub"`
+		Username string          `json:"username"`
+		Expiry   int64           `json:"exp"`
+		Audience json.RawMessage `json:"aud"`
+	}
+)
+
+var _ ClaimMapper = (*introspectionClaimMapper)(nil)
+
+// NewIntrospectionClaimMapper creates a ClaimMapper that validates bearer tokens with the
+// introspection endpoint in cfg.TokenIntrospection. Permissions come from the scopes listed in
+// ScopePermissions and from the permissions claim of the response, if present.
+func NewIntrospectionClaimMapper(cfg *config.Authorization, logger log.Logger) (ClaimMapper, error) {
+	timeout := cfg.TokenIntrospection.Timeout
+	if timeout <= 0 {
+		timeout = defaultIntrospectionTimeout
+	}
+	m, err := newIntrospectionClaimMapper(cfg, &http.Client{Timeout: timeout}, clock.NewRealTimeSource(), logger)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func newIntrospectionClaimMapper(
+	cfg *config.Authorization,
+	httpClient *http.Client,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) (*introspectionClaimMapper, error) {
+	introspection := cfg.TokenIntrospection
+	if introspection.Endpoint == "" {
+		return nil, errIntrospectionEndpointNotSet
+	}
+	if _, err := url.ParseRequestURI(introspection.Endpoint); err != nil {
+		return nil, fmt.Errorf("invalid token introspection endpoint: %w", err)
+	}
+	if introspection.CacheTTL == 0 {
+		introspection.CacheTTL = defaultIntrospectionCacheTTL
+	}
+	if introspection.CacheSize <= 0 {
+		introspection.CacheSize = defaultIntrospectionCacheSize
+	}
+	claimName := cfg.PermissionsClaimName
+	if claimName == "" {
+		claimName = defaultPermissionsClaimName
+	}
+
+	m := &introspectionClaimMapper{
+		config:               introspection,
+		permissionsClaimName: claimName,
+		httpClient:           httpClient,
+		timeSource:           timeSource,
+		logger:               logger,
+	}
+	if introspection.CacheTTL > 0 {
+		m.cache = cache.New(introspection.CacheSize, &cache.Options{
+			TTL:        introspection.CacheTTL,
+			TimeSource: timeSource,
+		})
+	}
+	return m, nil
+}
+
+func (m *introspectionClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
+	if authInfo.AuthToken == "" {
+		return &Claims{}, nil
+	}
+
+	parts := strings.Split(authInfo.AuthToken, " ")
+	if len(parts) != 2 {
+		return nil, serviceerror.NewPermissionDenied("unexpected authorization token format", "")
+	}
+	if !strings.EqualFold(parts[0], authorizationBearer) {
+		return nil, serviceerror.NewPermissionDenied("unexpected name in authorization token", "")
+	}
+
+	result, err := m.getResult(parts[1])
+	if err != nil {
+		return nil, err
+	}
+	if result.claims == nil {
+		return nil, errTokenInactive
+	}
+	if strings.TrimSpace(authInfo.Audience) != "" && !slices.Contains(result.audience, authInfo.Audience) {
+		return nil, serviceerror.NewPermissionDenied("audience mismatch", "")
+	}
+
+	// callers own the returned claims, so don't hand out the cached instance
+	claims := *result.claims
+	claims.Namespaces = maps.Clone(claims.Namespaces)
+	return &claims, nil
+}
+
+func (m *introspectionClaimMapper) getResult(token string) (*introspectionResult, error) {
+	if m.cache == nil {
+		return m.introspect(token)
+	}
+
+	hash := sha256.Sum256([]byte(token))
+	key := hex.EncodeToString(hash[:])
+	if cached, ok := m.cache.Get(key).(*introspectionResult); ok {
+		if cached.expiresAt.IsZero() || m.timeSource.Now().Before(cached.expiresAt) {
+			return cached, nil
+		}
+		m.cache.Delete(key)
+	}
+
+	result, err := m.introspect(token)
+	if err != nil {
+		return nil, err
+	}
+	m.cache.Put(key, result)
+	return result, nil
+}
+
+func (m *introspectionClaimMapper) introspect(token string) (_ *introspectionResult, retErr error) {
+	form := url.Values{"token": {token}, "token_type_hint": {"access_token"}}
+	req, err := http.NewRequest(http.MethodPost, m.config.Endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if m.config.ClientID != "" {
+		req.SetBasicAuth(url.QueryEscape(m.config.ClientID), url.QueryEscape(m.config.ClientSecret))
+	}
+
+	resp, err := m.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("token introspection request failed: %w", err)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token introspection request failed with status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, introspectionResponseMaxBytes))
+	if err != nil {
+		return nil, err
+	}
+	var response introspectionResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("unable to decode token introspection response: %w", err)
+	}
+
+	result := &introspectionResult{}
+	if response.Expiry > 0 {
+		result.expiresAt = time.Unix(response.Expiry, 0)
+	}
+	if !response.Active {
+		return result, nil
+	}
+	if result.audience, err = parseAudience(response.Audience); err != nil {
+		return nil, err
+	}
+
+	claims := &Claims{Subject: response.Subject}
+	if claims.Subject == "" {
+		claims.Subject = response.Username
+	}
+	for _, scope := range strings.Fields(response.Scope) {
+		for _, permission := range m.config.ScopePermissions[scope] {
+			if !addPermission(permission, claims) {
+				m.logger.Warn(fmt.Sprintf("ignoring permission in unexpected format: %v", permission))
+			}
+		}
+	}
+	if err := m.addClaimPermissions(body, claims); err != nil {
+		return nil, err
+	}
+	result.claims = claims
+	return result, nil
+}
+
+// addClaimPermissions adds permissions from the permissions claim, the same way the JWT claim
+// mapper does, so identity providers can use either scopes or a permissions claim.
+func (m *introspectionClaimMapper) addClaimPermissions(body []byte, claims *Claims) error {
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(body, &members); err != nil {
+		return fmt.Errorf("unable to decode token introspection response: %w", err)
+	}
+	raw, ok := members[m.permissionsClaimName]
+	if !ok {
+		return nil
+	}
+	var permissions []interface{}
+	if err := json.Unmarshal(raw, &permissions); err != nil {
+		m.logger.Warn(fmt.Sprintf("ignoring %q claim that is not an array", m.permissionsClaimName))
+		return nil
+	}
+	for _, permission := range permissions {
+		p, ok := permission.(string)
+		if !ok || !addPermission(p, claims) {
+			m.logger.Warn(fmt.Sprintf("ignoring permission in unexpected format: %v", permission))
+		}
+	}
+	return nil
+}
+
+// parseAudience decodes the "aud" member, which is either a string or an array of strings.
+func parseAudience(raw json.RawMessage) ([]string, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	var single string
+	if err := json.Unmarshal(raw, &single); err == nil {
+		return []string{single}, nil
+	}
+	var multiple []string
+	if err := json.Unmarshal(raw, &multiple); err != nil {
+		return nil, fmt.Errorf("unexpected value type of \"aud\" in token introspection response")
+	}
+	return multiple, nil
+}
@@ -0,0 +1,227 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/primitives"
+)
+
+type (
+	introspectionClaimMapperSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		server     *httptest.Server
+		requests   atomic.Int32
+		responses  map[string]map[string]interface{}
+		timeSource *clock.EventTimeSource
+		config     config.Authorization
+	}
+)
+
+func TestIntrospectionClaimMapperSuite(t *testing.T) {
+	s := new(introspectionClaimMapperSuite)
+	suite.Run(t, s)
+}
+
+func (s *introspectionClaimMapperSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.requests.Store(0)
+	s.timeSource = clock.NewEventTimeSource().Update(time.Unix(1_700_000_000, 0))
+	s.responses = map[string]map[string]interface{}{
+		"active-token": {
+			"active": true,
+			"sub":    testSubject,
+			"scope":  "openid temporal:orders:write temporal:admin",
+			"aud":    []string{"temporal", "other"},
+			"exp":    s.timeSource.Now().Add(time.Hour).Unix(),
+		},
+		"claims-token": {
+			"active":      true,
+			"username":    "svc",
+			"permissions": []string{"payments:read", "payments:worker"},
+		},
+		"expiring-token": {
+			"active": true,
+			"sub":    testSubject,
+			"exp":    s.timeSource.Now().Add(10 * time.Second).Unix(),
+		},
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.requests.Add(1)
+		user, password, ok := r.BasicAuth()
+		if !ok || user != "temporal" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		response, ok := s.responses[r.PostFormValue("token")]
+		if !ok {
+			response = map[string]interface{}{"active": false}
+		}
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	s.config = config.Authorization{
+		TokenIntrospection: config.TokenIntrospection{
+			Endpoint:     s.server.URL,
+			ClientID:     "temporal",
+			ClientSecret: "secret",
+			ScopePermissions: map[string][]string{
+				"temporal:orders:write": {"orders:write", "orders:read"},
+				"temporal:admin":        {primitives.SystemLocalNamespace + ":admin"},
+			},
+		},
+	}
+}
+
+func (s *introspectionClaimMapperSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *introspectionClaimMapperSuite) newMapper() ClaimMapper {
+	mapper, err := newIntrospectionClaimMapper(&s.config, s.server.Client(), s.timeSource, log.NewNoopLogger())
+	s.NoError(err)
+	return mapper
+}
+
+func (s *introspectionClaimMapperSuite) TestScopes() {
+	claims, err := s.newMapper().GetClaims(&AuthInfo{AuthToken: AddBearer("active-token"), Audience: "temporal"})
+	s.NoError(err)
+	s.Equal(testSubject, claims.Subject)
+	s.Equal(RoleAdmin, claims.System)
+	s.Equal(map[string]Role{"orders": RoleWriter | RoleReader}, claims.Namespaces)
+}
+
+func (s *introspectionClaimMapperSuite) TestPermissionsClaim() {
+	claims, err := s.newMapper().GetClaims(&AuthInfo{AuthToken: AddBearer("claims-token")})
+	s.NoError(err)
+	s.Equal("svc", claims.Subject)
+	s.Equal(RoleUndefined, claims.System)
+	s.Equal(map[string]Role{"payments": RoleReader | RoleWorker}, claims.Namespaces)
+}
+
+func (s *introspectionClaimMapperSuite) TestNoToken() {
+	claims, err := s.newMapper().GetClaims(&AuthInfo{})
+	s.NoError(err)
+	s.Equal(&Claims{}, claims)
+	s.Equal(int32(0), s.requests.Load())
+}
+
+func (s *introspectionClaimMapperSuite) TestInactiveToken() {
+	_, err := s.newMapper().GetClaims(&AuthInfo{AuthToken: AddBearer("revoked-token")})
+	s.ErrorIs(err, errTokenInactive)
+}
+
+func (s *introspectionClaimMapperSuite) TestMalformedHeader() {
+	_, err := s.newMapper().GetClaims(&AuthInfo{AuthToken: "active-token"})
+	s.Error(err)
+	_, err = s.newMapper().GetClaims(&AuthInfo{AuthToken: "Basic active-token"})
+	s.Error(err)
+	s.Equal(int32(0), s.requests.Load())
+}
+
+func (s *introspectionClaimMapperSuite) TestWrongAudience() {
+	_, err := s.newMapper().GetClaims(&AuthInfo{AuthToken: AddBearer("active-token"), Audience: "foo"})
+	s.Error(err)
+}
+
+func (s *introspectionClaimMapperSuite) TestEndpointError() {
+	s.config.TokenIntrospection.ClientSecret = "wrong"
+	mapper := s.newMapper()
+	_, err := mapper.GetClaims(&AuthInfo{AuthToken: AddBearer("active-token")})
+	s.Error(err)
+
+	// errors are not cached
+	_, err = mapper.GetClaims(&AuthInfo{AuthToken: AddBearer("active-token")})
+	s.Error(err)
+	s.Equal(int32(2), s.requests.Load())
+}
+
+func (s *introspectionClaimMapperSuite) TestCache() {
+	mapper := s.newMapper()
+	authInfo := &AuthInfo{AuthToken: AddBearer("active-token")}
+
+	claims, err := mapper.GetClaims(authInfo)
+	s.NoError(err)
+	claims.Namespaces["orders"] = RoleAdmin
+
+	claims, err = mapper.GetClaims(authInfo)
+	s.NoError(err)
+	s.Equal(RoleWriter|RoleReader, claims.Namespaces["orders"])
+	s.Equal(int32(1), s.requests.Load())
+
+	s.timeSource.Advance(defaultIntrospectionCacheTTL + time.Second)
+	_, err = mapper.GetClaims(authInfo)
+	s.NoError(err)
+	s.Equal(int32(2), s.requests.Load())
+}
+
+func (s *introspectionClaimMapperSuite) TestCacheRespectsExpiry() {
+	mapper := s.newMapper()
+	authInfo := &AuthInfo{AuthToken: AddBearer("expiring-token")}
+
+	_, err := mapper.GetClaims(authInfo)
+	s.NoError(err)
+	s.timeSource.Advance(5 * time.Second)
+	_, err = mapper.GetClaims(authInfo)
+	s.NoError(err)
+	s.Equal(int32(1), s.requests.Load())
+
+	s.timeSource.Advance(10 * time.Second)
+	_, err = mapper.GetClaims(authInfo)
+	s.NoError(err)
+	s.Equal(int32(2), s.requests.Load())
+}
+
+func (s *introspectionClaimMapperSuite) TestCacheDisabled() {
+	s.config.TokenIntrospection.CacheTTL = -1
+	mapper := s.newMapper()
+	authInfo := &AuthInfo{AuthToken: AddBearer("active-token")}
+	for i := 0; i < 2; i++ {
+		_, err := mapper.GetClaims(authInfo)
+		s.NoError(err)
+	}
+	s.Equal(int32(2), s.requests.Load())
+}
+
+func (s *introspectionClaimMapperSuite) TestInvalidConfig() {
+	_, err := NewIntrospectionClaimMapper(&config.Authorization{}, log.NewNoopLogger())
+	s.ErrorIs(err, errIntrospectionEndpointNotSet)
+
+	cm, err := GetClaimMapperFromConfig(&config.Authorization{ClaimMapper: "introspection"}, log.NewNoopLogger())
+	s.Error(err)
+	s.Nil(cm)
+}
@@ -0,0 +1,480 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/operatorservice/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/api"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	PolicyEffectAllow = "allow"
+	PolicyEffectDeny  = "deny"
+
+	// PolicyFallbackDeny denies calls that don't match any rule. This is the default.
+	PolicyFallbackDeny = "deny"
+	// PolicyFallbackAllow allows calls that don't match any rule.
+	PolicyFallbackAllow = "allow"
+	// PolicyFallbackRoles applies the default role-based authorizer to calls that don't match
+	// any rule.
+	PolicyFallbackRoles = "roles"
+)
+
+type (
+	// Policy is a list of rules evaluated in order against each call. The first rule that
+	// matches decides the call; if no rule matches, Fallback decides.
+	Policy struct {
+		Fallback string       `yaml:"fallback"`
+		Rules    []PolicyRule `yaml:"rules"`
+	}
+
+	// PolicyRule matches a call if every non-empty field matches. Each field is a list of
+	// patterns, any of which may match. In a pattern, "*" matches any sequence of characters.
+	//
+	// APIs may be given as full API names
+	// ("/temporal.api.workflowservice.v1.WorkflowService/SignalWorkflowExecution") or as method
+	// names ("SignalWorkflowExecution").
+	//
+	// TaskQueues and WorkflowTypes are taken from the request. A rule that sets them must list
+	// its APIs, and every API it lists must carry those fields in its request; otherwise the
+	// policy fails to load. E.g. SignalWorkflowExecution and TerminateWorkflowExecution don't
+	// include the workflow type, so restrict them by namespace instead. A request that leaves
+	// such a field unset matches deny rules but not allow rules.
+	PolicyRule struct {
+		Name          string   `yaml:"name"`
+		Effect        string   `yaml:"effect"`
+		Subjects      []string `yaml:"subjects"`
+		Namespaces    []string `yaml:"namespaces"`
+		APIs          []string `yaml:"apis"`
+		TaskQueues    []string `yaml:"taskQueues"`
+		WorkflowTypes []string `yaml:"workflowTypes"`
+	}
+
+	policyAuthorizer struct {
+		policy   atomic.Pointer[Policy]
+		fallback Authorizer
+		logger   log.Logger
+
+		filepath        string
+		lastUpdatedTime time.Time
+		stopOnce        sync.Once
+		stop            chan struct{}
+	}
+
+	hasTaskQueue interface {
+		GetTaskQueue() *taskqueuepb.TaskQueue
+	}
+
+	hasTaskQueueName interface {
+		GetTaskQueue() string
+	}
+
+	hasWorkflowType interface {
+		GetWorkflowType() *commonpb.WorkflowType
+	}
+)
+
+var _ Authorizer = (*policyAuthorizer)(nil)
+
+// NewPolicyAuthorizer creates an authorizer that evaluates the given policy.
+func NewPolicyAuthorizer(policy *Policy, logger log.Logger) (*policyAuthorizer, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	a := &policyAuthorizer{
+		fallback: NewDefaultAuthorizer(),
+		logger:   logger,
+	}
+	a.policy.Store(policy)
+	return a, nil
+}
+
+// NewPolicyAuthorizerFromConfig creates an authorizer that loads its policy from the
+// configured file. If a refresh interval is configured, the file is reloaded when it changes;
+// a file that fails to load or validate is logged and the previous policy stays in effect.
+func NewPolicyAuthorizerFromConfig(cfg *config.AuthorizationPolicy, logger log.Logger) (*policyAuthorizer, error) {
+	if cfg.Filepath == "" {
+		return nil, errors.New("authorization policy filepath is not set")
+	}
+	a := &policyAuthorizer{
+		fallback: NewDefaultAuthorizer(),
+		logger:   logger,
+		filepath: cfg.Filepath,
+	}
+	if _, err := a.Reload(); err != nil {
+		return nil, err
+	}
+	if cfg.RefreshInterval > 0 {
+		a.stop = make(chan struct{})
+		go a.refreshLoop(cfg.RefreshInterval)
+	}
+	return a, nil
+}
+
+// LoadPolicy parses and validates a policy from YAML.
+func LoadPolicy(contents []byte) (*Policy, error) {
+	var policy Policy
+	if err := yaml.Unmarshal(contents, &policy); err != nil {
+		return nil, fmt.Errorf("unable to decode authorization policy: %w", err)
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// Validate checks that the policy's fallback and rule effects are known values.
+func (p *Policy) Validate() error {
+	switch p.Fallback {
+	case "", PolicyFallbackDeny, PolicyFallbackAllow, PolicyFallbackRoles:
+	default:
+		return fmt.Errorf("unknown authorization policy fallback: %q", p.Fallback)
+	}
+	for i, rule := range p.Rules {
+		switch rule.Effect {
+		case PolicyEffectAllow, PolicyEffectDeny:
+		default:
+			return fmt.Errorf("authorization policy rule %d (%q): unknown effect: %q", i, rule.Name, rule.Effect)
+		}
+		if err := rule.validateRequestFields(); err != nil {
+			return fmt.Errorf("authorization policy rule %d (%q): %w", i, rule.Name, err)
+		}
+	}
+	return nil
+}
+
+// validateRequestFields checks that every API of a rule that restricts task queues or workflow
+// types carries those fields in its request. Otherwise the rule would never match those APIs,
+// and a deny rule would silently allow them.
+func (r *PolicyRule) validateRequestFields() error {
+	if len(r.TaskQueues) == 0 && len(r.WorkflowTypes) == 0 {
+		return nil
+	}
+	if len(r.APIs) == 0 {
+		return errors.New("rules that restrict taskQueues or workflowTypes must list their apis")
+	}
+	for _, pattern := range r.APIs {
+		matched := false
+		for _, method := range policyMethods {
+			if !matchAPIPattern(pattern, method.apiName) {
+				continue
+			}
+			matched = true
+			if len(r.TaskQueues) > 0 && !method.hasTaskQueue {
+				return fmt.Errorf("api %s doesn't carry a task queue", method.apiName)
+			}
+			if len(r.WorkflowTypes) > 0 && !method.hasWorkflowType {
+				return fmt.Errorf("api %s doesn't carry a workflow type", method.apiName)
+			}
+		}
+		if !matched {
+			return fmt.Errorf("api pattern %q doesn't match any api that can be restricted by taskQueues or workflowTypes", pattern)
+		}
+	}
+	return nil
+}
+
+// policyMethod describes which request fields of an API rules can match.
+type policyMethod struct {
+	apiName         string
+	hasTaskQueue    bool
+	hasWorkflowType bool
+}
+
+// policyMethods lists the APIs that rules restricting task queues or workflow types may apply to.
+var policyMethods = newPolicyMethods(
+	workflowservice.File_temporal_api_workflowservice_v1_service_proto.Services(),
+	operatorservice.File_temporal_api_operatorservice_v1_service_proto.Services(),
+	adminservice.File_temporal_server_api_adminservice_v1_service_proto.Services(),
+)
+
+func newPolicyMethods(services ...protoreflect.ServiceDescriptors) []policyMethod {
+	var methods []policyMethod
+	for _, sds := range services {
+		for i := 0; i < sds.Len(); i++ {
+			sd := sds.Get(i)
+			for j := 0; j < sd.Methods().Len(); j++ {
+				md := sd.Methods().Get(j)
+				mt, err := protoregistry.GlobalTypes.FindMessageByName(md.Input().FullName())
+				if err != nil {
+					continue
+				}
+				request := mt.Zero().Interface()
+				method := policyMethod{apiName: "/" + string(sd.FullName()) + "/" + string(md.Name())}
+				switch request.(type) {
+				case hasTaskQueue, hasTaskQueueName:
+					method.hasTaskQueue = true
+				}
+				_, method.hasWorkflowType = request.(hasWorkflowType)
+				methods = append(methods, method)
+			}
+		}
+	}
+	return methods
+}
+
+// Reload reads the policy file if it changed since the last load. Returns true if a new
+// policy was loaded.
+func (a *policyAuthorizer) Reload() (bool, error) {
+	fi, err := os.Stat(a.filepath)
+	if err != nil {
+		return false, fmt.Errorf("authorization policy: %w", err)
+	}
+	if !fi.ModTime().After(a.lastUpdatedTime) {
+		return false, nil
+	}
+	contents, err := os.ReadFile(a.filepath)
+	if err != nil {
+		return false, fmt.Errorf("authorization policy: %w", err)
+	}
+	policy, err := LoadPolicy(contents)
+	if err != nil {
+		return false, fmt.Errorf("authorization policy %s: %w", a.filepath, err)
+	}
+	a.policy.Store(policy)
+	a.lastUpdatedTime = fi.ModTime()
+	a.logger.Info("Loaded authorization policy",
+		tag.NewStringTag("filepath", a.filepath),
+		tag.NewInt("rules", len(policy.Rules)))
+	return true, nil
+}
+
+func (a *policyAuthorizer) refreshLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if _, err := a.Reload(); err != nil {
+				a.logger.Error("Unable to reload authorization policy.", tag.Error(err))
+			}
+		case <-a.stop:
+			return
+		}
+	}
+}
+
+// Close stops reloading the policy file.
+func (a *policyAuthorizer) Close() {
+	if a.stop != nil {
+		a.stopOnce.Do(func() { close(a.stop) })
+	}
+}
+
+// Authorize evaluates the policy rules in order. Health check APIs are always allowed.
+func (a *policyAuthorizer) Authorize(ctx context.Context, claims *Claims, target *CallTarget) (Result, error) {
+	if IsHealthCheckAPI(target.APIName) {
+		return resultAllow, nil
+	}
+
+	policy := a.policy.Load()
+	var subject string
+	if claims != nil {
+		subject = claims.Subject
+	}
+	attrs := newPolicyAttributes(subject, target)
+
+	for i := range policy.Rules {
+		rule := &policy.Rules[i]
+		if !rule.matches(attrs) {
+			continue
+		}
+		result := resultDeny
+		if rule.Effect == PolicyEffectAllow {
+			result = resultAllow
+		}
+		a.logDecision(result, rule.Name, attrs)
+		return result, nil
+	}
+
+	var result Result
+	switch policy.Fallback {
+	case PolicyFallbackAllow:
+		result = resultAllow
+	case PolicyFallbackRoles:
+		var err error
+		result, err = a.fallback.Authorize(ctx, claims, target)
+		if err != nil {
+			return result, err
+		}
+	default:
+		result = resultDeny
+	}
+	a.logDecision(result, "", attrs)
+	return result, nil
+}
+
+// logDecision logs denials at info level and allows at debug level, since allows are the
+// common case and would otherwise log every call.
+func (a *policyAuthorizer) logDecision(result Result, ruleName string, attrs policyAttributes) {
+	if ruleName == "" {
+		ruleName = "<fallback>"
+	}
+	tags := []tag.Tag{
+		tag.NewStringTag("authz-rule", ruleName),
+		tag.NewStringTag("authz-subject", attrs.subject),
+		tag.NewStringTag("authz-api", attrs.apiName),
+		tag.WorkflowNamespace(attrs.namespace),
+	}
+	if attrs.hasTaskQueue {
+		tags = append(tags, tag.WorkflowTaskQueueName(attrs.taskQueue))
+	}
+	if attrs.hasWorkflowType {
+		tags = append(tags, tag.WorkflowType(attrs.workflowType))
+	}
+	if result.Decision == DecisionAllow {
+		a.logger.Debug("Authorization policy allowed call", tags...)
+	} else {
+		a.logger.Info("Authorization policy denied call", tags...)
+	}
+}
+
+type policyAttributes struct {
+	subject         string
+	apiName         string
+	namespace       string
+	taskQueue       string
+	hasTaskQueue    bool
+	workflowType    string
+	hasWorkflowType bool
+}
+
+func newPolicyAttributes(subject string, target *CallTarget) policyAttributes {
+	attrs := policyAttributes{
+		subject:   subject,
+		apiName:   target.APIName,
+		namespace: target.Namespace,
+	}
+	switch req := target.Request.(type) {
+	case hasTaskQueue:
+		if tq := req.GetTaskQueue(); tq != nil {
+			attrs.taskQueue, attrs.hasTaskQueue = tq.GetName(), true
+		}
+	case hasTaskQueueName:
+		attrs.taskQueue, attrs.hasTaskQueue = req.GetTaskQueue(), true
+	}
+	if req, ok := target.Request.(hasWorkflowType); ok {
+		if wt := req.GetWorkflowType(); wt != nil {
+			attrs.workflowType, attrs.hasWorkflowType = wt.GetName(), true
+		}
+	}
+	return attrs
+}
+
+func (r *PolicyRule) matches(attrs policyAttributes) bool {
+	if len(r.Subjects) > 0 && !matchAnyPattern(r.Subjects, attrs.subject) {
+		return false
+	}
+	if len(r.Namespaces) > 0 && !matchAnyPattern(r.Namespaces, attrs.namespace) {
+		return false
+	}
+	if len(r.APIs) > 0 && !r.matchesAPI(attrs) {
+		return false
+	}
+	if len(r.TaskQueues) > 0 && !r.matchesRequestField(r.TaskQueues, attrs.taskQueue, attrs.hasTaskQueue) {
+		return false
+	}
+	if len(r.WorkflowTypes) > 0 && !r.matchesRequestField(r.WorkflowTypes, attrs.workflowType, attrs.hasWorkflowType) {
+		return false
+	}
+	return true
+}
+
+// matchesRequestField matches a field taken from the request. A request that doesn't set the
+// field matches deny rules, so that they fail closed, but not allow rules.
+func (r *PolicyRule) matchesRequestField(patterns []string, value string, ok bool) bool {
+	if !ok {
+		return r.Effect == PolicyEffectDeny
+	}
+	return matchAnyPattern(patterns, value)
+}
+
+func (r *PolicyRule) matchesAPI(attrs policyAttributes) bool {
+	for _, pattern := range r.APIs {
+		if matchAPIPattern(pattern, attrs.apiName) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchAPIPattern matches a full API name against a pattern that is either a full API name
+// (starting with "/") or a method name.
+func matchAPIPattern(pattern, apiName string) bool {
+	value := api.MethodName(apiName)
+	if strings.HasPrefix(pattern, "/") {
+		value = apiName
+	}
+	return matchPattern(pattern, value)
+}
+
+func matchAnyPattern(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if matchPattern(pattern, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchPattern matches value against a pattern where "*" matches any sequence of characters.
+func matchPattern(pattern, value string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == value
+	}
+	if !strings.HasPrefix(value, parts[0]) {
+		return false
+	}
+	value = value[len(parts[0]):]
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		idx := strings.Index(value, part)
+		if idx < 0 {
+			return false
+		}
+		value = value[idx+len(part):]
+	}
+	return len(value) >= len(last) && strings.HasSuffix(value, last)
+}
@@ -0,0 +1,384 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+)
+
+const testPolicy = `
+fallback: deny
+rules:
+  - name: no-terminate
+    effect: deny
+    apis: ["TerminateWorkflowExecution"]
+  - name: team-x-signal
+    effect: allow
+    subjects: ["team-x"]
+    namespaces: ["shared"]
+    apis: ["StartWorkflowExecution", "/temporal.api.workflowservice.v1.WorkflowService/SignalWithStart*"]
+    workflowTypes: ["OrderWorkflow"]
+  - name: team-x-block-other-types
+    effect: deny
+    subjects: ["team-x"]
+  - name: workers-poll-z
+    effect: allow
+    subjects: ["worker-*"]
+    namespaces: ["shared"]
+    apis: ["Poll*TaskQueue"]
+    taskQueues: ["z"]
+  - name: readers
+    effect: allow
+    subjects: ["*"]
+    apis: ["GetSystemInfo", "ListWorkflowExecutions"]
+`
+
+type (
+	policyAuthorizerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		authorizer *policyAuthorizer
+	}
+)
+
+func TestPolicyAuthorizerSuite(t *testing.T) {
+	suite.Run(t, new(policyAuthorizerSuite))
+}
+
+func (s *policyAuthorizerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	policy, err := LoadPolicy([]byte(testPolicy))
+	s.NoError(err)
+	s.authorizer, err = NewPolicyAuthorizer(policy, log.NewNoopLogger())
+	s.NoError(err)
+}
+
+func workflowServiceTarget(method, namespace string, request any) CallTarget {
+	return CallTarget{
+		APIName:   "/temporal.api.workflowservice.v1.WorkflowService/" + method,
+		Namespace: namespace,
+		Request:   request,
+	}
+}
+
+func (s *policyAuthorizerSuite) TestAuthorize() {
+	signalWithStart := func(wfType string) *workflowservice.SignalWithStartWorkflowExecutionRequest {
+		return &workflowservice.SignalWithStartWorkflowExecutionRequest{
+			WorkflowType: &commonpb.WorkflowType{Name: wfType},
+		}
+	}
+	pollWorkflow := func(tq string) *workflowservice.PollWorkflowTaskQueueRequest {
+		return &workflowservice.PollWorkflowTaskQueueRequest{TaskQueue: &taskqueuepb.TaskQueue{Name: tq}}
+	}
+	pollActivity := func(tq string) *workflowservice.PollActivityTaskQueueRequest {
+		return &workflowservice.PollActivityTaskQueueRequest{TaskQueue: &taskqueuepb.TaskQueue{Name: tq}}
+	}
+
+	testCases := []struct {
+		Name     string
+		Claims   *Claims
+		Target   CallTarget
+		Decision Decision
+	}{
+		// workflow type rules
+		{"TeamXSignalWithStartOrder", &Claims{Subject: "team-x"},
+			workflowServiceTarget("SignalWithStartWorkflowExecution", "shared", signalWithStart("OrderWorkflow")), DecisionAllow},
+		{"TeamXSignalWithStartOtherType", &Claims{Subject: "team-x"},
+			workflowServiceTarget("SignalWithStartWorkflowExecution", "shared", signalWithStart("PaymentWorkflow")), DecisionDeny},
+		{"TeamXSignalWithStartOtherNamespace", &Claims{Subject: "team-x"},
+			workflowServiceTarget("SignalWithStartWorkflowExecution", "private", signalWithStart("OrderWorkflow")), DecisionDeny},
+		{"TeamYSignalWithStartOrder", &Claims{Subject: "team-y"},
+			workflowServiceTarget("SignalWithStartWorkflowExecution", "shared", signalWithStart("OrderWorkflow")), DecisionDeny},
+		// request without a workflow type doesn't match a rule that requires one
+		{"TeamXSignalWithoutType", &Claims{Subject: "team-x"},
+			workflowServiceTarget("SignalWorkflowExecution", "shared", &workflowservice.SignalWorkflowExecutionRequest{}), DecisionDeny},
+		{"TeamXDescribeWithoutType", &Claims{Subject: "team-x"},
+			workflowServiceTarget("DescribeWorkflowExecution", "shared", &workflowservice.DescribeWorkflowExecutionRequest{}), DecisionDeny},
+
+		{"TeamXStartOrder", &Claims{Subject: "team-x"},
+			workflowServiceTarget("StartWorkflowExecution", "shared", &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType: &commonpb.WorkflowType{Name: "OrderWorkflow"},
+			}), DecisionAllow},
+		{"TeamXStartWithoutType", &Claims{Subject: "team-x"},
+			workflowServiceTarget("StartWorkflowExecution", "shared", &workflowservice.StartWorkflowExecutionRequest{}), DecisionDeny},
+
+		// task queue rules
+		{"WorkerPollWorkflowZ", &Claims{Subject: "worker-1"},
+			workflowServiceTarget("PollWorkflowTaskQueue", "shared", pollWorkflow("z")), DecisionAllow},
+		{"WorkerPollActivityZ", &Claims{Subject: "worker-2"},
+			workflowServiceTarget("PollActivityTaskQueue", "shared", pollActivity("z")), DecisionAllow},
+		{"WorkerPollOtherQueue", &Claims{Subject: "worker-1"},
+			workflowServiceTarget("PollWorkflowTaskQueue", "shared", pollWorkflow("other")), DecisionDeny},
+		{"WorkerPollOtherNamespace", &Claims{Subject: "worker-1"},
+			workflowServiceTarget("PollWorkflowTaskQueue", "private", pollWorkflow("z")), DecisionDeny},
+		{"NonWorkerPollZ", &Claims{Subject: "service"},
+			workflowServiceTarget("PollWorkflowTaskQueue", "shared", pollWorkflow("z")), DecisionDeny},
+		{"WorkerPollNilTaskQueue", &Claims{Subject: "worker-1"},
+			workflowServiceTarget("PollWorkflowTaskQueue", "shared", &workflowservice.PollWorkflowTaskQueueRequest{}), DecisionDeny},
+
+		// rule order: earlier deny wins over later allow
+		{"TerminateDeniedForAll", &Claims{Subject: "worker-1"},
+			workflowServiceTarget("TerminateWorkflowExecution", "shared", nil), DecisionDeny},
+		{"TeamXBlockedFromReaders", &Claims{Subject: "team-x"},
+			workflowServiceTarget("ListWorkflowExecutions", "shared", nil), DecisionDeny},
+
+		// wildcard subject and no namespace restriction
+		{"AnyoneListWorkflows", &Claims{Subject: "someone"},
+			workflowServiceTarget("ListWorkflowExecutions", "any", nil), DecisionAllow},
+		{"NoClaimsListWorkflows", nil,
+			workflowServiceTarget("ListWorkflowExecutions", "any", nil), DecisionAllow},
+
+		// fallback
+		{"NoMatchDenied", &Claims{Subject: "someone", System: RoleAdmin},
+			workflowServiceTarget("StartWorkflowExecution", "shared", nil), DecisionDeny},
+
+		// health checks always allowed
+		{"HealthCheck", nil, targetGrpcHealthCheck, DecisionAllow},
+	}
+
+	for _, tt := range testCases {
+		result, err := s.authorizer.Authorize(context.Background(), tt.Claims, &tt.Target)
+		s.NoError(err)
+		s.Equal(tt.Decision, result.Decision, "Failed case: %v", tt.Name)
+	}
+}
+
+func (s *policyAuthorizerSuite) TestFallback() {
+	testCases := []struct {
+		Name     string
+		Fallback string
+		Claims   *Claims
+		Target   CallTarget
+		Decision Decision
+	}{
+		{"DefaultIsDeny", "", &claimsSystemAdmin, targetStartWorkflow, DecisionDeny},
+		{"Deny", PolicyFallbackDeny, &claimsSystemAdmin, targetStartWorkflow, DecisionDeny},
+		{"Allow", PolicyFallbackAllow, &claimsNone, targetStartWorkflow, DecisionAllow},
+		{"AllowWithoutClaims", PolicyFallbackAllow, nil, targetStartWorkflow, DecisionAllow},
+		{"RolesAllowed", PolicyFallbackRoles, &claimsNamespaceWriter, targetStartWorkflow, DecisionAllow},
+		{"RolesDenied", PolicyFallbackRoles, &claimsNamespaceReader, targetStartWorkflow, DecisionDeny},
+		{"RolesWithoutClaims", PolicyFallbackRoles, nil, targetStartWorkflow, DecisionDeny},
+		{"RolesAdminAPI", PolicyFallbackRoles, &claimsNamespaceAdmin, targetAdminAPI, DecisionDeny},
+	}
+
+	for _, tt := range testCases {
+		authorizer, err := NewPolicyAuthorizer(&Policy{Fallback: tt.Fallback}, log.NewNoopLogger())
+		s.NoError(err)
+		result, err := authorizer.Authorize(context.Background(), tt.Claims, &tt.Target)
+		s.NoError(err)
+		s.Equal(tt.Decision, result.Decision, "Failed case: %v", tt.Name)
+	}
+}
+
+func (s *policyAuthorizerSuite) TestRuleOverridesRoles() {
+	policy, err := LoadPolicy([]byte(`
+fallback: roles
+rules:
+  - name: readonly-namespace
+    effect: deny
+    namespaces: ["` + testNamespace + `"]
+    apis: ["StartWorkflowExecution"]
+`))
+	s.NoError(err)
+	authorizer, err := NewPolicyAuthorizer(policy, log.NewNoopLogger())
+	s.NoError(err)
+
+	result, err := authorizer.Authorize(context.Background(), &claimsSystemAdmin, &targetStartWorkflow)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+
+	result, err = authorizer.Authorize(context.Background(), &claimsSystemAdmin, &targetAdminAPI)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+}
+
+func (s *policyAuthorizerSuite) TestTaskQueueNameRequest() {
+	policy := &Policy{Rules: []PolicyRule{{
+		Name:       "versioning",
+		Effect:     PolicyEffectAllow,
+		APIs:       []string{"UpdateWorkerVersioningRules"},
+		TaskQueues: []string{"z"},
+	}}}
+	authorizer, err := NewPolicyAuthorizer(policy, log.NewNoopLogger())
+	s.NoError(err)
+
+	// UpdateWorkerVersioningRulesRequest has a string task queue field
+	target := workflowServiceTarget("UpdateWorkerVersioningRules", "shared",
+		&workflowservice.UpdateWorkerVersioningRulesRequest{TaskQueue: "z"})
+	result, err := authorizer.Authorize(context.Background(), nil, &target)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+
+	target.Request = &workflowservice.UpdateWorkerVersioningRulesRequest{TaskQueue: "y"}
+	result, err = authorizer.Authorize(context.Background(), nil, &target)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+}
+
+func (s *policyAuthorizerSuite) TestInvalidPolicy() {
+	testCases := []struct {
+		Name   string
+		Policy string
+	}{
+		{"BadYaml", "rules: ["},
+		{"UnknownFallback", "fallback: maybe"},
+		{"MissingEffect", "rules: [{name: a}]"},
+		{"UnknownEffect", "rules: [{name: a, effect: permit}]"},
+		// rules restricting request fields must only list APIs that carry them
+		{"WorkflowTypeWithoutAPIs", "rules: [{name: a, effect: deny, workflowTypes: [X]}]"},
+		{"WorkflowTypeOnSignal", "rules: [{name: a, effect: deny, apis: [SignalWorkflowExecution], workflowTypes: [X]}]"},
+		{"WorkflowTypeOnAnyAPI", "rules: [{name: a, effect: deny, apis: ['*'], workflowTypes: [X]}]"},
+		{"WorkflowTypeOnTerminate", "rules: [{name: a, effect: deny, apis: ['/temporal.api.workflowservice.v1.WorkflowService/Terminate*'], workflowTypes: [X]}]"},
+		{"TaskQueueOnRequestCancel", "rules: [{name: a, effect: deny, apis: [RequestCancelWorkflowExecution], taskQueues: [z]}]"},
+		{"TaskQueueOnUnknownAPI", "rules: [{name: a, effect: deny, apis: [NoSuchAPI], taskQueues: [z]}]"},
+	}
+	for _, tt := range testCases {
+		_, err := LoadPolicy([]byte(tt.Policy))
+		s.Error(err, "Failed case: %v", tt.Name)
+	}
+}
+
+func (s *policyAuthorizerSuite) TestDenyRuleWithoutRequestField() {
+	policy, err := LoadPolicy([]byte(`
+fallback: allow
+rules:
+  - name: no-order-starts
+    effect: deny
+    apis: ["StartWorkflowExecution", "SignalWithStartWorkflowExecution"]
+    workflowTypes: ["OrderWorkflow"]
+`))
+	s.NoError(err)
+	authorizer, err := NewPolicyAuthorizer(policy, log.NewNoopLogger())
+	s.NoError(err)
+
+	for _, tt := range []struct {
+		Name     string
+		Target   CallTarget
+		Decision Decision
+	}{
+		{"Order", workflowServiceTarget("StartWorkflowExecution", "shared", &workflowservice.StartWorkflowExecutionRequest{
+			WorkflowType: &commonpb.WorkflowType{Name: "OrderWorkflow"},
+		}), DecisionDeny},
+		{"OtherType", workflowServiceTarget("StartWorkflowExecution", "shared", &workflowservice.StartWorkflowExecutionRequest{
+			WorkflowType: &commonpb.WorkflowType{Name: "PaymentWorkflow"},
+		}), DecisionAllow},
+		// deny rules fail closed when the request doesn't set the field
+		{"UnsetType", workflowServiceTarget("SignalWithStartWorkflowExecution", "shared",
+			&workflowservice.SignalWithStartWorkflowExecutionRequest{}), DecisionDeny},
+	} {
+		result, err := authorizer.Authorize(context.Background(), nil, &tt.Target)
+		s.NoError(err)
+		s.Equal(tt.Decision, result.Decision, "Failed case: %v", tt.Name)
+	}
+}
+
+func (s *policyAuthorizerSuite) TestReload() {
+	path := filepath.Join(s.T().TempDir(), "policy.yaml")
+	s.NoError(os.WriteFile(path, []byte("fallback: deny"), 0644))
+
+	authorizer, err := NewPolicyAuthorizerFromConfig(&config.AuthorizationPolicy{Filepath: path}, log.NewNoopLogger())
+	s.NoError(err)
+	defer authorizer.Close()
+
+	result, err := authorizer.Authorize(context.Background(), nil, &targetStartWorkflow)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+
+	// unchanged file is not reloaded
+	reloaded, err := authorizer.Reload()
+	s.NoError(err)
+	s.False(reloaded)
+
+	s.NoError(os.WriteFile(path, []byte("fallback: allow"), 0644))
+	s.NoError(os.Chtimes(path, time.Now().Add(time.Minute), time.Now().Add(time.Minute)))
+	reloaded, err = authorizer.Reload()
+	s.NoError(err)
+	s.True(reloaded)
+
+	result, err = authorizer.Authorize(context.Background(), nil, &targetStartWorkflow)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+
+	// invalid file keeps the previous policy
+	s.NoError(os.WriteFile(path, []byte("fallback: maybe"), 0644))
+	s.NoError(os.Chtimes(path, time.Now().Add(2*time.Minute), time.Now().Add(2*time.Minute)))
+	_, err = authorizer.Reload()
+	s.Error(err)
+
+	result, err = authorizer.Authorize(context.Background(), nil, &targetStartWorkflow)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+}
+
+func (s *policyAuthorizerSuite) TestFromConfig() {
+	_, err := NewAuthorizerFromConfig(&config.Authorization{Authorizer: "policy"}, log.NewNoopLogger())
+	s.Error(err)
+
+	_, err = NewAuthorizerFromConfig(&config.Authorization{
+		Authorizer: "policy",
+		Policy:     config.AuthorizationPolicy{Filepath: "/does/not/exist.yaml"},
+	}, log.NewNoopLogger())
+	s.Error(err)
+}
+
+func TestMatchPattern(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		value   string
+		match   bool
+	}{
+		{"abc", "abc", true},
+		{"abc", "abcd", false},
+		{"", "", true},
+		{"*", "", true},
+		{"*", "anything/at/all", true},
+		{"ab*", "abc", true},
+		{"ab*", "a", false},
+		{"*bc", "abc", true},
+		{"*bc", "abcd", false},
+		{"Poll*TaskQueue", "PollWorkflowTaskQueue", true},
+		{"Poll*TaskQueue", "PollTaskQueue", true},
+		{"Poll*TaskQueue", "PollNexusTaskQueues", false},
+		{"a*b*c", "a-b-b-c", true},
+		{"a*b*c", "a-c-b", false},
+		{"aa*aa", "aaa", false},
+	}
+	for _, tt := range testCases {
+		require.Equal(t, tt.match, matchPattern(tt.pattern, tt.value), "pattern %q value %q", tt.pattern, tt.value)
+	}
+}
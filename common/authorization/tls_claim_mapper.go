@@ -0,0 +1,215 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	defaultTLSSubjectTemplate = "{{.CommonName}}"
+
+	tlsFieldCommonName         = "commonname"
+	tlsFieldOrganization       = "organization"
+	tlsFieldOrganizationalUnit = "organizationalunit"
+	tlsFieldDNSName            = "dnsname"
+	tlsFieldURI                = "uri"
+	tlsFieldEmail              = "email"
+
+	// tlsRuleValueKey is the template key for the whole matched field value
+	tlsRuleValueKey = "value"
+)
+
+type (
+	// tlsClaimMapper derives claims from the client certificate presented on an mTLS connection
+	tlsClaimMapper struct {
+		logger  log.Logger
+		subject *template.Template
+		rules   []tlsClaimRule
+	}
+
+	tlsClaimRule struct {
+		field       string
+		pattern     *regexp.Regexp
+		permissions []*template.Template
+	}
+
+	// tlsCertFields is the data the subject template is evaluated against
+	tlsCertFields struct {
+		CommonName         string
+		Organization       []string
+		OrganizationalUnit []string
+		DNSNames           []string
+		URIs               []string
+		EmailAddresses     []string
+	}
+)
+
+var _ ClaimMapper = (*tlsClaimMapper)(nil)
+
+// NewTLSClaimMapper creates a ClaimMapper that maps fields of the client certificate to a
+// subject and permissions as described by cfg. Returns an error if any of the templates or
+// patterns are invalid.
+func NewTLSClaimMapper(cfg *config.TLSClaimMapper, logger log.Logger) (ClaimMapper, error) {
+	subjectTemplate := cfg.Subject
+	if subjectTemplate == "" {
+		subjectTemplate = defaultTLSSubjectTemplate
+	}
+	subject, err := template.New("subject").Option("missingkey=error").Parse(subjectTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tls claim mapper subject template: %w", err)
+	}
+
+	rules := make([]tlsClaimRule, 0, len(cfg.Rules))
+	for i, r := range cfg.Rules {
+		field := strings.ToLower(r.Field)
+		switch field {
+		case tlsFieldCommonName, tlsFieldOrganization, tlsFieldOrganizationalUnit,
+			tlsFieldDNSName, tlsFieldURI, tlsFieldEmail:
+		default:
+			return nil, fmt.Errorf("tls claim mapper rule %d: unknown field: %q", i, r.Field)
+		}
+		pattern, err := regexp.Compile(r.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("tls claim mapper rule %d: invalid pattern: %w", i, err)
+		}
+		rule := tlsClaimRule{field: field, pattern: pattern}
+		for j, p := range r.Permissions {
+			t, err := template.New(fmt.Sprintf("rule%d-permission%d", i, j)).Option("missingkey=error").Parse(p)
+			if err != nil {
+				return nil, fmt.Errorf("tls claim mapper rule %d: invalid permission template: %w", i, err)
+			}
+			rule.permissions = append(rule.permissions, t)
+		}
+		rules = append(rules, rule)
+	}
+
+	return &tlsClaimMapper{
+		logger:  logger,
+		subject: subject,
+		rules:   rules,
+	}, nil
+}
+
+func (m *tlsClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
+	claims := Claims{}
+
+	fields, ok := certFields(authInfo)
+	if !ok {
+		return &claims, nil
+	}
+
+	var subject strings.Builder
+	if err := m.subject.Execute(&subject, fields); err != nil {
+		return nil, fmt.Errorf("unable to evaluate tls claim mapper subject template: %w", err)
+	}
+	claims.Subject = subject.String()
+
+	for _, rule := range m.rules {
+		for _, value := range fields.values(rule.field) {
+			match := rule.pattern.FindStringSubmatch(value)
+			if match == nil {
+				continue
+			}
+			data := map[string]string{tlsRuleValueKey: value}
+			for i, name := range rule.pattern.SubexpNames() {
+				if name != "" {
+					data[name] = match[i]
+				}
+			}
+			for _, t := range rule.permissions {
+				var permission strings.Builder
+				if err := t.Execute(&permission, data); err != nil {
+					return nil, fmt.Errorf("unable to evaluate tls claim mapper permission template: %w", err)
+				}
+				if !addPermission(permission.String(), &claims) {
+					m.logger.Warn("ignoring permission in unexpected format",
+						tag.NewStringTag("permission", permission.String()))
+				}
+			}
+		}
+	}
+	return &claims, nil
+}
+
+// certFields extracts the fields rules can match on. The full certificate is used when
+// available; otherwise only the subject name is.
+func certFields(authInfo *AuthInfo) (tlsCertFields, bool) {
+	if cert := PeerCert(authInfo.TLSConnection); cert != nil {
+		return certFieldsFromCertificate(cert), true
+	}
+	if authInfo.TLSSubject != nil {
+		return certFieldsFromName(authInfo.TLSSubject), true
+	}
+	return tlsCertFields{}, false
+}
+
+func certFieldsFromName(name *pkix.Name) tlsCertFields {
+	return tlsCertFields{
+		CommonName:         name.CommonName,
+		Organization:       name.Organization,
+		OrganizationalUnit: name.OrganizationalUnit,
+	}
+}
+
+func certFieldsFromCertificate(cert *x509.Certificate) tlsCertFields {
+	fields := certFieldsFromName(&cert.Subject)
+	fields.DNSNames = cert.DNSNames
+	fields.EmailAddresses = cert.EmailAddresses
+	for _, uri := range cert.URIs {
+		fields.URIs = append(fields.URIs, uri.String())
+	}
+	return fields
+}
+
+func (f tlsCertFields) values(field string) []string {
+	switch field {
+	case tlsFieldCommonName:
+		if f.CommonName == "" {
+			return nil
+		}
+		return []string{f.CommonName}
+	case tlsFieldOrganization:
+		return f.Organization
+	case tlsFieldOrganizationalUnit:
+		return f.OrganizationalUnit
+	case tlsFieldDNSName:
+		return f.DNSNames
+	case tlsFieldURI:
+		return f.URIs
+	case tlsFieldEmail:
+		return f.EmailAddresses
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/primitives"
+	"google.golang.org/grpc/credentials"
+)
+
+type (
+	tlsClaimMapperSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		config config.TLSClaimMapper
+	}
+)
+
+func TestTLSClaimMapperSuite(t *testing.T) {
+	s := new(tlsClaimMapperSuite)
+	suite.Run(t, s)
+}
+
+func (s *tlsClaimMapperSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.config = config.TLSClaimMapper{
+		Rules: []config.TLSClaimMapperRule{
+			{
+				Field:       "organizationalUnit",
+				Pattern:     "^temporal-(?P<namespace>[a-z-]+)-(?P<role>read|write|admin)$",
+				Permissions: []string{"{{.namespace}}:{{.role}}"},
+			},
+			{
+				Field:       "uri",
+				Pattern:     "^spiffe://example.org/temporal/system$",
+				Permissions: []string{primitives.SystemLocalNamespace + ":admin"},
+			},
+			{
+				Field:       "dnsName",
+				Pattern:     `^(?P<namespace>[a-z-]+)\.workers\.example\.org$`,
+				Permissions: []string{"{{.namespace}}:worker", "{{.namespace}}:read"},
+			},
+		},
+	}
+}
+
+func (s *tlsClaimMapperSuite) newMapper() ClaimMapper {
+	mapper, err := NewTLSClaimMapper(&s.config, log.NewNoopLogger())
+	s.NoError(err)
+	return mapper
+}
+
+func tlsAuthInfo(cert *x509.Certificate) *AuthInfo {
+	return &AuthInfo{
+		TLSSubject: &cert.Subject,
+		TLSConnection: &credentials.TLSInfo{
+			State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}},
+		},
+	}
+}
+
+func (s *tlsClaimMapperSuite) TestNoCertificate() {
+	claims, err := s.newMapper().GetClaims(&AuthInfo{})
+	s.NoError(err)
+	s.Equal(&Claims{}, claims)
+}
+
+func (s *tlsClaimMapperSuite) TestSubjectAndOrganizationalUnits() {
+	cert := &x509.Certificate{
+		Subject: pkix.Name{
+			CommonName:         "payments-service",
+			OrganizationalUnit: []string{"temporal-payments-write", "temporal-orders-read", "unrelated"},
+		},
+	}
+	claims, err := s.newMapper().GetClaims(tlsAuthInfo(cert))
+	s.NoError(err)
+	s.Equal("payments-service", claims.Subject)
+	s.Equal(RoleUndefined, claims.System)
+	s.Equal(map[string]Role{"payments": RoleWriter, "orders": RoleReader}, claims.Namespaces)
+}
+
+func (s *tlsClaimMapperSuite) TestSubjectNameOnly() {
+	name := &pkix.Name{CommonName: "cli", OrganizationalUnit: []string{"temporal-orders-admin"}}
+	claims, err := s.newMapper().GetClaims(&AuthInfo{TLSSubject: name})
+	s.NoError(err)
+	s.Equal("cli", claims.Subject)
+	s.Equal(map[string]Role{"orders": RoleAdmin}, claims.Namespaces)
+}
+
+func (s *tlsClaimMapperSuite) TestSANs() {
+	s.config.Subject = "{{index .URIs 0}}"
+	system, err := url.Parse("spiffe://example.org/temporal/system")
+	s.NoError(err)
+	cert := &x509.Certificate{
+		URIs:     []*url.URL{system},
+		DNSNames: []string{"billing.workers.example.org"},
+	}
+	claims, err := s.newMapper().GetClaims(tlsAuthInfo(cert))
+	s.NoError(err)
+	s.Equal("spiffe://example.org/temporal/system", claims.Subject)
+	s.Equal(RoleAdmin, claims.System)
+	s.Equal(map[string]Role{"billing": RoleWorker | RoleReader}, claims.Namespaces)
+}
+
+func (s *tlsClaimMapperSuite) TestSubjectTemplateError() {
+	s.config.Subject = "{{index .URIs 0}}"
+	_, err := s.newMapper().GetClaims(tlsAuthInfo(&x509.Certificate{}))
+	s.Error(err)
+}
+
+func (s *tlsClaimMapperSuite) TestInvalidConfig() {
+	testCases := []struct {
+		name string
+		cfg  config.TLSClaimMapper
+	}{
+		{"subject", config.TLSClaimMapper{Subject: "{{.CommonName"}},
+		{"field", config.TLSClaimMapper{Rules: []config.TLSClaimMapperRule{{Field: "serial"}}}},
+		{"pattern", config.TLSClaimMapper{Rules: []config.TLSClaimMapperRule{{Field: "uri", Pattern: "("}}}},
+		{"permission", config.TLSClaimMapper{Rules: []config.TLSClaimMapperRule{
+			{Field: "uri", Pattern: ".*", Permissions: []string{"{{.value"}},
+		}}},
+	}
+	for _, tc := range testCases {
+		_, err := NewTLSClaimMapper(&tc.cfg, log.NewNoopLogger())
+		s.Error(err, tc.name)
+	}
+}
+
+func (s *tlsClaimMapperSuite) TestCompositeWithJWT() {
+	jwtMapper := &staticClaimMapper{claims: &Claims{
+		Subject:    "alice",
+		Namespaces: map[string]Role{"payments": RoleReader},
+	}}
+	cert := &x509.Certificate{
+		Subject: pkix.Name{
+			CommonName:         "payments-service",
+			OrganizationalUnit: []string{"temporal-payments-write"},
+		},
+	}
+	mapper := NewCompositeClaimMapper(jwtMapper, s.newMapper())
+	claims, err := mapper.GetClaims(tlsAuthInfo(cert))
+	s.NoError(err)
+	s.Equal("alice", claims.Subject)
+	s.Equal(map[string]Role{"payments": RoleReader | RoleWriter}, claims.Namespaces)
+	s.True(mapper.(ClaimMapperWithAuthInfoRequired).AuthInfoRequired())
+
+	// a rejected token fails the request even with a valid certificate
+	jwtMapper.claims, jwtMapper.err = nil, errUnauthorized
+	_, err = mapper.GetClaims(tlsAuthInfo(cert))
+	s.ErrorIs(err, errUnauthorized)
+}
+
+type staticClaimMapper struct {
+	claims *Claims
+	err    error
+}
+
+func (m *staticClaimMapper) GetClaims(_ *AuthInfo) (*Claims, error) {
+	return m.claims, m.err
+}
@@ -71,6 +71,10 @@ type (
 		// DynamicConfigClient is the config for setting up the file based dynamic config client
 		// Filepath should be relative to the root directory
 		DynamicConfigClient *dynamicconfig.FileBasedClientConfig `yaml:"dynamicConfigClient"`
+		// RemoteDynamicConfigClient is the config for polling dynamic config from an HTTP endpoint.
+		// If DynamicConfigClient is also set, values from the endpoint take precedence over
+		// values from the file.
+		RemoteDynamicConfigClient *dynamicconfig.RemoteClientConfig `yaml:"remoteDynamicConfigClient"`
 		// NamespaceDefaults is the default config for every namespace
 		NamespaceDefaults NamespaceDefaults `yaml:"namespaceDefaults"`
 		// ExporterConfig allows the specification of process-wide OTEL exporters
@@ -160,6 +164,22 @@ type (
 		Metrics *metrics.Config `yaml:"metrics"`
 		// Settings for authentication and authorization
 		Authorization Authorization `yaml:"authorization"`
+		// Audit is the configuration for auditing mutating frontend calls
+		Audit Audit `yaml:"audit"`
+	}
+
+	// Audit contains the config for the audit log of mutating frontend calls
+	Audit struct {
+		// Sink is where audit events are written: empty to disable auditing, "log", "file" or
+		// "persistence". Events written to persistence can be read with the ListAuditEvents
+		// admin API.
+		Sink string `yaml:"sink"`
+		// Filepath is the file that events are appended to, one JSON object per line, when
+		// Sink is "file"
+		Filepath string `yaml:"filepath"`
+		// Retention is how long events are kept when Sink is "persistence". Defaults to 7 days.
+		// Old events are deleted hourly by a single frontend host, elected in the membership ring.
+		Retention time.Duration `yaml:"retention"`
 	}
 
 	// RootTLS contains all TLS settings for the Temporal server
@@ -290,6 +310,8 @@ type (
 		DataStores map[string]DataStore `yaml:"datastores"`
 		// TransactionSizeLimit is the largest allowed transaction size
 		TransactionSizeLimit dynamicconfig.IntPropertyFn `yaml:"-" json:"-"`
+		// BlobCompression is the compression applied to history and mutable state blobs
+		BlobCompression dynamicconfig.StringPropertyFnWithNamespaceIDFilter `yaml:"-" json:"-"`
 	}
 
 	// DataStore is the configuration for a single datastore
@@ -500,6 +522,7 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
 		S3store   *S3Archiver        `yaml:"s3store"`
+		Blobstore *BlobstoreArchiver `yaml:"blobstore"`
 	}
 
 	// VisibilityArchival contains the config for visibility archival
@@ -517,6 +540,7 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		S3store   *S3Archiver        `yaml:"s3store"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
+		Blobstore *BlobstoreArchiver `yaml:"blobstore"`
 	}
 
 	// FilestoreArchiver contain the config for filestore archiver
@@ -538,6 +562,24 @@ type (
 		LogLevel         uint    `yaml:"logLevel"`
 	}
 
+	// BlobstoreArchiver contains the config for blobstore archiver
+	BlobstoreArchiver struct {
+		// Backend is the object store the archiver writes to, either "filesystem" or "memory"
+		Backend string `yaml:"backend"`
+		// RootDir is the directory blobs are written under when using the filesystem backend
+		RootDir  string `yaml:"rootDir"`
+		FileMode string `yaml:"fileMode"`
+		DirMode  string `yaml:"dirMode"`
+		// VisibilityFormat is the format visibility records are archived in, either "json" (default),
+		// which writes one blob per record, or "parquet", which batches records into columnar files
+		// partitioned by namespace and close date
+		VisibilityFormat string `yaml:"visibilityFormat"`
+		// ParquetBatchSize is the max number of records written into a single parquet file
+		ParquetBatchSize int `yaml:"parquetBatchSize"`
+		// ParquetFlushInterval is the max time a record is buffered before its batch is written
+		ParquetFlushInterval time.Duration `yaml:"parquetFlushInterval"`
+	}
+
 	// PublicClient is the config for internal nodes (history/matching/worker) connecting to
 	// frontend. There are three methods of connecting:
 	// 1. Use membership to locate "internal-frontend" and connect to them using the Internode
@@ -601,16 +643,74 @@ type (
 		// Signing key provider for validating JWT tokens
 		JWTKeyProvider       JWTKeyProvider `yaml:"jwtKeyProvider"`
 		PermissionsClaimName string         `yaml:"permissionsClaimName"`
-		// Empty string for noopAuthorizer or "default" for defaultAuthorizer
+		// Empty string for noopAuthorizer, "default" for defaultAuthorizer or "policy" for policyAuthorizer
 		Authorizer string `yaml:"authorizer"`
-		// Empty string for noopClaimMapper or "default" for defaultJWTClaimMapper
+		// Policy is the config for policyAuthorizer
+		Policy AuthorizationPolicy `yaml:"policy"`
+		// Empty string for noopClaimMapper, "default" for defaultJWTClaimMapper, "tls" for
+		// tlsClaimMapper, "default+tls" to combine claims from both or "introspection" for
+		// introspectionClaimMapper
 		ClaimMapper string `yaml:"claimMapper"`
+		// TLSClaimMapper is the config for tlsClaimMapper
+		TLSClaimMapper TLSClaimMapper `yaml:"tlsClaimMapper"`
+		// TokenIntrospection is the config for introspectionClaimMapper
+		TokenIntrospection TokenIntrospection `yaml:"tokenIntrospection"`
 		// Name of main auth header to pass to ClaimMapper (as `AuthToken`). Defaults to `authorization`.
 		AuthHeaderName string `yaml:"authHeaderName"`
 		// Name of extra auth header to pass to ClaimMapper (as `ExtraData`). Defaults to `authorization-extras`.
 		AuthExtraHeaderName string `yaml:"authExtraHeaderName"`
 	}
 
+	// AuthorizationPolicy contains the config for loading authorization policy rules from a file
+	AuthorizationPolicy struct {
+		Filepath string `yaml:"filepath"`
+		// If set, the file is checked for changes at this interval and reloaded
+		RefreshInterval time.Duration `yaml:"refreshInterval"`
+	}
+
+	// TLSClaimMapper contains the config for deriving claims from a client certificate
+	TLSClaimMapper struct {
+		// Subject is a text/template evaluated against the certificate fields (CommonName,
+		// Organization, OrganizationalUnit, DNSNames, URIs and EmailAddresses) to produce the
+		// subject of the claims. Defaults to "{{.CommonName}}".
+		Subject string `yaml:"subject"`
+		// Rules are evaluated against every value of the certificate field they reference, and
+		// all matching rules contribute permissions.
+		Rules []TLSClaimMapperRule `yaml:"rules"`
+	}
+
+	// TLSClaimMapperRule grants permissions to certificates with a field value matching Pattern
+	TLSClaimMapperRule struct {
+		// One of "commonName", "organization", "organizationalUnit", "dnsName", "uri" or "email"
+		Field string `yaml:"field"`
+		// Regular expression the field value must match. Named capture groups are available to
+		// the permission templates, e.g. "^temporal-(?P<namespace>[a-z-]+)$".
+		Pattern string `yaml:"pattern"`
+		// Permissions in the "<namespace>:<role>" format used by JWT claims. Each one is a
+		// text/template evaluated with the named capture groups and {{.value}}, the whole value.
+		Permissions []string `yaml:"permissions"`
+	}
+
+	// TokenIntrospection contains the config for validating opaque access tokens with an
+	// OAuth 2.0 token introspection endpoint (RFC 7662)
+	TokenIntrospection struct {
+		// Endpoint is the URL of the introspection endpoint
+		Endpoint string `yaml:"endpoint"`
+		// ClientID and ClientSecret are sent using HTTP basic authentication if set
+		ClientID     string `yaml:"clientId"`
+		ClientSecret string `yaml:"clientSecret"`
+		// Timeout for introspection requests. Defaults to 10s.
+		Timeout time.Duration `yaml:"timeout"`
+		// CacheTTL is how long introspection results are cached. Results are never cached
+		// past the expiry of the token. Defaults to 1m; a negative value disables caching.
+		CacheTTL time.Duration `yaml:"cacheTTL"`
+		// CacheSize is the maximum number of cached results. Defaults to 10000.
+		CacheSize int `yaml:"cacheSize"`
+		// ScopePermissions maps each OAuth scope to permissions in the "<namespace>:<role>"
+		// format used by JWT claims. Scopes not listed here are ignored.
+		ScopePermissions map[string][]string `yaml:"scopePermissions"`
+	}
+
 	// @@@SNIPSTART temporal-common-service-config-jwtkeyprovider
 	// Contains the config for signing key provider for validating JWT tokens
 	JWTKeyProvider struct {
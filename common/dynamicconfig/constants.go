@@ -162,6 +162,17 @@ for signal / start / signal with start API if namespace is not active`,
 		primitives.DefaultTransactionSizeLimit,
 		`TransactionSizeLimit is the largest allowed transaction size to persistence`,
 	)
+	PersistenceBlobCompression = NewNamespaceIDStringSetting(
+		"system.persistenceBlobCompression",
+		"none",
+		`PersistenceBlobCompression is the compression applied to history event batches, buffered events and the
+execution, activity, timer, child execution, request cancel and signal infos of mutable state before they are
+written to persistence. The execution state, CHASM nodes, checksums and tasks are never compressed. Allowed values
+are "none", "snappy" and "zstd". Blobs are decompressed on read regardless of this setting, so it can be changed
+at any time without a downgrade in between. Server versions without compression support can't read compressed
+blobs: before rolling back to such a version, set this to "none" and wait until every compressed history and
+mutable state has been rewritten or deleted by retention.`,
+	)
 	DisallowQuery = NewNamespaceBoolSetting(
 		"system.disallowQuery",
 		false,
@@ -450,6 +461,14 @@ number will fail with a FailedPrecondition error.`,
 		`RedirectRuleMaxUpstreamBuildIDsPerQueue is the max number of compatible redirect rules allowed to be connected
 in one chain in the versioning data for a task queue. Update requests which would cause the versioning data
 to exceed this number will fail with a FailedPrecondition error.`,
+	)
+	ActivityTypeRateLimitsPerQueue = NewNamespaceIntSetting(
+		"limit.activityTypeRateLimitsPerQueue",
+		100,
+		`ActivityTypeRateLimitsPerQueue is the max number of per-activity-type dispatch rate limits allowed to be
+defined for a task queue. Update requests which would cause the task queue to exceed this number will fail with
+a FailedPrecondition error. The limits are only enforced by the new matcher: requests that set limits fail with
+a FailedPrecondition error unless matching.useNewMatcher is enabled for the activity task queue.`,
 	)
 	MatchingDeletedRuleRetentionTime = NewNamespaceDurationSetting(
 		"matching.wv.DeletedRuleRetentionTime",
@@ -605,6 +624,13 @@ See DynamicRateLimitingParams comments for more details.`,
 		1000,
 		`FrontendVisibilityMaxPageSize is default max size for ListWorkflowExecutions in one page`,
 	)
+	FrontendVisibilityMaxHistogramBuckets = NewNamespaceIntSetting(
+		"frontend.visibilityMaxHistogramBuckets",
+		1000,
+		`FrontendVisibilityMaxHistogramBuckets is the max number of buckets of a histogram in AggregateWorkflowExecutions,
+counted from the first to the last non-empty bucket. Histograms with more buckets are rejected, since Elasticsearch fails
+searches with too many buckets and SQL databases would return all of them.`,
+	)
 	FrontendHistoryMaxPageSize = NewNamespaceIntSetting(
 		"frontend.historyMaxPageSize",
 		primitives.GetHistoryMaxPageSize,
@@ -637,6 +663,34 @@ This config is EXPERIMENTAL and may be changed or removed in a later release.`,
 		2,
 		`FrontendMaxNamespaceBurstRatioPerInstance is workflow namespace burst limit as a ratio of namespace RPS. The RPS
 used here will be the effective RPS from global and per-instance limits. The value must be 1 or higher.`,
+	)
+	FrontendEnableCallerFairness = NewNamespaceBoolSetting(
+		"frontend.enableCallerFairness",
+		false,
+		`FrontendEnableCallerFairness enables sharing the namespace rate limits fairly between the callers in
+the namespace, as identified by frontend.callerFairnessKey. Each caller that sent requests within
+frontend.callerFairnessActiveWindow is guaranteed its weighted share of the namespace limits, and may
+borrow the part of the limits the other callers leave unused.`,
+	)
+	FrontendCallerFairnessKey = NewNamespaceStringSetting(
+		"frontend.callerFairnessKey",
+		"identity",
+		`FrontendCallerFairnessKey selects how callers are identified for frontend.enableCallerFairness: "identity"
+uses the identity field of the request and "clientName" uses the client-name header. Requests without
+a caller are only subject to the namespace limits.`,
+	)
+	FrontendCallerFairnessWeights = NewNamespaceTypedSetting(
+		"frontend.callerFairnessWeights",
+		map[string]float64(nil),
+		`FrontendCallerFairnessWeights maps callers to their weight when sharing the namespace rate limits.
+Callers that are not listed have a weight of 1. Only listed callers are tagged by name on the
+caller_rate_limited metric, all others are tagged as "_other_".`,
+	)
+	FrontendCallerFairnessActiveWindow = NewGlobalDurationSetting(
+		"frontend.callerFairnessActiveWindow",
+		time.Minute,
+		`FrontendCallerFairnessActiveWindow is how long a caller keeps its share of the namespace rate limits
+after its last request.`,
 	)
 	FrontendMaxConcurrentLongRunningRequestsPerInstance = NewNamespaceIntSetting(
 		"frontend.namespaceCount",
@@ -1310,11 +1364,81 @@ second per poller by one physical queue manager`,
 		5,
 		`Number of simple priority levels (requires new matcher)`,
 	)
+	MatchingEnableFairness = NewTaskQueueBoolSetting(
+		"matching.enableFairness",
+		false,
+		`Enables fairness keys (requires new matcher). When enabled, the backlog of each fairness key is
+tracked in its own subqueue, and tasks at the same priority level are dispatched in weighted round-robin
+order across fairness keys instead of FIFO.`,
+	)
+	MatchingFairnessKeyWeights = NewTaskQueueTypedSetting(
+		"matching.fairnessKeyWeights",
+		map[string]float64{},
+		`Relative dispatch weights of fairness keys (requires fairness). Keys not present have weight 1.`,
+	)
+	MatchingMaxFairnessKeysPerPriority = NewTaskQueueIntSetting(
+		"matching.maxFairnessKeysPerPriority",
+		100,
+		`Maximum number of fairness keys tracked separately per priority level of a task queue (requires
+fairness). Tasks with keys beyond this limit share the backlog of the default key.`,
+	)
+	MatchingFairnessSubqueueIdleTime = NewTaskQueueDurationSetting(
+		"matching.fairnessSubqueueIdleTime",
+		5*time.Minute,
+		`Time after which the subqueue of a fairness key without backlog may be reassigned to a new fairness key
+once matching.maxFairnessKeysPerPriority keys are tracked (requires fairness).`,
+	)
 	MatchingBacklogTaskForwardTimeout = NewTaskQueueDurationSetting(
 		"matching.backlogTaskForwardTimeout",
 		60*time.Second,
 		`Timeout for forwarded backlog task (requires new matcher)`,
 	)
+	MatchingEnablePartitionAutoscaling = NewTaskQueueBoolSetting(
+		"matching.enablePartitionAutoscaling",
+		false,
+		`Enables automatic scaling of the number of task queue partitions based on load. When enabled, the
+partition counts chosen by the root partition override matching.numTaskqueueReadPartitions and
+matching.numTaskqueueWritePartitions.`,
+	)
+	MatchingPartitionAutoscalingInterval = NewTaskQueueDurationSetting(
+		"matching.partitionAutoscalingInterval",
+		time.Minute,
+		`Interval at which the root partition re-evaluates the partition counts (requires partition autoscaling)`,
+	)
+	MatchingPartitionAutoscalingMinPartitions = NewTaskQueueIntSetting(
+		"matching.partitionAutoscalingMinPartitions",
+		1,
+		`Minimum number of partitions chosen by partition autoscaling`,
+	)
+	MatchingPartitionAutoscalingMaxPartitions = NewTaskQueueIntSetting(
+		"matching.partitionAutoscalingMaxPartitions",
+		16,
+		`Maximum number of partitions chosen by partition autoscaling`,
+	)
+	MatchingPartitionAutoscalingTasksPerSecond = NewTaskQueueFloatSetting(
+		"matching.partitionAutoscalingTasksPerSecond",
+		500,
+		`Target add or dispatch rate per partition, in tasks per second, for partition autoscaling`,
+	)
+	MatchingPartitionAutoscalingPollersPerPartition = NewTaskQueueIntSetting(
+		"matching.partitionAutoscalingPollersPerPartition",
+		20,
+		`Target number of distinct pollers per partition for partition autoscaling`,
+	)
+	MatchingPartitionCountsCacheTTL = NewTaskQueueDurationSetting(
+		"matching.partitionCountsCacheTTL",
+		time.Minute,
+		`How long matching clients use partition counts returned by matching (requires partition
+autoscaling). Once they expire, tasks are added to the root partition until fresh counts are
+returned. Partition autoscaling keeps read partitions that are no longer written to for longer
+than this, so that no client adds tasks to a partition that is not read anymore.`,
+	)
+	MatchingPartitionAutoscalingBacklogAge = NewTaskQueueDurationSetting(
+		"matching.partitionAutoscalingBacklogAge",
+		30*time.Second,
+		`Backlog age above which partition autoscaling adds a partition, as long as every partition has
+at least one poller`,
+	)
 
 	// keys for history
 
@@ -1368,6 +1492,14 @@ If value less or equal to 0, will fall back to HistoryPersistenceMaxQPS`,
 		`HistoryPersistenceDynamicRateLimitingParams is a struct that contains all adjustable dynamic rate limiting params.
 Fields: Enabled, RefreshInterval, LatencyThreshold, ErrorThreshold, RateBackoffStepSize, RateIncreaseStepSize, RateMultiMin, RateMultiMax.
 See DynamicRateLimitingParams comments for more details.`,
+	)
+	HistoryPersistenceAdaptiveRateLimitingEnabled = NewNamespaceBoolSetting(
+		"history.persistenceAdaptiveRateLimitingEnabled",
+		true,
+		`HistoryPersistenceAdaptiveRateLimitingEnabled controls whether the per-namespace and per-shard persistence
+rate limits of a namespace are lowered when the persistence latency or error ratio observed for that namespace
+or shard exceeds the thresholds in HistoryPersistenceDynamicRateLimitingParams. It has no effect unless
+HistoryPersistenceDynamicRateLimitingParams is enabled.`,
 	)
 	HistoryLongPollExpirationInterval = NewNamespaceDurationSetting(
 		"history.longPollExpirationInterval",
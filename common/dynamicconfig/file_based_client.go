@@ -203,7 +203,7 @@ func (fc *fileBasedClient) Update() error {
 
 	prev := fc.values.Swap(newValues)
 	oldValues, _ := prev.(configValueMap)
-	changedMap := fc.diffAndLog(oldValues, newValues)
+	changedMap := diffAndLog(fc.logger, oldValues, newValues)
 	fc.logger.Info("Updated dynamic config")
 
 	if len(changedMap) == 0 {
@@ -282,7 +282,7 @@ func (fc *fileBasedClient) validateStaticConfig(config *FileBasedClientConfig) e
 	return nil
 }
 
-func (fc *fileBasedClient) diffAndLog(old configValueMap, new configValueMap) map[Key][]ConstrainedValue {
+func diffAndLog(logger log.Logger, old configValueMap, new configValueMap) map[Key][]ConstrainedValue {
 	changedMap := make(map[Key][]ConstrainedValue)
 
 	for key, newValues := range new {
@@ -290,12 +290,12 @@ func (fc *fileBasedClient) diffAndLog(old configValueMap, new configValueMap) ma
 		if !ok {
 			for _, newValue := range newValues {
 				// new key added
-				fc.diffAndLogValue(key, nil, &newValue)
+				diffAndLogValue(logger, key, nil, &newValue)
 			}
 			changedMap[Key(key)] = newValues
 		} else {
 			// compare existing keys
-			changed := fc.diffAndLogConstraints(key, oldValues, newValues)
+			changed := diffAndLogConstraints(logger, key, oldValues, newValues)
 			if changed {
 				changedMap[Key(key)] = newValues
 			}
@@ -306,7 +306,7 @@ func (fc *fileBasedClient) diffAndLog(old configValueMap, new configValueMap) ma
 	for key, oldValues := range old {
 		if _, ok := new[key]; !ok {
 			for _, oldValue := range oldValues {
-				fc.diffAndLogValue(key, &oldValue, nil)
+				diffAndLogValue(logger, key, &oldValue, nil)
 			}
 			changedMap[Key(key)] = nil
 		}
@@ -315,7 +315,7 @@ func (fc *fileBasedClient) diffAndLog(old configValueMap, new configValueMap) ma
 	return changedMap
 }
 
-func (fc *fileBasedClient) diffAndLogConstraints(key string, oldValues []ConstrainedValue, newValues []ConstrainedValue) bool {
+func diffAndLogConstraints(logger log.Logger, key string, oldValues []ConstrainedValue, newValues []ConstrainedValue) bool {
 	changed := false
 	for _, oldValue := range oldValues {
 		matchFound := false
@@ -323,13 +323,13 @@ func (fc *fileBasedClient) diffAndLogConstraints(key string, oldValues []Constra
 			if oldValue.Constraints == newValue.Constraints {
 				matchFound = true
 				if !reflect.DeepEqual(oldValue.Value, newValue.Value) {
-					fc.diffAndLogValue(key, &oldValue, &newValue)
+					diffAndLogValue(logger, key, &oldValue, &newValue)
 					changed = true
 				}
 			}
 		}
 		if !matchFound {
-			fc.diffAndLogValue(key, &oldValue, nil)
+			diffAndLogValue(logger, key, &oldValue, nil)
 			changed = true
 		}
 	}
@@ -342,26 +342,26 @@ func (fc *fileBasedClient) diffAndLogConstraints(key string, oldValues []Constra
 			}
 		}
 		if !matchFound {
-			fc.diffAndLogValue(key, nil, &newValue)
+			diffAndLogValue(logger, key, nil, &newValue)
 			changed = true
 		}
 	}
 	return changed
 }
 
-func (fc *fileBasedClient) diffAndLogValue(key string, oldValue *ConstrainedValue, newValue *ConstrainedValue) {
+func diffAndLogValue(logger log.Logger, key string, oldValue *ConstrainedValue, newValue *ConstrainedValue) {
 	logLine := &strings.Builder{}
 	logLine.Grow(128)
 	logLine.WriteString("dynamic config changed for the key: ")
 	logLine.WriteString(key)
 	logLine.WriteString(" oldValue: ")
-	fc.appendConstrainedValue(logLine, oldValue)
+	appendConstrainedValue(logLine, oldValue)
 	logLine.WriteString(" newValue: ")
-	fc.appendConstrainedValue(logLine, newValue)
-	fc.logger.Info(logLine.String())
+	appendConstrainedValue(logLine, newValue)
+	logger.Info(logLine.String())
 }
 
-func (fc *fileBasedClient) appendConstrainedValue(logLine *strings.Builder, value *ConstrainedValue) {
+func appendConstrainedValue(logLine *strings.Builder, value *ConstrainedValue) {
 	if value == nil {
 		logLine.WriteString("nil")
 	} else {
@@ -0,0 +1,185 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"slices"
+	"sync"
+
+	"go.temporal.io/server/common/log"
+	expmaps "golang.org/x/exp/maps"
+)
+
+var _ Client = (*layeredClient)(nil)
+var _ NotifyingClient = (*notifyingLayeredClient)(nil)
+
+type (
+	// layeredClient merges values from multiple clients. Layers are ordered from highest to
+	// lowest precedence: for each key, a value from an earlier layer replaces a value with the
+	// same constraints from a later layer, while values with other constraints are kept.
+	layeredClient struct {
+		layers []Client
+	}
+
+	// notifyingLayeredClient is used when every layer is a NotifyingClient. Any change in a
+	// layer is forwarded to subscribers with the merged values for the changed keys.
+	notifyingLayeredClient struct {
+		layeredClient
+
+		subscriptionLock sync.Mutex
+		subscriptionIdx  int
+		subscriptions    map[int]ClientUpdateFunc
+		cancelLayers     []func()
+
+		// serializes notifications, since layers may notify concurrently
+		notifyLock sync.Mutex
+	}
+)
+
+// NewLayeredClient returns a Client that merges values from the given clients, with earlier
+// clients taking precedence over later ones. If all clients implement NotifyingClient, so
+// does the returned client; otherwise the Collection falls back to polling.
+func NewLayeredClient(clients ...Client) Client {
+	switch len(clients) {
+	case 0:
+		return NewNoopClient()
+	case 1:
+		return clients[0]
+	}
+
+	base := layeredClient{layers: clients}
+	for _, c := range clients {
+		if _, ok := c.(NotifyingClient); !ok {
+			return &base
+		}
+	}
+	return &notifyingLayeredClient{
+		layeredClient: base,
+		subscriptions: make(map[int]ClientUpdateFunc),
+	}
+}
+
+// NewClientFromConfig creates the dynamic config client described by the file and remote
+// configs, either of which may be nil. When both are set, values from the remote endpoint
+// take precedence over values from the file. Returns nil if neither is set.
+func NewClientFromConfig(
+	fileConfig *FileBasedClientConfig,
+	remoteConfig *RemoteClientConfig,
+	logger log.Logger,
+	doneCh <-chan interface{},
+) (Client, error) {
+	var clients []Client
+	if remoteConfig != nil {
+		client, err := NewRemoteClient(remoteConfig, logger, doneCh)
+		if err != nil {
+			return nil, err
+		}
+		clients = append(clients, client)
+	}
+	if fileConfig != nil {
+		client, err := NewFileBasedClient(fileConfig, logger, doneCh)
+		if err != nil {
+			return nil, err
+		}
+		clients = append(clients, client)
+	}
+	if len(clients) == 0 {
+		return nil, nil
+	}
+	return NewLayeredClient(clients...), nil
+}
+
+func (lc *layeredClient) GetValue(key Key) []ConstrainedValue {
+	var found [][]ConstrainedValue
+	for _, layer := range lc.layers {
+		if cvs := layer.GetValue(key); len(cvs) > 0 {
+			found = append(found, cvs)
+		}
+	}
+	if len(found) <= 1 {
+		// common case: avoid allocating when there's nothing to merge
+		if len(found) == 0 {
+			return nil
+		}
+		return found[0]
+	}
+
+	var merged []ConstrainedValue
+	for _, cvs := range found {
+		for _, cv := range cvs {
+			if !slices.ContainsFunc(merged, func(m ConstrainedValue) bool { return m.Constraints == cv.Constraints }) {
+				merged = append(merged, cv)
+			}
+		}
+	}
+	return merged
+}
+
+func (lc *notifyingLayeredClient) Subscribe(f ClientUpdateFunc) (cancel func()) {
+	lc.subscriptionLock.Lock()
+	defer lc.subscriptionLock.Unlock()
+
+	if len(lc.subscriptions) == 0 {
+		for _, layer := range lc.layers {
+			lc.cancelLayers = append(lc.cancelLayers, layer.(NotifyingClient).Subscribe(lc.layerChanged))
+		}
+	}
+
+	lc.subscriptionIdx++
+	id := lc.subscriptionIdx
+	lc.subscriptions[id] = f
+
+	return func() {
+		lc.subscriptionLock.Lock()
+		defer lc.subscriptionLock.Unlock()
+		if _, ok := lc.subscriptions[id]; !ok {
+			return
+		}
+		delete(lc.subscriptions, id)
+		if len(lc.subscriptions) == 0 {
+			for _, cancelLayer := range lc.cancelLayers {
+				cancelLayer()
+			}
+			lc.cancelLayers = nil
+		}
+	}
+}
+
+func (lc *notifyingLayeredClient) layerChanged(changed map[Key][]ConstrainedValue) {
+	merged := make(map[Key][]ConstrainedValue, len(changed))
+	for key := range changed {
+		merged[key] = lc.GetValue(key)
+	}
+
+	lc.subscriptionLock.Lock()
+	subscriptions := expmaps.Values(lc.subscriptions)
+	lc.subscriptionLock.Unlock()
+
+	lc.notifyLock.Lock()
+	defer lc.notifyLock.Unlock()
+	for _, update := range subscriptions {
+		update(merged)
+	}
+}
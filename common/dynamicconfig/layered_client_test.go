@@ -0,0 +1,88 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+func TestLayeredClient_GetValue(t *testing.T) {
+	high := dynamicconfig.StaticClient{
+		"k": []dynamicconfig.ConstrainedValue{
+			{Value: 1},
+			{Constraints: dynamicconfig.Constraints{Namespace: "a"}, Value: 2},
+		},
+		"onlyHigh": 5,
+	}
+	low := dynamicconfig.StaticClient{
+		"k": []dynamicconfig.ConstrainedValue{
+			{Value: 10},
+			{Constraints: dynamicconfig.Constraints{Namespace: "b"}, Value: 20},
+		},
+		"onlyLow": 6,
+	}
+	client := dynamicconfig.NewLayeredClient(high, low)
+
+	require.ElementsMatch(t, []dynamicconfig.ConstrainedValue{
+		{Value: 1},
+		{Constraints: dynamicconfig.Constraints{Namespace: "a"}, Value: 2},
+		{Constraints: dynamicconfig.Constraints{Namespace: "b"}, Value: 20},
+	}, client.GetValue("k"))
+	require.Equal(t, []dynamicconfig.ConstrainedValue{{Value: 5}}, client.GetValue("onlyHigh"))
+	require.Equal(t, []dynamicconfig.ConstrainedValue{{Value: 6}}, client.GetValue("onlyLow"))
+	require.Nil(t, client.GetValue("missing"))
+
+	// a StaticClient doesn't notify, so neither does the layered client
+	_, ok := client.(dynamicconfig.NotifyingClient)
+	require.False(t, ok)
+}
+
+func TestLayeredClient_Subscribe(t *testing.T) {
+	high := dynamicconfig.NewMemoryClient()
+	low := dynamicconfig.NewMemoryClient()
+	client := dynamicconfig.NewLayeredClient(high, low)
+
+	var changes []map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue
+	cancel := client.(dynamicconfig.NotifyingClient).Subscribe(func(changed map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue) {
+		changes = append(changes, changed)
+	})
+
+	low.OverrideValue("k", 1)
+	require.Equal(t, []dynamicconfig.ConstrainedValue{{Value: 1}}, changes[0]["k"])
+
+	cleanup := high.OverrideValue("k", 2)
+	require.Equal(t, []dynamicconfig.ConstrainedValue{{Value: 2}}, changes[1]["k"])
+
+	// removing the override in the high layer exposes the low layer again
+	cleanup()
+	require.Equal(t, []dynamicconfig.ConstrainedValue{{Value: 1}}, changes[2]["k"])
+
+	cancel()
+	low.OverrideValue("k", 3)
+	require.Len(t, changes, 3)
+}
@@ -0,0 +1,291 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	expmaps "golang.org/x/exp/maps"
+)
+
+var _ Client = (*remoteClient)(nil)
+var _ NotifyingClient = (*remoteClient)(nil)
+
+const (
+	defaultRemoteTimeout = time.Second * 10
+	// Upper bound on the size of a response from a remote dynamic config endpoint.
+	maxRemoteConfigSize = 16 * 1024 * 1024
+)
+
+type (
+	// RemoteClientConfig is the config for the remote dynamic config client. The endpoint at
+	// URL is polled every PollInterval and must return the same structure as a dynamic config
+	// file, encoded as JSON. If the endpoint returns an ETag header, it's sent back in
+	// If-None-Match and a 304 response is treated as "no change".
+	RemoteClientConfig struct {
+		URL          string        `yaml:"url"`
+		PollInterval time.Duration `yaml:"pollInterval"`
+		// Timeout for a single request. Defaults to 10s.
+		Timeout time.Duration `yaml:"timeout"`
+		// Headers are added to every request, e.g. for authentication.
+		Headers map[string]string `yaml:"headers"`
+	}
+
+	remoteClient struct {
+		values     atomic.Value // configValueMap
+		logger     log.Logger
+		config     *RemoteClientConfig
+		httpClient *http.Client
+		etag       string
+		doneCh     <-chan interface{}
+
+		subscriptionLock sync.Mutex
+		subscriptionIdx  int
+		subscriptions    map[int]ClientUpdateFunc
+	}
+)
+
+// NewRemoteClient creates a client that polls a remote HTTP endpoint for dynamic config.
+// The first poll happens synchronously and an error is returned if it fails.
+func NewRemoteClient(config *RemoteClientConfig, logger log.Logger, doneCh <-chan interface{}) (*remoteClient, error) {
+	return NewRemoteClientWithHTTPClient(config, http.DefaultClient, logger, doneCh)
+}
+
+func NewRemoteClientWithHTTPClient(
+	config *RemoteClientConfig,
+	httpClient *http.Client,
+	logger log.Logger,
+	doneCh <-chan interface{},
+) (*remoteClient, error) {
+	client := &remoteClient{
+		logger:        logger,
+		config:        config,
+		httpClient:    httpClient,
+		doneCh:        doneCh,
+		subscriptions: make(map[int]ClientUpdateFunc),
+	}
+
+	err := client.init()
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+func (rc *remoteClient) GetValue(key Key) []ConstrainedValue {
+	values := rc.values.Load().(configValueMap)
+	return values[strings.ToLower(key.String())]
+}
+
+func (rc *remoteClient) Subscribe(f ClientUpdateFunc) (cancel func()) {
+	rc.subscriptionLock.Lock()
+	defer rc.subscriptionLock.Unlock()
+
+	rc.subscriptionIdx++
+	id := rc.subscriptionIdx
+	rc.subscriptions[id] = f
+
+	return func() {
+		rc.subscriptionLock.Lock()
+		defer rc.subscriptionLock.Unlock()
+		delete(rc.subscriptions, id)
+	}
+}
+
+func (rc *remoteClient) init() error {
+	if err := validateRemoteConfig(rc.config); err != nil {
+		return fmt.Errorf("unable to validate remote dynamic config: %w", err)
+	}
+
+	if err := rc.Update(); err != nil {
+		return fmt.Errorf("unable to read remote dynamic config: %w", err)
+	}
+
+	go func() {
+		ticker := time.NewTicker(rc.config.PollInterval)
+		for {
+			select {
+			case <-ticker.C:
+				err := rc.Update()
+				if err != nil {
+					rc.logger.Error("Unable to update remote dynamic config.", tag.Error(err))
+				}
+			case <-rc.doneCh:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// This is public mainly for testing. The update loop will call this periodically, you don't
+// have to call it explicitly.
+func (rc *remoteClient) Update() error {
+	contents, etag, err := rc.fetch()
+	if err != nil {
+		return fmt.Errorf("remote dynamic config: %s: %w", rc.config.URL, err)
+	}
+	if contents == nil {
+		// not modified
+		return nil
+	}
+
+	newValues, lr := loadFile(contents)
+	for _, e := range lr.Errors {
+		rc.logger.Warn("remote dynamic config error", tag.Error(e))
+	}
+	for _, w := range lr.Warnings {
+		rc.logger.Warn("remote dynamic config warning", tag.Error(w))
+	}
+	if len(lr.Errors) > 0 {
+		return fmt.Errorf("loading remote dynamic config failed: %d errors, %d warnings",
+			len(lr.Errors), len(lr.Warnings))
+	}
+	if dropped := dropInvalidValues(newValues); dropped > 0 {
+		rc.logger.Warn("Ignoring unregistered or invalid remote dynamic config values",
+			tag.NewInt("count", dropped))
+	}
+
+	prev := rc.values.Swap(newValues)
+	rc.etag = etag
+	oldValues, _ := prev.(configValueMap)
+	changedMap := diffAndLog(rc.logger, oldValues, newValues)
+	rc.logger.Info("Updated remote dynamic config")
+
+	if len(changedMap) == 0 {
+		return nil
+	}
+
+	rc.subscriptionLock.Lock()
+	subscriptions := expmaps.Values(rc.subscriptions)
+	rc.subscriptionLock.Unlock()
+
+	for _, update := range subscriptions {
+		update(changedMap)
+	}
+
+	return nil
+}
+
+// fetch returns the response body and ETag, or nil contents if the endpoint reports that the
+// config has not changed since the last successful fetch.
+func (rc *remoteClient) fetch() ([]byte, string, error) {
+	timeout := rc.config.Timeout
+	if timeout <= 0 {
+		timeout = defaultRemoteTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rc.config.URL, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	req.Header.Set("Accept", "application/json")
+	for k, v := range rc.config.Headers {
+		req.Header.Set(k, v)
+	}
+	if rc.etag != "" {
+		req.Header.Set("If-None-Match", rc.etag)
+	}
+
+	resp, err := rc.httpClient.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotModified:
+		return nil, "", nil
+	default:
+		return nil, "", fmt.Errorf("unexpected response status %q", resp.Status)
+	}
+
+	contents, err := io.ReadAll(io.LimitReader(resp.Body, maxRemoteConfigSize+1))
+	if err != nil {
+		return nil, "", err
+	}
+	if len(contents) > maxRemoteConfigSize {
+		return nil, "", fmt.Errorf("response is larger than %d bytes", maxRemoteConfigSize)
+	}
+	return contents, resp.Header.Get("ETag"), nil
+}
+
+func validateRemoteConfig(config *RemoteClientConfig) error {
+	if config == nil {
+		return errors.New("configuration for remote dynamic config client is nil")
+	}
+	if config.URL == "" {
+		return errors.New("url must be set")
+	}
+	if config.PollInterval < minPollInterval {
+		return fmt.Errorf("poll interval should be at least %v", minPollInterval)
+	}
+	return nil
+}
+
+// dropInvalidValues removes keys that are not in the registry and values that fail the
+// registered setting's validation, so that a bad entry in a remote source can't override a
+// good value from a lower-precedence source. Returns the number of values removed.
+func dropInvalidValues(values configValueMap) int {
+	dropped := 0
+	for key, cvs := range values {
+		setting := queryRegistry(Key(key))
+		if setting == nil {
+			dropped += len(cvs)
+			delete(values, key)
+			continue
+		}
+		valid := cvs[:0]
+		for _, cv := range cvs {
+			if setting.Validate(cv.Value) != nil {
+				dropped++
+				continue
+			}
+			valid = append(valid, cv)
+		}
+		if len(valid) == 0 {
+			delete(values, key)
+		} else {
+			values[key] = valid
+		}
+	}
+	return dropped
+}
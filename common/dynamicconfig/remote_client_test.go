@@ -0,0 +1,193 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+)
+
+type remoteClientSuite struct {
+	suite.Suite
+	*require.Assertions
+
+	server *httptest.Server
+	doneCh chan interface{}
+
+	lock     sync.Mutex
+	body     string
+	etag     string
+	requests int
+	notMod   int
+}
+
+const (
+	testRemoteIntKey  = "testRemoteIntKey"
+	testRemoteBoolKey = "testRemoteBoolKey"
+)
+
+func TestRemoteClientSuite(t *testing.T) {
+	suite.Run(t, new(remoteClientSuite))
+}
+
+func (s *remoteClientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	dynamicconfig.ResetRegistryForTest()
+	dynamicconfig.NewGlobalIntSetting(testRemoteIntKey, 0, "")
+	dynamicconfig.NewNamespaceBoolSetting(testRemoteBoolKey, false, "")
+
+	s.doneCh = make(chan interface{})
+	s.requests = 0
+	s.notMod = 0
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		s.requests++
+		if s.etag != "" && r.Header.Get("If-None-Match") == s.etag {
+			s.notMod++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		if s.etag != "" {
+			w.Header().Set("ETag", s.etag)
+		}
+		_, _ = w.Write([]byte(s.body))
+	}))
+}
+
+func (s *remoteClientSuite) TearDownTest() {
+	close(s.doneCh)
+	s.server.Close()
+}
+
+func (s *remoteClientSuite) setResponse(body, etag string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.body = body
+	s.etag = etag
+}
+
+func (s *remoteClientSuite) newClient() dynamicconfig.Client {
+	client, err := dynamicconfig.NewRemoteClientWithHTTPClient(&dynamicconfig.RemoteClientConfig{
+		URL:          s.server.URL,
+		PollInterval: time.Hour,
+	}, s.server.Client(), log.NewNoopLogger(), s.doneCh)
+	s.NoError(err)
+	return client
+}
+
+func (s *remoteClientSuite) TestGetValue() {
+	s.setResponse(`{
+		"testRemoteIntKey": [{"value": 10}],
+		"testRemoteBoolKey": [{"value": true, "constraints": {"namespace": "ns"}}]
+	}`, "")
+	client := s.newClient()
+
+	s.Equal([]dynamicconfig.ConstrainedValue{{Value: 10}}, client.GetValue(testRemoteIntKey))
+	s.Equal([]dynamicconfig.ConstrainedValue{
+		{Constraints: dynamicconfig.Constraints{Namespace: "ns"}, Value: true},
+	}, client.GetValue("TESTREMOTEBOOLKEY"))
+}
+
+func (s *remoteClientSuite) TestDropsInvalidValues() {
+	s.setResponse(`{
+		"testRemoteIntKey": [{"value": "not a number"}, {"value": 5, "constraints": {}}],
+		"unregisteredKey": [{"value": 1}]
+	}`, "")
+	client := s.newClient()
+
+	s.Equal([]dynamicconfig.ConstrainedValue{{Value: 5}}, client.GetValue(testRemoteIntKey))
+	s.Nil(client.GetValue("unregisteredKey"))
+}
+
+func (s *remoteClientSuite) TestDecodeErrorRejected() {
+	s.setResponse(`{"testRemoteIntKey": [{"value": 1}]}`, "")
+	client := s.newClient()
+
+	s.setResponse(`{"testRemoteIntKey": [`, "")
+	s.Error(client.(interface{ Update() error }).Update())
+	s.Equal([]dynamicconfig.ConstrainedValue{{Value: 1}}, client.GetValue(testRemoteIntKey))
+}
+
+func (s *remoteClientSuite) TestETagAndSubscribe() {
+	s.setResponse(`{"testRemoteIntKey": [{"value": 1}]}`, `"v1"`)
+	client := s.newClient()
+	updater := client.(interface{ Update() error })
+
+	var changes []map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue
+	cancel := client.(dynamicconfig.NotifyingClient).Subscribe(func(changed map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue) {
+		changes = append(changes, changed)
+	})
+	defer cancel()
+
+	// same etag: not modified, no notification
+	s.NoError(updater.Update())
+	s.Equal(1, s.notMod)
+	s.Empty(changes)
+
+	s.setResponse(`{"testRemoteBoolKey": [{"value": true}]}`, `"v2"`)
+	s.NoError(updater.Update())
+	s.Len(changes, 1)
+	s.Equal(map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue{
+		"testremoteintkey":  nil,
+		"testremoteboolkey": {{Value: true}},
+	}, changes[0])
+	s.Nil(client.GetValue(testRemoteIntKey))
+	s.Equal(3, s.requests)
+}
+
+func (s *remoteClientSuite) TestInvalidConfig() {
+	_, err := dynamicconfig.NewRemoteClient(&dynamicconfig.RemoteClientConfig{
+		PollInterval: time.Minute,
+	}, log.NewNoopLogger(), s.doneCh)
+	s.Error(err)
+
+	_, err = dynamicconfig.NewRemoteClient(&dynamicconfig.RemoteClientConfig{
+		URL:          s.server.URL,
+		PollInterval: time.Second,
+	}, log.NewNoopLogger(), s.doneCh)
+	s.Error(err)
+}
+
+func (s *remoteClientSuite) TestServerError() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	_, err := dynamicconfig.NewRemoteClient(&dynamicconfig.RemoteClientConfig{
+		URL:          server.URL,
+		PollInterval: time.Minute,
+	}, log.NewNoopLogger(), s.doneCh)
+	s.ErrorContains(err, "500")
+}
@@ -42,6 +42,10 @@ const (
 	CallerNameHeaderName = "caller-name"
 	CallerTypeHeaderName = "caller-type"
 	CallOriginHeaderName = "call-initiation"
+
+	// FairnessKeyHeaderName may be set on workflow start requests to assign a fairness key to the
+	// workflow's tasks, see TaskInfo.fairness_key.
+	FairnessKeyHeaderName = "fairness-key"
 )
 
 var (
@@ -54,6 +58,7 @@ var (
 		CallerNameHeaderName,
 		CallerTypeHeaderName,
 		CallOriginHeaderName,
+		FairnessKeyHeaderName,
 	}
 )
 
@@ -303,6 +303,8 @@ const (
 	VisibilityPersistenceScanWorkflowExecutionsScope = "ScanWorkflowExecutions"
 	// VisibilityPersistenceCountWorkflowExecutionsScope tracks CountWorkflowExecutions calls made by service to visibility persistence layer
 	VisibilityPersistenceCountWorkflowExecutionsScope = "CountWorkflowExecutions"
+	// VisibilityPersistenceAggregateWorkflowExecutionsScope tracks AggregateWorkflowExecutions calls made by service to visibility persistence layer
+	VisibilityPersistenceAggregateWorkflowExecutionsScope = "AggregateWorkflowExecutions"
 	// VisibilityPersistenceGetWorkflowExecutionScope tracks GetWorkflowExecution calls made by service to visibility persistence layer
 	VisibilityPersistenceGetWorkflowExecutionScope = "GetWorkflowExecution"
 )
@@ -611,6 +613,10 @@ var (
 		"service_error_with_type",
 		WithDescription("The number of all service request errors by error type."),
 	)
+	CallerRateLimitedCounter = NewCounterDef(
+		"caller_rate_limited",
+		WithDescription("The number of requests rejected because the caller exceeded its share of the namespace rate limits."),
+	)
 	ServiceLatency                           = NewTimerDef("service_latency")
 	ServiceLatencyNoUserLatency              = NewTimerDef("service_latency_nouserlatency")
 	ServiceLatencyUserLatency                = NewTimerDef("service_latency_userlatency")
@@ -1225,6 +1231,14 @@ var (
 		"persistence_latency",
 		WithDescription("Persistence latency, keyed by `operation`"),
 	)
+	PersistenceBlobUncompressedSize = NewBytesHistogramDef(
+		"persistence_blob_uncompressed_size",
+		WithDescription("Size of a history or mutable state blob before compression, keyed by `compression`"),
+	)
+	PersistenceBlobCompressedSize = NewBytesHistogramDef(
+		"persistence_blob_compressed_size",
+		WithDescription("Size of a history or mutable state blob after compression, keyed by `compression`"),
+	)
 	PersistenceShardRPS                    = NewDimensionlessHistogramDef("persistence_shard_rps")
 	PersistenceErrResourceExhaustedCounter = NewCounterDef("persistence_errors_resource_exhausted")
 	VisibilityPersistenceRequests          = NewCounterDef("visibility_persistence_requests")
@@ -59,6 +59,7 @@ const (
 	actionType     = "action_type"
 	workerBuildId  = "worker-build-id"
 	destination    = "destination"
+	caller         = "caller"
 	// Generic reason tag can be used anywhere a reason is needed.
 	reason = "reason"
 	// See server.api.enums.v1.ReplicationTaskType
@@ -67,6 +68,7 @@ const (
 
 	namespaceAllValue = "all"
 	unknownValue      = "_unknown_"
+	otherValue        = "_other_"
 	totalMetricSuffix = "_total"
 	tagExcludedValue  = "_tag_excluded_"
 
@@ -364,6 +366,21 @@ func ActionType(value string) Tag {
 	return &tagImpl{key: actionType, value: value}
 }
 
+// CallerTag returns a new tag for the caller a request is attributed to within its namespace,
+// e.g. a worker identity. Only use it for callers from a bounded set, such as the configured
+// ones, and CallerOtherTag for the rest.
+func CallerTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return &tagImpl{key: caller, value: value}
+}
+
+// CallerOtherTag returns a new caller tag that stands for all callers that aren't tagged by name.
+func CallerOtherTag() Tag {
+	return &tagImpl{key: caller, value: otherValue}
+}
+
 func OperationTag(value string) Tag {
 	return &tagImpl{key: OperationTagName, value: value}
 }
@@ -35,6 +35,7 @@ import (
 	rulespb "go.temporal.io/api/rules/v1"
 	"go.temporal.io/api/serviceerror"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/util"
 	expmaps "golang.org/x/exp/maps"
@@ -308,6 +309,12 @@ func (ns *Namespace) GetWorkflowRules() []*rulespb.WorkflowRule {
 	return expmaps.Values(ns.config.WorkflowRules)
 }
 
+// BusinessCalendar returns the business calendar with the given name, if defined.
+func (ns *Namespace) BusinessCalendar(name string) (*schedulespb.BusinessCalendar, bool) {
+	calendar, ok := ns.config.GetBusinessCalendars()[name]
+	return calendar, ok
+}
+
 // Error returns the reason associated with this bad binary.
 func (e BadBinaryError) Error() string {
 	return e.info.Reason
@@ -175,6 +175,7 @@ func (h *taskExecutorImpl) handleNamespaceCreationReplicationTask(
 				VisibilityArchivalState:      task.Config.GetVisibilityArchivalState(),
 				VisibilityArchivalUri:        task.Config.GetVisibilityArchivalUri(),
 				CustomSearchAttributeAliases: task.Config.GetCustomSearchAttributeAliases(),
+				BusinessCalendars:            task.GetBusinessCalendars(),
 			},
 			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
 				ActiveClusterName: task.ReplicationConfig.GetActiveClusterName(),
@@ -306,6 +307,7 @@ func (h *taskExecutorImpl) handleNamespaceUpdateReplicationTask(
 			VisibilityArchivalState:      task.Config.GetVisibilityArchivalState(),
 			VisibilityArchivalUri:        task.Config.GetVisibilityArchivalUri(),
 			CustomSearchAttributeAliases: task.Config.GetCustomSearchAttributeAliases(),
+			BusinessCalendars:            task.GetBusinessCalendars(),
 		}
 		if task.Config.GetBadBinaries() != nil {
 			request.Namespace.Config.BadBinaries = task.Config.GetBadBinaries()
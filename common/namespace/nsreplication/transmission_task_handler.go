@@ -123,9 +123,10 @@ func (r *replicator) HandleTransmissionTask(
 				ActiveClusterName: replicationConfig.ActiveClusterName,
 				Clusters:          convertClusterReplicationConfigToProto(replicationConfig.Clusters),
 			},
-			ConfigVersion:   configVersion,
-			FailoverVersion: failoverVersion,
-			FailoverHistory: convertFailoverHistoryToReplicationProto(failoverHistoy),
+			ConfigVersion:     configVersion,
+			FailoverVersion:   failoverVersion,
+			FailoverHistory:   convertFailoverHistoryToReplicationProto(failoverHistoy),
+			BusinessCalendars: config.BusinessCalendars,
 		},
 	}
 
@@ -36,6 +36,7 @@ import (
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives"
@@ -240,6 +241,9 @@ func (s *transmissionTaskSuite) TestHandleTransmissionTask_UpdateNamespaceTask_I
 	configVersion := int64(0)
 	failoverVersion := int64(59)
 	clusters := []string{clusterActive, clusterStandby}
+	businessCalendars := map[string]*schedulespb.BusinessCalendar{
+		"us": {Name: "us", NonBusinessWeekdays: []int32{0, 6}},
+	}
 
 	namespaceOperation := enumsspb.NAMESPACE_OPERATION_UPDATE
 	info := &persistencespb.NamespaceInfo{
@@ -257,6 +261,7 @@ func (s *transmissionTaskSuite) TestHandleTransmissionTask_UpdateNamespaceTask_I
 		VisibilityArchivalState: visibilityArchivalState,
 		VisibilityArchivalUri:   visibilityArchivalURI,
 		BadBinaries:             &namespacepb.BadBinaries{Binaries: map[string]*namespacepb.BadBinaryInfo{}},
+		BusinessCalendars:       businessCalendars,
 	}
 	replicationConfig := &persistencespb.NamespaceReplicationConfig{
 		ActiveClusterName: clusterActive,
@@ -289,8 +294,9 @@ func (s *transmissionTaskSuite) TestHandleTransmissionTask_UpdateNamespaceTask_I
 					ActiveClusterName: clusterActive,
 					Clusters:          convertClusterReplicationConfigToProto(clusters),
 				},
-				ConfigVersion:   configVersion,
-				FailoverVersion: failoverVersion,
+				ConfigVersion:     configVersion,
+				FailoverVersion:   failoverVersion,
+				BusinessCalendars: businessCalendars,
 			},
 		},
 	}).Return(nil)
@@ -0,0 +1,244 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+const (
+	// systemAuditLogQueueName is the name of the queue that holds audit events of calls that are
+	// not scoped to a namespace. Events of other calls go to a queue per namespace.
+	systemAuditLogQueueName = "audit"
+
+	trimAuditEventsPageSize = 100
+
+	ErrMsgDeserializeAuditEvent = "failed to deserialize audit event"
+)
+
+var (
+	ErrAppendAuditEventRequestEventIsNil  = errors.New("append audit event request event is nil")
+	ErrReadAuditEventsNonPositivePageSize = errors.New("page size to read audit events must be positive")
+)
+
+type (
+	auditLogManagerImpl struct {
+		queue QueueV2
+	}
+)
+
+var _ AuditLogManager = (*auditLogManagerImpl)(nil)
+
+func NewAuditLogManager(queue QueueV2) AuditLogManager {
+	return &auditLogManagerImpl{
+		queue: queue,
+	}
+}
+
+// GetAuditLogQueueName returns the name of the queue that holds the audit events of a namespace.
+// Keeping a queue per namespace lets events of one namespace be read without filtering.
+func GetAuditLogQueueName(namespace string) string {
+	if namespace == "" {
+		return systemAuditLogQueueName
+	}
+	return systemAuditLogQueueName + "." + namespace
+}
+
+// AppendAuditEvent adds an event to the end of the audit log of its namespace, creating the
+// queue on first use.
+func (m *auditLogManagerImpl) AppendAuditEvent(
+	ctx context.Context,
+	request *AppendAuditEventRequest,
+) (*AppendAuditEventResponse, error) {
+	if request.Event == nil {
+		return nil, ErrAppendAuditEventRequestEventIsNil
+	}
+	data, err := request.Event.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	queueName := GetAuditLogQueueName(request.Event.GetNamespace())
+	enqueueRequest := &InternalEnqueueMessageRequest{
+		QueueType: QueueTypeAuditLog,
+		QueueName: queueName,
+		Blob: &commonpb.DataBlob{
+			EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+			Data:         data,
+		},
+	}
+
+	response, err := m.queue.EnqueueMessage(ctx, enqueueRequest)
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		_, err = m.queue.CreateQueue(ctx, &InternalCreateQueueRequest{
+			QueueType: QueueTypeAuditLog,
+			QueueName: queueName,
+		})
+		if err != nil && !errors.Is(err, ErrQueueAlreadyExists) {
+			return nil, err
+		}
+		response, err = m.queue.EnqueueMessage(ctx, enqueueRequest)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &AppendAuditEventResponse{
+		Metadata: response.Metadata,
+	}, nil
+}
+
+// ReadAuditEvents returns a page of the audit events of a namespace, oldest first. An empty page
+// is returned if no event was ever appended for the namespace.
+func (m *auditLogManagerImpl) ReadAuditEvents(
+	ctx context.Context,
+	request *ReadAuditEventsRequest,
+) (*ReadAuditEventsResponse, error) {
+	if request.PageSize <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrReadAuditEventsNonPositivePageSize, request.PageSize)
+	}
+
+	response, err := m.queue.ReadMessages(ctx, &InternalReadMessagesRequest{
+		QueueType:     QueueTypeAuditLog,
+		QueueName:     GetAuditLogQueueName(request.Namespace),
+		PageSize:      request.PageSize,
+		NextPageToken: request.NextPageToken,
+	})
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return &ReadAuditEventsResponse{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*persistencespb.AuditEvent, len(response.Messages))
+	for i, message := range response.Messages {
+		event, err := deserializeAuditEvent(message)
+		if err != nil {
+			return nil, err
+		}
+		events[i] = event
+	}
+	return &ReadAuditEventsResponse{
+		Events:        events,
+		NextPageToken: response.NextPageToken,
+	}, nil
+}
+
+// TrimAuditEvents deletes the events older than the given time from the audit logs of all
+// namespaces.
+func (m *auditLogManagerImpl) TrimAuditEvents(
+	ctx context.Context,
+	request *TrimAuditEventsRequest,
+) (*TrimAuditEventsResponse, error) {
+	var deleted int64
+	var nextPageToken []byte
+	for {
+		response, err := m.queue.ListQueues(ctx, &InternalListQueuesRequest{
+			QueueType:     QueueTypeAuditLog,
+			PageSize:      trimAuditEventsPageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, queue := range response.Queues {
+			n, err := m.trimQueue(ctx, queue.QueueName, request.ExclusiveMaxEventTime)
+			if err != nil {
+				return nil, err
+			}
+			deleted += n
+		}
+		nextPageToken = response.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+	return &TrimAuditEventsResponse{EventsDeleted: deleted}, nil
+}
+
+// trimQueue deletes the events at the front of a queue that are older than maxTime. Events are
+// appended in roughly increasing time order, so it stops at the first event that isn't.
+func (m *auditLogManagerImpl) trimQueue(ctx context.Context, queueName string, maxTime time.Time) (int64, error) {
+	var lastExpired *MessageMetadata
+	var nextPageToken []byte
+	for done := false; !done; {
+		response, err := m.queue.ReadMessages(ctx, &InternalReadMessagesRequest{
+			QueueType:     QueueTypeAuditLog,
+			QueueName:     queueName,
+			PageSize:      trimAuditEventsPageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return 0, err
+		}
+		for _, message := range response.Messages {
+			event, err := deserializeAuditEvent(message)
+			if err != nil {
+				return 0, err
+			}
+			if !event.GetTime().AsTime().Before(maxTime) {
+				done = true
+				break
+			}
+			lastExpired = &message.MetaData
+		}
+		nextPageToken = response.NextPageToken
+		if len(nextPageToken) == 0 {
+			done = true
+		}
+	}
+	if lastExpired == nil {
+		return 0, nil
+	}
+	response, err := m.queue.RangeDeleteMessages(ctx, &InternalRangeDeleteMessagesRequest{
+		QueueType:                   QueueTypeAuditLog,
+		QueueName:                   queueName,
+		InclusiveMaxMessageMetadata: *lastExpired,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return response.MessagesDeleted, nil
+}
+
+func (m *auditLogManagerImpl) Close() {}
+
+func deserializeAuditEvent(message QueueV2Message) (*persistencespb.AuditEvent, error) {
+	event := &persistencespb.AuditEvent{}
+	if err := serialization.Proto3Decode(message.Data.Data, message.Data.EncodingType, event); err != nil {
+		return nil, fmt.Errorf("%v: %w", ErrMsgDeserializeAuditEvent, err)
+	}
+	return event, nil
+}
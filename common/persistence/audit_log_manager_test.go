@@ -0,0 +1,124 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/mock"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAuditLogManager_ReadsNamespaceQueue(t *testing.T) {
+	controller := gomock.NewController(t)
+	queue := mock.NewMockQueueV2(controller)
+	manager := persistence.NewAuditLogManager(queue)
+
+	event := &persistencespb.AuditEvent{Namespace: "ns1", Subject: "a"}
+	queue.EXPECT().EnqueueMessage(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.InternalEnqueueMessageRequest) (*persistence.InternalEnqueueMessageResponse, error) {
+			require.Equal(t, "audit.ns1", request.QueueName)
+			return &persistence.InternalEnqueueMessageResponse{}, nil
+		})
+	_, err := manager.AppendAuditEvent(context.Background(), &persistence.AppendAuditEventRequest{Event: event})
+	require.NoError(t, err)
+
+	queue.EXPECT().ReadMessages(gomock.Any(), &persistence.InternalReadMessagesRequest{
+		QueueType: persistence.QueueTypeAuditLog,
+		QueueName: "audit.ns1",
+		PageSize:  10,
+	}).Return(&persistence.InternalReadMessagesResponse{
+		Messages: []persistence.QueueV2Message{auditMessage(t, 1, event)},
+	}, nil)
+	response, err := manager.ReadAuditEvents(context.Background(), &persistence.ReadAuditEventsRequest{
+		Namespace: "ns1",
+		PageSize:  10,
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Events, 1)
+	require.Equal(t, "a", response.Events[0].Subject)
+
+	require.Equal(t, "audit", persistence.GetAuditLogQueueName(""))
+}
+
+func TestAuditLogManager_Trim(t *testing.T) {
+	controller := gomock.NewController(t)
+	queue := mock.NewMockQueueV2(controller)
+	manager := persistence.NewAuditLogManager(queue)
+
+	now := time.Now()
+	queue.EXPECT().ListQueues(gomock.Any(), gomock.Any()).Return(&persistence.InternalListQueuesResponse{
+		Queues: []persistence.QueueInfo{{QueueName: "audit.ns1"}, {QueueName: "audit.ns2"}},
+	}, nil)
+	queue.EXPECT().ReadMessages(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.InternalReadMessagesRequest) (*persistence.InternalReadMessagesResponse, error) {
+			if request.QueueName == "audit.ns2" {
+				return &persistence.InternalReadMessagesResponse{
+					Messages: []persistence.QueueV2Message{
+						auditMessage(t, 1, &persistencespb.AuditEvent{Time: timestamppb.New(now)}),
+					},
+				}, nil
+			}
+			return &persistence.InternalReadMessagesResponse{
+				Messages: []persistence.QueueV2Message{
+					auditMessage(t, 1, &persistencespb.AuditEvent{Time: timestamppb.New(now.Add(-3 * time.Hour))}),
+					auditMessage(t, 2, &persistencespb.AuditEvent{Time: timestamppb.New(now.Add(-2 * time.Hour))}),
+					auditMessage(t, 3, &persistencespb.AuditEvent{Time: timestamppb.New(now)}),
+				},
+				NextPageToken: []byte("more"),
+			}, nil
+		}).Times(2)
+	queue.EXPECT().RangeDeleteMessages(gomock.Any(), &persistence.InternalRangeDeleteMessagesRequest{
+		QueueType:                   persistence.QueueTypeAuditLog,
+		QueueName:                   "audit.ns1",
+		InclusiveMaxMessageMetadata: persistence.MessageMetadata{ID: 2},
+	}).Return(&persistence.InternalRangeDeleteMessagesResponse{MessagesDeleted: 2}, nil)
+
+	response, err := manager.TrimAuditEvents(context.Background(), &persistence.TrimAuditEventsRequest{
+		ExclusiveMaxEventTime: now.Add(-time.Hour),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), response.EventsDeleted)
+}
+
+func auditMessage(t *testing.T, id int64, event *persistencespb.AuditEvent) persistence.QueueV2Message {
+	data, err := event.Marshal()
+	require.NoError(t, err)
+	return persistence.QueueV2Message{
+		MetaData: persistence.MessageMetadata{ID: id},
+		Data: &commonpb.DataBlob{
+			EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+			Data:         data,
+		},
+	}
+}
@@ -0,0 +1,245 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+const (
+	batchFailureManifestQueueNamePrefix = "batch"
+
+	readBatchFailuresQueuePageSize = 100
+
+	ErrMsgDeserializeBatchFailureManifestEntry = "failed to deserialize batch failure manifest entry"
+)
+
+var (
+	ErrAppendBatchFailuresRequestEntryIsNil  = errors.New("append batch failures request entry is nil")
+	ErrReadBatchFailuresNonPositivePageSize  = errors.New("page size to read batch failures must be positive")
+	ErrInvalidReadBatchFailuresNextPageToken = &InvalidPersistenceRequestError{
+		Msg: "invalid next-page token for reading batch failures",
+	}
+)
+
+type (
+	batchFailureManifestManagerImpl struct {
+		queue QueueV2
+	}
+)
+
+var _ BatchFailureManifestManager = (*batchFailureManifestManagerImpl)(nil)
+
+func NewBatchFailureManifestManager(queue QueueV2) BatchFailureManifestManager {
+	return &batchFailureManifestManagerImpl{
+		queue: queue,
+	}
+}
+
+// GetBatchFailureManifestQueueName returns the name of the queue that holds the failure manifest
+// of a batch job. Run ids are unique, but the namespace is part of the name so that the manifests
+// of a namespace can be listed.
+func GetBatchFailureManifestQueueName(namespaceID string, jobRunID string) string {
+	return batchFailureManifestQueueNamePrefix + "." + namespaceID + "." + jobRunID
+}
+
+// AppendBatchFailures adds an entry to the end of the failure manifest of a batch job, creating
+// the queue on first use.
+func (m *batchFailureManifestManagerImpl) AppendBatchFailures(
+	ctx context.Context,
+	request *AppendBatchFailuresRequest,
+) (*AppendBatchFailuresResponse, error) {
+	if request.Entry == nil {
+		return nil, ErrAppendBatchFailuresRequestEntryIsNil
+	}
+	data, err := request.Entry.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	queueName := GetBatchFailureManifestQueueName(request.NamespaceID, request.JobRunID)
+	enqueueRequest := &InternalEnqueueMessageRequest{
+		QueueType: QueueTypeBatchFailureManifest,
+		QueueName: queueName,
+		Blob: &commonpb.DataBlob{
+			EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+			Data:         data,
+		},
+	}
+
+	response, err := m.queue.EnqueueMessage(ctx, enqueueRequest)
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		_, err = m.queue.CreateQueue(ctx, &InternalCreateQueueRequest{
+			QueueType: QueueTypeBatchFailureManifest,
+			QueueName: queueName,
+		})
+		if err != nil && !errors.Is(err, ErrQueueAlreadyExists) {
+			return nil, err
+		}
+		response, err = m.queue.EnqueueMessage(ctx, enqueueRequest)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &AppendBatchFailuresResponse{
+		Metadata: response.Metadata,
+	}, nil
+}
+
+// ReadBatchFailures returns a page of the entries of the failure manifest of a batch job. Since
+// a later entry for a page replaces the earlier ones, the whole queue is read on every call; the
+// batch worker bounds the size of a manifest. An empty page is returned if the job has no
+// manifest.
+func (m *batchFailureManifestManagerImpl) ReadBatchFailures(
+	ctx context.Context,
+	request *ReadBatchFailuresRequest,
+) (*ReadBatchFailuresResponse, error) {
+	if request.PageSize <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrReadBatchFailuresNonPositivePageSize, request.PageSize)
+	}
+	var firstPage int64
+	if len(request.NextPageToken) > 0 {
+		var err error
+		firstPage, err = strconv.ParseInt(string(request.NextPageToken), 10, 64)
+		if err != nil || firstPage <= 0 {
+			return nil, ErrInvalidReadBatchFailuresNextPageToken
+		}
+	}
+
+	var entries []*persistencespb.BatchFailureManifestEntry
+	var nextPageToken []byte
+	for {
+		response, err := m.queue.ReadMessages(ctx, &InternalReadMessagesRequest{
+			QueueType:     QueueTypeBatchFailureManifest,
+			QueueName:     GetBatchFailureManifestQueueName(request.NamespaceID, request.JobRunID),
+			PageSize:      readBatchFailuresQueuePageSize,
+			NextPageToken: nextPageToken,
+		})
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			return &ReadBatchFailuresResponse{}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, message := range response.Messages {
+			entry, err := deserializeBatchFailureManifestEntry(message)
+			if err != nil {
+				return nil, err
+			}
+			// a retried page replaces the entries recorded since its previous attempt
+			for len(entries) > 0 && entries[len(entries)-1].GetPage() >= entry.GetPage() {
+				entries = entries[:len(entries)-1]
+			}
+			entries = append(entries, entry)
+		}
+		nextPageToken = response.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	response := &ReadBatchFailuresResponse{}
+	for _, entry := range entries {
+		if entry.GetPage() < firstPage {
+			continue
+		}
+		if len(response.Entries) == request.PageSize {
+			response.NextPageToken = []byte(strconv.FormatInt(entry.GetPage(), 10))
+			break
+		}
+		response.Entries = append(response.Entries, entry)
+	}
+	return response, nil
+}
+
+// ListBatchFailureManifests returns the run ids of the batch jobs of a namespace that have a
+// failure manifest. A page may have fewer run ids than the page size, or none, while there are
+// more.
+func (m *batchFailureManifestManagerImpl) ListBatchFailureManifests(
+	ctx context.Context,
+	request *ListBatchFailureManifestsRequest,
+) (*ListBatchFailureManifestsResponse, error) {
+	response, err := m.queue.ListQueues(ctx, &InternalListQueuesRequest{
+		QueueType:     QueueTypeBatchFailureManifest,
+		PageSize:      request.PageSize,
+		NextPageToken: request.NextPageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	prefix := GetBatchFailureManifestQueueName(request.NamespaceID, "")
+	var jobRunIDs []string
+	for _, queue := range response.Queues {
+		if jobRunID, ok := strings.CutPrefix(queue.QueueName, prefix); ok && jobRunID != "" {
+			jobRunIDs = append(jobRunIDs, jobRunID)
+		}
+	}
+	return &ListBatchFailureManifestsResponse{
+		JobRunIDs:     jobRunIDs,
+		NextPageToken: response.NextPageToken,
+	}, nil
+}
+
+// DeleteBatchFailures deletes all entries of the failure manifest of a batch job.
+func (m *batchFailureManifestManagerImpl) DeleteBatchFailures(
+	ctx context.Context,
+	request *DeleteBatchFailuresRequest,
+) (*DeleteBatchFailuresResponse, error) {
+	response, err := m.queue.RangeDeleteMessages(ctx, &InternalRangeDeleteMessagesRequest{
+		QueueType:                   QueueTypeBatchFailureManifest,
+		QueueName:                   GetBatchFailureManifestQueueName(request.NamespaceID, request.JobRunID),
+		InclusiveMaxMessageMetadata: MessageMetadata{ID: math.MaxInt64},
+	})
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return &DeleteBatchFailuresResponse{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &DeleteBatchFailuresResponse{EntriesDeleted: response.MessagesDeleted}, nil
+}
+
+func (m *batchFailureManifestManagerImpl) Close() {}
+
+func deserializeBatchFailureManifestEntry(message QueueV2Message) (*persistencespb.BatchFailureManifestEntry, error) {
+	entry := &persistencespb.BatchFailureManifestEntry{}
+	if err := serialization.Proto3Decode(message.Data.Data, message.Data.EncodingType, entry); err != nil {
+		return nil, fmt.Errorf("%v: %w", ErrMsgDeserializeBatchFailureManifestEntry, err)
+	}
+	return entry, nil
+}
@@ -0,0 +1,170 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBatchFailureManifestManager_Append(t *testing.T) {
+	controller := gomock.NewController(t)
+	queue := mock.NewMockQueueV2(controller)
+	manager := persistence.NewBatchFailureManifestManager(queue)
+
+	// the queue is created on first use
+	queue.EXPECT().EnqueueMessage(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("queue not found"))
+	queue.EXPECT().CreateQueue(gomock.Any(), &persistence.InternalCreateQueueRequest{
+		QueueType: persistence.QueueTypeBatchFailureManifest,
+		QueueName: "batch.ns-id.run-id",
+	}).Return(&persistence.InternalCreateQueueResponse{}, nil)
+	queue.EXPECT().EnqueueMessage(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.InternalEnqueueMessageRequest) (*persistence.InternalEnqueueMessageResponse, error) {
+			require.Equal(t, persistence.QueueTypeBatchFailureManifest, request.QueueType)
+			require.Equal(t, "batch.ns-id.run-id", request.QueueName)
+			return &persistence.InternalEnqueueMessageResponse{Metadata: persistence.MessageMetadata{ID: 1}}, nil
+		})
+	response, err := manager.AppendBatchFailures(context.Background(), &persistence.AppendBatchFailuresRequest{
+		NamespaceID: "ns-id",
+		JobRunID:    "run-id",
+		Entry:       &persistencespb.BatchFailureManifestEntry{JobId: "job", Page: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), response.Metadata.ID)
+}
+
+func TestBatchFailureManifestManager_Read(t *testing.T) {
+	controller := gomock.NewController(t)
+	queue := mock.NewMockQueueV2(controller)
+	manager := persistence.NewBatchFailureManifestManager(queue)
+
+	// page 3 was recorded again by a retried activity, which replaces the entries since
+	queue.EXPECT().ReadMessages(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.InternalReadMessagesRequest) (*persistence.InternalReadMessagesResponse, error) {
+			if len(request.NextPageToken) == 0 {
+				return &persistence.InternalReadMessagesResponse{
+					Messages: []persistence.QueueV2Message{
+						batchFailureMessage(t, 1, &persistencespb.BatchFailureManifestEntry{Page: 1}),
+						batchFailureMessage(t, 2, &persistencespb.BatchFailureManifestEntry{Page: 3, Failures: []*persistencespb.BatchFailure{{Error: "stale"}}}),
+						batchFailureMessage(t, 3, &persistencespb.BatchFailureManifestEntry{Page: 4}),
+					},
+					NextPageToken: []byte("more"),
+				}, nil
+			}
+			return &persistence.InternalReadMessagesResponse{
+				Messages: []persistence.QueueV2Message{
+					batchFailureMessage(t, 4, &persistencespb.BatchFailureManifestEntry{Page: 3, Failures: []*persistencespb.BatchFailure{{Error: "retried"}}}),
+					batchFailureMessage(t, 5, &persistencespb.BatchFailureManifestEntry{Page: 5}),
+				},
+			}, nil
+		}).Times(4)
+
+	read := func(nextPageToken []byte) *persistence.ReadBatchFailuresResponse {
+		response, err := manager.ReadBatchFailures(context.Background(), &persistence.ReadBatchFailuresRequest{
+			NamespaceID:   "ns-id",
+			JobRunID:      "run-id",
+			PageSize:      2,
+			NextPageToken: nextPageToken,
+		})
+		require.NoError(t, err)
+		return response
+	}
+	response := read(nil)
+	require.Len(t, response.Entries, 2)
+	require.Equal(t, int64(1), response.Entries[0].GetPage())
+	require.Equal(t, "retried", response.Entries[1].GetFailures()[0].GetError())
+
+	response = read(response.NextPageToken)
+	require.Len(t, response.Entries, 1)
+	require.Equal(t, int64(5), response.Entries[0].GetPage())
+	require.Empty(t, response.NextPageToken)
+
+	_, err := manager.ReadBatchFailures(context.Background(), &persistence.ReadBatchFailuresRequest{
+		PageSize:      1,
+		NextPageToken: []byte("invalid"),
+	})
+	require.ErrorIs(t, err, persistence.ErrInvalidReadBatchFailuresNextPageToken)
+
+	// a job without failures has no queue
+	queue.EXPECT().ReadMessages(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("queue not found"))
+	response = read(nil)
+	require.Empty(t, response.Entries)
+}
+
+func TestBatchFailureManifestManager_ListAndDelete(t *testing.T) {
+	controller := gomock.NewController(t)
+	queue := mock.NewMockQueueV2(controller)
+	manager := persistence.NewBatchFailureManifestManager(queue)
+
+	queue.EXPECT().ListQueues(gomock.Any(), &persistence.InternalListQueuesRequest{
+		QueueType: persistence.QueueTypeBatchFailureManifest,
+		PageSize:  10,
+	}).Return(&persistence.InternalListQueuesResponse{
+		Queues: []persistence.QueueInfo{
+			{QueueName: "batch.ns-id.run-1"},
+			{QueueName: "batch.other-ns-id.run-2"},
+			{QueueName: "batch.ns-id.run-3"},
+		},
+	}, nil)
+	listResponse, err := manager.ListBatchFailureManifests(context.Background(), &persistence.ListBatchFailureManifestsRequest{
+		NamespaceID: "ns-id",
+		PageSize:    10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"run-1", "run-3"}, listResponse.JobRunIDs)
+
+	queue.EXPECT().RangeDeleteMessages(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.InternalRangeDeleteMessagesRequest) (*persistence.InternalRangeDeleteMessagesResponse, error) {
+			require.Equal(t, "batch.ns-id.run-1", request.QueueName)
+			return &persistence.InternalRangeDeleteMessagesResponse{MessagesDeleted: 3}, nil
+		})
+	deleteResponse, err := manager.DeleteBatchFailures(context.Background(), &persistence.DeleteBatchFailuresRequest{
+		NamespaceID: "ns-id",
+		JobRunID:    "run-1",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), deleteResponse.EntriesDeleted)
+}
+
+func batchFailureMessage(t *testing.T, id int64, entry *persistencespb.BatchFailureManifestEntry) persistence.QueueV2Message {
+	data, err := entry.Marshal()
+	require.NoError(t, err)
+	return persistence.QueueV2Message{
+		MetaData: persistence.MessageMetadata{ID: id},
+		Data: &commonpb.DataBlob{
+			EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+			Data:         data,
+		},
+	}
+}
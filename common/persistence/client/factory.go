@@ -66,6 +66,10 @@ type (
 		NewHistoryTaskQueueManager() (persistence.HistoryTaskQueueManager, error)
 		// NewNexusEndpointManager returns a new manager for nexus endpoints
 		NewNexusEndpointManager() (persistence.NexusEndpointManager, error)
+		// NewAuditLogManager returns a new manager for the audit log
+		NewAuditLogManager() (persistence.AuditLogManager, error)
+		// NewBatchFailureManifestManager returns a new manager for the failure manifests of batch jobs
+		NewBatchFailureManifestManager() (persistence.BatchFailureManifestManager, error)
 	}
 
 	factoryImpl struct {
@@ -199,7 +203,15 @@ func (f *factoryImpl) NewExecutionManager() (persistence.ExecutionManager, error
 		return nil, err
 	}
 
-	result := persistence.NewExecutionManager(store, f.serializer, f.eventBlobCache, f.logger, f.config.TransactionSizeLimit)
+	result := persistence.NewExecutionManager(
+		store,
+		f.serializer,
+		f.eventBlobCache,
+		f.logger,
+		f.config.TransactionSizeLimit,
+		f.config.BlobCompression,
+		f.metricsHandler,
+	)
 	if f.systemRateLimiter != nil && f.namespaceRateLimiter != nil {
 		result = persistence.NewExecutionPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.shardRateLimiter, f.logger)
 	}
@@ -234,6 +246,22 @@ func (f *factoryImpl) NewHistoryTaskQueueManager() (persistence.HistoryTaskQueue
 	return persistence.NewHistoryTaskQueueManager(q, serialization.NewSerializer()), nil
 }
 
+func (f *factoryImpl) NewAuditLogManager() (persistence.AuditLogManager, error) {
+	q, err := f.dataStoreFactory.NewQueueV2()
+	if err != nil {
+		return nil, err
+	}
+	return persistence.NewAuditLogManager(q), nil
+}
+
+func (f *factoryImpl) NewBatchFailureManifestManager() (persistence.BatchFailureManifestManager, error) {
+	q, err := f.dataStoreFactory.NewQueueV2()
+	if err != nil {
+		return nil, err
+	}
+	return persistence.NewBatchFailureManifestManager(q), nil
+}
+
 func (f *factoryImpl) NewNexusEndpointManager() (persistence.NexusEndpointManager, error) {
 	store, err := f.dataStoreFactory.NewNexusEndpointStore()
 	if err != nil {
@@ -54,6 +54,9 @@ type (
 	PersistenceBurstRatio              dynamicconfig.FloatPropertyFn
 
 	DynamicRateLimitingParams dynamicconfig.TypedPropertyFn[dynamicconfig.DynamicRateLimitingParams]
+	// PersistenceAdaptiveRateLimitingEnabled controls whether the per-namespace and per-shard rate limits
+	// of a namespace are adjusted based on persistence health. If nil, only the host-level limit is adjusted.
+	PersistenceAdaptiveRateLimitingEnabled dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
 	ClusterName string
 
@@ -74,6 +77,7 @@ type (
 		Logger                             log.Logger
 		HealthSignals                      persistence.HealthSignalAggregator
 		DynamicRateLimitingParams          DynamicRateLimitingParams
+		AdaptiveRateLimitingEnabled        PersistenceAdaptiveRateLimitingEnabled `optional:"true"`
 	}
 
 	FactoryProviderFn func(NewFactoryParams) Factory
@@ -90,6 +94,8 @@ var Module = fx.Options(
 	fx.Provide(managerProvider(Factory.NewExecutionManager)),
 	fx.Provide(managerProvider(Factory.NewHistoryTaskQueueManager)),
 	fx.Provide(managerProvider(Factory.NewNexusEndpointManager)),
+	fx.Provide(managerProvider(Factory.NewAuditLogManager)),
+	fx.Provide(managerProvider(Factory.NewBatchFailureManifestManager)),
 
 	fx.Provide(ClusterNameProvider),
 	fx.Provide(HealthSignalAggregatorProvider),
@@ -133,6 +139,11 @@ func FactoryProvider(
 			RequestPriorityFn,
 			params.OperatorRPSRatio,
 			params.PersistenceBurstRatio,
+			params.HealthSignals,
+			params.DynamicRateLimitingParams,
+			params.AdaptiveRateLimitingEnabled,
+			params.MetricsHandler,
+			params.Logger,
 		)
 		shardRequestRateLimiter = NewPriorityNamespaceShardRateLimiter(
 			params.PersistenceMaxQPS,
@@ -140,6 +151,11 @@ func FactoryProvider(
 			RequestPriorityFn,
 			params.OperatorRPSRatio,
 			params.PersistenceBurstRatio,
+			params.HealthSignals,
+			params.DynamicRateLimitingParams,
+			params.AdaptiveRateLimitingEnabled,
+			params.MetricsHandler,
+			params.Logger,
 		)
 	}
 
@@ -45,13 +45,32 @@ const (
 )
 
 type (
+	// HealthSignals is the source of the latency and error signals a HealthRequestRateLimiterImpl adapts to.
+	// persistence.HealthSignalAggregator satisfies it with host-level signals.
+	HealthSignals interface {
+		AverageLatency() float64
+		ErrorRatio() float64
+	}
+
+	// shardHealthSignals exposes the signals of a single shard from a persistence.HealthSignalAggregator
+	shardHealthSignals struct {
+		aggregator persistence.HealthSignalAggregator
+		shardID    int32
+	}
+
+	// namespaceHealthSignals exposes the signals of a single namespace from a persistence.HealthSignalAggregator
+	namespaceHealthSignals struct {
+		aggregator persistence.HealthSignalAggregator
+		namespace  string
+	}
+
 	HealthRequestRateLimiterImpl struct {
 		enabled    atomic.Bool
 		params     DynamicRateLimitingParams                               // dynamic config struct
 		curOptions atomic.Pointer[dynamicconfig.DynamicRateLimitingParams] // current dynamic config values (updated on refresh)
 
 		rateLimiter   *quotas.RateLimiterImpl
-		healthSignals persistence.HealthSignalAggregator
+		healthSignals HealthSignals
 
 		refreshTimer *time.Ticker
 
@@ -64,10 +83,14 @@ type (
 	}
 )
 
-var _ quotas.RequestRateLimiter = (*HealthRequestRateLimiterImpl)(nil)
+var (
+	_ quotas.RequestRateLimiter = (*HealthRequestRateLimiterImpl)(nil)
+	_ HealthSignals             = (*shardHealthSignals)(nil)
+	_ HealthSignals             = (*namespaceHealthSignals)(nil)
+)
 
 func NewHealthRequestRateLimiterImpl(
-	healthSignals persistence.HealthSignalAggregator,
+	healthSignals HealthSignals,
 	rateFn quotas.RateFn,
 	params DynamicRateLimitingParams,
 	burstRatio PersistenceBurstRatio,
@@ -181,3 +204,33 @@ func (rl *HealthRequestRateLimiterImpl) errorThresholdExceeded() bool {
 	curOptions := *rl.curOptions.Load()
 	return curOptions.ErrorThreshold > 0 && rl.healthSignals.ErrorRatio() > curOptions.ErrorThreshold
 }
+
+func newShardHealthSignals(aggregator persistence.HealthSignalAggregator, shardID int32) *shardHealthSignals {
+	return &shardHealthSignals{
+		aggregator: aggregator,
+		shardID:    shardID,
+	}
+}
+
+func (s *shardHealthSignals) AverageLatency() float64 {
+	return s.aggregator.ShardAverageLatency(s.shardID)
+}
+
+func (s *shardHealthSignals) ErrorRatio() float64 {
+	return s.aggregator.ShardErrorRatio(s.shardID)
+}
+
+func newNamespaceHealthSignals(aggregator persistence.HealthSignalAggregator, namespace string) *namespaceHealthSignals {
+	return &namespaceHealthSignals{
+		aggregator: aggregator,
+		namespace:  namespace,
+	}
+}
+
+func (s *namespaceHealthSignals) AverageLatency() float64 {
+	return s.aggregator.NamespaceAverageLatency(s.namespace)
+}
+
+func (s *namespaceHealthSignals) ErrorRatio() float64 {
+	return s.aggregator.NamespaceErrorRatio(s.namespace)
+}
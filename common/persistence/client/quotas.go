@@ -25,14 +25,22 @@
 package client
 
 import (
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/service/history/tasks"
 )
 
+const (
+	rateLimitScopeTagName   = "rate_limit_scope"
+	rateLimitScopeNamespace = "namespace"
+	rateLimitScopeShard     = "shard"
+)
+
 type (
 	perShardPerNamespaceKey struct {
 		namespaceID string
@@ -123,6 +131,11 @@ func NewPriorityNamespaceRateLimiter(
 	requestPriorityFn quotas.RequestPriorityFn,
 	operatorRPSRatio OperatorRPSRatio,
 	burstRatio PersistenceBurstRatio,
+	healthSignals p.HealthSignalAggregator,
+	dynamicParams DynamicRateLimitingParams,
+	adaptiveRateLimitingEnabled PersistenceAdaptiveRateLimitingEnabled,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
 ) quotas.RequestRateLimiter {
 
 	return newPriorityNamespaceRateLimiter(
@@ -131,6 +144,11 @@ func NewPriorityNamespaceRateLimiter(
 		requestPriorityFn,
 		operatorRPSRatio,
 		burstRatio,
+		healthSignals,
+		dynamicParams,
+		adaptiveRateLimitingEnabled,
+		metricsHandler,
+		logger,
 	)
 }
 
@@ -140,6 +158,11 @@ func NewPriorityNamespaceShardRateLimiter(
 	requestPriorityFn quotas.RequestPriorityFn,
 	operatorRPSRatio OperatorRPSRatio,
 	burstRatio PersistenceBurstRatio,
+	healthSignals p.HealthSignalAggregator,
+	dynamicParams DynamicRateLimitingParams,
+	adaptiveRateLimitingEnabled PersistenceAdaptiveRateLimitingEnabled,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
 ) quotas.RequestRateLimiter {
 
 	return newPerShardPerNamespacePriorityRateLimiter(
@@ -148,6 +171,11 @@ func NewPriorityNamespaceShardRateLimiter(
 		requestPriorityFn,
 		operatorRPSRatio,
 		burstRatio,
+		healthSignals,
+		dynamicParams,
+		adaptiveRateLimitingEnabled,
+		metricsHandler,
+		logger,
 	)
 }
 
@@ -157,19 +185,43 @@ func newPerShardPerNamespacePriorityRateLimiter(
 	requestPriorityFn quotas.RequestPriorityFn,
 	operatorRPSRatio OperatorRPSRatio,
 	burstRatio PersistenceBurstRatio,
+	healthSignals p.HealthSignalAggregator,
+	dynamicParams DynamicRateLimitingParams,
+	adaptiveRateLimitingEnabled PersistenceAdaptiveRateLimitingEnabled,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
 ) quotas.RequestRateLimiter {
 	return quotas.NewMapRequestRateLimiter(func(req quotas.Request) quotas.RequestRateLimiter {
 		if hasCaller(req) && hasCallerSegment(req) {
-			return newPriorityRateLimiter(func() float64 {
+			rateFn := func() float64 {
 				if perShardNamespaceMaxQPS == nil || perShardNamespaceMaxQPS(req.Caller) <= 0 {
 					return float64(hostMaxQPS())
 				}
 				return float64(perShardNamespaceMaxQPS(req.Caller))
-			},
+			}
+			rateLimiter := newPriorityRateLimiter(
+				rateFn,
 				requestPriorityFn,
 				operatorRPSRatio,
 				burstRatio,
 			)
+			if healthSignals == nil || dynamicParams == nil || adaptiveRateLimitingEnabled == nil {
+				return rateLimiter
+			}
+			return quotas.NewMultiRequestRateLimiter(
+				// shard-level dynamic rate limiter, backs off when the shard's persistence requests
+				// become slow or start failing
+				NewHealthRequestRateLimiterImpl(
+					newShardHealthSignals(healthSignals, req.CallerSegment),
+					rateFn,
+					namespaceDynamicRateLimitingParams(dynamicParams, adaptiveRateLimitingEnabled, req.Caller),
+					burstRatio,
+					// shard ID is deliberately not tagged to keep the cardinality bounded
+					metricsHandler.WithTags(metrics.NamespaceTag(req.Caller), metrics.StringTag(rateLimitScopeTagName, rateLimitScopeShard)),
+					log.With(logger, tag.WorkflowNamespace(req.Caller), tag.ShardID(req.CallerSegment)),
+				),
+				rateLimiter,
+			)
 		}
 		return quotas.NoopRequestRateLimiter
 	},
@@ -190,31 +242,67 @@ func newPriorityNamespaceRateLimiter(
 	requestPriorityFn quotas.RequestPriorityFn,
 	operatorRPSRatio OperatorRPSRatio,
 	burstRatio PersistenceBurstRatio,
+	healthSignals p.HealthSignalAggregator,
+	dynamicParams DynamicRateLimitingParams,
+	adaptiveRateLimitingEnabled PersistenceAdaptiveRateLimitingEnabled,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
 ) quotas.RequestRateLimiter {
 	return quotas.NewNamespaceRequestRateLimiter(func(req quotas.Request) quotas.RequestRateLimiter {
 		if hasCaller(req) {
-			return newPriorityRateLimiter(
-				func() float64 {
-					if namespaceMaxQPS == nil {
-						return float64(hostMaxQPS())
-					}
+			rateFn := func() float64 {
+				if namespaceMaxQPS == nil {
+					return float64(hostMaxQPS())
+				}
 
-					namespaceQPS := float64(namespaceMaxQPS(req.Caller))
-					if namespaceQPS <= 0 {
-						return float64(hostMaxQPS())
-					}
+				namespaceQPS := float64(namespaceMaxQPS(req.Caller))
+				if namespaceQPS <= 0 {
+					return float64(hostMaxQPS())
+				}
 
-					return namespaceQPS
-				},
+				return namespaceQPS
+			}
+			rateLimiter := newPriorityRateLimiter(
+				rateFn,
 				requestPriorityFn,
 				operatorRPSRatio,
 				burstRatio,
 			)
+			if healthSignals == nil || dynamicParams == nil || adaptiveRateLimitingEnabled == nil {
+				return rateLimiter
+			}
+			return quotas.NewMultiRequestRateLimiter(
+				// namespace-level dynamic rate limiter, backs off when the namespace's persistence
+				// requests become slow or start failing
+				NewHealthRequestRateLimiterImpl(
+					newNamespaceHealthSignals(healthSignals, req.Caller),
+					rateFn,
+					namespaceDynamicRateLimitingParams(dynamicParams, adaptiveRateLimitingEnabled, req.Caller),
+					burstRatio,
+					metricsHandler.WithTags(metrics.NamespaceTag(req.Caller), metrics.StringTag(rateLimitScopeTagName, rateLimitScopeNamespace)),
+					log.With(logger, tag.WorkflowNamespace(req.Caller)),
+				),
+				rateLimiter,
+			)
 		}
 		return quotas.NoopRequestRateLimiter
 	})
 }
 
+// namespaceDynamicRateLimitingParams returns the dynamic rate limiting params with rate adjustment
+// turned off for namespaces that opted out of adaptive rate limiting.
+func namespaceDynamicRateLimitingParams(
+	dynamicParams DynamicRateLimitingParams,
+	adaptiveRateLimitingEnabled PersistenceAdaptiveRateLimitingEnabled,
+	namespace string,
+) DynamicRateLimitingParams {
+	return func() dynamicconfig.DynamicRateLimitingParams {
+		params := dynamicParams()
+		params.Enabled = params.Enabled && adaptiveRateLimitingEnabled(namespace)
+		return params
+	}
+}
+
 func newPriorityRateLimiter(
 	rateFn quotas.RateFn,
 	requestPriorityFn quotas.RequestPriorityFn,
@@ -33,7 +33,11 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/testing/temporalapi"
 )
@@ -115,6 +119,11 @@ func (s *quotasSuite) TestPriorityNamespaceRateLimiter_DoesLimit() {
 		RequestPriorityFn,
 		operatorRPSRatioFn,
 		burstRatio,
+		nil,
+		nil,
+		nil,
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
 	)
 
 	request := quotas.NewRequest(
@@ -150,6 +159,11 @@ func (s *quotasSuite) TestPerShardNamespaceRateLimiter_DoesLimit() {
 		RequestPriorityFn,
 		operatorRPSRatioFn,
 		burstRatio,
+		nil,
+		nil,
+		nil,
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
 	)
 
 	request := quotas.NewRequest(
@@ -173,6 +187,80 @@ func (s *quotasSuite) TestPerShardNamespaceRateLimiter_DoesLimit() {
 	s.True(wasLimited)
 }
 
+func (s *quotasSuite) TestNamespaceAdaptiveRateLimiter() {
+	healthSignals := persistence.NewHealthSignalAggregatorImpl(
+		true,
+		time.Minute,
+		100,
+		metrics.NoopMetricsHandler,
+		dynamicconfig.GetIntPropertyFn(0),
+		dynamicconfig.GetFloatPropertyFn(0),
+		log.NewNoopLogger(),
+	)
+	healthSignals.Record(1, "slow-namespace", time.Second, nil)
+	healthSignals.Record(2, "healthy-namespace", time.Millisecond, nil)
+
+	dynamicParams := DynamicRateLimitingParams(func() dynamicconfig.DynamicRateLimitingParams {
+		params := dynamicconfig.DefaultDynamicRateLimitingParams
+		params.Enabled = true
+		params.LatencyThreshold = 100
+		params.RateMultiMin = 0.5
+		return params
+	})
+	adaptiveRateLimitingEnabled := PersistenceAdaptiveRateLimitingEnabled(func(namespace string) bool {
+		return namespace != "opted-out-namespace"
+	})
+	rateFn := func() float64 { return 100 }
+	burstRatio := func() float64 { return 1 }
+
+	newLimiter := func(signals HealthSignals, namespace string) *HealthRequestRateLimiterImpl {
+		return NewHealthRequestRateLimiterImpl(
+			signals,
+			rateFn,
+			namespaceDynamicRateLimitingParams(dynamicParams, adaptiveRateLimitingEnabled, namespace),
+			burstRatio,
+			metrics.NoopMetricsHandler,
+			log.NewNoopLogger(),
+		)
+	}
+
+	slowLimiter := newLimiter(newNamespaceHealthSignals(healthSignals, "slow-namespace"), "slow-namespace")
+	s.True(slowLimiter.enabled.Load())
+	slowLimiter.refreshRate()
+	s.Equal(70.0, slowLimiter.rateLimiter.Rate())
+	slowLimiter.refreshRate()
+	s.Equal(50.0, slowLimiter.rateLimiter.Rate())
+
+	healthyLimiter := newLimiter(newNamespaceHealthSignals(healthSignals, "healthy-namespace"), "healthy-namespace")
+	healthyLimiter.refreshRate()
+	s.Equal(100.0, healthyLimiter.rateLimiter.Rate())
+
+	slowShardLimiter := newLimiter(newShardHealthSignals(healthSignals, 1), "healthy-namespace")
+	slowShardLimiter.refreshRate()
+	s.Equal(70.0, slowShardLimiter.rateLimiter.Rate())
+
+	optedOutLimiter := newLimiter(newNamespaceHealthSignals(healthSignals, "opted-out-namespace"), "opted-out-namespace")
+	s.False(optedOutLimiter.enabled.Load())
+}
+
+func (s *quotasSuite) TestNamespaceAdaptiveRateLimiter_NotConfigured() {
+	limiter := newPriorityNamespaceRateLimiter(
+		func(namespace string) int { return 1 },
+		func() int { return 1 },
+		RequestPriorityFn,
+		func() float64 { return 0.2 },
+		func() float64 { return 1 },
+		persistence.NoopHealthSignalAggregator,
+		nil,
+		nil,
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
+	)
+
+	request := quotas.NewRequest("test-api", 1, "test-namespace", "api", -1, "frontend")
+	s.True(limiter.Allow(time.Now(), request))
+}
+
 func (s *quotasSuite) TestOperatorPrioritized() {
 	rateFn := func() float64 { return 5 }
 	operatorRPSRatioFn := func() float64 { return 0.2 }
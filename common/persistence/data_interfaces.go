@@ -1247,6 +1247,24 @@ type (
 		ListQueues(ctx context.Context, request *ListQueuesRequest) (*ListQueuesResponse, error)
 	}
 
+	// AuditLogManager stores audit events of mutating frontend calls in a queue.
+	AuditLogManager interface {
+		Closeable
+		AppendAuditEvent(ctx context.Context, request *AppendAuditEventRequest) (*AppendAuditEventResponse, error)
+		ReadAuditEvents(ctx context.Context, request *ReadAuditEventsRequest) (*ReadAuditEventsResponse, error)
+		TrimAuditEvents(ctx context.Context, request *TrimAuditEventsRequest) (*TrimAuditEventsResponse, error)
+	}
+
+	// BatchFailureManifestManager stores the failure manifests of batch jobs in a queue per job,
+	// outside of the history of the batch workflow.
+	BatchFailureManifestManager interface {
+		Closeable
+		AppendBatchFailures(ctx context.Context, request *AppendBatchFailuresRequest) (*AppendBatchFailuresResponse, error)
+		ReadBatchFailures(ctx context.Context, request *ReadBatchFailuresRequest) (*ReadBatchFailuresResponse, error)
+		ListBatchFailureManifests(ctx context.Context, request *ListBatchFailureManifestsRequest) (*ListBatchFailureManifestsResponse, error)
+		DeleteBatchFailures(ctx context.Context, request *DeleteBatchFailuresRequest) (*DeleteBatchFailuresResponse, error)
+	}
+
 	HistoryTaskQueueManagerImpl struct {
 		queue      QueueV2
 		serializer serialization.Serializer
@@ -1331,6 +1349,81 @@ type (
 		Queues        []QueueInfo
 		NextPageToken []byte
 	}
+
+	AppendAuditEventRequest struct {
+		Event *persistencespb.AuditEvent
+	}
+
+	AppendAuditEventResponse struct {
+		Metadata MessageMetadata
+	}
+
+	ReadAuditEventsRequest struct {
+		// Namespace whose events are read. Empty reads the events of calls that are not scoped
+		// to a namespace.
+		Namespace     string
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	ReadAuditEventsResponse struct {
+		Events        []*persistencespb.AuditEvent
+		NextPageToken []byte
+	}
+
+	TrimAuditEventsRequest struct {
+		ExclusiveMaxEventTime time.Time
+	}
+
+	TrimAuditEventsResponse struct {
+		EventsDeleted int64
+	}
+
+	AppendBatchFailuresRequest struct {
+		NamespaceID string
+		// Run id of the batch job
+		JobRunID string
+		Entry    *persistencespb.BatchFailureManifestEntry
+	}
+
+	AppendBatchFailuresResponse struct {
+		Metadata MessageMetadata
+	}
+
+	ReadBatchFailuresRequest struct {
+		NamespaceID string
+		JobRunID    string
+		// Max number of entries to return
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	ReadBatchFailuresResponse struct {
+		// Entries in increasing order of page, without the entries replaced by later ones
+		Entries       []*persistencespb.BatchFailureManifestEntry
+		NextPageToken []byte
+	}
+
+	ListBatchFailureManifestsRequest struct {
+		NamespaceID   string
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	ListBatchFailureManifestsResponse struct {
+		// Run ids of the batch jobs of the namespace that have a failure manifest
+		JobRunIDs     []string
+		NextPageToken []byte
+	}
+
+	DeleteBatchFailuresRequest struct {
+		NamespaceID string
+		JobRunID    string
+	}
+
+	DeleteBatchFailuresResponse struct {
+		EntriesDeleted int64
+	}
 )
 
 func (e *InvalidPersistenceRequestError) Error() string {
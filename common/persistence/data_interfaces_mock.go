@@ -1469,3 +1469,180 @@ func (mr *MockHistoryTaskQueueManagerMockRecorder) ReadTasks(ctx, request any) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTasks", reflect.TypeOf((*MockHistoryTaskQueueManager)(nil).ReadTasks), ctx, request)
 }
+
+// MockAuditLogManager is a mock of AuditLogManager interface.
+type MockAuditLogManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditLogManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditLogManagerMockRecorder is the mock recorder for MockAuditLogManager.
+type MockAuditLogManagerMockRecorder struct {
+	mock *MockAuditLogManager
+}
+
+// NewMockAuditLogManager creates a new mock instance.
+func NewMockAuditLogManager(ctrl *gomock.Controller) *MockAuditLogManager {
+	mock := &MockAuditLogManager{ctrl: ctrl}
+	mock.recorder = &MockAuditLogManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditLogManager) EXPECT() *MockAuditLogManagerMockRecorder {
+	return m.recorder
+}
+
+// AppendAuditEvent mocks base method.
+func (m *MockAuditLogManager) AppendAuditEvent(ctx context.Context, request *AppendAuditEventRequest) (*AppendAuditEventResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendAuditEvent", ctx, request)
+	ret0, _ := ret[0].(*AppendAuditEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppendAuditEvent indicates an expected call of AppendAuditEvent.
+func (mr *MockAuditLogManagerMockRecorder) AppendAuditEvent(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendAuditEvent", reflect.TypeOf((*MockAuditLogManager)(nil).AppendAuditEvent), ctx, request)
+}
+
+// Close mocks base method.
+func (m *MockAuditLogManager) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockAuditLogManagerMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockAuditLogManager)(nil).Close))
+}
+
+// ReadAuditEvents mocks base method.
+func (m *MockAuditLogManager) ReadAuditEvents(ctx context.Context, request *ReadAuditEventsRequest) (*ReadAuditEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAuditEvents", ctx, request)
+	ret0, _ := ret[0].(*ReadAuditEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAuditEvents indicates an expected call of ReadAuditEvents.
+func (mr *MockAuditLogManagerMockRecorder) ReadAuditEvents(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAuditEvents", reflect.TypeOf((*MockAuditLogManager)(nil).ReadAuditEvents), ctx, request)
+}
+
+// TrimAuditEvents mocks base method.
+func (m *MockAuditLogManager) TrimAuditEvents(ctx context.Context, request *TrimAuditEventsRequest) (*TrimAuditEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrimAuditEvents", ctx, request)
+	ret0, _ := ret[0].(*TrimAuditEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TrimAuditEvents indicates an expected call of TrimAuditEvents.
+func (mr *MockAuditLogManagerMockRecorder) TrimAuditEvents(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrimAuditEvents", reflect.TypeOf((*MockAuditLogManager)(nil).TrimAuditEvents), ctx, request)
+}
+
+// MockBatchFailureManifestManager is a mock of BatchFailureManifestManager interface.
+type MockBatchFailureManifestManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatchFailureManifestManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockBatchFailureManifestManagerMockRecorder is the mock recorder for MockBatchFailureManifestManager.
+type MockBatchFailureManifestManagerMockRecorder struct {
+	mock *MockBatchFailureManifestManager
+}
+
+// NewMockBatchFailureManifestManager creates a new mock instance.
+func NewMockBatchFailureManifestManager(ctrl *gomock.Controller) *MockBatchFailureManifestManager {
+	mock := &MockBatchFailureManifestManager{ctrl: ctrl}
+	mock.recorder = &MockBatchFailureManifestManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBatchFailureManifestManager) EXPECT() *MockBatchFailureManifestManagerMockRecorder {
+	return m.recorder
+}
+
+// AppendBatchFailures mocks base method.
+func (m *MockBatchFailureManifestManager) AppendBatchFailures(ctx context.Context, request *AppendBatchFailuresRequest) (*AppendBatchFailuresResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendBatchFailures", ctx, request)
+	ret0, _ := ret[0].(*AppendBatchFailuresResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppendBatchFailures indicates an expected call of AppendBatchFailures.
+func (mr *MockBatchFailureManifestManagerMockRecorder) AppendBatchFailures(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendBatchFailures", reflect.TypeOf((*MockBatchFailureManifestManager)(nil).AppendBatchFailures), ctx, request)
+}
+
+// Close mocks base method.
+func (m *MockBatchFailureManifestManager) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockBatchFailureManifestManagerMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockBatchFailureManifestManager)(nil).Close))
+}
+
+// DeleteBatchFailures mocks base method.
+func (m *MockBatchFailureManifestManager) DeleteBatchFailures(ctx context.Context, request *DeleteBatchFailuresRequest) (*DeleteBatchFailuresResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBatchFailures", ctx, request)
+	ret0, _ := ret[0].(*DeleteBatchFailuresResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBatchFailures indicates an expected call of DeleteBatchFailures.
+func (mr *MockBatchFailureManifestManagerMockRecorder) DeleteBatchFailures(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBatchFailures", reflect.TypeOf((*MockBatchFailureManifestManager)(nil).DeleteBatchFailures), ctx, request)
+}
+
+// ListBatchFailureManifests mocks base method.
+func (m *MockBatchFailureManifestManager) ListBatchFailureManifests(ctx context.Context, request *ListBatchFailureManifestsRequest) (*ListBatchFailureManifestsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBatchFailureManifests", ctx, request)
+	ret0, _ := ret[0].(*ListBatchFailureManifestsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBatchFailureManifests indicates an expected call of ListBatchFailureManifests.
+func (mr *MockBatchFailureManifestManagerMockRecorder) ListBatchFailureManifests(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBatchFailureManifests", reflect.TypeOf((*MockBatchFailureManifestManager)(nil).ListBatchFailureManifests), ctx, request)
+}
+
+// ReadBatchFailures mocks base method.
+func (m *MockBatchFailureManifestManager) ReadBatchFailures(ctx context.Context, request *ReadBatchFailuresRequest) (*ReadBatchFailuresResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadBatchFailures", ctx, request)
+	ret0, _ := ret[0].(*ReadBatchFailuresResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadBatchFailures indicates an expected call of ReadBatchFailures.
+func (mr *MockBatchFailureManifestManagerMockRecorder) ReadBatchFailures(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadBatchFailures", reflect.TypeOf((*MockBatchFailureManifestManager)(nil).ReadBatchFailures), ctx, request)
+}
@@ -42,6 +42,8 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/service/history/tasks"
@@ -56,6 +58,8 @@ type (
 		logger                log.Logger
 		pagingTokenSerializer *jsonHistoryTokenSerializer
 		transactionSizeLimit  dynamicconfig.IntPropertyFn
+		blobCompression       dynamicconfig.StringPropertyFnWithNamespaceIDFilter
+		metricsHandler        metrics.Handler
 	}
 )
 
@@ -68,6 +72,8 @@ func NewExecutionManager(
 	eventBlobCache XDCCache,
 	logger log.Logger,
 	transactionSizeLimit dynamicconfig.IntPropertyFn,
+	blobCompression dynamicconfig.StringPropertyFnWithNamespaceIDFilter,
+	metricsHandler metrics.Handler,
 ) ExecutionManager {
 	return &executionManagerImpl{
 		serializer:            serializer,
@@ -76,6 +82,8 @@ func NewExecutionManager(
 		logger:                logger,
 		pagingTokenSerializer: newJSONHistoryTokenSerializer(),
 		transactionSizeLimit:  transactionSizeLimit,
+		blobCompression:       blobCompression,
+		metricsHandler:        metricsHandler,
 	}
 }
 
@@ -486,9 +494,15 @@ func (m *executionManagerImpl) serializeWorkflowEventBatches(
 			workflowEvents.Events[len(workflowEvents.Events)-1].EventId+1,
 		)
 		newEvents.ShardID = shardID
-		workflowNewEvents = append(workflowNewEvents, newEvents)
 		historyStatistics.SizeDiff += len(newEvents.Node.Events.Data)
 		historyStatistics.CountDiff += len(workflowEvents.Events)
+		// XDC cache and history statistics always see the uncompressed blob,
+		// compression only applies to what is written to the history node table.
+		newEvents.Node.Events, err = m.compressBlob(workflowEvents.NamespaceID, newEvents.Node.Events)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		workflowNewEvents = append(workflowNewEvents, newEvents)
 	}
 	return xdcKVs, workflowNewEvents, &historyStatistics, nil
 }
@@ -597,7 +611,7 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 		NextEventID:     input.NextEventID,
 	}
 
-	result.ExecutionInfoBlob, err = m.serializer.WorkflowExecutionInfoToBlob(input.ExecutionInfo, enumspb.ENCODING_TYPE_PROTO3)
+	result.ExecutionInfoBlob, err = m.serializeExecutionInfo(input.ExecutionInfo)
 	if err != nil {
 		return nil, err
 	}
@@ -607,7 +621,7 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 	}
 
 	for key, info := range input.UpsertActivityInfos {
-		blob, err := m.serializer.ActivityInfoToBlob(info, enumspb.ENCODING_TYPE_PROTO3)
+		blob, err := serializeInfo(m, input.ExecutionInfo.GetNamespaceId(), info, m.serializer.ActivityInfoToBlob)
 		if err != nil {
 			return nil, err
 		}
@@ -615,7 +629,7 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 	}
 
 	for key, info := range input.UpsertTimerInfos {
-		blob, err := m.serializer.TimerInfoToBlob(info, enumspb.ENCODING_TYPE_PROTO3)
+		blob, err := serializeInfo(m, input.ExecutionInfo.GetNamespaceId(), info, m.serializer.TimerInfoToBlob)
 		if err != nil {
 			return nil, err
 		}
@@ -623,7 +637,7 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 	}
 
 	for key, info := range input.UpsertChildExecutionInfos {
-		blob, err := m.serializer.ChildExecutionInfoToBlob(info, enumspb.ENCODING_TYPE_PROTO3)
+		blob, err := serializeInfo(m, input.ExecutionInfo.GetNamespaceId(), info, m.serializer.ChildExecutionInfoToBlob)
 		if err != nil {
 			return nil, err
 		}
@@ -631,7 +645,7 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 	}
 
 	for key, info := range input.UpsertRequestCancelInfos {
-		blob, err := m.serializer.RequestCancelInfoToBlob(info, enumspb.ENCODING_TYPE_PROTO3)
+		blob, err := serializeInfo(m, input.ExecutionInfo.GetNamespaceId(), info, m.serializer.RequestCancelInfoToBlob)
 		if err != nil {
 			return nil, err
 		}
@@ -639,7 +653,7 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 	}
 
 	for key, info := range input.UpsertSignalInfos {
-		blob, err := m.serializer.SignalInfoToBlob(info, enumspb.ENCODING_TYPE_PROTO3)
+		blob, err := serializeInfo(m, input.ExecutionInfo.GetNamespaceId(), info, m.serializer.SignalInfoToBlob)
 		if err != nil {
 			return nil, err
 		}
@@ -657,6 +671,10 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 		if err != nil {
 			return nil, err
 		}
+		result.NewBufferedEvents, err = m.compressBlob(input.ExecutionInfo.GetNamespaceId(), result.NewBufferedEvents)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	result.LastWriteVersion, err = getCurrentBranchLastWriteVersion(input.ExecutionInfo.VersionHistories)
@@ -703,7 +721,7 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot( // unexport
 		NextEventID:     input.NextEventID,
 	}
 
-	result.ExecutionInfoBlob, err = m.serializer.WorkflowExecutionInfoToBlob(input.ExecutionInfo, enumspb.ENCODING_TYPE_PROTO3)
+	result.ExecutionInfoBlob, err = m.serializeExecutionInfo(input.ExecutionInfo)
 	if err != nil {
 		return nil, err
 	}
@@ -717,35 +735,35 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot( // unexport
 	}
 
 	for key, info := range input.ActivityInfos {
-		blob, err := m.serializer.ActivityInfoToBlob(info, enumspb.ENCODING_TYPE_PROTO3)
+		blob, err := serializeInfo(m, input.ExecutionInfo.GetNamespaceId(), info, m.serializer.ActivityInfoToBlob)
 		if err != nil {
 			return nil, err
 		}
 		result.ActivityInfos[key] = blob
 	}
 	for key, info := range input.TimerInfos {
-		blob, err := m.serializer.TimerInfoToBlob(info, enumspb.ENCODING_TYPE_PROTO3)
+		blob, err := serializeInfo(m, input.ExecutionInfo.GetNamespaceId(), info, m.serializer.TimerInfoToBlob)
 		if err != nil {
 			return nil, err
 		}
 		result.TimerInfos[key] = blob
 	}
 	for key, info := range input.ChildExecutionInfos {
-		blob, err := m.serializer.ChildExecutionInfoToBlob(info, enumspb.ENCODING_TYPE_PROTO3)
+		blob, err := serializeInfo(m, input.ExecutionInfo.GetNamespaceId(), info, m.serializer.ChildExecutionInfoToBlob)
 		if err != nil {
 			return nil, err
 		}
 		result.ChildExecutionInfos[key] = blob
 	}
 	for key, info := range input.RequestCancelInfos {
-		blob, err := m.serializer.RequestCancelInfoToBlob(info, enumspb.ENCODING_TYPE_PROTO3)
+		blob, err := serializeInfo(m, input.ExecutionInfo.GetNamespaceId(), info, m.serializer.RequestCancelInfoToBlob)
 		if err != nil {
 			return nil, err
 		}
 		result.RequestCancelInfos[key] = blob
 	}
 	for key, info := range input.SignalInfos {
-		blob, err := m.serializer.SignalInfoToBlob(info, enumspb.ENCODING_TYPE_PROTO3)
+		blob, err := serializeInfo(m, input.ExecutionInfo.GetNamespaceId(), info, m.serializer.SignalInfoToBlob)
 		if err != nil {
 			return nil, err
 		}
@@ -768,6 +786,65 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot( // unexport
 	return result, nil
 }
 
+func (m *executionManagerImpl) serializeExecutionInfo(
+	info *persistencespb.WorkflowExecutionInfo,
+) (*commonpb.DataBlob, error) {
+	blob, err := m.serializer.WorkflowExecutionInfoToBlob(info, enumspb.ENCODING_TYPE_PROTO3)
+	if err != nil {
+		return nil, err
+	}
+	return m.compressBlob(info.GetNamespaceId(), blob)
+}
+
+// serializeInfo encodes one of the activity, timer, child execution, request cancel or signal infos
+// of a mutable state and compresses it.
+func serializeInfo[T any](
+	m *executionManagerImpl,
+	namespaceID string,
+	info T,
+	toBlob func(T, enumspb.EncodingType) (*commonpb.DataBlob, error),
+) (*commonpb.DataBlob, error) {
+	blob, err := toBlob(info, enumspb.ENCODING_TYPE_PROTO3)
+	if err != nil {
+		return nil, err
+	}
+	return m.compressBlob(namespaceID, blob)
+}
+
+// compressBlob applies the blob compression configured for the namespace. Readers don't need to know
+// about the configuration since every decode path in the serialization package handles compressed blobs.
+// It covers history event batches, buffered events, the execution info and the activity, timer, child
+// execution, request cancel and signal infos. The execution state, CHASM nodes, checksum and tasks are
+// always stored uncompressed.
+func (m *executionManagerImpl) compressBlob(
+	namespaceID string,
+	blob *commonpb.DataBlob,
+) (*commonpb.DataBlob, error) {
+	if m.blobCompression == nil || blob == nil {
+		return blob, nil
+	}
+	compression, err := serialization.ParseCompressionType(m.blobCompression(namespace.ID(namespaceID)))
+	if err != nil {
+		m.logger.Warn("Invalid persistence blob compression config, storing blob uncompressed",
+			tag.WorkflowNamespaceID(namespaceID), tag.Error(err))
+		return blob, nil
+	}
+	if compression == serialization.CompressionTypeNone {
+		return blob, nil
+	}
+
+	compressed, err := serialization.CompressBlob(blob, compression)
+	if err != nil {
+		return nil, err
+	}
+	if m.metricsHandler != nil {
+		compressionTag := metrics.StringTag("compression", string(compression))
+		metrics.PersistenceBlobUncompressedSize.With(m.metricsHandler).Record(int64(len(blob.Data)), compressionTag)
+		metrics.PersistenceBlobCompressedSize.With(m.metricsHandler).Record(int64(len(compressed.Data)), compressionTag)
+	}
+	return compressed, nil
+}
+
 func (m *executionManagerImpl) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *DeleteWorkflowExecutionRequest,
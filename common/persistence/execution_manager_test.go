@@ -0,0 +1,84 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/testing/protorequire"
+)
+
+// namedExecutionStore only implements GetName, which is all that serialization needs.
+type namedExecutionStore struct {
+	ExecutionStore
+}
+
+func (namedExecutionStore) GetName() string {
+	return "sqlite"
+}
+
+func TestSerializeWorkflowSnapshot_CompressesMutableStateInfos(t *testing.T) {
+	serializer := serialization.NewSerializer()
+	manager := NewExecutionManager(
+		namedExecutionStore{},
+		serializer,
+		nil,
+		log.NewNoopLogger(),
+		dynamicconfig.GetIntPropertyFn(4*1024*1024),
+		dynamicconfig.GetStringPropertyFnFilteredByNamespaceID(string(serialization.CompressionTypeZstd)),
+		metrics.NoopMetricsHandler,
+	).(*executionManagerImpl)
+
+	// Long enough to be worth compressing.
+	id := strings.Repeat("compressible-", 50)
+	timerInfo := &persistencespb.TimerInfo{TimerId: id}
+	signalInfo := &persistencespb.SignalInfo{RequestId: id}
+	snapshot, err := manager.SerializeWorkflowSnapshot(&WorkflowSnapshot{
+		ExecutionInfo:  &persistencespb.WorkflowExecutionInfo{NamespaceId: "namespace-id", WorkflowId: id},
+		ExecutionState: &persistencespb.WorkflowExecutionState{RunId: "run-id"},
+		TimerInfos:     map[string]*persistencespb.TimerInfo{id: timerInfo},
+		SignalInfos:    map[int64]*persistencespb.SignalInfo{1: signalInfo},
+	})
+	require.NoError(t, err)
+
+	require.True(t, serialization.IsCompressedBlob(snapshot.ExecutionInfoBlob.GetData()))
+	require.True(t, serialization.IsCompressedBlob(snapshot.TimerInfos[id].GetData()))
+	require.True(t, serialization.IsCompressedBlob(snapshot.SignalInfos[1].GetData()))
+	require.False(t, serialization.IsCompressedBlob(snapshot.ExecutionStateBlob.GetData()))
+
+	decodedTimerInfo, err := serializer.TimerInfoFromBlob(snapshot.TimerInfos[id])
+	require.NoError(t, err)
+	protorequire.ProtoEqual(t, timerInfo, decodedTimerInfo)
+	decodedSignalInfo, err := serializer.SignalInfoFromBlob(snapshot.SignalInfos[1])
+	require.NoError(t, err)
+	protorequire.ProtoEqual(t, signalInfo, decodedSignalInfo)
+}
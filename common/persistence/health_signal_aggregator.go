@@ -39,6 +39,10 @@ import (
 
 const (
 	emitMetricsInterval = 30 * time.Second
+
+	// keyedSignalBufferRatio is the size of the buffers used for per-shard and per-namespace
+	// signals relative to the host-level buffer, as each of them sees a fraction of the requests
+	keyedSignalBufferRatio = 10
 )
 
 type (
@@ -46,10 +50,20 @@ type (
 		Record(callerSegment int32, namespace string, latency time.Duration, err error)
 		AverageLatency() float64
 		ErrorRatio() float64
+		ShardAverageLatency(shardID int32) float64
+		ShardErrorRatio(shardID int32) float64
+		NamespaceAverageLatency(namespace string) float64
+		NamespaceErrorRatio(namespace string) float64
 		Start()
 		Stop()
 	}
 
+	// healthSignals holds the latency and error averages for a single key
+	healthSignals struct {
+		latencyAverage aggregate.MovingWindowAverage
+		errorRatio     aggregate.MovingWindowAverage
+	}
+
 	HealthSignalAggregatorImpl struct {
 		status     int32
 		shutdownCh chan struct{}
@@ -62,6 +76,11 @@ type (
 		latencyAverage     aggregate.MovingWindowAverage
 		errorRatio         aggregate.MovingWindowAverage
 
+		windowSize       time.Duration
+		keyedBufferSize  int
+		shardSignals     sync.Map // shardID -> *healthSignals
+		namespaceSignals sync.Map // namespace -> *healthSignals
+
 		metricsHandler            metrics.Handler
 		emitMetricsTimer          *time.Ticker
 		perShardRPSWarnLimit      dynamicconfig.IntPropertyFn
@@ -90,6 +109,8 @@ func NewHealthSignalAggregatorImpl(
 		perShardPerNsRPSWarnLimit: perShardPerNsRPSWarnLimit,
 		logger:                    logger,
 		aggregationEnabled:        aggregationEnabled,
+		windowSize:                windowSize,
+		keyedBufferSize:           max(1, maxBufferSize/keyedSignalBufferRatio),
 	}
 
 	if aggregationEnabled {
@@ -122,10 +143,21 @@ func (s *HealthSignalAggregatorImpl) Record(callerSegment int32, namespace strin
 	if s.aggregationEnabled {
 		s.latencyAverage.Record(latency.Milliseconds())
 
+		unhealthy := int64(0)
 		if isUnhealthyError(err) {
-			s.errorRatio.Record(1)
-		} else {
-			s.errorRatio.Record(0)
+			unhealthy = 1
+		}
+		s.errorRatio.Record(unhealthy)
+
+		if callerSegment != CallerSegmentMissing {
+			signals := s.getOrCreateSignals(&s.shardSignals, callerSegment)
+			signals.latencyAverage.Record(latency.Milliseconds())
+			signals.errorRatio.Record(unhealthy)
+		}
+		if namespace != "" {
+			signals := s.getOrCreateSignals(&s.namespaceSignals, namespace)
+			signals.latencyAverage.Record(latency.Milliseconds())
+			signals.errorRatio.Record(unhealthy)
 		}
 	}
 
@@ -142,6 +174,45 @@ func (s *HealthSignalAggregatorImpl) ErrorRatio() float64 {
 	return s.errorRatio.Average()
 }
 
+func (s *HealthSignalAggregatorImpl) ShardAverageLatency(shardID int32) float64 {
+	if signals, ok := s.shardSignals.Load(shardID); ok {
+		return signals.(*healthSignals).latencyAverage.Average()
+	}
+	return 0
+}
+
+func (s *HealthSignalAggregatorImpl) ShardErrorRatio(shardID int32) float64 {
+	if signals, ok := s.shardSignals.Load(shardID); ok {
+		return signals.(*healthSignals).errorRatio.Average()
+	}
+	return 0
+}
+
+func (s *HealthSignalAggregatorImpl) NamespaceAverageLatency(namespace string) float64 {
+	if signals, ok := s.namespaceSignals.Load(namespace); ok {
+		return signals.(*healthSignals).latencyAverage.Average()
+	}
+	return 0
+}
+
+func (s *HealthSignalAggregatorImpl) NamespaceErrorRatio(namespace string) float64 {
+	if signals, ok := s.namespaceSignals.Load(namespace); ok {
+		return signals.(*healthSignals).errorRatio.Average()
+	}
+	return 0
+}
+
+func (s *HealthSignalAggregatorImpl) getOrCreateSignals(signalsMap *sync.Map, key any) *healthSignals {
+	if signals, ok := signalsMap.Load(key); ok {
+		return signals.(*healthSignals)
+	}
+	signals, _ := signalsMap.LoadOrStore(key, &healthSignals{
+		latencyAverage: aggregate.NewMovingWindowAvgImpl(s.windowSize, s.keyedBufferSize),
+		errorRatio:     aggregate.NewMovingWindowAvgImpl(s.windowSize, s.keyedBufferSize),
+	})
+	return signals.(*healthSignals)
+}
+
 func (s *HealthSignalAggregatorImpl) incrementShardRequestCount(shardID int32, namespace string) {
 	s.requestsLock.Lock()
 	defer s.requestsLock.Unlock()
@@ -26,8 +26,13 @@ package persistence
 
 import (
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/require"
 	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 )
 
 func Test_isUnhealthyError(t *testing.T) {
@@ -81,3 +86,35 @@ func Test_isUnhealthyError(t *testing.T) {
 		})
 	}
 }
+
+func TestHealthSignalAggregator_KeyedSignals(t *testing.T) {
+	aggregator := NewHealthSignalAggregatorImpl(
+		true,
+		time.Minute,
+		100,
+		metrics.NoopMetricsHandler,
+		dynamicconfig.GetIntPropertyFn(0),
+		dynamicconfig.GetFloatPropertyFn(0),
+		log.NewNoopLogger(),
+	)
+
+	aggregator.Record(1, "ns-a", 100*time.Millisecond, nil)
+	aggregator.Record(1, "ns-b", 300*time.Millisecond, &serviceerror.DeadlineExceeded{})
+	aggregator.Record(2, "ns-a", 200*time.Millisecond, nil)
+	aggregator.Record(CallerSegmentMissing, "", 400*time.Millisecond, nil)
+
+	require.Equal(t, 250.0, aggregator.AverageLatency())
+	require.Equal(t, 0.25, aggregator.ErrorRatio())
+
+	require.Equal(t, 200.0, aggregator.ShardAverageLatency(1))
+	require.Equal(t, 0.5, aggregator.ShardErrorRatio(1))
+	require.Equal(t, 200.0, aggregator.ShardAverageLatency(2))
+	require.Equal(t, 0.0, aggregator.ShardErrorRatio(2))
+	require.Equal(t, 0.0, aggregator.ShardAverageLatency(3))
+
+	require.Equal(t, 150.0, aggregator.NamespaceAverageLatency("ns-a"))
+	require.Equal(t, 0.0, aggregator.NamespaceErrorRatio("ns-a"))
+	require.Equal(t, 300.0, aggregator.NamespaceAverageLatency("ns-b"))
+	require.Equal(t, 1.0, aggregator.NamespaceErrorRatio("ns-b"))
+	require.Equal(t, 0.0, aggregator.NamespaceAverageLatency("ns-c"))
+}
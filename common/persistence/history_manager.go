@@ -36,6 +36,7 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives/timestamp"
 )
 
@@ -815,8 +816,13 @@ func (m *executionManagerImpl) readRawHistoryBranchAndFilter(
 	if len(nodes) > 0 {
 		dataBlobs = make([]*commonpb.DataBlob, len(nodes))
 		for index, node := range nodes {
-			dataBlobs[index] = node.Events
-			dataSize += len(node.Events.Data)
+			// Raw history is handed out to replication and admin APIs as is, so it must never leak compression.
+			blob, err := serialization.DecompressBlob(node.Events)
+			if err != nil {
+				return nil, nil, nil, nil, 0, err
+			}
+			dataBlobs[index] = blob
+			dataSize += len(blob.Data)
 			transactionIDs = append(transactionIDs, node.TransactionID)
 			nodeIDs = append(nodeIDs, node.NodeID)
 		}
@@ -904,8 +910,13 @@ func (m *executionManagerImpl) readRawHistoryBranchReverseAndFilter(
 	if len(nodes) > 0 {
 		dataBlobs = make([]*commonpb.DataBlob, len(nodes))
 		for index, node := range nodes {
-			dataBlobs[index] = node.Events
-			dataSize += len(node.Events.Data)
+			// Raw history is handed out to replication and admin APIs as is, so it must never leak compression.
+			blob, err := serialization.DecompressBlob(node.Events)
+			if err != nil {
+				return nil, nil, nil, 0, err
+			}
+			dataBlobs[index] = blob
+			dataSize += len(blob.Data)
 			transactionIDs = append(transactionIDs, node.TransactionID)
 		}
 		lastNode := nodes[len(nodes)-1]
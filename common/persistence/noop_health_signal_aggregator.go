@@ -49,3 +49,19 @@ func (a *noopSignalAggregator) AverageLatency() float64 {
 func (*noopSignalAggregator) ErrorRatio() float64 {
 	return 0
 }
+
+func (*noopSignalAggregator) ShardAverageLatency(_ int32) float64 {
+	return 0
+}
+
+func (*noopSignalAggregator) ShardErrorRatio(_ int32) float64 {
+	return 0
+}
+
+func (*noopSignalAggregator) NamespaceAverageLatency(_ string) float64 {
+	return 0
+}
+
+func (*noopSignalAggregator) NamespaceErrorRatio(_ string) float64 {
+	return 0
+}
@@ -34,6 +34,9 @@ const (
 	QueueTypeUnspecified   QueueV2Type = 0
 	QueueTypeHistoryNormal QueueV2Type = 1
 	QueueTypeHistoryDLQ    QueueV2Type = 2
+	QueueTypeAuditLog      QueueV2Type = 3
+	// QueueTypeBatchFailureManifest queues hold the failure manifest of a batch job.
+	QueueTypeBatchFailureManifest QueueV2Type = 4
 
 	// FirstQueueMessageID is the ID of the first message written to a queue partition.
 	FirstQueueMessageID = 0
@@ -204,7 +204,11 @@ func Proto3Decode(blob []byte, e enumspb.EncodingType, result proto.Message) err
 	if e != enumspb.ENCODING_TYPE_PROTO3 {
 		return NewUnknownEncodingTypeError(e.String(), enumspb.ENCODING_TYPE_PROTO3)
 	}
-	err := proto.Unmarshal(blob, result)
+	blob, err := decompress(blob, e)
+	if err != nil {
+		return err
+	}
+	err = proto.Unmarshal(blob, result)
 	if err != nil {
 		return NewDeserializationError(enumspb.ENCODING_TYPE_PROTO3, err)
 	}
@@ -0,0 +1,176 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serialization
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+type (
+	// CompressionType is the algorithm applied on top of an already encoded DataBlob before it is
+	// written to persistence. Compression is transparent to readers: every decode path in this
+	// package detects compressed data and decompresses it before unmarshalling.
+	CompressionType string
+)
+
+const (
+	CompressionTypeNone   CompressionType = "none"
+	CompressionTypeSnappy CompressionType = "snappy"
+	CompressionTypeZstd   CompressionType = "zstd"
+
+	// MinCompressibleBlobSize is the smallest blob that is worth compressing. Smaller blobs are
+	// stored as is since the framing overhead outweighs any savings.
+	MinCompressibleBlobSize = 256
+
+	compressionAlgorithmSnappy byte = 1
+	compressionAlgorithmZstd   byte = 2
+)
+
+var (
+	// compressedBlobMagic prefixes every compressed blob. A proto3 or JSON encoded message can never
+	// start with a zero byte (field number 0 is reserved in proto), so the prefix unambiguously
+	// separates compressed blobs from the ones written before compression was enabled.
+	compressedBlobMagic  = []byte{0x00, 'T', 'C'}
+	compressedHeaderSize = len(compressedBlobMagic) + 1
+
+	errUnknownCompressionAlgorithm = errors.New("unknown compression algorithm")
+
+	zstdEncoder = sync.OnceValues(func() (*zstd.Encoder, error) {
+		return zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+	})
+	zstdDecoder = sync.OnceValues(func() (*zstd.Decoder, error) {
+		return zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+	})
+)
+
+// ParseCompressionType converts a dynamic config value into a CompressionType. Empty string is
+// treated as CompressionTypeNone.
+func ParseCompressionType(value string) (CompressionType, error) {
+	switch CompressionType(value) {
+	case "", CompressionTypeNone:
+		return CompressionTypeNone, nil
+	case CompressionTypeSnappy:
+		return CompressionTypeSnappy, nil
+	case CompressionTypeZstd:
+		return CompressionTypeZstd, nil
+	default:
+		return CompressionTypeNone, fmt.Errorf("unsupported blob compression type: %q", value)
+	}
+}
+
+// IsCompressedBlob returns true if data was produced by CompressBlob.
+func IsCompressedBlob(data []byte) bool {
+	return len(data) >= compressedHeaderSize && bytes.HasPrefix(data, compressedBlobMagic)
+}
+
+// CompressBlob compresses the data of the given blob with the given algorithm. The encoding type
+// of the blob is preserved. The original blob is returned when compression is disabled, the blob
+// is too small or compression does not reduce its size.
+func CompressBlob(blob *commonpb.DataBlob, compression CompressionType) (*commonpb.DataBlob, error) {
+	if blob == nil || compression == CompressionTypeNone || len(blob.Data) < MinCompressibleBlobSize || IsCompressedBlob(blob.Data) {
+		return blob, nil
+	}
+
+	var algorithm byte
+	var compressed []byte
+	switch compression {
+	case CompressionTypeSnappy:
+		algorithm = compressionAlgorithmSnappy
+		compressed = snappy.Encode(nil, blob.Data)
+	case CompressionTypeZstd:
+		encoder, err := zstdEncoder()
+		if err != nil {
+			return nil, NewSerializationError(blob.EncodingType, err)
+		}
+		algorithm = compressionAlgorithmZstd
+		compressed = encoder.EncodeAll(blob.Data, nil)
+	default:
+		return nil, NewSerializationError(blob.EncodingType, fmt.Errorf("%w: %v", errUnknownCompressionAlgorithm, compression))
+	}
+
+	if len(compressed)+compressedHeaderSize >= len(blob.Data) {
+		return blob, nil
+	}
+
+	data := make([]byte, 0, compressedHeaderSize+len(compressed))
+	data = append(data, compressedBlobMagic...)
+	data = append(data, algorithm)
+	data = append(data, compressed...)
+	return &commonpb.DataBlob{
+		EncodingType: blob.EncodingType,
+		Data:         data,
+	}, nil
+}
+
+// DecompressBlob reverts CompressBlob. Blobs which are not compressed are returned as is.
+func DecompressBlob(blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	if blob == nil || !IsCompressedBlob(blob.Data) {
+		return blob, nil
+	}
+	data, err := decompress(blob.Data, blob.EncodingType)
+	if err != nil {
+		return nil, err
+	}
+	return &commonpb.DataBlob{
+		EncodingType: blob.EncodingType,
+		Data:         data,
+	}, nil
+}
+
+func decompress(data []byte, encoding enumspb.EncodingType) ([]byte, error) {
+	if !IsCompressedBlob(data) {
+		return data, nil
+	}
+
+	payload := data[compressedHeaderSize:]
+	switch data[len(compressedBlobMagic)] {
+	case compressionAlgorithmSnappy:
+		decompressed, err := snappy.Decode(nil, payload)
+		if err != nil {
+			return nil, NewDeserializationError(encoding, err)
+		}
+		return decompressed, nil
+	case compressionAlgorithmZstd:
+		decoder, err := zstdDecoder()
+		if err != nil {
+			return nil, NewDeserializationError(encoding, err)
+		}
+		decompressed, err := decoder.DecodeAll(payload, nil)
+		if err != nil {
+			return nil, NewDeserializationError(encoding, err)
+		}
+		return decompressed, nil
+	default:
+		return nil, NewDeserializationError(encoding, fmt.Errorf("%w: %v", errUnknownCompressionAlgorithm, data[len(compressedBlobMagic)]))
+	}
+}
@@ -0,0 +1,155 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serialization
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/testing/protorequire"
+)
+
+func TestParseCompressionType(t *testing.T) {
+	for value, expected := range map[string]CompressionType{
+		"":       CompressionTypeNone,
+		"none":   CompressionTypeNone,
+		"snappy": CompressionTypeSnappy,
+		"zstd":   CompressionTypeZstd,
+	} {
+		actual, err := ParseCompressionType(value)
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+	}
+
+	_, err := ParseCompressionType("gzip")
+	require.Error(t, err)
+}
+
+func TestCompressBlob_RoundTrip(t *testing.T) {
+	serializer := NewSerializer()
+	events := []*historypb.HistoryEvent{
+		{
+			EventId:   1,
+			EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_COMPLETED,
+			Attributes: &historypb.HistoryEvent_ActivityTaskCompletedEventAttributes{
+				ActivityTaskCompletedEventAttributes: &historypb.ActivityTaskCompletedEventAttributes{
+					Result: payloads.EncodeString(strings.Repeat("payload", 1000)),
+				},
+			},
+		},
+	}
+	blob, err := serializer.SerializeEvents(events, enumspb.ENCODING_TYPE_PROTO3)
+	require.NoError(t, err)
+
+	for _, compression := range []CompressionType{CompressionTypeSnappy, CompressionTypeZstd} {
+		t.Run(string(compression), func(t *testing.T) {
+			compressed, err := CompressBlob(blob, compression)
+			require.NoError(t, err)
+			require.True(t, IsCompressedBlob(compressed.Data))
+			require.Less(t, len(compressed.Data), len(blob.Data))
+			require.Equal(t, blob.EncodingType, compressed.EncodingType)
+
+			// compressing twice is a no-op
+			again, err := CompressBlob(compressed, compression)
+			require.NoError(t, err)
+			require.Equal(t, compressed.Data, again.Data)
+
+			deserialized, err := serializer.DeserializeEvents(compressed)
+			require.NoError(t, err)
+			protorequire.ProtoSliceEqual(t, events, deserialized)
+
+			stripped, err := serializer.DeserializeStrippedEvents(compressed)
+			require.NoError(t, err)
+			require.Len(t, stripped, 1)
+
+			decompressed, err := DecompressBlob(compressed)
+			require.NoError(t, err)
+			require.Equal(t, blob.Data, decompressed.Data)
+		})
+	}
+}
+
+func TestCompressBlob_MutableState(t *testing.T) {
+	serializer := NewSerializer()
+	info := &persistencespb.ActivityInfo{
+		ActivityId:   strings.Repeat("activity", 100),
+		ActivityType: &commonpb.ActivityType{Name: strings.Repeat("type", 100)},
+	}
+	blob, err := serializer.ActivityInfoToBlob(info, enumspb.ENCODING_TYPE_PROTO3)
+	require.NoError(t, err)
+
+	compressed, err := CompressBlob(blob, CompressionTypeZstd)
+	require.NoError(t, err)
+	require.True(t, IsCompressedBlob(compressed.Data))
+
+	deserialized, err := serializer.ActivityInfoFromBlob(compressed)
+	require.NoError(t, err)
+	protorequire.ProtoEqual(t, info, deserialized)
+}
+
+func TestCompressBlob_Skipped(t *testing.T) {
+	small := &commonpb.DataBlob{
+		EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+		Data:         []byte("small"),
+	}
+	compressed, err := CompressBlob(small, CompressionTypeZstd)
+	require.NoError(t, err)
+	require.Same(t, small, compressed)
+
+	large := &commonpb.DataBlob{
+		EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+		Data:         []byte(strings.Repeat("a", MinCompressibleBlobSize)),
+	}
+	compressed, err = CompressBlob(large, CompressionTypeNone)
+	require.NoError(t, err)
+	require.Same(t, large, compressed)
+
+	decompressed, err := DecompressBlob(large)
+	require.NoError(t, err)
+	require.Same(t, large, decompressed)
+}
+
+func TestDecompressBlob_Corrupted(t *testing.T) {
+	corrupted := &commonpb.DataBlob{
+		EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+		Data:         append(append([]byte{}, compressedBlobMagic...), compressionAlgorithmZstd, 1, 2, 3),
+	}
+	_, err := DecompressBlob(corrupted)
+	var deserializationErr *DeserializationError
+	require.ErrorAs(t, err, &deserializationErr)
+
+	unknown := &commonpb.DataBlob{
+		EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+		Data:         append(append([]byte{}, compressedBlobMagic...), 42, 1, 2, 3),
+	}
+	_, err = DecompressBlob(unknown)
+	require.ErrorIs(t, err, errUnknownCompressionAlgorithm)
+}
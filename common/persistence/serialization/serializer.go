@@ -176,8 +176,12 @@ func (t *serializerImpl) DeserializeEvents(data *commonpb.DataBlob) ([]*historyp
 	var err error
 	switch data.EncodingType {
 	case enumspb.ENCODING_TYPE_PROTO3:
+		var blob []byte
+		if blob, err = decompress(data.Data, data.EncodingType); err != nil {
+			return nil, err
+		}
 		// Client API currently specifies encodingType on requests which span multiple of these objects
-		err = events.Unmarshal(data.Data)
+		err = events.Unmarshal(blob)
 	default:
 		return nil, NewUnknownEncodingTypeError(data.EncodingType.String(), enumspb.ENCODING_TYPE_PROTO3)
 	}
@@ -200,11 +204,15 @@ func (t *serializerImpl) DeserializeStrippedEvents(data *commonpb.DataBlob) ([]*
 	//nolint:exhaustive
 	switch data.EncodingType {
 	case enumspb.ENCODING_TYPE_PROTO3:
+		var blob []byte
+		if blob, err = decompress(data.Data, data.EncodingType); err != nil {
+			return nil, err
+		}
 		// Discard unknown fields to improve performance. StrippedHistoryEvents is usually deserialized from HistoryEvent
 		// which has extra fields that are not needed for this message.
 		err = proto.UnmarshalOptions{
 			DiscardUnknown: true,
-		}.Unmarshal(data.Data, events)
+		}.Unmarshal(blob, events)
 	default:
 		return nil, NewUnknownEncodingTypeError(data.EncodingType.String(), enumspb.ENCODING_TYPE_PROTO3)
 	}
@@ -233,8 +241,12 @@ func (t *serializerImpl) DeserializeEvent(data *commonpb.DataBlob) (*historypb.H
 	var err error
 	switch data.EncodingType {
 	case enumspb.ENCODING_TYPE_PROTO3:
+		var blob []byte
+		if blob, err = decompress(data.Data, data.EncodingType); err != nil {
+			return nil, err
+		}
 		// Client API currently specifies encodingType on requests which span multiple of these objects
-		err = event.Unmarshal(data.Data)
+		err = event.Unmarshal(blob)
 	default:
 		return nil, NewUnknownEncodingTypeError(data.EncodingType.String(), enumspb.ENCODING_TYPE_PROTO3)
 	}
@@ -215,6 +215,10 @@ func parseCountGroupByGroupValue(fieldName string, value any) (any, error) {
 			)
 		}
 	default:
+		// Some drivers return text columns as bytes.
+		if bytesValue, ok := value.([]byte); ok {
+			return string(bytesValue), nil
+		}
 		return value, nil
 	}
 }
@@ -46,6 +46,7 @@ import (
 	"go.temporal.io/server/common/debug"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -96,6 +97,8 @@ func NewExecutionMutableStateSuite(
 			nil,
 			logger,
 			dynamicconfig.GetIntPropertyFn(4*1024*1024),
+			dynamicconfig.GetStringPropertyFnFilteredByNamespaceID(string(serialization.CompressionTypeNone)),
+			metrics.NoopMetricsHandler,
 		),
 		historyBranchUtil: historyBranchUtil,
 		Logger:            logger,
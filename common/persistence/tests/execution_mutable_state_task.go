@@ -41,6 +41,7 @@ import (
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
@@ -106,6 +107,8 @@ func NewExecutionMutableStateTaskSuite(
 			nil,
 			logger,
 			dynamicconfig.GetIntPropertyFn(4*1024*1024),
+			dynamicconfig.GetStringPropertyFnFilteredByNamespaceID(string(serialization.CompressionTypeNone)),
+			metrics.NoopMetricsHandler,
 		),
 		Logger: logger,
 	}
@@ -40,6 +40,7 @@ import (
 	"go.temporal.io/server/common/debug"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/testing/protorequire"
@@ -90,6 +91,8 @@ func NewHistoryEventsSuite(
 			nil,
 			logger,
 			dynamicconfig.GetIntPropertyFn(4*1024*1024),
+			dynamicconfig.GetStringPropertyFnFilteredByNamespaceID(string(serialization.CompressionTypeNone)),
+			metrics.NoopMetricsHandler,
 		),
 		serializer: eventSerializer,
 		logger:     logger,
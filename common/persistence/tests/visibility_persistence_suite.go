@@ -33,6 +33,7 @@ import (
 	"github.com/stretchr/testify/require"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/common/debug"
@@ -1074,6 +1075,124 @@ func (s *VisibilityPersistenceSuite) TestCountGroupByWorkflowExecutions() {
 	s.Equal(int64(5), resp.Count)
 }
 
+func (s *VisibilityPersistenceSuite) TestAggregateWorkflowExecutions() {
+	testNamespaceUUID := namespace.ID(uuid.New())
+	bucketStart := time.Now().UTC().Truncate(time.Hour).Add(-2 * time.Hour)
+
+	var startRequests []*manager.RecordWorkflowExecutionStartedRequest
+	for i := 0; i < 5; i++ {
+		startTime := bucketStart.Add(time.Duration(i) * 20 * time.Minute)
+		startRequests = append(
+			startRequests,
+			s.createOpenWorkflowRecord(
+				testNamespaceUUID,
+				"visibility-workflow-test",
+				"visibility-workflow",
+				startTime,
+				startTime,
+				"test-queue",
+			),
+		)
+	}
+	for i, duration := range map[int]time.Duration{0: time.Second, 1: 2 * time.Second, 3: 4 * time.Second} {
+		s.createClosedWorkflowRecord(
+			startRequests[i],
+			startRequests[i].StartTime.Add(duration),
+			enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		)
+	}
+
+	resp, err := s.VisibilityMgr.AggregateWorkflowExecutions(
+		s.ctx,
+		&manager.AggregateWorkflowExecutionsRequest{
+			NamespaceID: testNamespaceUUID,
+			Histogram: &manager.HistogramAggregation{
+				Field:      searchattribute.StartTime,
+				Interval:   time.Hour,
+				GroupBy:    searchattribute.ExecutionStatus,
+				MaxBuckets: 10,
+			},
+			Percentiles: &manager.PercentilesAggregation{
+				Field:    searchattribute.ExecutionDuration,
+				Percents: []float64{50, 100},
+			},
+		},
+	)
+	s.NoError(err)
+
+	runningStatusPayload, _ := searchattribute.EncodeValue(
+		enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String(),
+		enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+	)
+	completedStatusPayload, _ := searchattribute.EncodeValue(
+		enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED.String(),
+		enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+	)
+	s.Len(resp.Buckets, 2)
+	s.Equal(bucketStart, resp.Buckets[0].StartTime)
+	s.Equal(int64(3), resp.Buckets[0].Count)
+	s.ElementsMatch(
+		[]*workflowservice.CountWorkflowExecutionsResponse_AggregationGroup{
+			{GroupValues: []*commonpb.Payload{runningStatusPayload}, Count: 1},
+			{GroupValues: []*commonpb.Payload{completedStatusPayload}, Count: 2},
+		},
+		resp.Buckets[0].Groups,
+	)
+	s.Equal(bucketStart.Add(time.Hour), resp.Buckets[1].StartTime)
+	s.Equal(int64(2), resp.Buckets[1].Count)
+	s.ElementsMatch(
+		[]*workflowservice.CountWorkflowExecutionsResponse_AggregationGroup{
+			{GroupValues: []*commonpb.Payload{runningStatusPayload}, Count: 1},
+			{GroupValues: []*commonpb.Payload{completedStatusPayload}, Count: 1},
+		},
+		resp.Buckets[1].Groups,
+	)
+	s.Equal(
+		[]*manager.PercentileValue{
+			{Percent: 50, Value: float64(2 * time.Second)},
+			{Percent: 100, Value: float64(4 * time.Second)},
+		},
+		resp.Percentiles,
+	)
+
+	// Running executions have no close time and are not counted.
+	resp, err = s.VisibilityMgr.AggregateWorkflowExecutions(
+		s.ctx,
+		&manager.AggregateWorkflowExecutionsRequest{
+			NamespaceID: testNamespaceUUID,
+			Query:       "WorkflowType = 'visibility-workflow'",
+			Histogram: &manager.HistogramAggregation{
+				Field:      searchattribute.CloseTime,
+				Interval:   24 * time.Hour,
+				MaxBuckets: 10,
+			},
+		},
+	)
+	s.NoError(err)
+	var closedCount int64
+	for _, bucket := range resp.Buckets {
+		closedCount += bucket.Count
+		s.Nil(bucket.Groups)
+	}
+	s.Equal(int64(3), closedCount)
+	s.Nil(resp.Percentiles)
+
+	// Executions started over more than an hour don't fit in 10 buckets of a minute.
+	_, err = s.VisibilityMgr.AggregateWorkflowExecutions(
+		s.ctx,
+		&manager.AggregateWorkflowExecutionsRequest{
+			NamespaceID: testNamespaceUUID,
+			Histogram: &manager.HistogramAggregation{
+				Field:      searchattribute.StartTime,
+				Interval:   time.Minute,
+				MaxBuckets: 10,
+			},
+		},
+	)
+	var invalidArgErr *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgErr)
+}
+
 func (s *VisibilityPersistenceSuite) listWithPagination(namespaceID namespace.ID, pageSize int) []*workflowpb.WorkflowExecutionInfo {
 	var executions []*workflowpb.WorkflowExecutionInfo
 	resp, err := s.VisibilityMgr.ListWorkflowExecutions(s.ctx, &manager.ListWorkflowExecutionsRequestV2{
@@ -59,6 +59,7 @@ type (
 		ListWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error)
 		ScanWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error)
 		CountWorkflowExecutions(ctx context.Context, request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error)
+		AggregateWorkflowExecutions(ctx context.Context, request *AggregateWorkflowExecutionsRequest) (*AggregateWorkflowExecutionsResponse, error)
 		GetWorkflowExecution(ctx context.Context, request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error)
 	}
 
@@ -145,6 +146,50 @@ type (
 		Groups []*workflowservice.CountWorkflowExecutionsResponse_AggregationGroup
 	}
 
+	// AggregateWorkflowExecutionsRequest is request from AggregateWorkflowExecutions.
+	// At least one of Histogram and Percentiles must be set.
+	AggregateWorkflowExecutionsRequest struct {
+		NamespaceID namespace.ID
+		Namespace   namespace.Name // namespace.Name is not persisted.
+		Query       string
+		Histogram   *HistogramAggregation
+		Percentiles *PercentilesAggregation
+	}
+
+	// HistogramAggregation counts executions in fixed size time buckets of a datetime search attribute.
+	// Buckets are aligned to the Unix epoch and executions without a value for the field are skipped.
+	HistogramAggregation struct {
+		Field    string        // Datetime search attribute, e.g. StartTime or CloseTime.
+		Interval time.Duration // Bucket size, must be a whole number of seconds.
+		GroupBy  string        // Optional keyword search attribute to split each bucket by, e.g. ExecutionStatus.
+		// Maximum number of buckets from the first to the last non-empty bucket, inclusive. Histograms
+		// with more buckets fail with an InvalidArgument error instead of being computed.
+		MaxBuckets int
+	}
+
+	// PercentilesAggregation computes percentiles of a numeric search attribute.
+	PercentilesAggregation struct {
+		Field    string    // Int or Double search attribute, e.g. ExecutionDuration.
+		Percents []float64 // Percentiles to compute, each in range (0, 100].
+	}
+
+	// AggregateWorkflowExecutionsResponse is response to AggregateWorkflowExecutions
+	AggregateWorkflowExecutionsResponse struct {
+		Buckets     []*HistogramBucket // sorted by StartTime, empty buckets are omitted
+		Percentiles []*PercentileValue // in the same order as the requested percents
+	}
+
+	HistogramBucket struct {
+		StartTime time.Time
+		Count     int64
+		Groups    []*workflowservice.CountWorkflowExecutionsResponse_AggregationGroup // only set if GroupBy is set
+	}
+
+	PercentileValue struct {
+		Percent float64
+		Value   float64 // ExecutionDuration values are in nanoseconds. Zero if no execution has a value.
+	}
+
 	// VisibilityDeleteWorkflowExecutionRequest contains the request params for DeleteWorkflowExecution call
 	VisibilityDeleteWorkflowExecutionRequest struct {
 		NamespaceID namespace.ID
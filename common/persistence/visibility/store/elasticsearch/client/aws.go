@@ -25,15 +25,38 @@
 package client
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
-	elasticaws "github.com/olivere/elastic/v7/aws/v4"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+)
+
+const (
+	awsServiceOpenSearch           = "es"
+	awsServiceOpenSearchServerless = "aoss"
+)
+
+type (
+	// awsV4SigningTransport signs every request with AWS Signature Version 4.
+	// Unlike olivere/elastic/v7/aws/v4 it supports service names other than "es",
+	// and always sends payload hash header which is required by OpenSearch Serverless.
+	awsV4SigningTransport struct {
+		next    http.RoundTripper
+		signer  *v4.Signer
+		region  string
+		service string
+	}
 )
 
 func NewAwsHttpClient(config ESAWSRequestSigningConfig) (*http.Client, error) {
@@ -73,5 +96,56 @@ func NewAwsHttpClient(config ESAWSRequestSigningConfig) (*http.Client, error) {
 		return nil, fmt.Errorf("unknown AWS credential provider specified: %+v. Accepted options are 'static', 'environment' or 'session'", config.CredentialProvider)
 	}
 
-	return elasticaws.NewV4SigningClient(awsCredentials, config.Region), nil
+	switch config.Service {
+	case "":
+		config.Service = awsServiceOpenSearch
+	case awsServiceOpenSearch, awsServiceOpenSearchServerless:
+	default:
+		return nil, fmt.Errorf("unknown AWS service specified: %v. Accepted options are '%s' or '%s'", config.Service, awsServiceOpenSearch, awsServiceOpenSearchServerless)
+	}
+
+	return newAwsV4SigningClient(awsCredentials, config.Region, config.Service, http.DefaultTransport), nil
+}
+
+func newAwsV4SigningClient(awsCredentials *credentials.Credentials, region string, service string, next http.RoundTripper) *http.Client {
+	return &http.Client{
+		Transport: &awsV4SigningTransport{
+			next:    next,
+			signer:  v4.NewSigner(awsCredentials),
+			region:  region,
+			service: service,
+		},
+	}
+}
+
+func (t *awsV4SigningTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if strings.HasPrefix(req.Header.Get("Authorization"), "AWS4") {
+		// Request is already signed.
+		return t.next.RoundTrip(req)
+	}
+
+	// RoundTripper must not modify the original request.
+	req = req.Clone(req.Context())
+	if strings.Contains(req.URL.RawPath, "%2C") {
+		req.URL.RawPath = url.PathEscape(req.URL.RawPath)
+	}
+
+	var body []byte
+	var bodyReader io.ReadSeeker
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		bodyReader = bytes.NewReader(body)
+	}
+	payloadHash := sha256.Sum256(body)
+	req.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(payloadHash[:]))
+
+	if _, err := t.signer.Sign(req, bodyReader, t.service, t.region, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	return t.next.RoundTrip(req)
 }
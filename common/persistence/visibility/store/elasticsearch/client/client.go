@@ -46,6 +46,7 @@ type (
 		Search(ctx context.Context, p *SearchParameters) (*elastic.SearchResult, error)
 		Count(ctx context.Context, index string, query elastic.Query) (int64, error)
 		CountGroupBy(ctx context.Context, index string, query elastic.Query, aggName string, agg elastic.Aggregation) (*elastic.SearchResult, error)
+		Aggregate(ctx context.Context, index string, query elastic.Query, aggs map[string]elastic.Aggregation) (*elastic.SearchResult, error)
 		RunBulkProcessor(ctx context.Context, p *BulkProcessorParameters) (BulkProcessor, error)
 
 		// TODO (alex): move this to some admin client (and join with IntegrationTestsClient)
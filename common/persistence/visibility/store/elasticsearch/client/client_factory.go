@@ -25,35 +25,143 @@
 package client
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"sync"
+	"time"
 
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 )
 
-func NewClient(config *Config, httpClient *http.Client, logger log.Logger) (Client, error) {
-	switch config.Version {
-	case "v8", "v7", "":
-		return newClient(config, httpClient, logger)
-	default:
-		return nil, fmt.Errorf("not supported Elasticsearch version: %v", config.Version)
+const (
+	versionOpenSearch2 = "opensearch2"
+
+	distributionDetectionTimeout = 5 * time.Second
+)
+
+type (
+	// versionedClient is implemented by both Elasticsearch and OpenSearch clients.
+	versionedClient interface {
+		CLIClient
+		IntegrationTestsClient
 	}
+
+	// distributionDetectingClient is used when version is not set and cluster distribution couldn't be
+	// detected on client creation (i.e. cluster is not available yet). Detection is retried by every call
+	// that differs between Elasticsearch and OpenSearch until it succeeds. Other calls are wire compatible
+	// and are delegated to clientImpl.
+	distributionDetectingClient struct {
+		*clientImpl
+		logger log.Logger
+
+		mu       sync.Mutex
+		detected versionedClient
+	}
+)
+
+var _ versionedClient = (*distributionDetectingClient)(nil)
+
+func NewClient(config *Config, httpClient *http.Client, logger log.Logger) (Client, error) {
+	return newVersionedClient(config, httpClient, logger)
 }
 
 func NewCLIClient(config *Config, logger log.Logger) (CLIClient, error) {
-	switch config.Version {
-	case "v8", "v7", "":
-		return newClient(config, nil, logger)
-	default:
-		return nil, fmt.Errorf("not supported Elasticsearch version: %v", config.Version)
-	}
+	return newVersionedClient(config, nil, logger)
 }
 
 func NewFunctionalTestsClient(config *Config, logger log.Logger) (IntegrationTestsClient, error) {
+	return newVersionedClient(config, nil, logger)
+}
+
+// newVersionedClient creates a client for configured version. If version is not set,
+// cluster distribution is detected and OpenSearch specific client is used for OpenSearch clusters.
+// If detection fails (i.e. cluster is not available yet), it is retried lazily by distributionDetectingClient.
+func newVersionedClient(config *Config, httpClient *http.Client, logger log.Logger) (versionedClient, error) {
 	switch config.Version {
-	case "v8", "v7", "":
-		return newClient(config, nil, logger)
+	case "v8", "v7", versionOpenSearch2, "":
 	default:
 		return nil, fmt.Errorf("not supported Elasticsearch version: %v", config.Version)
 	}
+
+	esClient, err := newClient(config, httpClient, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	switch config.Version {
+	case versionOpenSearch2:
+		return newOpenSearchClient(esClient), nil
+	case "":
+		ctx, cancel := context.WithTimeout(context.Background(), distributionDetectionTimeout)
+		defer cancel()
+		isOpenSearch, err := esClient.isOpenSearch(ctx)
+		if isOpenSearch {
+			if err != nil {
+				return nil, err
+			}
+			return newOpenSearchClient(esClient), nil
+		}
+		if err == nil {
+			return esClient, nil
+		}
+		logger.Warn("Unable to detect Elasticsearch distribution, detection will be retried. Set version explicitly to skip detection.", tag.Error(err))
+		return &distributionDetectingClient{
+			clientImpl: esClient,
+			logger:     logger,
+		}, nil
+	}
+	return esClient, nil
+}
+
+func (c *distributionDetectingClient) resolve(ctx context.Context) (versionedClient, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.detected != nil {
+		return c.detected, nil
+	}
+	isOpenSearch, err := c.isOpenSearch(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to detect Elasticsearch distribution: %w", err)
+	}
+	if isOpenSearch {
+		c.detected = newOpenSearchClient(c.clientImpl)
+	} else {
+		c.detected = c.clientImpl
+	}
+	return c.detected, nil
+}
+
+func (c *distributionDetectingClient) IsPointInTimeSupported(ctx context.Context) bool {
+	detected, err := c.resolve(ctx)
+	if err != nil {
+		c.logger.Warn("Point in time is considered not supported.", tag.Error(err))
+		return false
+	}
+	return detected.IsPointInTimeSupported(ctx)
+}
+
+func (c *distributionDetectingClient) OpenPointInTime(ctx context.Context, index string, keepAliveInterval string) (string, error) {
+	detected, err := c.resolve(ctx)
+	if err != nil {
+		return "", err
+	}
+	return detected.OpenPointInTime(ctx, index, keepAliveInterval)
+}
+
+func (c *distributionDetectingClient) ClosePointInTime(ctx context.Context, id string) (bool, error) {
+	detected, err := c.resolve(ctx)
+	if err != nil {
+		return false, err
+	}
+	return detected.ClosePointInTime(ctx, id)
+}
+
+func (c *distributionDetectingClient) IndexPutTemplate(ctx context.Context, templateName string, bodyString string) (bool, error) {
+	detected, err := c.resolve(ctx)
+	if err != nil {
+		return false, err
+	}
+	return detected.IndexPutTemplate(ctx, templateName, bodyString)
 }
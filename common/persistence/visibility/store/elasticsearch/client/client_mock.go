@@ -66,6 +66,21 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// Aggregate mocks base method.
+func (m *MockClient) Aggregate(ctx context.Context, index string, query elastic.Query, aggs map[string]elastic.Aggregation) (*elastic.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Aggregate", ctx, index, query, aggs)
+	ret0, _ := ret[0].(*elastic.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Aggregate indicates an expected call of Aggregate.
+func (mr *MockClientMockRecorder) Aggregate(ctx, index, query, aggs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Aggregate", reflect.TypeOf((*MockClient)(nil).Aggregate), ctx, index, query, aggs)
+}
+
 // CatIndices mocks base method.
 func (m *MockClient) CatIndices(ctx context.Context, target string) (elastic.CatIndicesResponse, error) {
 	m.ctrl.T.Helper()
@@ -358,6 +373,21 @@ func (m *MockCLIClient) EXPECT() *MockCLIClientMockRecorder {
 	return m.recorder
 }
 
+// Aggregate mocks base method.
+func (m *MockCLIClient) Aggregate(ctx context.Context, index string, query elastic.Query, aggs map[string]elastic.Aggregation) (*elastic.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Aggregate", ctx, index, query, aggs)
+	ret0, _ := ret[0].(*elastic.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Aggregate indicates an expected call of Aggregate.
+func (mr *MockCLIClientMockRecorder) Aggregate(ctx, index, query, aggs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Aggregate", reflect.TypeOf((*MockCLIClient)(nil).Aggregate), ctx, index, query, aggs)
+}
+
 // CatIndices mocks base method.
 func (m *MockCLIClient) CatIndices(ctx context.Context, target string) (elastic.CatIndicesResponse, error) {
 	m.ctrl.T.Helper()
@@ -664,6 +694,21 @@ func (m *MockIntegrationTestsClient) EXPECT() *MockIntegrationTestsClientMockRec
 	return m.recorder
 }
 
+// Aggregate mocks base method.
+func (m *MockIntegrationTestsClient) Aggregate(ctx context.Context, index string, query elastic.Query, aggs map[string]elastic.Aggregation) (*elastic.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Aggregate", ctx, index, query, aggs)
+	ret0, _ := ret[0].(*elastic.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Aggregate indicates an expected call of Aggregate.
+func (mr *MockIntegrationTestsClientMockRecorder) Aggregate(ctx, index, query, aggs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Aggregate", reflect.TypeOf((*MockIntegrationTestsClient)(nil).Aggregate), ctx, index, query, aggs)
+}
+
 // CatIndices mocks base method.
 func (m *MockIntegrationTestsClient) CatIndices(ctx context.Context, target string) (elastic.CatIndicesResponse, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,273 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/blang/semver/v4"
+	"github.com/olivere/elastic/v7"
+	"github.com/olivere/elastic/v7/uritemplates"
+)
+
+type (
+	// openSearchClient implements Client for OpenSearch 2.x clusters.
+	// OpenSearch is wire compatible with Elasticsearch 7.10 for documents, search (including search
+	// with a point in time), scroll, count, aggregations, bulk, mappings and index settings, so these
+	// calls are delegated to the embedded clientImpl. The calls below are wire incompatible and are
+	// overridden with raw requests:
+	//   - IsPointInTimeSupported: Elasticsearch client checks the "default" build flavor, which OpenSearch
+	//     doesn't report, so the OpenSearch version is checked instead.
+	//   - OpenPointInTime: OpenSearch uses POST /{index}/_search/point_in_time instead of POST /{index}/_pit
+	//     and returns "pit_id" instead of "id".
+	//   - ClosePointInTime: OpenSearch uses DELETE /_search/point_in_time with a "pit_id" list instead of
+	//     DELETE /_pit with a single "id".
+	//   - IndexPutTemplate: legacy /_template templates are deprecated in OpenSearch 2.x, so the template
+	//     is converted and stored as a composable /_index_template.
+	// Any method added to Client must be checked against OpenSearch before it is delegated here.
+	openSearchClient struct {
+		*clientImpl
+
+		initIsOpenSearchPointInTimeSupported sync.Once
+		isOpenSearchPointInTimeSupported     bool
+	}
+
+	clusterInfo struct {
+		Version struct {
+			Number       string `json:"number"`
+			Distribution string `json:"distribution"`
+			BuildFlavor  string `json:"build_flavor"`
+		} `json:"version"`
+	}
+
+	openSearchOpenPointInTimeResponse struct {
+		PitID string `json:"pit_id"`
+	}
+
+	openSearchClosePointInTimeResponse struct {
+		Pits []struct {
+			PitID      string `json:"pit_id"`
+			Successful bool   `json:"successful"`
+		} `json:"pits"`
+	}
+
+	acknowledgedResponse struct {
+		Acknowledged bool `json:"acknowledged"`
+	}
+)
+
+const (
+	openSearchDistribution = "opensearch"
+)
+
+var (
+	openSearchPointInTimeSupportedIn = semver.MustParseRange(">=2.4.0")
+	openSearchSupportedIn            = semver.MustParseRange(">=2.0.0")
+)
+
+var _ Client = (*openSearchClient)(nil)
+var _ IntegrationTestsClient = (*openSearchClient)(nil)
+var _ CLIClient = (*openSearchClient)(nil)
+
+func newOpenSearchClient(c *clientImpl) *openSearchClient {
+	return &openSearchClient{
+		clientImpl: c,
+	}
+}
+
+func (c *openSearchClient) IsPointInTimeSupported(ctx context.Context) bool {
+	c.initIsOpenSearchPointInTimeSupported.Do(func() {
+		c.isOpenSearchPointInTimeSupported = c.queryOpenSearchPointInTimeSupported(ctx)
+	})
+	return c.isOpenSearchPointInTimeSupported
+}
+
+func (c *openSearchClient) queryOpenSearchPointInTimeSupported(ctx context.Context) bool {
+	info, err := c.getClusterInfo(ctx)
+	if err != nil || info.Version.Distribution != openSearchDistribution {
+		return false
+	}
+	osVersion, err := semver.ParseTolerant(info.Version.Number)
+	if err != nil {
+		return false
+	}
+	return openSearchPointInTimeSupportedIn(osVersion)
+}
+
+func (c *openSearchClient) OpenPointInTime(ctx context.Context, index string, keepAliveInterval string) (string, error) {
+	path, err := uritemplates.Expand("/{index}/_search/point_in_time", map[string]string{
+		"index": index,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	res, err := c.esClient.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method:  "POST",
+		Path:    path,
+		Params:  url.Values{"keep_alive": []string{keepAliveInterval}},
+		Headers: http.Header{},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var resp openSearchOpenPointInTimeResponse
+	if err := json.Unmarshal(res.Body, &resp); err != nil {
+		return "", err
+	}
+	if resp.PitID == "" {
+		return "", errors.New("OpenSearch didn't return point in time id")
+	}
+	return resp.PitID, nil
+}
+
+func (c *openSearchClient) ClosePointInTime(ctx context.Context, id string) (bool, error) {
+	res, err := c.esClient.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method:  "DELETE",
+		Path:    "/_search/point_in_time",
+		Params:  url.Values{},
+		Body:    map[string][]string{"pit_id": {id}},
+		Headers: http.Header{},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	var resp openSearchClosePointInTimeResponse
+	if err := json.Unmarshal(res.Body, &resp); err != nil {
+		return false, err
+	}
+	for _, pit := range resp.Pits {
+		if pit.PitID == id {
+			return pit.Successful, nil
+		}
+	}
+	return false, nil
+}
+
+// IndexPutTemplate accepts a legacy (Elasticsearch 7 "_template") template body and stores it
+// as a composable index template, which is the only template type that is not deprecated in OpenSearch 2.x.
+func (c *openSearchClient) IndexPutTemplate(ctx context.Context, templateName string, bodyString string) (bool, error) {
+	body, err := convertToComposableIndexTemplate(bodyString)
+	if err != nil {
+		return false, err
+	}
+
+	path, err := uritemplates.Expand("/_index_template/{name}", map[string]string{
+		"name": templateName,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	res, err := c.esClient.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method:  "PUT",
+		Path:    path,
+		Params:  url.Values{},
+		Body:    body,
+		Headers: http.Header{},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	var resp acknowledgedResponse
+	if err := json.Unmarshal(res.Body, &resp); err != nil {
+		return false, err
+	}
+	return resp.Acknowledged, nil
+}
+
+// getClusterInfo returns the response of the root endpoint of the cluster.
+// Unlike elastic.PingResult, it includes the distribution name which is set only by OpenSearch.
+func (c *clientImpl) getClusterInfo(ctx context.Context) (*clusterInfo, error) {
+	res, err := c.esClient.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method:  "GET",
+		Path:    "/",
+		Params:  url.Values{},
+		Headers: http.Header{},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var info clusterInfo
+	if err := json.Unmarshal(res.Body, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// isOpenSearch detects if the cluster is OpenSearch and returns an error if its version is not supported.
+func (c *clientImpl) isOpenSearch(ctx context.Context) (bool, error) {
+	info, err := c.getClusterInfo(ctx)
+	if err != nil {
+		return false, err
+	}
+	if info.Version.Distribution != openSearchDistribution {
+		return false, nil
+	}
+	osVersion, err := semver.ParseTolerant(info.Version.Number)
+	if err != nil {
+		return true, fmt.Errorf("unable to parse OpenSearch version %q: %w", info.Version.Number, err)
+	}
+	if !openSearchSupportedIn(osVersion) {
+		return true, fmt.Errorf("not supported OpenSearch version: %v", info.Version.Number)
+	}
+	return true, nil
+}
+
+func convertToComposableIndexTemplate(bodyString string) (map[string]any, error) {
+	var legacy map[string]any
+	if err := json.Unmarshal([]byte(bodyString), &legacy); err != nil {
+		return nil, fmt.Errorf("unable to parse index template: %w", err)
+	}
+	if _, ok := legacy["template"]; ok {
+		// Already a composable index template.
+		return legacy, nil
+	}
+
+	template := make(map[string]any)
+	body := make(map[string]any)
+	for key, value := range legacy {
+		switch key {
+		case "settings", "mappings", "aliases":
+			template[key] = value
+		case "order":
+			body["priority"] = value
+		default:
+			body[key] = value
+		}
+	}
+	body["template"] = template
+	return body, nil
+}
@@ -0,0 +1,400 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/olivere/elastic/v7"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/log"
+)
+
+type (
+	// openSearchFixtureServer is a recorded HTTP fixture of OpenSearch 2.x REST API.
+	openSearchFixtureServer struct {
+		*httptest.Server
+
+		mu        sync.Mutex
+		responses map[string]string
+		requests  []recordedRequest
+	}
+
+	recordedRequest struct {
+		method string
+		path   string
+		query  url.Values
+		header http.Header
+		body   string
+	}
+)
+
+const (
+	openSearch2RootResponse = `{
+  "name": "opensearch-node1",
+  "cluster_name": "opensearch-cluster",
+  "version": {
+    "distribution": "opensearch",
+    "number": "2.11.1",
+    "build_type": "tar",
+    "lucene_version": "9.7.0",
+    "minimum_wire_compatibility_version": "7.10.0",
+    "minimum_index_compatibility_version": "7.0.0"
+  },
+  "tagline": "The OpenSearch Project: https://opensearch.org/"
+}`
+	elasticsearch7RootResponse = `{
+  "name": "es-node1",
+  "cluster_name": "docker-cluster",
+  "version": {
+    "number": "7.17.9",
+    "build_flavor": "default",
+    "build_type": "docker",
+    "lucene_version": "8.11.1"
+  },
+  "tagline": "You Know, for Search"
+}`
+)
+
+func newOpenSearchFixtureServer(t *testing.T, responses map[string]string) *openSearchFixtureServer {
+	s := &openSearchFixtureServer{
+		responses: responses,
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *openSearchFixtureServer) handle(w http.ResponseWriter, r *http.Request) {
+	var reader io.Reader = r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gzipReader, err := gzip.NewReader(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		reader = gzipReader
+	}
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, recordedRequest{
+		method: r.Method,
+		path:   r.URL.Path,
+		query:  r.URL.Query(),
+		header: r.Header.Clone(),
+		body:   string(body),
+	})
+	response, ok := s.responses[r.Method+" "+r.URL.Path]
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":{"type":"resource_not_found_exception"},"status":404}`))
+		return
+	}
+	_, _ = w.Write([]byte(response))
+}
+
+func (s *openSearchFixtureServer) lastRequest(t *testing.T) recordedRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	require.NotEmpty(t, s.requests)
+	return s.requests[len(s.requests)-1]
+}
+
+func (s *openSearchFixtureServer) config(t *testing.T, version string) *Config {
+	serverURL, err := url.Parse(s.URL)
+	require.NoError(t, err)
+	return &Config{
+		Version: version,
+		URL:     *serverURL,
+	}
+}
+
+func TestNewClient_DetectsDistribution(t *testing.T) {
+	tests := []struct {
+		name         string
+		version      string
+		rootResponse string
+		openSearch   bool
+		err          string
+	}{
+		{
+			name:         "OpenSearch 2 is detected",
+			rootResponse: openSearch2RootResponse,
+			openSearch:   true,
+		},
+		{
+			name:         "Elasticsearch is detected",
+			rootResponse: elasticsearch7RootResponse,
+		},
+		{
+			name:         "OpenSearch 1 is not supported",
+			rootResponse: `{"version":{"distribution":"opensearch","number":"1.3.14"}}`,
+			err:          "not supported OpenSearch version: 1.3.14",
+		},
+		{
+			name:       "explicit OpenSearch version skips detection",
+			version:    versionOpenSearch2,
+			openSearch: true,
+		},
+		{
+			name:         "explicit Elasticsearch version skips detection",
+			version:      "v7",
+			rootResponse: openSearch2RootResponse,
+		},
+		{
+			name:    "unknown version",
+			version: "v6",
+			err:     "not supported Elasticsearch version: v6",
+		},
+		{
+			name:    "OpenSearch version without major version",
+			version: "opensearch",
+			err:     "not supported Elasticsearch version: opensearch",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			responses := map[string]string{}
+			if tc.rootResponse != "" {
+				responses["GET /"] = tc.rootResponse
+			}
+			server := newOpenSearchFixtureServer(t, responses)
+
+			c, err := NewClient(server.config(t, tc.version), nil, log.NewNoopLogger())
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			_, isOpenSearch := c.(*openSearchClient)
+			require.Equal(t, tc.openSearch, isOpenSearch)
+		})
+	}
+}
+
+func TestNewClient_DetectionFailureIsRetried(t *testing.T) {
+	server := newOpenSearchFixtureServer(t, map[string]string{})
+
+	c, err := NewFunctionalTestsClient(server.config(t, ""), log.NewNoopLogger())
+	require.NoError(t, err)
+	require.IsType(t, &distributionDetectingClient{}, c)
+
+	// Calls that differ between Elasticsearch and OpenSearch fail until the distribution is detected.
+	_, err = c.IndexPutTemplate(context.Background(), "temporal_visibility_v1_template", `{"index_patterns":["temporal_visibility_v1*"]}`)
+	require.ErrorContains(t, err, "unable to detect Elasticsearch distribution")
+	require.False(t, c.IsPointInTimeSupported(context.Background()))
+
+	server.mu.Lock()
+	server.responses["GET /"] = openSearch2RootResponse
+	server.responses["PUT /_index_template/temporal_visibility_v1_template"] = `{"acknowledged":true}`
+	server.mu.Unlock()
+
+	ack, err := c.IndexPutTemplate(context.Background(), "temporal_visibility_v1_template", `{"index_patterns":["temporal_visibility_v1*"]}`)
+	require.NoError(t, err)
+	require.True(t, ack)
+	require.Equal(t, "/_index_template/temporal_visibility_v1_template", server.lastRequest(t).path)
+	require.True(t, c.IsPointInTimeSupported(context.Background()))
+}
+
+func TestOpenSearchClient_IsPointInTimeSupported(t *testing.T) {
+	tests := []struct {
+		name         string
+		rootResponse string
+		expected     bool
+	}{
+		{
+			name:         "OpenSearch 2.11",
+			rootResponse: openSearch2RootResponse,
+			expected:     true,
+		},
+		{
+			name:         "OpenSearch 2.3",
+			rootResponse: `{"version":{"distribution":"opensearch","number":"2.3.0"}}`,
+			expected:     false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := newOpenSearchFixtureServer(t, map[string]string{"GET /": tc.rootResponse})
+			c, err := NewClient(server.config(t, versionOpenSearch2), nil, log.NewNoopLogger())
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, c.IsPointInTimeSupported(context.Background()))
+		})
+	}
+}
+
+func TestOpenSearchClient_PointInTime(t *testing.T) {
+	server := newOpenSearchFixtureServer(t, map[string]string{
+		"POST /temporal_visibility_v1/_search/point_in_time": `{
+  "pit_id": "o463QQEPbXktaW5kZXgtMDAwMDAxFnNOWU43ckt3U3IyaFVpbGE1UWEtMncAFjFyeXBsRGJmVFM2RTB6eVg1aVVqQncAAAAAAAAAAAIWcDVrM3ZIX0pRNS1XejE5YXRPRFhzUQEWc05ZTjdyS3dTcjJoVWlsYTVRYS0ydwAA",
+  "_shards": {"total": 1, "successful": 1, "skipped": 0, "failed": 0},
+  "creation_time": 1658146050064
+}`,
+		"DELETE /_search/point_in_time": `{
+  "pits": [
+    {
+      "successful": true,
+      "pit_id": "o463QQEPbXktaW5kZXgtMDAwMDAxFnNOWU43ckt3U3IyaFVpbGE1UWEtMncAFjFyeXBsRGJmVFM2RTB6eVg1aVVqQncAAAAAAAAAAAIWcDVrM3ZIX0pRNS1XejE5YXRPRFhzUQEWc05ZTjdyS3dTcjJoVWlsYTVRYS0ydwAA"
+    }
+  ]
+}`,
+		"POST /_search": `{
+  "pit_id": "o463QQEPbXktaW5kZXgtMDAwMDAxFnNOWU43ckt3U3IyaFVpbGE1UWEtMncAFjFyeXBsRGJmVFM2RTB6eVg1aVVqQncAAAAAAAAAAAIWcDVrM3ZIX0pRNS1XejE5YXRPRFhzUQEWc05ZTjdyS3dTcjJoVWlsYTVRYS0ydwAA",
+  "took": 3,
+  "timed_out": false,
+  "hits": {"hits": [{"_index": "temporal_visibility_v1", "_id": "wid~rid", "_source": {"WorkflowId": "wid"}, "sort": [1, "rid"]}]}
+}`,
+	})
+	c, err := NewClient(server.config(t, versionOpenSearch2), nil, log.NewNoopLogger())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	pitID, err := c.OpenPointInTime(ctx, "temporal_visibility_v1", "1m")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(pitID, "o463QQ"))
+	req := server.lastRequest(t)
+	require.Equal(t, http.MethodPost, req.method)
+	require.Equal(t, "1m", req.query.Get("keep_alive"))
+
+	result, err := c.Search(ctx, &SearchParameters{
+		Index:       "temporal_visibility_v1",
+		Query:       elastic.NewTermQuery("NamespaceId", "nid"),
+		PageSize:    10,
+		PointInTime: elastic.NewPointInTimeWithKeepAlive(pitID, "1m"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, pitID, result.PitId)
+	require.Len(t, result.Hits.Hits, 1)
+	req = server.lastRequest(t)
+	require.Equal(t, "/_search", req.path)
+	var searchBody map[string]any
+	require.NoError(t, json.Unmarshal([]byte(req.body), &searchBody))
+	require.Equal(t, map[string]any{"id": pitID, "keep_alive": "1m"}, searchBody["pit"])
+
+	closed, err := c.ClosePointInTime(ctx, pitID)
+	require.NoError(t, err)
+	require.True(t, closed)
+	req = server.lastRequest(t)
+	require.Equal(t, http.MethodDelete, req.method)
+	require.JSONEq(t, `{"pit_id":["`+pitID+`"]}`, req.body)
+
+	closed, err = c.ClosePointInTime(ctx, "unknown")
+	require.NoError(t, err)
+	require.False(t, closed)
+}
+
+func TestOpenSearchClient_IndexPutTemplate(t *testing.T) {
+	server := newOpenSearchFixtureServer(t, map[string]string{
+		"PUT /_index_template/temporal_visibility_v1_template": `{"acknowledged": true}`,
+	})
+	c, err := NewFunctionalTestsClient(server.config(t, versionOpenSearch2), log.NewNoopLogger())
+	require.NoError(t, err)
+
+	ack, err := c.IndexPutTemplate(context.Background(), "temporal_visibility_v1_template", `{
+  "order": 0,
+  "index_patterns": ["temporal_visibility_v1*"],
+  "settings": {"index": {"number_of_shards": "1"}},
+  "mappings": {"dynamic": "false", "properties": {"NamespaceId": {"type": "keyword"}}}
+}`)
+	require.NoError(t, err)
+	require.True(t, ack)
+	require.JSONEq(t, `{
+  "priority": 0,
+  "index_patterns": ["temporal_visibility_v1*"],
+  "template": {
+    "settings": {"index": {"number_of_shards": "1"}},
+    "mappings": {"dynamic": "false", "properties": {"NamespaceId": {"type": "keyword"}}}
+  }
+}`, server.lastRequest(t).body)
+}
+
+func TestConvertToComposableIndexTemplate(t *testing.T) {
+	body, err := convertToComposableIndexTemplate(`{"index_patterns":["a*"],"template":{"settings":{}}}`)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"index_patterns": []any{"a*"}, "template": map[string]any{"settings": map[string]any{}}}, body)
+
+	body, err = convertToComposableIndexTemplate(`{"order":1,"version":3,"index_patterns":["a*"],"aliases":{"a":{}}}`)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"priority":       float64(1),
+		"version":        float64(3),
+		"index_patterns": []any{"a*"},
+		"template":       map[string]any{"aliases": map[string]any{"a": map[string]any{}}},
+	}, body)
+
+	_, err = convertToComposableIndexTemplate(`not json`)
+	require.ErrorContains(t, err, "unable to parse index template")
+}
+
+func TestAwsV4SigningTransport(t *testing.T) {
+	server := newOpenSearchFixtureServer(t, map[string]string{
+		"GET /":               openSearch2RootResponse,
+		"POST /index/_search": `{"hits": {"hits": []}}`,
+	})
+	httpClient := newAwsV4SigningClient(
+		credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		"us-west-2",
+		awsServiceOpenSearchServerless,
+		http.DefaultTransport,
+	)
+
+	c, err := NewClient(server.config(t, ""), httpClient, log.NewNoopLogger())
+	require.NoError(t, err)
+	require.IsType(t, &openSearchClient{}, c)
+
+	_, err = c.Search(context.Background(), &SearchParameters{
+		Index: "index",
+		Query: elastic.NewMatchAllQuery(),
+	})
+	require.NoError(t, err)
+
+	req := server.lastRequest(t)
+	authorization := req.header.Get("Authorization")
+	require.True(t, strings.HasPrefix(authorization, "AWS4-HMAC-SHA256 Credential=AKID/"), authorization)
+	require.Contains(t, authorization, "/us-west-2/aoss/aws4_request")
+	require.Contains(t, authorization, "x-amz-content-sha256")
+	require.NotEmpty(t, req.header.Get("X-Amz-Content-Sha256"))
+	require.NotEmpty(t, req.body)
+}
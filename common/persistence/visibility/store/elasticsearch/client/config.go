@@ -43,6 +43,8 @@ const (
 // Config for connecting to Elasticsearch
 type (
 	Config struct {
+		// Version is one of "v7", "v8", "opensearch2" or empty. If empty, cluster distribution is detected on client
+		// creation, and retried on first use if the cluster is not available yet.
 		Version                      string                    `yaml:"version"`
 		URL                          url.URL                   `yaml:"url"`
 		URLs                         []url.URL                 `yaml:"urls"`
@@ -63,6 +65,9 @@ type (
 	ESAWSRequestSigningConfig struct {
 		Enabled bool   `yaml:"enabled"`
 		Region  string `yaml:"region"`
+		// Service is AWS service name used for signing: "es" (default) for Amazon OpenSearch Service
+		// or "aoss" for Amazon OpenSearch Serverless.
+		Service string `yaml:"service"`
 
 		// Possible options for CredentialProvider include:
 		//   1) static (fill out static Credential Provider)
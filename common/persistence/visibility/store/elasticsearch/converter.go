@@ -69,6 +69,7 @@ func NewQueryConverter(
 	}
 	whereConverter.And = query.NewAndConverter(whereConverter)
 	whereConverter.Or = query.NewOrConverter(whereConverter)
+	whereConverter.Not = query.NewNotConverter(whereConverter)
 
 	return query.NewConverter(fnInterceptor, whereConverter)
 }
@@ -44,11 +44,12 @@ var errorCases = map[string]string{
 	"insert into a values(1,2)":              query.NotSupportedErrMessage,
 	"update a set id = 1":                    query.NotSupportedErrMessage,
 	"delete from a where id=1":               query.NotSupportedErrMessage,
-	"select * from a where NOT(id=1)":        query.NotSupportedErrMessage,
 	"select * from a where 1 = 1":            query.InvalidExpressionErrMessage,
 	"select * from a where 1=a":              query.InvalidExpressionErrMessage,
 	"select * from a where zz(k=2)":          query.NotSupportedErrMessage,
-	"select * from a group by k, m":          query.NotSupportedErrMessage,
+	"select * from a group by k, k":          query.InvalidExpressionErrMessage,
+	"select * from a where a = zz()":         query.NotSupportedErrMessage,
+	"select * from a where a > now() * 2":    query.InvalidExpressionErrMessage,
 	"select * from a group by k order by id": query.NotSupportedErrMessage,
 	"select * from a where a like '%a%'":     "operator 'like' not allowed in comparison expression",
 	"select * from a where a not like '%a%'": "operator 'not like' not allowed in comparison expression",
@@ -96,6 +97,11 @@ var supportedWhereCases = map[string]string{
 	"create_time between '2015-01-01T00:00:00+0800' and '2017-01-01T00:00:00+0800' and process_id = 0 and status >= 1 and content = '三个男人' and phone = '15810324322'": `{"bool":{"filter":[{"range":{"create_time":{"from":"2015-01-01T00:00:00+0800","include_lower":true,"include_upper":true,"to":"2017-01-01T00:00:00+0800"}}},{"term":{"process_id":0}},{"range":{"status":{"from":1,"include_lower":true,"include_upper":true,"to":null}}},{"match":{"content":{"query":"三个男人"}}},{"match":{"phone":{"query":"15810324322"}}}]}}`,
 	"value starts_with 'prefix'":     `{"bool":{"filter":{"prefix":{"value":"prefix"}}}}`,
 	"value not starts_with 'prefix'": `{"bool":{"must_not":{"prefix":{"value":"prefix"}}}}`,
+	"NOT(id=1)":                      `{"bool":{"must_not":{"term":{"id":1}}}}`,
+	"not id in (1,2) and status = 1": `{"bool":{"filter":[{"bool":{"must_not":{"terms":{"id":[1,2]}}}},{"term":{"status":1}}]}}`,
+	"not (id = 1 or status = 1)":     `{"bool":{"must_not":{"bool":{"should":[{"term":{"id":1}},{"term":{"status":1}}]}}}}`,
+	"create_time > date_sub('2015-01-02T00:00:00Z', interval 1 day)":                           `{"bool":{"filter":{"range":{"create_time":{"from":"2015-01-01T00:00:00Z","include_lower":false,"include_upper":true,"to":null}}}}}`,
+	"create_time between '2015-01-02T00:00:00Z' - interval 36 hour and '2015-01-02T00:00:00Z'": `{"bool":{"filter":{"range":{"create_time":{"from":"2014-12-31T12:00:00Z","include_lower":true,"include_upper":true,"to":"2015-01-02T00:00:00Z"}}}}}`,
 }
 
 var supportedWhereOrderCases = map[string]struct {
@@ -128,6 +134,10 @@ var supportedWhereGroupByCases = map[string]struct {
 		query:   `{"bool":{"filter":{"term":{"id":1}}}}`,
 		groupBy: []string{"status"},
 	},
+	"group by status, channel": {
+		query:   ``,
+		groupBy: []string{"status", "channel"},
+	},
 }
 
 var testNameTypeMap = searchattribute.NewNameTypeMapStub(
@@ -119,11 +119,16 @@ func (ni *nameInterceptor) Name(name string, usage query.FieldNameUsage) (string
 			)
 		}
 	case query.FieldNameGroupBy:
-		if fieldName != searchattribute.ExecutionStatus {
-			return "", query.NewConverterError(
-				"'group by' clause is only supported for %s search attribute",
-				searchattribute.ExecutionStatus,
-			)
+		if err := query.ValidateGroupByField(name, fieldType); err != nil {
+			return "", err
+		}
+	case query.FieldNameHistogram:
+		if err := query.ValidateHistogramField(name, fieldType); err != nil {
+			return "", err
+		}
+	case query.FieldNamePercentiles:
+		if err := query.ValidatePercentilesField(name, fieldType); err != nil {
+			return "", err
 		}
 	}
 
@@ -61,6 +61,11 @@ const (
 	delimiter                    = "~"
 	scrollKeepAliveInterval      = "1m"
 	pointInTimeKeepAliveInterval = "1m"
+
+	histogramAggName    = "histogram"
+	histogramMinAggName = "histogram_min"
+	histogramMaxAggName = "histogram_max"
+	percentilesAggName  = "percentiles"
 )
 
 type (
@@ -542,6 +547,106 @@ func (s *VisibilityStore) countGroupByWorkflowExecutions(
 	return s.parseCountGroupByResponse(esResponse, groupByFields)
 }
 
+func (s *VisibilityStore) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *manager.AggregateWorkflowExecutionsRequest,
+) (*manager.AggregateWorkflowExecutionsResponse, error) {
+	queryParams, err := s.convertQuery(request.Namespace, request.NamespaceID, request.Query)
+	if err != nil {
+		return nil, err
+	}
+	if len(queryParams.GroupBy) > 0 || len(queryParams.Sorter) > 0 {
+		return nil, serviceerror.NewInvalidArgument(
+			"'group by' and 'order by' clauses are not supported in aggregation queries",
+		)
+	}
+
+	saTypeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.index, false)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("unable to read search attribute types: %v", err))
+	}
+	nameInterceptor := NewNameInterceptor(request.Namespace, saTypeMap, s.searchAttributesMapperProvider)
+	resolveField := func(name string, usage query.FieldNameUsage) (string, error) {
+		fieldName, err := nameInterceptor.Name(name, usage)
+		if err != nil {
+			var converterErr *query.ConverterError
+			if errors.As(err, &converterErr) {
+				return "", converterErr.ToInvalidArgument()
+			}
+			return "", err
+		}
+		return fieldName, nil
+	}
+
+	aggs := make(map[string]elastic.Aggregation, 2)
+	var groupByField string
+	if h := request.Histogram; h != nil {
+		fieldName, err := resolveField(h.Field, query.FieldNameHistogram)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.validateHistogramBuckets(ctx, queryParams.Query, fieldName, h); err != nil {
+			return nil, err
+		}
+		histogramAgg := elastic.NewDateHistogramAggregation().
+			Field(fieldName).
+			FixedInterval(fmt.Sprintf("%ds", int64(h.Interval/time.Second))).
+			MinDocCount(1)
+		if h.GroupBy != "" {
+			groupByField, err = resolveField(h.GroupBy, query.FieldNameGroupBy)
+			if err != nil {
+				return nil, err
+			}
+			histogramAgg.SubAggregation(groupByField, elastic.NewTermsAggregation().Field(groupByField))
+		}
+		aggs[histogramAggName] = histogramAgg
+	}
+	if p := request.Percentiles; p != nil {
+		fieldName, err := resolveField(p.Field, query.FieldNamePercentiles)
+		if err != nil {
+			return nil, err
+		}
+		aggs[percentilesAggName] = elastic.NewPercentilesAggregation().
+			Field(fieldName).
+			Percentiles(p.Percents...)
+	}
+
+	esResponse, err := s.esClient.Aggregate(ctx, s.index, queryParams.Query, aggs)
+	if err != nil {
+		return nil, ConvertElasticsearchClientError("AggregateWorkflowExecutions failed", err)
+	}
+	return s.parseAggregateResponse(esResponse, request, groupByField, saTypeMap)
+}
+
+// validateHistogramBuckets returns an error if the histogram would have more buckets than allowed.
+// Elasticsearch fails the whole search with too_many_buckets otherwise, so the range of the field
+// is looked up first with a cheap min and max aggregation.
+func (s *VisibilityStore) validateHistogramBuckets(
+	ctx context.Context,
+	esQuery elastic.Query,
+	fieldName string,
+	h *manager.HistogramAggregation,
+) error {
+	esResponse, err := s.esClient.Aggregate(ctx, s.index, esQuery, map[string]elastic.Aggregation{
+		histogramMinAggName: elastic.NewMinAggregation().Field(fieldName),
+		histogramMaxAggName: elastic.NewMaxAggregation().Field(fieldName),
+	})
+	if err != nil {
+		return ConvertElasticsearchClientError("AggregateWorkflowExecutions failed", err)
+	}
+	minAgg, minOk := esResponse.Aggregations.Min(histogramMinAggName)
+	maxAgg, maxOk := esResponse.Aggregations.Max(histogramMaxAggName)
+	if !minOk || !maxOk {
+		return serviceerror.NewInternal("histogram range aggregation is missing in Elasticsearch response")
+	}
+	if minAgg.Value == nil || maxAgg.Value == nil {
+		// No execution has a value for the field.
+		return nil
+	}
+	// Min and max of date fields are milliseconds since epoch.
+	return query.ValidateHistogramBucketCount(*minAgg.Value/1000, *maxAgg.Value/1000, h.Interval, h.MaxBuckets)
+}
+
 func (s *VisibilityStore) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
@@ -1208,6 +1313,85 @@ func (s *VisibilityStore) parseCountGroupByResponse(
 //	[]interface{}, for JSON arrays
 //	map[string]interface{}, for JSON objects (should never be a case)
 //	nil for JSON null
+func (s *VisibilityStore) parseAggregateResponse(
+	searchResult *elastic.SearchResult,
+	request *manager.AggregateWorkflowExecutionsRequest,
+	groupByField string,
+	saTypeMap searchattribute.NameTypeMap,
+) (*manager.AggregateWorkflowExecutionsResponse, error) {
+	response := &manager.AggregateWorkflowExecutionsResponse{}
+
+	if request.Histogram != nil {
+		histogram, ok := searchResult.Aggregations.DateHistogram(histogramAggName)
+		if !ok {
+			return nil, serviceerror.NewInternal("histogram aggregation is missing in Elasticsearch response")
+		}
+		var groupByType enumspb.IndexedValueType
+		if groupByField != "" {
+			var err error
+			groupByType, err = saTypeMap.GetType(groupByField)
+			if err != nil {
+				return nil, err
+			}
+		}
+		for _, esBucket := range histogram.Buckets {
+			bucket := &manager.HistogramBucket{
+				// Date histogram keys are milliseconds since epoch.
+				StartTime: time.UnixMilli(int64(esBucket.Key)).UTC(),
+				Count:     esBucket.DocCount,
+			}
+			if groupByField != "" {
+				terms, ok := esBucket.Terms(groupByField)
+				if !ok {
+					return nil, serviceerror.NewInternal("group by aggregation is missing in Elasticsearch response")
+				}
+				for _, term := range terms.Buckets {
+					value, err := finishParseJSONValue(term.Key, groupByType)
+					if err != nil {
+						return nil, serviceerror.NewInternal(fmt.Sprintf("unable to parse value %v: %v", term.Key, err))
+					}
+					payload, err := searchattribute.EncodeValue(value, groupByType)
+					if err != nil {
+						return nil, serviceerror.NewInternal(fmt.Sprintf("unable to encode value %v: %v", value, err))
+					}
+					bucket.Groups = append(
+						bucket.Groups,
+						&workflowservice.CountWorkflowExecutionsResponse_AggregationGroup{
+							GroupValues: []*commonpb.Payload{payload},
+							Count:       term.DocCount,
+						},
+					)
+				}
+			}
+			response.Buckets = append(response.Buckets, bucket)
+		}
+	}
+
+	if request.Percentiles != nil {
+		percentiles, ok := searchResult.Aggregations.Percentiles(percentilesAggName)
+		if !ok {
+			return nil, serviceerror.NewInternal("percentiles aggregation is missing in Elasticsearch response")
+		}
+		// Keys are the requested percents formatted as doubles, e.g. "50.0" or "99.9".
+		values := make(map[float64]float64, len(percentiles.Values))
+		for key, value := range percentiles.Values {
+			percent, err := strconv.ParseFloat(key, 64)
+			if err != nil {
+				return nil, serviceerror.NewInternal(fmt.Sprintf("unable to parse percentile key %q: %v", key, err))
+			}
+			values[percent] = value
+		}
+		for _, percent := range request.Percentiles.Percents {
+			response.Percentiles = append(response.Percentiles, &manager.PercentileValue{
+				Percent: percent,
+				Value:   values[percent],
+			})
+		}
+	}
+
+	return response, nil
+}
+
 func finishParseJSONValue(val interface{}, t enumspb.IndexedValueType) (interface{}, error) {
 	// Custom search attributes support array of a particular type.
 	if arrayValue, isArray := val.([]interface{}); isArray {
@@ -1294,18 +1294,18 @@ func (s *ESVisibilitySuite) TestCountWorkflowExecutions_GroupBy() {
 		resp),
 	)
 
-	// test only allowed to group by a single field
-	request.Query = "GROUP BY ExecutionStatus, WorkflowType"
+	// test only allowed to group by keyword fields
+	request.Query = "GROUP BY ExecutionStatus, StartTime"
 	resp, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
 	s.Error(err)
-	s.Contains(err.Error(), "'group by' clause supports only a single field")
+	s.Contains(err.Error(), "'group by' clause is only supported for search attributes of type Keyword")
 	s.Nil(resp)
 
-	// test only allowed to group by ExecutionStatus
-	request.Query = "GROUP BY WorkflowType"
+	// test not allowed to group by the same field twice
+	request.Query = "GROUP BY ExecutionStatus, ExecutionStatus"
 	resp, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
 	s.Error(err)
-	s.Contains(err.Error(), "'group by' clause is only supported for ExecutionStatus search attribute")
+	s.Contains(err.Error(), "'group by' clause has duplicate field ExecutionStatus")
 	s.Nil(resp)
 }
 
@@ -1592,6 +1592,171 @@ func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 	}
 }
 
+func (s *ESVisibilitySuite) TestAggregateWorkflowExecutions() {
+	statusCompletedPayload, _ := searchattribute.EncodeValue(
+		enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED.String(),
+		enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+	)
+	statusRunningPayload, _ := searchattribute.EncodeValue(
+		enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String(),
+		enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+	)
+	bucketStart := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	request := &manager.AggregateWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       `WorkflowType = "wf-type"`,
+		Histogram: &manager.HistogramAggregation{
+			Field:      searchattribute.StartTime,
+			Interval:   time.Hour,
+			GroupBy:    searchattribute.ExecutionStatus,
+			MaxBuckets: 3,
+		},
+		Percentiles: &manager.PercentilesAggregation{
+			Field:    searchattribute.ExecutionDuration,
+			Percents: []float64{50, 99.9},
+		},
+	}
+	esQuery := elastic.NewBoolQuery().Filter(
+		elastic.NewTermQuery(searchattribute.NamespaceID, testNamespaceID.String()),
+		elastic.NewBoolQuery().Filter(elastic.NewTermQuery(searchattribute.WorkflowType, "wf-type")),
+	).MustNot(namespaceDivisionExists)
+	histogramRangeAggs := map[string]elastic.Aggregation{
+		histogramMinAggName: elastic.NewMinAggregation().Field(searchattribute.StartTime),
+		histogramMaxAggName: elastic.NewMaxAggregation().Field(searchattribute.StartTime),
+	}
+	histogramRangeResult := func(last time.Time) *elastic.SearchResult {
+		return &elastic.SearchResult{
+			Aggregations: map[string]json.RawMessage{
+				histogramMinAggName: json.RawMessage(fmt.Sprintf(`{"value": %d}`, bucketStart.Add(time.Minute).UnixMilli())),
+				histogramMaxAggName: json.RawMessage(fmt.Sprintf(`{"value": %d}`, last.UnixMilli())),
+			},
+		}
+	}
+	s.mockESClient.EXPECT().
+		Aggregate(gomock.Any(), testIndex, esQuery, histogramRangeAggs).
+		Return(histogramRangeResult(bucketStart.Add(2*time.Hour+time.Minute)), nil)
+	s.mockESClient.EXPECT().
+		Aggregate(
+			gomock.Any(),
+			testIndex,
+			esQuery,
+			map[string]elastic.Aggregation{
+				histogramAggName: elastic.NewDateHistogramAggregation().
+					Field(searchattribute.StartTime).
+					FixedInterval("3600s").
+					MinDocCount(1).
+					SubAggregation(
+						searchattribute.ExecutionStatus,
+						elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus),
+					),
+				percentilesAggName: elastic.NewPercentilesAggregation().
+					Field(searchattribute.ExecutionDuration).
+					Percentiles(50, 99.9),
+			},
+		).
+		Return(&elastic.SearchResult{
+			Aggregations: map[string]json.RawMessage{
+				histogramAggName: json.RawMessage(fmt.Sprintf(
+					`{
+						"buckets": [
+							{
+								"key": %d,
+								"doc_count": 3,
+								"ExecutionStatus": {
+									"buckets": [
+										{"key": "Completed", "doc_count": 2},
+										{"key": "Running", "doc_count": 1}
+									]
+								}
+							},
+							{
+								"key": %d,
+								"doc_count": 1,
+								"ExecutionStatus": {
+									"buckets": [
+										{"key": "Running", "doc_count": 1}
+									]
+								}
+							}
+						]
+					}`,
+					bucketStart.UnixMilli(),
+					bucketStart.Add(2*time.Hour).UnixMilli(),
+				)),
+				percentilesAggName: json.RawMessage(
+					`{"values": {"50.0": 2000000000, "99.9": 4000000000}}`,
+				),
+			},
+		}, nil)
+
+	resp, err := s.visibilityStore.AggregateWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+	s.Len(resp.Buckets, 2)
+	s.Equal(bucketStart, resp.Buckets[0].StartTime)
+	s.Equal(int64(3), resp.Buckets[0].Count)
+	s.True(temporalproto.DeepEqual(
+		[]*workflowservice.CountWorkflowExecutionsResponse_AggregationGroup{
+			{GroupValues: []*commonpb.Payload{statusCompletedPayload}, Count: 2},
+			{GroupValues: []*commonpb.Payload{statusRunningPayload}, Count: 1},
+		},
+		resp.Buckets[0].Groups,
+	))
+	s.Equal(bucketStart.Add(2*time.Hour), resp.Buckets[1].StartTime)
+	s.Equal(int64(1), resp.Buckets[1].Count)
+	s.Equal(
+		[]*manager.PercentileValue{
+			{Percent: 50, Value: 2000000000},
+			{Percent: 99.9, Value: 4000000000},
+		},
+		resp.Percentiles,
+	)
+
+	// The histogram is not computed if it has more buckets than allowed.
+	s.mockESClient.EXPECT().
+		Aggregate(gomock.Any(), testIndex, esQuery, histogramRangeAggs).
+		Return(histogramRangeResult(bucketStart.Add(3*time.Hour)), nil)
+	_, err = s.visibilityStore.AggregateWorkflowExecutions(context.Background(), request)
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+	s.ErrorContains(err, "Histogram with interval 1h0m0s has 4 buckets, more than the limit of 3.")
+
+	// Field types are validated before calling Elasticsearch.
+	_, err = s.visibilityStore.AggregateWorkflowExecutions(context.Background(), &manager.AggregateWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Histogram: &manager.HistogramAggregation{
+			Field:    searchattribute.WorkflowType,
+			Interval: time.Hour,
+		},
+	})
+	s.ErrorAs(err, &invalidArgument)
+	s.ErrorContains(err, "histogram is only supported for search attributes of type Datetime")
+
+	_, err = s.visibilityStore.AggregateWorkflowExecutions(context.Background(), &manager.AggregateWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Percentiles: &manager.PercentilesAggregation{
+			Field:    searchattribute.StartTime,
+			Percents: []float64{50},
+		},
+	})
+	s.ErrorAs(err, &invalidArgument)
+	s.ErrorContains(err, "percentiles are only supported for search attributes of type Int or Double")
+
+	_, err = s.visibilityStore.AggregateWorkflowExecutions(context.Background(), &manager.AggregateWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       "GROUP BY ExecutionStatus",
+		Histogram: &manager.HistogramAggregation{
+			Field:    searchattribute.StartTime,
+			Interval: time.Hour,
+		},
+	})
+	s.ErrorAs(err, &invalidArgument)
+}
+
 func (s *ESVisibilitySuite) TestGetWorkflowExecution() {
 	s.mockESClient.EXPECT().Get(gomock.Any(), testIndex, gomock.Any()).DoAndReturn(
 		func(ctx context.Context, index string, docID string) (*elastic.GetResult, error) {
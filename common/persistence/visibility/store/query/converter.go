@@ -55,12 +55,17 @@ type (
 		RangeCond      ExprConverter
 		ComparisonExpr ExprConverter
 		Is             ExprConverter
+		Not            ExprConverter
 	}
 
 	andConverter struct {
 		where ExprConverter
 	}
 
+	notConverter struct {
+		where ExprConverter
+	}
+
 	orConverter struct {
 		where ExprConverter
 	}
@@ -133,6 +138,7 @@ func NewWhereConverter(
 		RangeCond:      rangeCond,
 		ComparisonExpr: comparisonExpr,
 		Is:             is,
+		Not:            &notSupportedExprConverter{},
 	}
 }
 
@@ -148,6 +154,12 @@ func NewOrConverter(whereConverter ExprConverter) ExprConverter {
 	}
 }
 
+func NewNotConverter(whereConverter ExprConverter) ExprConverter {
+	return &notConverter{
+		where: whereConverter,
+	}
+}
+
 func NewRangeCondConverter(
 	fnInterceptor FieldNameInterceptor,
 	fvInterceptor FieldValuesInterceptor,
@@ -213,6 +225,10 @@ func (c *Converter) ConvertWhereOrderBy(whereOrderBy string) (*QueryParams, erro
 
 // ConvertSql transforms SQL to Elasticsearch query.
 func (c *Converter) ConvertSql(sql string) (*QueryParams, error) {
+	sql, err := ExpandIntervalShorthands(sql)
+	if err != nil {
+		return nil, err
+	}
 	stmt, err := sqlparser.Parse(sql)
 	if err != nil {
 		return nil, NewConverterError("%s: %v", MalformedSqlQueryErrMessage, err)
@@ -244,9 +260,6 @@ func (c *Converter) convertSelect(sel *sqlparser.Select) (*QueryParams, error) {
 		queryParams.Query = query
 	}
 
-	if len(sel.GroupBy) > 1 {
-		return nil, NewConverterError("%s: 'group by' clause supports only a single field", NotSupportedErrMessage)
-	}
 	for _, groupByExpr := range sel.GroupBy {
 		_, colName, err := convertColName(c.fnInterceptor, groupByExpr, FieldNameGroupBy)
 		if err != nil {
@@ -254,6 +267,9 @@ func (c *Converter) convertSelect(sel *sqlparser.Select) (*QueryParams, error) {
 		}
 		queryParams.GroupBy = append(queryParams.GroupBy, colName)
 	}
+	if err := ValidateGroupBy(queryParams.GroupBy); err != nil {
+		return nil, err
+	}
 
 	for _, orderByExpr := range sel.OrderBy {
 		_, colName, err := convertColName(c.fnInterceptor, orderByExpr.Expr, FieldNameSorter)
@@ -296,9 +312,12 @@ func (w *WhereConverter) Convert(expr sqlparser.Expr) (elastic.Query, error) {
 	case *sqlparser.IsExpr:
 		return w.Is.Convert(e)
 	case *sqlparser.NotExpr:
-		return nil, NewConverterError("%s: 'not' expression", NotSupportedErrMessage)
+		if w.Not == nil {
+			return nil, NewConverterError("%s: 'not' expression", NotSupportedErrMessage)
+		}
+		return w.Not.Convert(e)
 	case *sqlparser.FuncExpr:
-		return nil, NewConverterError("%s: function expression", NotSupportedErrMessage)
+		return nil, NewConverterError("%s: function expression (functions can only be used in values)", NotSupportedErrMessage)
 	case *sqlparser.ColName:
 		return nil, NewConverterError("incomplete expression")
 	default:
@@ -372,6 +391,19 @@ func (o *orConverter) Convert(expr sqlparser.Expr) (elastic.Query, error) {
 	return elastic.NewBoolQuery().Should(leftQuery, rightQuery), nil
 }
 
+func (n *notConverter) Convert(expr sqlparser.Expr) (elastic.Query, error) {
+	notExpr, ok := expr.(*sqlparser.NotExpr)
+	if !ok {
+		return nil, NewConverterError("%v is not a 'not' expression", sqlparser.String(expr))
+	}
+
+	query, err := n.where.Convert(notExpr.Expr)
+	if err != nil {
+		return nil, err
+	}
+	return elastic.NewBoolQuery().MustNot(query), nil
+}
+
 func (r *rangeCondConverter) Convert(expr sqlparser.Expr) (elastic.Query, error) {
 	rangeCond, ok := expr.(*sqlparser.RangeCond)
 	if !ok {
@@ -383,11 +415,11 @@ func (r *rangeCondConverter) Convert(expr sqlparser.Expr) (elastic.Query, error)
 		return nil, wrapConverterError("unable to convert left part of 'between' expression", err)
 	}
 
-	fromValue, err := sqlquery.ParseValue(sqlparser.String(rangeCond.From))
+	fromValue, err := parseDateMathValue(rangeCond.From)
 	if err != nil {
 		return nil, err
 	}
-	toValue, err := sqlquery.ParseValue(sqlparser.String(rangeCond.To))
+	toValue, err := parseDateMathValue(rangeCond.To)
 	if err != nil {
 		return nil, err
 	}
@@ -551,8 +583,8 @@ func convertComparisonExprValue(expr sqlparser.Expr) (interface{}, error) {
 		return result, nil
 	case *sqlparser.GroupConcatExpr:
 		return nil, NewConverterError("%s: 'group_concat'", NotSupportedErrMessage)
-	case *sqlparser.FuncExpr:
-		return nil, NewConverterError("%s: nested func", NotSupportedErrMessage)
+	case *sqlparser.FuncExpr, *sqlparser.BinaryExpr:
+		return parseDateMathValue(e)
 	case *sqlparser.ColName:
 		return nil, NewConverterError(
 			"%s: column name on the right side of comparison expression (did you forget to quote %q?)",
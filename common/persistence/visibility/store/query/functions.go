@@ -0,0 +1,342 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package query
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/temporalio/sqlparser"
+	"go.temporal.io/server/common/sqlquery"
+)
+
+// Date math expressions are evaluated when the query is converted, rather than by the database,
+// so that every visibility store compares against the same point in time regardless of the
+// database clock and time zone.
+const (
+	FuncNow     = "now"
+	FuncDateAdd = "date_add"
+	FuncDateSub = "date_sub"
+)
+
+var (
+	intervalShorthandRegexp = regexp.MustCompile(`^\s*(-?\d+)\s*([a-zA-Z]+)\s*$`)
+
+	// intervalShorthandUnits maps the units accepted in interval shorthands to interval units.
+	intervalShorthandUnits = map[string]string{
+		"us": "microsecond", "microsecond": "microsecond", "microseconds": "microsecond",
+		"s": "second", "sec": "second", "secs": "second", "second": "second", "seconds": "second",
+		"m": "minute", "min": "minute", "mins": "minute", "minute": "minute", "minutes": "minute",
+		"h": "hour", "hr": "hour", "hrs": "hour", "hour": "hour", "hours": "hour",
+		"d": "day", "day": "day", "days": "day",
+		"w": "week", "week": "week", "weeks": "week",
+		"month": "month", "months": "month",
+		"quarter": "quarter", "quarters": "quarter",
+		"y": "year", "year": "year", "years": "year",
+	}
+)
+
+// IsDateMathExpr reports whether expr is a function call or an arithmetic expression, which are
+// only supported as date math in values of comparisons.
+func IsDateMathExpr(expr sqlparser.Expr) bool {
+	switch expr.(type) {
+	case *sqlparser.FuncExpr, *sqlparser.BinaryExpr:
+		return true
+	default:
+		return false
+	}
+}
+
+// EvaluateDateMathExpr evaluates a date math expression and returns the resulting time as a string
+// value in RFC3339Nano format. Supported expressions are:
+//   - now()
+//   - date_add(t, interval n unit) and date_sub(t, interval n unit)
+//   - t + interval n unit and t - interval n unit
+//
+// where t is a datetime string or another date math expression and unit is one of microsecond,
+// second, minute, hour, day, week, month, quarter or year. Intervals may also be written as a
+// quoted shorthand, such as interval '1h' or interval '7 days', which ExpandIntervalShorthands
+// rewrites before the query is parsed.
+func EvaluateDateMathExpr(expr sqlparser.Expr, now time.Time) (*sqlparser.SQLVal, error) {
+	t, err := evaluateTimeExpr(expr, now)
+	if err != nil {
+		return nil, err
+	}
+	return sqlparser.NewStrVal([]byte(t.UTC().Format(time.RFC3339Nano))), nil
+}
+
+func evaluateTimeExpr(expr sqlparser.Expr, now time.Time) (time.Time, error) {
+	switch e := expr.(type) {
+	case *sqlparser.SQLVal:
+		if e.Type != sqlparser.StrVal {
+			return time.Time{}, NewConverterError(
+				"%s: date math operand must be a datetime string (got: %v)",
+				InvalidExpressionErrMessage,
+				sqlparser.String(e),
+			)
+		}
+		t, err := time.Parse(time.RFC3339Nano, string(e.Val))
+		if err != nil {
+			return time.Time{}, NewConverterError(
+				"%s: unable to parse datetime '%s'",
+				InvalidExpressionErrMessage,
+				string(e.Val),
+			)
+		}
+		return t, nil
+	case *sqlparser.ParenExpr:
+		return evaluateTimeExpr(e.Expr, now)
+	case *sqlparser.FuncExpr:
+		return evaluateFuncExpr(e, now)
+	case *sqlparser.BinaryExpr:
+		interval, ok := e.Right.(*sqlparser.IntervalExpr)
+		if !ok {
+			return time.Time{}, NewConverterError(
+				"%s: right-hand side of '%s' must be an interval (got: %v)",
+				InvalidExpressionErrMessage,
+				e.Operator,
+				sqlparser.String(e.Right),
+			)
+		}
+		var negate bool
+		switch e.Operator {
+		case sqlparser.PlusStr:
+		case sqlparser.MinusStr:
+			negate = true
+		default:
+			return time.Time{}, NewConverterError(
+				"%s: operator '%s' in date math expression",
+				NotSupportedErrMessage,
+				e.Operator,
+			)
+		}
+		t, err := evaluateTimeExpr(e.Left, now)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return addInterval(t, interval, negate)
+	default:
+		return time.Time{}, NewConverterError(
+			"%s: unexpected date math operand %v",
+			InvalidExpressionErrMessage,
+			sqlparser.String(expr),
+		)
+	}
+}
+
+func evaluateFuncExpr(expr *sqlparser.FuncExpr, now time.Time) (time.Time, error) {
+	funcName := expr.Name.Lowered()
+	switch funcName {
+	case FuncNow:
+		if len(expr.Exprs) != 0 {
+			return time.Time{}, NewConverterError("%s: %s() takes no arguments", InvalidExpressionErrMessage, funcName)
+		}
+		return now, nil
+	case FuncDateAdd, FuncDateSub:
+		if len(expr.Exprs) != 2 {
+			return time.Time{}, NewConverterError(
+				"%s: %s() takes a datetime and an interval",
+				InvalidExpressionErrMessage,
+				funcName,
+			)
+		}
+		args := make([]sqlparser.Expr, len(expr.Exprs))
+		for i, arg := range expr.Exprs {
+			aliased, ok := arg.(*sqlparser.AliasedExpr)
+			if !ok || !aliased.As.IsEmpty() {
+				return time.Time{}, NewConverterError(
+					"%s: invalid argument %v of %s()",
+					InvalidExpressionErrMessage,
+					sqlparser.String(arg),
+					funcName,
+				)
+			}
+			args[i] = aliased.Expr
+		}
+		interval, ok := args[1].(*sqlparser.IntervalExpr)
+		if !ok {
+			return time.Time{}, NewConverterError(
+				"%s: second argument of %s() must be an interval",
+				InvalidExpressionErrMessage,
+				funcName,
+			)
+		}
+		t, err := evaluateTimeExpr(args[0], now)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return addInterval(t, interval, funcName == FuncDateSub)
+	default:
+		return time.Time{}, NewConverterError("%s: function %s()", NotSupportedErrMessage, funcName)
+	}
+}
+
+func addInterval(t time.Time, interval *sqlparser.IntervalExpr, negate bool) (time.Time, error) {
+	val, ok := interval.Expr.(*sqlparser.SQLVal)
+	if !ok {
+		return time.Time{}, NewConverterError(
+			"%s: interval must be a number (got: %v)",
+			InvalidExpressionErrMessage,
+			sqlparser.String(interval.Expr),
+		)
+	}
+	n, err := strconv.ParseInt(string(val.Val), 10, 64)
+	if err != nil {
+		return time.Time{}, NewConverterError(
+			"%s: interval must be an integer (got: %v)",
+			InvalidExpressionErrMessage,
+			string(val.Val),
+		)
+	}
+	if negate {
+		n = -n
+	}
+	switch strings.ToLower(interval.Unit) {
+	case "microsecond":
+		return t.Add(time.Duration(n) * time.Microsecond), nil
+	case "second":
+		return t.Add(time.Duration(n) * time.Second), nil
+	case "minute":
+		return t.Add(time.Duration(n) * time.Minute), nil
+	case "hour":
+		return t.Add(time.Duration(n) * time.Hour), nil
+	case "day":
+		return t.AddDate(0, 0, int(n)), nil
+	case "week":
+		return t.AddDate(0, 0, 7*int(n)), nil
+	case "month":
+		return t.AddDate(0, int(n), 0), nil
+	case "quarter":
+		return t.AddDate(0, 3*int(n), 0), nil
+	case "year":
+		return t.AddDate(int(n), 0, 0), nil
+	default:
+		return time.Time{}, NewConverterError("%s: interval unit '%s'", NotSupportedErrMessage, interval.Unit)
+	}
+}
+
+// parseDateMathValue evaluates expr if it is date math and otherwise parses it as a literal value.
+func parseDateMathValue(expr sqlparser.Expr) (interface{}, error) {
+	if IsDateMathExpr(expr) {
+		val, err := EvaluateDateMathExpr(expr, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		expr = val
+	}
+	return sqlquery.ParseValue(sqlparser.String(expr))
+}
+
+// ExpandIntervalShorthands rewrites interval shorthands, such as interval '1h' or
+// interval '7 days', into the interval n unit form the parser accepts. String literals and quoted
+// identifiers are left unchanged.
+func ExpandIntervalShorthands(sql string) (string, error) {
+	var sb strings.Builder
+	for i := 0; i < len(sql); {
+		c := sql[i]
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			end := skipQuoted(sql, i)
+			sb.WriteString(sql[i:end])
+			i = end
+		case isIdentifierChar(c):
+			end := i
+			for end < len(sql) && isIdentifierChar(sql[end]) {
+				end++
+			}
+			word := sql[i:end]
+			sb.WriteString(word)
+			i = end
+			if !strings.EqualFold(word, "interval") {
+				continue
+			}
+			start := i
+			for start < len(sql) && (sql[start] == ' ' || sql[start] == '\t' || sql[start] == '\n' || sql[start] == '\r') {
+				start++
+			}
+			if start == len(sql) || sql[start] != '\'' {
+				continue
+			}
+			end = skipQuoted(sql, start)
+			if end-start < 2 || sql[end-1] != '\'' {
+				continue // unterminated, left for the parser to reject
+			}
+			interval, err := parseIntervalShorthand(sql[start+1 : end-1])
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(sql[i:start])
+			sb.WriteString(interval)
+			i = end
+		default:
+			sb.WriteByte(c)
+			i++
+		}
+	}
+	return sb.String(), nil
+}
+
+func parseIntervalShorthand(shorthand string) (string, error) {
+	match := intervalShorthandRegexp.FindStringSubmatch(shorthand)
+	if match == nil {
+		return "", NewConverterError(
+			"%s: interval '%s' must be a number followed by a unit, such as '1h' or '7 days'",
+			InvalidExpressionErrMessage,
+			shorthand,
+		)
+	}
+	unit, ok := intervalShorthandUnits[strings.ToLower(match[2])]
+	if !ok {
+		return "", NewConverterError("%s: interval unit '%s'", NotSupportedErrMessage, match[2])
+	}
+	return match[1] + " " + unit, nil
+}
+
+// skipQuoted returns the index after the quoted string or identifier starting at start, or the
+// length of sql if it is not terminated.
+func skipQuoted(sql string, start int) int {
+	quote := sql[start]
+	for i := start + 1; i < len(sql); i++ {
+		switch sql[i] {
+		case '\\':
+			if quote != '`' {
+				i++
+			}
+		case quote:
+			if i+1 < len(sql) && sql[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return len(sql)
+}
+
+func isIdentifierChar(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
@@ -0,0 +1,185 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package query
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/temporalio/sqlparser"
+)
+
+func TestEvaluateDateMathExpr(t *testing.T) {
+	s := assert.New(t)
+	now := time.Date(2024, 1, 31, 12, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		input         string
+		expectedValue time.Time
+		expectedErr   string
+	}{
+		{
+			input:         "now()",
+			expectedValue: now,
+		},
+		{
+			input:         "NOW()",
+			expectedValue: now,
+		},
+		{
+			input:         "now() - interval 1 hour",
+			expectedValue: now.Add(-time.Hour),
+		},
+		{
+			input:         "now() + interval 30 second",
+			expectedValue: now.Add(30 * time.Second),
+		},
+		{
+			input:         "now() - interval 2 day - interval 15 minute",
+			expectedValue: now.AddDate(0, 0, -2).Add(-15 * time.Minute),
+		},
+		{
+			input:         "date_sub(now(), interval 1 week)",
+			expectedValue: now.AddDate(0, 0, -7),
+		},
+		{
+			input:         "date_add(now(), interval 1 month)",
+			expectedValue: now.AddDate(0, 1, 0),
+		},
+		{
+			input:         "date_add('2024-01-01T00:00:00Z', interval 1 quarter)",
+			expectedValue: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			input:         "'2024-01-01T00:00:00Z' - interval 1 year",
+			expectedValue: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			input:       "now(1)",
+			expectedErr: "invalid expression: now() takes no arguments",
+		},
+		{
+			input:       "date_sub(now())",
+			expectedErr: "invalid expression: date_sub() takes a datetime and an interval",
+		},
+		{
+			input:       "date_sub(now(), 1)",
+			expectedErr: "invalid expression: second argument of date_sub() must be an interval",
+		},
+		{
+			input:       "now() - 1",
+			expectedErr: "invalid expression: right-hand side of '-' must be an interval (got: 1)",
+		},
+		{
+			input:       "now() * interval 1 hour",
+			expectedErr: "operation is not supported: operator '*' in date math expression",
+		},
+		{
+			input:       "now() - interval 1 fortnight",
+			expectedErr: "operation is not supported: interval unit 'fortnight'",
+		},
+		{
+			input:       "unix_timestamp()",
+			expectedErr: "operation is not supported: function unix_timestamp()",
+		},
+		{
+			input:       "'yesterday' - interval 1 day",
+			expectedErr: "invalid expression: unable to parse datetime 'yesterday'",
+		},
+	}
+
+	for _, tc := range testCases {
+		stmt, err := sqlparser.Parse("select * from t where x = " + tc.input)
+		s.NoError(err, tc.input)
+		expr := stmt.(*sqlparser.Select).Where.Expr.(*sqlparser.ComparisonExpr).Right
+		s.True(IsDateMathExpr(expr), tc.input)
+
+		got, err := EvaluateDateMathExpr(expr, now)
+		if tc.expectedErr == "" {
+			s.NoError(err, tc.input)
+			s.Equal(tc.expectedValue.Format(time.RFC3339Nano), string(got.Val), tc.input)
+		} else {
+			var converterErr *ConverterError
+			s.ErrorAs(err, &converterErr, tc.input)
+			s.EqualError(err, tc.expectedErr, tc.input)
+		}
+	}
+}
+
+func TestExpandIntervalShorthands(t *testing.T) {
+	s := assert.New(t)
+
+	testCases := []struct {
+		input       string
+		expected    string
+		expectedErr string
+	}{
+		{
+			input:    "StartTime > now() - interval '1h'",
+			expected: "StartTime > now() - interval 1 hour",
+		},
+		{
+			input:    "CloseTime < date_sub(now(), INTERVAL '7 days') and x = 1",
+			expected: "CloseTime < date_sub(now(), INTERVAL 7 day) and x = 1",
+		},
+		{
+			input:    "StartTime > now() - interval\t'30m' - interval '15 s'",
+			expected: "StartTime > now() - interval\t30 minute - interval 15 second",
+		},
+		{
+			input:    "StartTime > now() - interval 1 hour",
+			expected: "StartTime > now() - interval 1 hour",
+		},
+		{
+			input:    `WorkflowType = "interval '1h'" and TaskQueue = 'it''s interval ''1h'''`,
+			expected: `WorkflowType = "interval '1h'" and TaskQueue = 'it''s interval ''1h'''`,
+		},
+		{
+			input:    "my_interval = '1h'",
+			expected: "my_interval = '1h'",
+		},
+		{
+			input:       "StartTime > now() - interval 'an hour'",
+			expectedErr: "invalid expression: interval 'an hour' must be a number followed by a unit, such as '1h' or '7 days'",
+		},
+		{
+			input:       "StartTime > now() - interval '2 fortnights'",
+			expectedErr: "operation is not supported: interval unit 'fortnights'",
+		},
+	}
+
+	for _, tc := range testCases {
+		got, err := ExpandIntervalShorthands(tc.input)
+		if tc.expectedErr == "" {
+			s.NoError(err, tc.input)
+			s.Equal(tc.expected, got, tc.input)
+		} else {
+			var converterErr *ConverterError
+			s.ErrorAs(err, &converterErr, tc.input)
+			s.EqualError(err, tc.expectedErr, tc.input)
+		}
+	}
+}
@@ -43,6 +43,8 @@ const (
 	FieldNameFilter FieldNameUsage = iota
 	FieldNameSorter
 	FieldNameGroupBy
+	FieldNameHistogram
+	FieldNamePercentiles
 )
 
 func (n *NopFieldNameInterceptor) Name(name string, _ FieldNameUsage) (string, error) {
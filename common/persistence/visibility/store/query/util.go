@@ -23,12 +23,97 @@
 package query
 
 import (
+	"fmt"
+	"math"
 	"strconv"
 	"time"
 
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common/primitives/timestamp"
 )
 
+// ValidateGroupByField returns an error if a search attribute can't be used in a 'group by'
+// clause. Only search attributes of type Keyword (which includes ExecutionStatus) are supported.
+func ValidateGroupByField(name string, fieldType enumspb.IndexedValueType) error {
+	if fieldType != enumspb.INDEXED_VALUE_TYPE_KEYWORD {
+		return NewConverterError(
+			"%s: 'group by' clause is only supported for search attributes of type %s (got: %s of type %s)",
+			NotSupportedErrMessage,
+			enumspb.INDEXED_VALUE_TYPE_KEYWORD.String(),
+			name,
+			fieldType.String(),
+		)
+	}
+	return nil
+}
+
+// ValidateGroupBy returns an error if the same field appears more than once in a 'group by' clause.
+func ValidateGroupBy(fieldNames []string) error {
+	seen := make(map[string]struct{}, len(fieldNames))
+	for _, fieldName := range fieldNames {
+		if _, ok := seen[fieldName]; ok {
+			return NewConverterError(
+				"%s: 'group by' clause has duplicate field %s",
+				InvalidExpressionErrMessage,
+				fieldName,
+			)
+		}
+		seen[fieldName] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateHistogramField returns an error if a search attribute can't be used as the time field
+// of a histogram aggregation. Only search attributes of type Datetime are supported.
+func ValidateHistogramField(name string, fieldType enumspb.IndexedValueType) error {
+	if fieldType != enumspb.INDEXED_VALUE_TYPE_DATETIME {
+		return NewConverterError(
+			"%s: histogram is only supported for search attributes of type %s (got: %s of type %s)",
+			NotSupportedErrMessage,
+			enumspb.INDEXED_VALUE_TYPE_DATETIME.String(),
+			name,
+			fieldType.String(),
+		)
+	}
+	return nil
+}
+
+// ValidateHistogramBucketCount returns an error if a histogram with buckets of the given interval
+// has more than maxBuckets buckets between the buckets of first and last, which are the smallest
+// and largest values of the field in seconds since epoch. Empty buckets in between are counted
+// too, so that the limit doesn't depend on how executions are spread over time.
+func ValidateHistogramBucketCount(first float64, last float64, interval time.Duration, maxBuckets int) error {
+	intervalSeconds := interval.Seconds()
+	buckets := math.Floor(last/intervalSeconds) - math.Floor(first/intervalSeconds) + 1
+	if buckets > float64(maxBuckets) {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf(
+			"Histogram with interval %v has %.0f buckets, more than the limit of %d. Use a larger interval or a narrower query.",
+			interval,
+			buckets,
+			maxBuckets,
+		))
+	}
+	return nil
+}
+
+// ValidatePercentilesField returns an error if a search attribute can't be used in a percentiles
+// aggregation. Only search attributes of type Int (which includes ExecutionDuration) and Double
+// are supported.
+func ValidatePercentilesField(name string, fieldType enumspb.IndexedValueType) error {
+	if fieldType != enumspb.INDEXED_VALUE_TYPE_INT && fieldType != enumspb.INDEXED_VALUE_TYPE_DOUBLE {
+		return NewConverterError(
+			"%s: percentiles are only supported for search attributes of type %s or %s (got: %s of type %s)",
+			NotSupportedErrMessage,
+			enumspb.INDEXED_VALUE_TYPE_INT.String(),
+			enumspb.INDEXED_VALUE_TYPE_DOUBLE.String(),
+			name,
+			fieldType.String(),
+		)
+	}
+	return nil
+}
+
 func ParseExecutionDurationStr(durationStr string) (time.Duration, error) {
 	if durationNanos, err := strconv.ParseInt(durationStr, 10, 64); err == nil {
 		return time.Duration(durationNanos), nil
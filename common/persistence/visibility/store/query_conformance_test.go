@@ -0,0 +1,199 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package store_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
+	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
+	"go.temporal.io/server/common/persistence/visibility/store/query"
+	"go.temporal.io/server/common/persistence/visibility/store/sql"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+const (
+	testNamespaceName = namespace.Name("test-namespace")
+	testNamespaceID   = namespace.ID("test-namespace-id")
+)
+
+// queryConverters converts a visibility query with every visibility store and returns the names
+// of the GROUP BY fields, so the same query can be checked against all of them.
+var queryConverters = map[string]func(queryString string) ([]string, error){
+	"elasticsearch": func(queryString string) ([]string, error) {
+		converter := elasticsearch.NewQueryConverter(
+			elasticsearch.NewNameInterceptor(
+				testNamespaceName,
+				searchattribute.TestNameTypeMap,
+				searchattribute.NewTestMapperProvider(&searchattribute.TestMapper{}),
+			),
+			elasticsearch.NewValuesInterceptor(testNamespaceName, searchattribute.TestNameTypeMap),
+			searchattribute.TestNameTypeMap,
+		)
+		queryParams, err := converter.ConvertWhereOrderBy(queryString)
+		if err != nil {
+			return nil, err
+		}
+		return queryParams.GroupBy, nil
+	},
+	mysql.PluginName:      sqlQueryConverter(mysql.PluginName),
+	postgresql.PluginName: sqlQueryConverter(postgresql.PluginName),
+	sqlite.PluginName:     sqlQueryConverter(sqlite.PluginName),
+}
+
+func sqlQueryConverter(pluginName string) func(queryString string) ([]string, error) {
+	return func(queryString string) ([]string, error) {
+		filter, err := sql.NewQueryConverter(
+			pluginName,
+			testNamespaceName,
+			testNamespaceID,
+			searchattribute.TestNameTypeMap,
+			&searchattribute.TestMapper{},
+			queryString,
+		).BuildCountStmt()
+		if err != nil {
+			return nil, err
+		}
+		return filter.GroupBy, nil
+	}
+}
+
+func TestQueryConformance(t *testing.T) {
+	testCases := []struct {
+		query           string
+		expectedErr     string
+		expectedGroupBy []string
+	}{
+		{
+			query: "AliasForKeyword01 = 'foo'",
+		},
+		{
+			query: "NOT AliasForKeyword01 = 'foo'",
+		},
+		{
+			query: "NOT (AliasForKeyword01 = 'foo' OR AliasForInt01 > 10)",
+		},
+		{
+			query: "AliasForKeyword01 = 'foo' AND NOT (ExecutionStatus = 'Running' OR AliasForBool01 = true)",
+		},
+		{
+			query: "NOT AliasForKeyword01 IN ('foo', 'bar')",
+		},
+		{
+			query: "NOT NOT AliasForInt01 BETWEEN 1 AND 10",
+		},
+		{
+			query: "StartTime > now() - interval 1 hour",
+		},
+		{
+			query: "CloseTime < date_sub(now(), interval 7 day)",
+		},
+		{
+			query: "AliasForDatetime01 BETWEEN '2024-01-01T00:00:00Z' - interval 1 month AND now()",
+		},
+		{
+			query: "NOT StartTime >= date_add('2024-01-01T00:00:00Z', interval 2 week)",
+		},
+		{
+			query: "StartTime > now() - interval '1h'",
+		},
+		{
+			query: "CloseTime < date_sub(now(), interval '7 days') AND AliasForKeyword01 = 'interval ''1h'''",
+		},
+		{
+			query:       "StartTime > unix_timestamp()",
+			expectedErr: query.NotSupportedErrMessage,
+		},
+		{
+			query:       "StartTime > now() - 1",
+			expectedErr: query.InvalidExpressionErrMessage,
+		},
+		{
+			query:       "StartTime > now() - interval 1 fortnight",
+			expectedErr: query.NotSupportedErrMessage,
+		},
+		{
+			query:       "StartTime > now() - interval 'an hour'",
+			expectedErr: query.InvalidExpressionErrMessage,
+		},
+		{
+			query:       "StartTime > now() - interval '1 fortnight'",
+			expectedErr: query.NotSupportedErrMessage,
+		},
+		{
+			query:       "now() > StartTime",
+			expectedErr: query.InvalidExpressionErrMessage,
+		},
+		{
+			query:           "GROUP BY ExecutionStatus",
+			expectedGroupBy: []string{searchattribute.ExecutionStatus},
+		},
+		{
+			query:           "AliasForKeyword01 = 'foo' GROUP BY ExecutionStatus, TaskQueue",
+			expectedGroupBy: []string{searchattribute.ExecutionStatus, searchattribute.TaskQueue},
+		},
+		{
+			query:           "GROUP BY WorkflowType, AliasForKeyword01, ExecutionStatus",
+			expectedGroupBy: []string{searchattribute.WorkflowType, "Keyword01", searchattribute.ExecutionStatus},
+		},
+		{
+			query:       "GROUP BY ExecutionStatus, StartTime",
+			expectedErr: query.NotSupportedErrMessage,
+		},
+		{
+			query:       "GROUP BY AliasForInt01",
+			expectedErr: query.NotSupportedErrMessage,
+		},
+		{
+			query:       "GROUP BY ExecutionStatus, ExecutionStatus",
+			expectedErr: query.InvalidExpressionErrMessage,
+		},
+		{
+			query:       "GROUP BY ExecutionStatus LIMIT 10",
+			expectedErr: query.NotSupportedErrMessage,
+		},
+	}
+
+	for _, tc := range testCases {
+		for name, convert := range queryConverters {
+			t.Run(fmt.Sprintf("%s/%s", name, tc.query), func(t *testing.T) {
+				groupBy, err := convert(tc.query)
+				if tc.expectedErr != "" {
+					var converterErr *query.ConverterError
+					assert.ErrorAs(t, err, &converterErr)
+					assert.ErrorContains(t, err, tc.expectedErr)
+					return
+				}
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedGroupBy, groupBy)
+			})
+		}
+	}
+}
@@ -56,9 +56,26 @@ type (
 
 		buildCountStmt(namespaceID namespace.ID, queryString string, groupBy []string) (string, []any)
 
+		// buildAggregateStmt builds a statement that selects the given expressions, which can be
+		// aggregate or window functions, over the executions matching the query without grouping.
+		buildAggregateStmt(namespaceID namespace.ID, queryString string, selectExprs []string) (string, []any)
+
+		// buildPercentilesStmt builds a statement that selects a single row with the percentiles of
+		// a numeric column over the executions matching the query, followed by their count.
+		buildPercentilesStmt(
+			namespaceID namespace.ID,
+			queryString string,
+			colName string,
+			percents []float64,
+		) (string, []any)
+
 		getDatetimeFormat() string
 
 		getCoalesceCloseTimeExpr() sqlparser.Expr
+
+		// getDatetimeBucketExpr returns an expression that truncates a datetime column to the start
+		// of its bucket, as an integer number of seconds since epoch. Buckets are aligned to epoch.
+		getDatetimeBucketExpr(colName string, intervalSeconds int64) string
 	}
 
 	QueryConverter struct {
@@ -196,6 +213,135 @@ func (c *QueryConverter) BuildCountStmt() (*sqlplugin.VisibilitySelectFilter, er
 	}, nil
 }
 
+// BuildHistogramStmt builds a statement that counts the executions matching the query in fixed size
+// buckets of a datetime field, optionally split by a keyword field. The first group by column of the
+// returned filter is the bucket start in seconds since epoch, followed by groupBy if it is set.
+func (c *QueryConverter) BuildHistogramStmt(
+	field string,
+	interval time.Duration,
+	groupBy string,
+) (*sqlplugin.VisibilitySelectFilter, error) {
+	qp, err := c.convertAggregationWhereString()
+	if err != nil {
+		return nil, err
+	}
+	fieldCol, err := c.convertAggregationField(field, query.ValidateHistogramField)
+	if err != nil {
+		return nil, err
+	}
+	groupByExprs := []string{c.getDatetimeBucketExpr(fieldCol.dbColName.Name, int64(interval/time.Second))}
+	groupByFields := []string{histogramBucketColumn}
+	if groupBy != "" {
+		groupByCol, err := c.convertAggregationField(groupBy, query.ValidateGroupByField)
+		if err != nil {
+			return nil, err
+		}
+		groupByExprs = append(groupByExprs, groupByCol.dbColName.Name)
+		groupByFields = append(groupByFields, groupByCol.fieldName)
+	}
+	queryString, queryArgs := c.buildCountStmt(
+		c.namespaceID,
+		withNotNullCondition(qp.queryString, fieldCol.dbColName.Name),
+		groupByExprs,
+	)
+	return &sqlplugin.VisibilitySelectFilter{
+		Query:     queryString,
+		QueryArgs: queryArgs,
+		GroupBy:   groupByFields,
+	}, nil
+}
+
+// BuildHistogramBoundsStmt builds a statement that selects the first and last bucket of a histogram
+// over a datetime field, in seconds since epoch, followed by the number of executions in it. It is
+// used to limit the number of buckets before BuildHistogramStmt is run.
+func (c *QueryConverter) BuildHistogramBoundsStmt(
+	field string,
+	interval time.Duration,
+) (*sqlplugin.VisibilitySelectFilter, error) {
+	qp, err := c.convertAggregationWhereString()
+	if err != nil {
+		return nil, err
+	}
+	fieldCol, err := c.convertAggregationField(field, query.ValidateHistogramField)
+	if err != nil {
+		return nil, err
+	}
+	bucketExpr := c.getDatetimeBucketExpr(fieldCol.dbColName.Name, int64(interval/time.Second))
+	queryString, queryArgs := c.buildAggregateStmt(
+		c.namespaceID,
+		withNotNullCondition(qp.queryString, fieldCol.dbColName.Name),
+		[]string{"MIN(" + bucketExpr + ")", "MAX(" + bucketExpr + ")", "COUNT(*)"},
+	)
+	return &sqlplugin.VisibilitySelectFilter{
+		Query:     queryString,
+		QueryArgs: queryArgs,
+		GroupBy:   []string{histogramBucketColumn, histogramBucketColumn},
+	}, nil
+}
+
+// BuildPercentilesStmt builds a statement that computes percentiles of a numeric field over the
+// executions matching the query. It returns a single row with a group value for every percent,
+// followed by the number of executions with a value for the field.
+func (c *QueryConverter) BuildPercentilesStmt(
+	field string,
+	percents []float64,
+) (*sqlplugin.VisibilitySelectFilter, error) {
+	qp, err := c.convertAggregationWhereString()
+	if err != nil {
+		return nil, err
+	}
+	fieldCol, err := c.convertAggregationField(field, query.ValidatePercentilesField)
+	if err != nil {
+		return nil, err
+	}
+	queryString, queryArgs := c.buildPercentilesStmt(
+		c.namespaceID,
+		withNotNullCondition(qp.queryString, fieldCol.dbColName.Name),
+		fieldCol.dbColName.Name,
+		percents,
+	)
+	groupBy := make([]string, len(percents))
+	for i := range groupBy {
+		groupBy[i] = fieldCol.fieldName
+	}
+	return &sqlplugin.VisibilitySelectFilter{
+		Query:     queryString,
+		QueryArgs: queryArgs,
+		GroupBy:   groupBy,
+	}, nil
+}
+
+func (c *QueryConverter) convertAggregationWhereString() (*queryParams, error) {
+	qp, err := c.convertWhereString(c.queryString)
+	if err != nil {
+		return nil, err
+	}
+	if len(qp.groupBy) > 0 {
+		return nil, query.NewConverterError(
+			"%s: 'group by' clause in aggregation query",
+			query.NotSupportedErrMessage,
+		)
+	}
+	return qp, nil
+}
+
+// convertAggregationField resolves the search attribute an aggregation is computed over. Unlike
+// in the where clause, CloseTime refers to the column itself so that running executions are skipped.
+func (c *QueryConverter) convertAggregationField(
+	name string,
+	validate func(name string, fieldType enumspb.IndexedValueType) error,
+) (*saColName, error) {
+	var expr sqlparser.Expr = &sqlparser.ColName{Name: sqlparser.NewColIdent(name)}
+	col, err := c.convertColName(&expr)
+	if err != nil {
+		return nil, err
+	}
+	if err := validate(col.alias, col.valueType); err != nil {
+		return nil, err
+	}
+	return col, nil
+}
+
 func (c *QueryConverter) convertWhereString(queryString string) (*queryParams, error) {
 	where := strings.TrimSpace(queryString)
 	if where != "" &&
@@ -204,7 +350,10 @@ func (c *QueryConverter) convertWhereString(queryString string) (*queryParams, e
 		where = "where " + where
 	}
 	// sqlparser can't parse just WHERE clause but instead accepts only valid SQL statement.
-	sql := "select * from table1 " + where
+	sql, err := query.ExpandIntervalShorthands("select * from table1 " + where)
+	if err != nil {
+		return nil, err
+	}
 	stmt, err := sqlparser.Parse(sql)
 	if err != nil {
 		return nil, query.NewConverterError("%s: %v", query.MalformedSqlQueryErrMessage, err)
@@ -282,27 +431,18 @@ func (c *QueryConverter) convertSelectStmt(sel *sqlparser.Select) error {
 		}
 	}
 
-	if len(sel.GroupBy) > 1 {
-		return query.NewConverterError(
-			"%s: 'group by' clause supports only a single field",
-			query.NotSupportedErrMessage,
-		)
-	}
+	groupByFields := make([]string, len(sel.GroupBy))
 	for k := range sel.GroupBy {
 		colName, err := c.convertColName(&sel.GroupBy[k])
 		if err != nil {
 			return err
 		}
-		if colName.fieldName != searchattribute.ExecutionStatus {
-			return query.NewConverterError(
-				"%s: 'group by' clause is only supported for %s search attribute",
-				query.NotSupportedErrMessage,
-				searchattribute.ExecutionStatus,
-			)
+		if err := query.ValidateGroupByField(colName.alias, colName.valueType); err != nil {
+			return err
 		}
+		groupByFields[k] = colName.fieldName
 	}
-
-	return nil
+	return query.ValidateGroupBy(groupByFields)
 }
 
 func (c *QueryConverter) convertWhereExpr(expr *sqlparser.Expr) error {
@@ -326,7 +466,10 @@ func (c *QueryConverter) convertWhereExpr(expr *sqlparser.Expr) error {
 	case *sqlparser.IsExpr:
 		return c.convertIsExpr(expr)
 	case *sqlparser.FuncExpr:
-		return query.NewConverterError("%s: function expression", query.NotSupportedErrMessage)
+		return query.NewConverterError(
+			"%s: function expression (functions can only be used in values)",
+			query.NotSupportedErrMessage,
+		)
 	case *sqlparser.ColName:
 		return query.NewConverterError("%s: incomplete expression", query.InvalidExpressionErrMessage)
 	default:
@@ -559,8 +702,14 @@ func (c *QueryConverter) convertValueExpr(
 		return nil
 	case *sqlparser.GroupConcatExpr:
 		return query.NewConverterError("%s: 'group_concat'", query.NotSupportedErrMessage)
-	case *sqlparser.FuncExpr:
-		return query.NewConverterError("%s: nested func", query.NotSupportedErrMessage)
+	case *sqlparser.FuncExpr, *sqlparser.BinaryExpr:
+		// Date math is evaluated here and replaced with its value.
+		value, err := query.EvaluateDateMathExpr(e, time.Now())
+		if err != nil {
+			return err
+		}
+		*exprRef = value
+		return c.convertValueExpr(exprRef, name, saFieldName, saType)
 	case *sqlparser.ColName:
 		return query.NewConverterError(
 			"%s: column name on the right side of comparison expression (did you forget to quote '%s'?)",
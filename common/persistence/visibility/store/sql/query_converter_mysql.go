@@ -113,6 +113,16 @@ func (c *mysqlQueryConverter) getCoalesceCloseTimeExpr() sqlparser.Expr {
 	)
 }
 
+func (c *mysqlQueryConverter) getDatetimeBucketExpr(colName string, intervalSeconds int64) string {
+	// UNIX_TIMESTAMP depends on the session time zone, while datetime values are stored in UTC.
+	return fmt.Sprintf(
+		"TIMESTAMPDIFF(SECOND, '1970-01-01 00:00:00', %s) DIV %d * %d",
+		colName,
+		intervalSeconds,
+		intervalSeconds,
+	)
+}
+
 func (c *mysqlQueryConverter) convertKeywordListComparisonExpr(
 	expr *sqlparser.ComparisonExpr,
 ) (sqlparser.Expr, error) {
@@ -309,3 +319,43 @@ func (c *mysqlQueryConverter) buildCountStmt(
 		groupByClause,
 	), queryArgs
 }
+
+func (c *mysqlQueryConverter) buildAggregateStmt(
+	namespaceID namespace.ID,
+	queryString string,
+	selectExprs []string,
+) (string, []any) {
+	var whereClauses []string
+	var queryArgs []any
+
+	whereClauses = append(
+		whereClauses,
+		fmt.Sprintf("(%s = ?)", searchattribute.GetSqlDbColName(searchattribute.NamespaceID)),
+	)
+	queryArgs = append(queryArgs, namespaceID.String())
+
+	if len(queryString) > 0 {
+		whereClauses = append(whereClauses, queryString)
+	}
+
+	return fmt.Sprintf(
+		`SELECT %s
+		FROM executions_visibility ev
+		LEFT JOIN custom_search_attributes
+		USING (%s, %s)
+		WHERE %s`,
+		strings.Join(selectExprs, ", "),
+		searchattribute.GetSqlDbColName(searchattribute.NamespaceID),
+		searchattribute.GetSqlDbColName(searchattribute.RunID),
+		strings.Join(whereClauses, " AND "),
+	), queryArgs
+}
+
+func (c *mysqlQueryConverter) buildPercentilesStmt(
+	namespaceID namespace.ID,
+	queryString string,
+	colName string,
+	percents []float64,
+) (string, []any) {
+	return buildRowNumberPercentilesStmt(c, namespaceID, queryString, colName, percents)
+}
@@ -56,6 +56,32 @@ func (s *mysqlQueryConverterSuite) TestGetCoalesceCloseTimeExpr() {
 	)
 }
 
+func (s *mysqlQueryConverterSuite) TestGetDatetimeBucketExpr() {
+	s.Equal(
+		"TIMESTAMPDIFF(SECOND, '1970-01-01 00:00:00', start_time) DIV 3600 * 3600",
+		s.queryConverter.getDatetimeBucketExpr("start_time", 3600),
+	)
+}
+
+func (s *mysqlQueryConverterSuite) TestBuildPercentilesStmt() {
+	queryString, queryArgs := s.queryConverter.buildPercentilesStmt(
+		testNamespaceID,
+		"execution_duration is not null",
+		"execution_duration",
+		[]float64{50, 99.9},
+	)
+	s.Contains(
+		queryString,
+		"SELECT MIN(CASE WHEN 100 * percentile_row >= 50 * percentile_rows THEN percentile_value END), "+
+			"MIN(CASE WHEN 100 * percentile_row >= 99.9 * percentile_rows THEN percentile_value END), "+
+			"COUNT(*) FROM (SELECT execution_duration AS percentile_value, "+
+			"ROW_NUMBER() OVER (ORDER BY execution_duration) AS percentile_row, "+
+			"COUNT(*) OVER () AS percentile_rows",
+	)
+	s.Contains(queryString, "execution_duration is not null) ranked_executions")
+	s.Equal([]any{testNamespaceID.String()}, queryArgs)
+}
+
 func (s *mysqlQueryConverterSuite) TestConvertKeywordListComparisonExpr() {
 	var tests = []testCase{
 		{
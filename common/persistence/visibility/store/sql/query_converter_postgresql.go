@@ -83,6 +83,15 @@ func (c *pgQueryConverter) getDatetimeFormat() string {
 	return "2006-01-02 15:04:05.999999"
 }
 
+func (c *pgQueryConverter) getDatetimeBucketExpr(colName string, intervalSeconds int64) string {
+	return fmt.Sprintf(
+		"CAST(FLOOR(EXTRACT(EPOCH FROM %s) / %d) * %d AS BIGINT)",
+		colName,
+		intervalSeconds,
+		intervalSeconds,
+	)
+}
+
 func (c *pgQueryConverter) getCoalesceCloseTimeExpr() sqlparser.Expr {
 	return newFuncExpr(
 		coalesceFuncName,
@@ -305,3 +314,46 @@ func (c *pgQueryConverter) buildCountStmt(
 		groupByClause,
 	), queryArgs
 }
+
+func (c *pgQueryConverter) buildAggregateStmt(
+	namespaceID namespace.ID,
+	queryString string,
+	selectExprs []string,
+) (string, []any) {
+	var whereClauses []string
+	var queryArgs []any
+
+	whereClauses = append(
+		whereClauses,
+		fmt.Sprintf("(%s = ?)", searchattribute.GetSqlDbColName(searchattribute.NamespaceID)),
+	)
+	queryArgs = append(queryArgs, namespaceID.String())
+
+	if len(queryString) > 0 {
+		whereClauses = append(whereClauses, queryString)
+	}
+
+	return fmt.Sprintf(
+		"SELECT %s FROM executions_visibility WHERE %s",
+		strings.Join(selectExprs, ", "),
+		strings.Join(whereClauses, " AND "),
+	), queryArgs
+}
+
+func (c *pgQueryConverter) buildPercentilesStmt(
+	namespaceID namespace.ID,
+	queryString string,
+	colName string,
+	percents []float64,
+) (string, []any) {
+	// PERCENTILE_CONT interpolates between the two nearest values like Elasticsearch does.
+	selectExprs := make([]string, 0, len(percents)+1)
+	for _, percent := range percents {
+		selectExprs = append(
+			selectExprs,
+			fmt.Sprintf("PERCENTILE_CONT(%s / 100.0) WITHIN GROUP (ORDER BY %s)", formatPercent(percent), colName),
+		)
+	}
+	selectExprs = append(selectExprs, "COUNT(*)")
+	return c.buildAggregateStmt(namespaceID, queryString, selectExprs)
+}
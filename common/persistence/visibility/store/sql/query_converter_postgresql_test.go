@@ -56,6 +56,29 @@ func (s *postgresqlQueryConverterSuite) TestGetCoalesceCloseTimeExpr() {
 	)
 }
 
+func (s *postgresqlQueryConverterSuite) TestGetDatetimeBucketExpr() {
+	s.Equal(
+		"CAST(FLOOR(EXTRACT(EPOCH FROM start_time) / 3600) * 3600 AS BIGINT)",
+		s.queryConverter.getDatetimeBucketExpr("start_time", 3600),
+	)
+}
+
+func (s *postgresqlQueryConverterSuite) TestBuildPercentilesStmt() {
+	queryString, queryArgs := s.queryConverter.buildPercentilesStmt(
+		testNamespaceID,
+		"execution_duration is not null",
+		"execution_duration",
+		[]float64{50, 99.9},
+	)
+	s.Equal(
+		"SELECT PERCENTILE_CONT(50 / 100.0) WITHIN GROUP (ORDER BY execution_duration), "+
+			"PERCENTILE_CONT(99.9 / 100.0) WITHIN GROUP (ORDER BY execution_duration), COUNT(*) "+
+			"FROM executions_visibility WHERE (namespace_id = ?) AND execution_duration is not null",
+		queryString,
+	)
+	s.Equal([]any{testNamespaceID.String()}, queryArgs)
+}
+
 func (s *postgresqlQueryConverterSuite) TestConvertKeywordListComparisonExpr() {
 	var tests = []testCase{
 		{
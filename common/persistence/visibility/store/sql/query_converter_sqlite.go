@@ -67,6 +67,15 @@ func (c *sqliteQueryConverter) getDatetimeFormat() string {
 	return "2006-01-02 15:04:05.999999-07:00"
 }
 
+func (c *sqliteQueryConverter) getDatetimeBucketExpr(colName string, intervalSeconds int64) string {
+	return fmt.Sprintf(
+		"CAST(STRFTIME('%%s', %s) AS INTEGER) / %d * %d",
+		colName,
+		intervalSeconds,
+		intervalSeconds,
+	)
+}
+
 func (c *sqliteQueryConverter) getCoalesceCloseTimeExpr() sqlparser.Expr {
 	return newFuncExpr(
 		coalesceFuncName,
@@ -346,6 +355,40 @@ func (c *sqliteQueryConverter) buildCountStmt(
 	), queryArgs
 }
 
+func (c *sqliteQueryConverter) buildAggregateStmt(
+	namespaceID namespace.ID,
+	queryString string,
+	selectExprs []string,
+) (string, []any) {
+	var whereClauses []string
+	var queryArgs []any
+
+	whereClauses = append(
+		whereClauses,
+		fmt.Sprintf("(%s = ?)", searchattribute.GetSqlDbColName(searchattribute.NamespaceID)),
+	)
+	queryArgs = append(queryArgs, namespaceID.String())
+
+	if len(queryString) > 0 {
+		whereClauses = append(whereClauses, queryString)
+	}
+
+	return fmt.Sprintf(
+		"SELECT %s FROM executions_visibility WHERE %s",
+		strings.Join(selectExprs, ", "),
+		strings.Join(whereClauses, " AND "),
+	), queryArgs
+}
+
+func (c *sqliteQueryConverter) buildPercentilesStmt(
+	namespaceID namespace.ID,
+	queryString string,
+	colName string,
+	percents []float64,
+) (string, []any) {
+	return buildRowNumberPercentilesStmt(c, namespaceID, queryString, colName, percents)
+}
+
 func buildFtsQueryString(colname string, values ...string) string {
 	// FTS query format: 'colname : ("token1" OR "token2" OR ...)'
 	return fmt.Sprintf(`%s : ("%s")`, colname, strings.Join(values, `" OR "`))
@@ -56,6 +56,32 @@ func (s *sqliteQueryConverterSuite) TestGetCoalesceCloseTimeExpr() {
 	)
 }
 
+func (s *sqliteQueryConverterSuite) TestGetDatetimeBucketExpr() {
+	s.Equal(
+		"CAST(STRFTIME('%s', start_time) AS INTEGER) / 3600 * 3600",
+		s.queryConverter.getDatetimeBucketExpr("start_time", 3600),
+	)
+}
+
+func (s *sqliteQueryConverterSuite) TestBuildPercentilesStmt() {
+	queryString, queryArgs := s.queryConverter.buildPercentilesStmt(
+		testNamespaceID,
+		"execution_duration is not null",
+		"execution_duration",
+		[]float64{50, 99.9},
+	)
+	s.Contains(
+		queryString,
+		"SELECT MIN(CASE WHEN 100 * percentile_row >= 50 * percentile_rows THEN percentile_value END), "+
+			"MIN(CASE WHEN 100 * percentile_row >= 99.9 * percentile_rows THEN percentile_value END), "+
+			"COUNT(*) FROM (SELECT execution_duration AS percentile_value, "+
+			"ROW_NUMBER() OVER (ORDER BY execution_duration) AS percentile_row, "+
+			"COUNT(*) OVER () AS percentile_rows",
+	)
+	s.Contains(queryString, "execution_duration is not null) ranked_executions")
+	s.Equal([]any{testNamespaceID.String()}, queryArgs)
+}
+
 func (s *sqliteQueryConverterSuite) TestConvertKeywordListComparisonExpr() {
 	var tests = []testCase{
 		{
@@ -128,24 +128,60 @@ func (s *queryConverterSuite) TestConvertWhereString() {
 			err: nil,
 		},
 		{
-			name:   "group by two fields not supported",
-			input:  "GROUP BY ExecutionStatus, WorkflowType",
+			name:  "group by two fields",
+			input: "GROUP BY WorkflowType, ExecutionStatus",
+			output: &queryParams{
+				queryString: "TemporalNamespaceDivision is null",
+				groupBy:     []string{searchattribute.WorkflowType, searchattribute.ExecutionStatus},
+			},
+			err: nil,
+		},
+		{
+			name:  "group by custom keyword",
+			input: "AliasForInt01 = 1 GROUP BY AliasForKeyword01",
+			output: &queryParams{
+				queryString: "(Int01 = 1) and TemporalNamespaceDivision is null",
+				groupBy:     []string{"Keyword01"},
+			},
+			err: nil,
+		},
+		{
+			name:   "group by non keyword",
+			input:  "GROUP BY AliasForInt01",
 			output: nil,
 			err: query.NewConverterError(
-				"%s: 'group by' clause supports only a single field",
+				"%s: 'group by' clause is only supported for search attributes of type %s (got: %s of type %s)",
 				query.NotSupportedErrMessage,
+				enumspb.INDEXED_VALUE_TYPE_KEYWORD.String(),
+				"AliasForInt01",
+				enumspb.INDEXED_VALUE_TYPE_INT.String(),
 			),
 		},
 		{
-			name:   "group by non ExecutionStatus",
-			input:  "GROUP BY WorkflowType",
+			name:   "group by duplicate field",
+			input:  "GROUP BY ExecutionStatus, ExecutionStatus",
 			output: nil,
 			err: query.NewConverterError(
-				"%s: 'group by' clause is only supported for %s search attribute",
-				query.NotSupportedErrMessage,
+				"%s: 'group by' clause has duplicate field %s",
+				query.InvalidExpressionErrMessage,
 				searchattribute.ExecutionStatus,
 			),
 		},
+		{
+			name:   "not expression",
+			input:  "NOT AliasForKeyword01 IN ('foo', 'bar')",
+			output: &queryParams{queryString: "(not Keyword01 in ('foo', 'bar')) and TemporalNamespaceDivision is null"},
+			err:    nil,
+		},
+		{
+			name:   "function expression not supported",
+			input:  "now()",
+			output: nil,
+			err: query.NewConverterError(
+				"%s: function expression (functions can only be used in values)",
+				query.NotSupportedErrMessage,
+			),
+		},
 		{
 			name:   "order by not supported",
 			input:  "ORDER BY StartTime",
@@ -440,6 +476,20 @@ func (s *queryConverterSuite) TestConvertRangeCond() {
 			),
 			err: nil,
 		},
+		{
+			name: "between date math expressions",
+			input: fmt.Sprintf(
+				"AliasForDatetime01 BETWEEN '%s' - interval 1 day AND date_add('%s', interval 1 day)",
+				toDatetime.Format(time.RFC3339Nano),
+				fromDatetime.Format(time.RFC3339Nano),
+			),
+			output: fmt.Sprintf(
+				"Datetime01 between '%s' and '%s'",
+				fromDatetime.Format(s.queryConverter.getDatetimeFormat()),
+				toDatetime.Format(s.queryConverter.getDatetimeFormat()),
+			),
+			err: nil,
+		},
 		{
 			name:   "text type not supported",
 			input:  "AliasForText01 BETWEEN 'abc' AND 'abd'",
@@ -781,6 +831,33 @@ func (s *queryConverterSuite) TestConvertValueExpr() {
 			output: fmt.Sprintf("'%s'", dt.Format(s.queryConverter.getDatetimeFormat())),
 			err:    nil,
 		},
+		{
+			name:  "valid date math",
+			input: fmt.Sprintf("date_sub('%s', interval 90 minute)", dt.Format(time.RFC3339Nano)),
+			args: map[string]any{
+				"saName":      "AliasForDatetime01",
+				"saFieldName": "Datetime01",
+				"saType":      enumspb.INDEXED_VALUE_TYPE_DATETIME,
+			},
+			output: fmt.Sprintf("'%s'", dt.Add(-90*time.Minute).Format(s.queryConverter.getDatetimeFormat())),
+			err:    nil,
+		},
+		{
+			name:  "invalid date math",
+			input: "now() * 2",
+			args: map[string]any{
+				"saName":      "AliasForDatetime01",
+				"saFieldName": "Datetime01",
+				"saType":      enumspb.INDEXED_VALUE_TYPE_DATETIME,
+			},
+			output: "",
+			err: query.NewConverterError(
+				"%s: right-hand side of '%s' must be an interval (got: %v)",
+				query.InvalidExpressionErrMessage,
+				"*",
+				"2",
+			),
+		},
 		{
 			name:  "valid tuple",
 			input: "('foo', 'bar')",
@@ -1015,6 +1092,129 @@ func (s *queryConverterSuite) TestParseSQLVal() {
 	}
 }
 
+func (s *queryConverterSuite) TestBuildHistogramStmt() {
+	tests := []struct {
+		name            string
+		query           string
+		field           string
+		groupBy         string
+		expectedExprs   []string
+		expectedGroupBy []string
+		err             string
+	}{
+		{
+			name:  "start time",
+			query: "AliasForKeyword01 = 'foo'",
+			field: searchattribute.StartTime,
+			expectedExprs: []string{
+				s.pqc.getDatetimeBucketExpr("start_time", 3600),
+				"(Keyword01 = 'foo')",
+				"start_time is not null",
+			},
+			expectedGroupBy: []string{histogramBucketColumn},
+		},
+		{
+			name:    "close time group by status",
+			field:   searchattribute.CloseTime,
+			groupBy: searchattribute.ExecutionStatus,
+			expectedExprs: []string{
+				"close_time is not null",
+				"GROUP BY " + s.pqc.getDatetimeBucketExpr("close_time", 3600) + ", status",
+			},
+			expectedGroupBy: []string{histogramBucketColumn, searchattribute.ExecutionStatus},
+		},
+		{
+			name:    "custom fields",
+			field:   "AliasForDatetime01",
+			groupBy: "AliasForKeyword01",
+			expectedExprs: []string{
+				s.pqc.getDatetimeBucketExpr("Datetime01", 3600),
+				"Datetime01 is not null",
+			},
+			expectedGroupBy: []string{histogramBucketColumn, "Keyword01"},
+		},
+		{
+			name:  "non datetime field",
+			field: "AliasForInt01",
+			err:   "histogram is only supported for search attributes of type Datetime (got: AliasForInt01 of type Int)",
+		},
+		{
+			name:    "non keyword group by",
+			field:   searchattribute.StartTime,
+			groupBy: "AliasForInt01",
+			err:     "'group by' clause is only supported for search attributes of type Keyword (got: AliasForInt01 of type Int)",
+		},
+		{
+			name:  "unknown field",
+			field: "Unknown",
+			err:   "column name 'Unknown' is not a valid search attribute",
+		},
+		{
+			name:  "group by in query",
+			query: "GROUP BY ExecutionStatus",
+			field: searchattribute.StartTime,
+			err:   "'group by' clause in aggregation query",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.queryConverter.queryString = tc.query
+			filter, err := s.queryConverter.BuildHistogramStmt(tc.field, time.Hour, tc.groupBy)
+			if tc.err != "" {
+				s.ErrorContains(err, tc.err)
+				return
+			}
+			s.NoError(err)
+			for _, expr := range tc.expectedExprs {
+				s.Contains(filter.Query, expr)
+			}
+			s.Equal(tc.expectedGroupBy, filter.GroupBy)
+		})
+	}
+}
+
+func (s *queryConverterSuite) TestBuildHistogramBoundsStmt() {
+	s.queryConverter.queryString = "AliasForKeyword01 = 'foo'"
+	filter, err := s.queryConverter.BuildHistogramBoundsStmt(searchattribute.CloseTime, time.Hour)
+	s.NoError(err)
+	bucketExpr := s.pqc.getDatetimeBucketExpr("close_time", 3600)
+	s.Contains(filter.Query, "MIN("+bucketExpr+"), MAX("+bucketExpr+"), COUNT(*)")
+	s.Contains(filter.Query, "(Keyword01 = 'foo')")
+	s.Contains(filter.Query, "close_time is not null")
+	s.NotContains(filter.Query, "GROUP BY")
+	s.Equal([]any{testNamespaceID.String()}, filter.QueryArgs)
+	s.Equal([]string{histogramBucketColumn, histogramBucketColumn}, filter.GroupBy)
+
+	_, err = s.queryConverter.BuildHistogramBoundsStmt(searchattribute.ExecutionDuration, time.Hour)
+	s.ErrorContains(
+		err,
+		"histogram is only supported for search attributes of type Datetime (got: ExecutionDuration of type Int)",
+	)
+}
+
+func (s *queryConverterSuite) TestBuildPercentilesStmt() {
+	s.queryConverter.queryString = "ExecutionStatus = 'Completed'"
+	filter, err := s.queryConverter.BuildPercentilesStmt(searchattribute.ExecutionDuration, []float64{50, 99.9})
+	s.NoError(err)
+	s.Contains(filter.Query, "(status = 2)")
+	s.Contains(filter.Query, "execution_duration is not null")
+	s.NotContains(filter.Query, "GROUP BY")
+	s.Equal([]any{testNamespaceID.String()}, filter.QueryArgs)
+	s.Equal([]string{searchattribute.ExecutionDuration, searchattribute.ExecutionDuration}, filter.GroupBy)
+
+	filter, err = s.queryConverter.BuildPercentilesStmt("AliasForDouble01", []float64{50})
+	s.NoError(err)
+	s.Contains(filter.Query, "ORDER BY Double01")
+	s.Equal([]string{"Double01"}, filter.GroupBy)
+
+	_, err = s.queryConverter.BuildPercentilesStmt(searchattribute.StartTime, []float64{50})
+	s.ErrorContains(
+		err,
+		"percentiles are only supported for search attributes of type Int or Double (got: StartTime of type Datetime)",
+	)
+}
+
 func TestSupportedComparisonOperators(t *testing.T) {
 	s := assert.New(t)
 	msg := "If you're changing the supported operators, remember to check they work with " +
@@ -25,11 +25,14 @@
 package sql
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/temporalio/sqlparser"
 	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/visibility/store/query"
 	"go.temporal.io/server/common/searchattribute"
 )
@@ -58,6 +61,9 @@ type (
 
 const (
 	coalesceFuncName = "coalesce"
+
+	// histogramBucketColumn names the bucket start column in the group by list of a histogram statement.
+	histogramBucketColumn = "HistogramBucket"
 )
 
 var _ sqlparser.Expr = (*unsafeSQLString)(nil)
@@ -128,6 +134,59 @@ func addPrefix(prefix string, fields []string) []string {
 	return out
 }
 
+// withNotNullCondition adds a condition that dbColName is set to a where clause built by the converter.
+func withNotNullCondition(queryString string, dbColName string) string {
+	condition := dbColName + " is not null"
+	if queryString == "" {
+		return condition
+	}
+	return queryString + " and " + condition
+}
+
+// buildRowNumberPercentilesStmt builds a percentiles statement with the nearest-rank method for
+// databases without an ordered-set percentile aggregate. Rows are numbered in order of the column
+// value and the p-th percentile is the value of the first row whose number is at least p% of the
+// number of rows.
+func buildRowNumberPercentilesStmt(
+	pqc pluginQueryConverter,
+	namespaceID namespace.ID,
+	queryString string,
+	colName string,
+	percents []float64,
+) (string, []any) {
+	rankedStmt, queryArgs := pqc.buildAggregateStmt(
+		namespaceID,
+		queryString,
+		[]string{
+			colName + " AS percentile_value",
+			fmt.Sprintf("ROW_NUMBER() OVER (ORDER BY %s) AS percentile_row", colName),
+			"COUNT(*) OVER () AS percentile_rows",
+		},
+	)
+	selectExprs := make([]string, 0, len(percents)+1)
+	for _, percent := range percents {
+		selectExprs = append(
+			selectExprs,
+			fmt.Sprintf(
+				"MIN(CASE WHEN 100 * percentile_row >= %s * percentile_rows THEN percentile_value END)",
+				formatPercent(percent),
+			),
+		)
+	}
+	selectExprs = append(selectExprs, "COUNT(*)")
+	return fmt.Sprintf(
+		"SELECT %s FROM (%s) ranked_executions",
+		strings.Join(selectExprs, ", "),
+		rankedStmt,
+	), queryArgs
+}
+
+// formatPercent formats a percent as a SQL numeric literal. Percents are validated to be in range
+// (0, 100], so they are never formatted with an exponent.
+func formatPercent(percent float64) string {
+	return strconv.FormatFloat(percent, 'f', -1, 64)
+}
+
 func getMaxDatetimeValue() time.Time {
 	t, _ := time.Parse(time.RFC3339, "9999-12-31T23:59:59Z")
 	return t
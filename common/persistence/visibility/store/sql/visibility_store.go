@@ -28,6 +28,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
 	"time"
 
 	commonpb "go.temporal.io/api/common/v1"
@@ -347,6 +349,177 @@ func (s *VisibilityStore) countGroupByWorkflowExecutions(
 	return resp, nil
 }
 
+func (s *VisibilityStore) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *manager.AggregateWorkflowExecutionsRequest,
+) (*manager.AggregateWorkflowExecutionsResponse, error) {
+	saTypeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.GetIndexName(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	saMapper, err := s.searchAttributesMapperProvider.GetMapper(request.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	newConverter := func() *QueryConverter {
+		return NewQueryConverter(
+			s.GetName(),
+			request.Namespace,
+			request.NamespaceID,
+			saTypeMap,
+			saMapper,
+			request.Query,
+		)
+	}
+	resp := &manager.AggregateWorkflowExecutionsResponse{}
+
+	if h := request.Histogram; h != nil {
+		boundsFilter, err := newConverter().BuildHistogramBoundsStmt(h.Field, h.Interval)
+		if err != nil {
+			return nil, convertQueryConverterError(err)
+		}
+		empty, err := s.validateHistogramBuckets(ctx, boundsFilter, h)
+		if err != nil {
+			return nil, err
+		}
+		if !empty {
+			selectFilter, err := newConverter().BuildHistogramStmt(h.Field, h.Interval, h.GroupBy)
+			if err != nil {
+				return nil, convertQueryConverterError(err)
+			}
+			resp.Buckets, err = s.aggregateHistogram(ctx, selectFilter, saTypeMap)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if p := request.Percentiles; p != nil {
+		selectFilter, err := newConverter().BuildPercentilesStmt(p.Field, p.Percents)
+		if err != nil {
+			return nil, convertQueryConverterError(err)
+		}
+		resp.Percentiles, err = s.aggregatePercentiles(ctx, selectFilter, p.Percents)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
+// validateHistogramBuckets returns an error if the histogram would have more buckets than allowed,
+// before the executions are grouped by bucket. It returns true if no execution is in the histogram.
+func (s *VisibilityStore) validateHistogramBuckets(
+	ctx context.Context,
+	boundsFilter *sqlplugin.VisibilitySelectFilter,
+	h *manager.HistogramAggregation,
+) (bool, error) {
+	rows, err := s.sqlStore.Db.CountGroupByFromVisibility(ctx, *boundsFilter)
+	if err != nil {
+		return false, serviceerror.NewUnavailable(
+			fmt.Sprintf("AggregateWorkflowExecutions operation failed. Query failed: %v", err))
+	}
+	if len(rows) == 0 || rows[0].Count == 0 {
+		return true, nil
+	}
+	first, err := parseAggregateValue(rows[0].GroupValues[0])
+	if err != nil {
+		return false, err
+	}
+	last, err := parseAggregateValue(rows[0].GroupValues[1])
+	if err != nil {
+		return false, err
+	}
+	return false, query.ValidateHistogramBucketCount(first, last, h.Interval, h.MaxBuckets)
+}
+
+func (s *VisibilityStore) aggregateHistogram(
+	ctx context.Context,
+	selectFilter *sqlplugin.VisibilitySelectFilter,
+	saTypeMap searchattribute.NameTypeMap,
+) ([]*manager.HistogramBucket, error) {
+	var groupByType enumspb.IndexedValueType
+	if len(selectFilter.GroupBy) > 1 {
+		var err error
+		groupByType, err = saTypeMap.GetType(selectFilter.GroupBy[1])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err := s.sqlStore.Db.CountGroupByFromVisibility(ctx, *selectFilter)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(
+			fmt.Sprintf("AggregateWorkflowExecutions operation failed. Query failed: %v", err))
+	}
+
+	var buckets []*manager.HistogramBucket
+	bucketsByStart := make(map[int64]*manager.HistogramBucket)
+	for _, row := range rows {
+		startSeconds, err := parseAggregateValue(row.GroupValues[0])
+		if err != nil {
+			return nil, err
+		}
+		bucket, ok := bucketsByStart[int64(startSeconds)]
+		if !ok {
+			bucket = &manager.HistogramBucket{StartTime: time.Unix(int64(startSeconds), 0).UTC()}
+			bucketsByStart[int64(startSeconds)] = bucket
+			buckets = append(buckets, bucket)
+		}
+		bucket.Count += row.Count
+		if len(row.GroupValues) > 1 {
+			groupValue, err := searchattribute.EncodeValue(row.GroupValues[1], groupByType)
+			if err != nil {
+				return nil, err
+			}
+			bucket.Groups = append(
+				bucket.Groups,
+				&workflowservice.CountWorkflowExecutionsResponse_AggregationGroup{
+					GroupValues: []*commonpb.Payload{groupValue},
+					Count:       row.Count,
+				},
+			)
+		}
+	}
+
+	slices.SortFunc(buckets, func(a, b *manager.HistogramBucket) int {
+		return a.StartTime.Compare(b.StartTime)
+	})
+	return buckets, nil
+}
+
+// aggregatePercentiles reads the percentiles computed by the database, see BuildPercentilesStmt.
+// Percentiles are zero if no execution has a value for the field.
+func (s *VisibilityStore) aggregatePercentiles(
+	ctx context.Context,
+	selectFilter *sqlplugin.VisibilitySelectFilter,
+	percents []float64,
+) ([]*manager.PercentileValue, error) {
+	rows, err := s.sqlStore.Db.CountGroupByFromVisibility(ctx, *selectFilter)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(
+			fmt.Sprintf("AggregateWorkflowExecutions operation failed. Query failed: %v", err))
+	}
+
+	result := make([]*manager.PercentileValue, len(percents))
+	for i, percent := range percents {
+		result[i] = &manager.PercentileValue{Percent: percent}
+	}
+	if len(rows) == 0 || rows[0].Count == 0 {
+		return result, nil
+	}
+	for i, value := range rows[0].GroupValues {
+		result[i].Value, err = parseAggregateValue(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
 func (s *VisibilityStore) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
@@ -540,3 +713,35 @@ func (s *VisibilityStore) processRowSearchAttributes(
 	}
 	return aliasedSas, nil
 }
+
+// convertQueryConverterError converts ConverterError to InvalidArgument and passes through all
+// other errors (which should be only mapper errors).
+func convertQueryConverterError(err error) error {
+	var converterErr *query.ConverterError
+	if errors.As(err, &converterErr) {
+		return converterErr.ToInvalidArgument()
+	}
+	return err
+}
+
+// parseAggregateValue parses a numeric aggregate column, which drivers return as different types.
+func parseAggregateValue(value any) (float64, error) {
+	switch v := value.(type) {
+	case int64:
+		return float64(v), nil
+	case int32:
+		return float64(v), nil
+	case int:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	case string:
+		return strconv.ParseFloat(v, 64)
+	default:
+		return 0, serviceerror.NewInternal(
+			fmt.Sprintf("Unable to parse aggregate value from DB (got: %v of type: %T)", value, value),
+		)
+	}
+}
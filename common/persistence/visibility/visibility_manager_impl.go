@@ -188,6 +188,16 @@ func (p *visibilityManagerImpl) CountWorkflowExecutions(
 	return response, err
 }
 
+func (p *visibilityManagerImpl) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *manager.AggregateWorkflowExecutionsRequest,
+) (*manager.AggregateWorkflowExecutionsResponse, error) {
+	if err := validateAggregateWorkflowExecutionsRequest(request); err != nil {
+		return nil, err
+	}
+	return p.store.AggregateWorkflowExecutions(ctx, request)
+}
+
 func (p *visibilityManagerImpl) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
@@ -373,3 +383,40 @@ func serializeMemo(memo *commonpb.Memo) (*commonpb.DataBlob, error) {
 		EncodingType: MemoEncoding,
 	}, nil
 }
+
+func validateAggregateWorkflowExecutionsRequest(request *manager.AggregateWorkflowExecutionsRequest) error {
+	if request.Histogram == nil && request.Percentiles == nil {
+		return serviceerror.NewInvalidArgument("At least one of histogram and percentiles aggregations must be set.")
+	}
+	if h := request.Histogram; h != nil {
+		if h.Field == "" {
+			return serviceerror.NewInvalidArgument("Histogram field is not set.")
+		}
+		if h.Interval < time.Second || h.Interval%time.Second != 0 {
+			return serviceerror.NewInvalidArgument(
+				fmt.Sprintf("Histogram interval must be a positive whole number of seconds (got: %v).", h.Interval),
+			)
+		}
+		if h.MaxBuckets <= 0 {
+			return serviceerror.NewInvalidArgument(
+				fmt.Sprintf("Histogram max buckets must be positive (got: %v).", h.MaxBuckets),
+			)
+		}
+	}
+	if pc := request.Percentiles; pc != nil {
+		if pc.Field == "" {
+			return serviceerror.NewInvalidArgument("Percentiles field is not set.")
+		}
+		if len(pc.Percents) == 0 {
+			return serviceerror.NewInvalidArgument("Percentiles aggregation requires at least one percent.")
+		}
+		for _, percent := range pc.Percents {
+			if percent <= 0 || percent > 100 {
+				return serviceerror.NewInvalidArgument(
+					fmt.Sprintf("Percent must be in range (0, 100] (got: %v).", percent),
+				)
+			}
+		}
+	}
+	return nil
+}
@@ -160,6 +160,16 @@ func (m *visibilityManagerRateLimited) CountWorkflowExecutions(
 	return m.delegate.CountWorkflowExecutions(ctx, request)
 }
 
+func (m *visibilityManagerRateLimited) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *manager.AggregateWorkflowExecutionsRequest,
+) (*manager.AggregateWorkflowExecutionsResponse, error) {
+	if ok := allow(ctx, "AggregateWorkflowExecutions", m.readRateLimiter); !ok {
+		return nil, persistence.ErrPersistenceSystemLimitExceeded
+	}
+	return m.delegate.AggregateWorkflowExecutions(ctx, request)
+}
+
 func (m *visibilityManagerRateLimited) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
@@ -33,6 +33,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
@@ -217,3 +218,99 @@ func (s *VisibilityManagerSuite) TestGetWorkflowExecution() {
 	_, err = s.visibilityManager.GetWorkflowExecution(context.Background(), request)
 	s.Equal(persistence.ErrPersistenceSystemLimitExceeded, err)
 }
+
+func (s *VisibilityManagerSuite) TestAggregateWorkflowExecutions() {
+	request := &manager.AggregateWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceUUID,
+		Namespace:   testNamespace,
+		Percentiles: &manager.PercentilesAggregation{
+			Field:    "ExecutionDuration",
+			Percents: []float64{50, 99},
+		},
+	}
+	s.visibilityStore.EXPECT().AggregateWorkflowExecutions(gomock.Any(), request).Return(
+		&manager.AggregateWorkflowExecutionsResponse{},
+		nil,
+	)
+	s.metricsHandler.EXPECT().
+		WithTags(
+			metrics.OperationTag(metrics.VisibilityPersistenceAggregateWorkflowExecutionsScope),
+			metrics.VisibilityPluginNameTag(s.visibilityStore.GetName()),
+			metrics.VisibilityIndexNameTag(s.visibilityStore.GetIndexName()),
+		).
+		Return(metrics.NoopMetricsHandler).Times(2)
+	_, err := s.visibilityManager.AggregateWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+
+	// no remaining tokens
+	_, err = s.visibilityManager.AggregateWorkflowExecutions(context.Background(), request)
+	s.Equal(persistence.ErrPersistenceSystemLimitExceeded, err)
+}
+
+func TestValidateAggregateWorkflowExecutionsRequest(t *testing.T) {
+	testCases := []struct {
+		name    string
+		request *manager.AggregateWorkflowExecutionsRequest
+		err     string
+	}{
+		{
+			name: "histogram and percentiles",
+			request: &manager.AggregateWorkflowExecutionsRequest{
+				Histogram:   &manager.HistogramAggregation{Field: "StartTime", Interval: time.Hour, MaxBuckets: 10},
+				Percentiles: &manager.PercentilesAggregation{Field: "ExecutionDuration", Percents: []float64{0.1, 100}},
+			},
+		},
+		{
+			name:    "no aggregation",
+			request: &manager.AggregateWorkflowExecutionsRequest{},
+			err:     "At least one of histogram and percentiles aggregations must be set.",
+		},
+		{
+			name: "no histogram field",
+			request: &manager.AggregateWorkflowExecutionsRequest{
+				Histogram: &manager.HistogramAggregation{Interval: time.Hour},
+			},
+			err: "Histogram field is not set.",
+		},
+		{
+			name: "sub-second interval",
+			request: &manager.AggregateWorkflowExecutionsRequest{
+				Histogram: &manager.HistogramAggregation{Field: "StartTime", Interval: 1500 * time.Millisecond},
+			},
+			err: "Histogram interval must be a positive whole number of seconds (got: 1.5s).",
+		},
+		{
+			name: "no max buckets",
+			request: &manager.AggregateWorkflowExecutionsRequest{
+				Histogram: &manager.HistogramAggregation{Field: "StartTime", Interval: time.Hour},
+			},
+			err: "Histogram max buckets must be positive (got: 0).",
+		},
+		{
+			name: "no percents",
+			request: &manager.AggregateWorkflowExecutionsRequest{
+				Percentiles: &manager.PercentilesAggregation{Field: "ExecutionDuration"},
+			},
+			err: "Percentiles aggregation requires at least one percent.",
+		},
+		{
+			name: "percent out of range",
+			request: &manager.AggregateWorkflowExecutionsRequest{
+				Percentiles: &manager.PercentilesAggregation{Field: "ExecutionDuration", Percents: []float64{50, 101}},
+			},
+			err: "Percent must be in range (0, 100] (got: 101).",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateAggregateWorkflowExecutionsRequest(tc.request)
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			var invalidArgument *serviceerror.InvalidArgument
+			require.ErrorAs(t, err, &invalidArgument)
+			require.EqualError(t, err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,293 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quotas
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	defaultFairShareWeight = 1.0
+)
+
+type (
+	// FairShareRateLimiterFn creates the rate limiter for a source of requests. shareFn returns
+	// the fraction of the common budget the source is currently entitled to.
+	FairShareRateLimiterFn func(req Request, shareFn func() float64) RequestRateLimiter
+
+	// SourceWeightFn returns the weight of a source of requests relative to other sources
+	SourceWeightFn func(source string) float64
+
+	// FairShareRequestRateLimiterImpl divides a common budget between the sources of requests
+	// (see Request.Source). Each source that made a request within the active window gets a
+	// share of the budget proportional to its weight, so a single busy source can't use up the
+	// budget of the others. Requests without a source are not limited.
+	//
+	// The limiter is work-conserving: every request within a share also consumes from the pool
+	// rate limiter, which is sized to the whole budget, and a source over its share may borrow
+	// whatever the pool has left. Once the other sources use their shares again, the pool runs
+	// out and the borrowing stops.
+	FairShareRequestRateLimiterImpl struct {
+		rateLimiterGenFn FairShareRateLimiterFn
+		poolRateLimiter  RequestRateLimiter
+		weightFn         SourceWeightFn
+		activeWindow     time.Duration
+
+		sync.Mutex
+		sources   map[string]*fairShareSource
+		latest    time.Time
+		lastPrune time.Time
+	}
+
+	fairShareSource struct {
+		rateLimiter RequestRateLimiter
+		lastSeen    time.Time
+	}
+
+	// scaledRateBurst scales the rate and burst of a RateBurst by a ratio
+	scaledRateBurst struct {
+		base    RateBurst
+		ratioFn func() float64
+	}
+)
+
+var _ RequestRateLimiter = (*FairShareRequestRateLimiterImpl)(nil)
+var _ RateBurst = (*scaledRateBurst)(nil)
+
+func NewFairShareRequestRateLimiter(
+	rateLimiterGenFn FairShareRateLimiterFn,
+	poolRateLimiter RequestRateLimiter,
+	weightFn SourceWeightFn,
+	activeWindow time.Duration,
+) *FairShareRequestRateLimiterImpl {
+	return &FairShareRequestRateLimiterImpl{
+		rateLimiterGenFn: rateLimiterGenFn,
+		poolRateLimiter:  poolRateLimiter,
+		weightFn:         weightFn,
+		activeWindow:     activeWindow,
+		sources:          make(map[string]*fairShareSource),
+	}
+}
+
+// NewScaledRateBurst returns a RateBurst with the rate and burst of base multiplied by the
+// ratio returned by ratioFn. The burst is at least 1.
+func NewScaledRateBurst(
+	base RateBurst,
+	ratioFn func() float64,
+) RateBurst {
+	return &scaledRateBurst{
+		base:    base,
+		ratioFn: ratioFn,
+	}
+}
+
+// Allow attempts to allow a request to go through. The method returns
+// immediately with a true or false indicating if the request can make
+// progress
+func (r *FairShareRequestRateLimiterImpl) Allow(
+	now time.Time,
+	request Request,
+) bool {
+	if request.Source == "" {
+		return true
+	}
+	if !r.getOrInitRateLimiter(now, request).Allow(now, request) {
+		// over its share, the source may only borrow what is left unused
+		return r.poolRateLimiter.Allow(now, request)
+	}
+	_ = r.poolRateLimiter.Reserve(now, request)
+	return true
+}
+
+// Reserve returns a Reservation that indicates how long the caller
+// must wait before event happen.
+func (r *FairShareRequestRateLimiterImpl) Reserve(
+	now time.Time,
+	request Request,
+) Reservation {
+	if request.Source == "" {
+		return NoopReservation
+	}
+	reservation := r.getOrInitRateLimiter(now, request).Reserve(now, request)
+	if reservation.OK() && reservation.DelayFrom(now) > 0 {
+		// rather than waiting for its own share, the source may borrow what is left unused
+		borrowed := r.poolRateLimiter.Reserve(now, request)
+		if borrowed.OK() && borrowed.DelayFrom(now) == 0 {
+			reservation.CancelAt(now)
+			return borrowed
+		}
+		borrowed.CancelAt(now)
+	}
+	return NewPriorityReservation(reservation, []Reservation{r.poolRateLimiter.Reserve(now, request)})
+}
+
+// Wait waits till the deadline for a rate limit token to allow the request
+// to go through.
+func (r *FairShareRequestRateLimiterImpl) Wait(
+	ctx context.Context,
+	request Request,
+) error {
+	if request.Source == "" {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	now := time.Now().UTC()
+	reservation := r.Reserve(now, request)
+	if !reservation.OK() {
+		return fmt.Errorf("rate: Wait(n=%d) would exceed context deadline", request.Token)
+	}
+
+	delay := reservation.DelayFrom(now)
+	if delay == 0 {
+		return nil
+	}
+	waitLimit := InfDuration
+	if deadline, ok := ctx.Deadline(); ok {
+		waitLimit = deadline.Sub(now)
+	}
+	if waitLimit < delay {
+		reservation.CancelAt(now)
+		return fmt.Errorf("rate: Wait(n=%d) would exceed context deadline", request.Token)
+	}
+
+	t := time.NewTimer(delay)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+
+	case <-ctx.Done():
+		reservation.CancelAt(time.Now())
+		return ctx.Err()
+	}
+}
+
+// Share returns the fraction of the budget the source is currently entitled to.
+func (r *FairShareRequestRateLimiterImpl) Share(source string) float64 {
+	r.Lock()
+	defer r.Unlock()
+
+	weight := r.weight(source)
+	total := weight
+	for s, state := range r.sources {
+		if s != source && r.isActive(state) {
+			total += r.weight(s)
+		}
+	}
+	return weight / total
+}
+
+func (r *FairShareRequestRateLimiterImpl) getOrInitRateLimiter(
+	now time.Time,
+	req Request,
+) RequestRateLimiter {
+	if rateLimiter, ok := r.touch(now, req.Source); ok {
+		return rateLimiter
+	}
+
+	// the rate limiter may compute the share of the source while being created, so it must be
+	// created without holding the lock
+	source := req.Source
+	newRateLimiter := r.rateLimiterGenFn(req, func() float64 { return r.Share(source) })
+
+	r.Lock()
+	defer r.Unlock()
+
+	state, ok := r.sources[source]
+	if !ok {
+		state = &fairShareSource{rateLimiter: newRateLimiter}
+		r.sources[source] = state
+	}
+	if now.After(state.lastSeen) {
+		state.lastSeen = now
+	}
+	return state.rateLimiter
+}
+
+// touch records activity of the source and returns its rate limiter, if it has one.
+func (r *FairShareRequestRateLimiterImpl) touch(
+	now time.Time,
+	source string,
+) (RequestRateLimiter, bool) {
+	r.Lock()
+	defer r.Unlock()
+
+	if now.After(r.latest) {
+		r.latest = now
+	}
+	if r.latest.Sub(r.lastPrune) > r.activeWindow {
+		r.prune()
+	}
+
+	state, ok := r.sources[source]
+	if !ok {
+		return nil, false
+	}
+	if now.After(state.lastSeen) {
+		state.lastSeen = now
+	}
+	return state.rateLimiter, true
+}
+
+// prune removes sources that have been idle for longer than the active window, so identities
+// of workers that went away don't accumulate.
+func (r *FairShareRequestRateLimiterImpl) prune() {
+	r.lastPrune = r.latest
+	for source, state := range r.sources {
+		if !r.isActive(state) {
+			delete(r.sources, source)
+		}
+	}
+}
+
+func (r *FairShareRequestRateLimiterImpl) isActive(state *fairShareSource) bool {
+	return r.latest.Sub(state.lastSeen) <= r.activeWindow
+}
+
+func (r *FairShareRequestRateLimiterImpl) weight(source string) float64 {
+	weight := r.weightFn(source)
+	if weight <= 0 {
+		return defaultFairShareWeight
+	}
+	return weight
+}
+
+func (s *scaledRateBurst) Rate() float64 {
+	return s.base.Rate() * s.ratioFn()
+}
+
+func (s *scaledRateBurst) Burst() int {
+	return max(1, int(math.Ceil(float64(s.base.Burst())*s.ratioFn())))
+}
@@ -0,0 +1,164 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quotas
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	fairShareRequestRateLimiterSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		weights     map[string]float64
+		rateLimiter *FairShareRequestRateLimiterImpl
+	}
+)
+
+const (
+	testFairShareRate   = 10
+	testFairShareWindow = time.Minute
+)
+
+func TestFairShareRequestRateLimiterSuite(t *testing.T) {
+	s := new(fairShareRequestRateLimiterSuite)
+	suite.Run(t, s)
+}
+
+func (s *fairShareRequestRateLimiterSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.weights = map[string]float64{"heavy": 3}
+	rateBurst := NewRateBurst(
+		func() float64 { return testFairShareRate },
+		func() int { return testFairShareRate },
+	)
+	s.rateLimiter = NewFairShareRequestRateLimiter(
+		func(req Request, shareFn func() float64) RequestRateLimiter {
+			return NewRequestRateLimiterAdapter(NewDynamicRateLimiter(NewScaledRateBurst(rateBurst, shareFn), time.Minute))
+		},
+		NewRequestRateLimiterAdapter(NewDynamicRateLimiter(rateBurst, time.Minute)),
+		func(source string) float64 { return s.weights[source] },
+		testFairShareWindow,
+	)
+}
+
+func (s *fairShareRequestRateLimiterSuite) request(source string) Request {
+	return Request{API: "test-api", Token: 1, Caller: "test-namespace", Source: source}
+}
+
+func (s *fairShareRequestRateLimiterSuite) TestNoSource() {
+	now := time.Now()
+	for i := 0; i < 2*testFairShareRate; i++ {
+		s.True(s.rateLimiter.Allow(now, s.request("")))
+	}
+	s.Equal(NoopReservation, s.rateLimiter.Reserve(now, s.request("")))
+	s.NoError(s.rateLimiter.Wait(context.Background(), s.request("")))
+}
+
+func (s *fairShareRequestRateLimiterSuite) TestShare() {
+	now := time.Now()
+	s.Equal(1.0, s.rateLimiter.Share("light"))
+
+	s.True(s.rateLimiter.Allow(now, s.request("heavy")))
+	s.Equal(1.0, s.rateLimiter.Share("heavy"))
+	s.Equal(0.25, s.rateLimiter.Share("light"))
+
+	s.True(s.rateLimiter.Allow(now, s.request("light")))
+	s.True(s.rateLimiter.Allow(now, s.request("other")))
+	s.Equal(0.6, s.rateLimiter.Share("heavy"))
+	s.Equal(0.2, s.rateLimiter.Share("light"))
+
+	// sources that stop sending requests give up their share
+	later := now.Add(testFairShareWindow + time.Second)
+	s.True(s.rateLimiter.Allow(later, s.request("light")))
+	s.Equal(1.0, s.rateLimiter.Share("light"))
+	s.Len(s.rateLimiter.sources, 1)
+}
+
+func (s *fairShareRequestRateLimiterSuite) TestAllow() {
+	now := time.Now()
+	s.True(s.rateLimiter.Allow(now, s.request("heavy")))
+
+	// the light source is entitled to a quarter of the budget, but may borrow what the heavy
+	// source leaves unused
+	allowed := 0
+	for i := 0; i < testFairShareRate; i++ {
+		if s.rateLimiter.Allow(now, s.request("light")) {
+			allowed++
+		}
+	}
+	s.Equal(testFairShareRate-1, allowed)
+
+	// which doesn't affect the share of the heavy source
+	for i := 0; i < 7; i++ {
+		s.True(s.rateLimiter.Allow(now, s.request("heavy")))
+	}
+
+	// and once the budget is used up, there is nothing left to borrow
+	later := now.Add(time.Second / testFairShareRate)
+	s.False(s.rateLimiter.Allow(later, s.request("light")))
+}
+
+func (s *fairShareRequestRateLimiterSuite) TestReserve() {
+	now := time.Now()
+	s.True(s.rateLimiter.Allow(now, s.request("heavy")))
+	for i := 0; i < 3; i++ {
+		reservation := s.rateLimiter.Reserve(now, s.request("light"))
+		s.True(reservation.OK())
+		s.Zero(reservation.DelayFrom(now))
+	}
+
+	// over its share, the light source borrows from the pool instead of waiting
+	reservation := s.rateLimiter.Reserve(now, s.request("light"))
+	s.True(reservation.OK())
+	s.Zero(reservation.DelayFrom(now))
+
+	// a cancelled borrow is returned to the pool
+	reservation.CancelAt(now)
+	for i := 0; i < testFairShareRate-4; i++ {
+		s.True(s.rateLimiter.Allow(now, s.request("light")))
+	}
+	s.True(s.rateLimiter.Reserve(now, s.request("light")).DelayFrom(now) > 0)
+}
+
+func (s *fairShareRequestRateLimiterSuite) TestScaledRateBurst() {
+	ratio := 0.5
+	rateBurst := NewScaledRateBurst(
+		NewRateBurst(func() float64 { return 10 }, func() int { return 3 }),
+		func() float64 { return ratio },
+	)
+	s.Equal(5.0, rateBurst.Rate())
+	s.Equal(2, rateBurst.Burst())
+
+	ratio = 0.01
+	s.Equal(1, rateBurst.Burst())
+}
@@ -32,6 +32,9 @@ type (
 		CallerType    string
 		CallerSegment int32
 		Initiation    string
+		// Source identifies the client making the request within the Caller, e.g. a worker
+		// identity. It is only set where requests are shared fairly between sources.
+		Source string
 	}
 )
 
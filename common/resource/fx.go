@@ -343,6 +343,7 @@ func MatchingClientProvider(matchingRawClient MatchingRawClient) MatchingClient
 
 func PersistenceConfigProvider(persistenceConfig config.Persistence, dc *dynamicconfig.Collection) *config.Persistence {
 	persistenceConfig.TransactionSizeLimit = dynamicconfig.TransactionSizeLimit.Get(dc)
+	persistenceConfig.BlobCompression = dynamicconfig.PersistenceBlobCompression.Get(dc)
 	return &persistenceConfig
 }
 
@@ -0,0 +1,474 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/api"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/persistence"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	AuditSinkLog         = "log"
+	AuditSinkFile        = "file"
+	AuditSinkPersistence = "persistence"
+
+	auditPersistenceTimeout    = 5 * time.Second
+	auditPersistenceBufferSize = 10000
+	auditPersistenceBatchSize  = 100
+	auditTrimInterval          = time.Hour
+	auditTrimTimeout           = 10 * time.Minute
+	defaultAuditRetention      = 7 * 24 * time.Hour
+	// auditTrimOwnerKey is looked up in the frontend membership ring to elect the single host
+	// that trims the audit log.
+	auditTrimOwnerKey = "audit-log-trim"
+)
+
+type (
+	// AuditSink receives audit events. Write is called on the request path after the handler
+	// returns, so it should be fast. Errors are logged and don't fail the request.
+	AuditSink interface {
+		Write(ctx context.Context, event *persistencespb.AuditEvent) error
+	}
+
+	// AuditInterceptor records an AuditEvent for every mutating WorkflowService,
+	// OperatorService and AdminService call. It must run before the authorization interceptor
+	// so that denied calls are audited; the authorization interceptor records the claims it
+	// maps for it.
+	AuditInterceptor struct {
+		sink       AuditSink
+		logger     log.Logger
+		timeSource clock.TimeSource
+	}
+
+	logAuditSink struct {
+		logger log.Logger
+	}
+
+	fileAuditSink struct {
+		lock sync.Mutex
+		file *os.File
+	}
+
+	// persistenceAuditSink buffers events and appends them to the audit log in the background,
+	// so that requests don't wait on a persistence write. The frontend host that owns
+	// auditTrimOwnerKey in the membership ring also trims events older than the retention from
+	// the audit log.
+	persistenceAuditSink struct {
+		manager          persistence.AuditLogManager
+		logger           log.Logger
+		timeSource       clock.TimeSource
+		retention        time.Duration
+		frontendResolver membership.ServiceResolver
+		hostInfoProvider membership.HostInfoProvider
+
+		events     chan *persistencespb.AuditEvent
+		shutdownCh chan struct{}
+		closeOnce  sync.Once
+		wg         sync.WaitGroup
+	}
+
+	workflowExecutionGetter interface {
+		GetWorkflowExecution() *commonpb.WorkflowExecution
+	}
+
+	executionGetter interface {
+		GetExecution() *commonpb.WorkflowExecution
+	}
+
+	workflowIDGetter interface {
+		GetWorkflowId() string
+	}
+
+	runIDGetter interface {
+		GetRunId() string
+	}
+
+	scheduleIDGetter interface {
+		GetScheduleId() string
+	}
+
+	identityGetter interface {
+		GetIdentity() string
+	}
+)
+
+var (
+	_ grpc.UnaryServerInterceptor = (*AuditInterceptor)(nil).Intercept
+
+	// Calls to methods with these prefixes are not audited: they are either read-only or
+	// issued by workers at a high rate as part of normal task processing.
+	unauditedMethodPrefixes = []string{
+		"Describe",
+		"Get",
+		"List",
+		"Count",
+		"Scan",
+		"Query",
+		"Poll",
+		"Respond",
+		"RecordActivityTaskHeartbeat",
+		"DeepHealthCheck",
+		"Simulate",
+	}
+)
+
+func NewAuditInterceptor(
+	sink AuditSink,
+	logger log.Logger,
+	timeSource clock.TimeSource,
+) *AuditInterceptor {
+	return &AuditInterceptor{
+		sink:       sink,
+		logger:     logger,
+		timeSource: timeSource,
+	}
+}
+
+// NewAuditSinkFromConfig returns the sink described by the config, or nil if auditing is
+// disabled.
+func NewAuditSinkFromConfig(
+	cfg *config.Audit,
+	logger log.Logger,
+	auditLogManager persistence.AuditLogManager,
+	timeSource clock.TimeSource,
+	frontendResolver membership.ServiceResolver,
+	hostInfoProvider membership.HostInfoProvider,
+) (AuditSink, error) {
+	switch strings.ToLower(cfg.Sink) {
+	case "":
+		return nil, nil
+	case AuditSinkLog:
+		return NewLogAuditSink(logger), nil
+	case AuditSinkFile:
+		return NewFileAuditSink(cfg.Filepath)
+	case AuditSinkPersistence:
+		retention := cfg.Retention
+		if retention <= 0 {
+			retention = defaultAuditRetention
+		}
+		return NewPersistenceAuditSink(auditLogManager, logger, timeSource, retention, frontendResolver, hostInfoProvider), nil
+	}
+	return nil, fmt.Errorf("unknown audit sink: %s", cfg.Sink)
+}
+
+func (i *AuditInterceptor) Intercept(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if i.sink == nil || !IsAuditedMethod(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	ctx = authorization.WithClaimsRecorder(ctx)
+	resp, err := handler(ctx, req)
+
+	event := NewAuditEvent(ctx, info.FullMethod, req, resp, err)
+	event.Time = timestamppb.New(i.timeSource.Now())
+	if writeErr := i.sink.Write(ctx, event); writeErr != nil {
+		i.logger.Error("Unable to write audit event.",
+			tag.NewStringTag("api-name", event.ApiName),
+			tag.WorkflowNamespace(event.Namespace),
+			tag.Error(writeErr))
+	}
+	return resp, err
+}
+
+// IsAuditedMethod returns true for mutating WorkflowService, OperatorService and AdminService
+// methods.
+func IsAuditedMethod(fullMethod string) bool {
+	if !strings.HasPrefix(fullMethod, api.WorkflowServicePrefix) &&
+		!strings.HasPrefix(fullMethod, api.OperatorServicePrefix) &&
+		!strings.HasPrefix(fullMethod, api.AdminServicePrefix) {
+		return false
+	}
+	methodName := api.MethodName(fullMethod)
+	for _, prefix := range unauditedMethodPrefixes {
+		if strings.HasPrefix(methodName, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// NewAuditEvent builds an audit event for a call from its request, response and error. The
+// event time is not set.
+func NewAuditEvent(ctx context.Context, fullMethod string, req any, resp any, err error) *persistencespb.AuditEvent {
+	event := &persistencespb.AuditEvent{
+		ApiName:    fullMethod,
+		StatusCode: serviceerror.ToStatus(err).Code().String(),
+	}
+	if err != nil {
+		event.ErrorMessage = err.Error()
+	}
+	if claims, ok := ctx.Value(authorization.MappedClaims).(*authorization.Claims); ok && claims != nil {
+		event.Subject = claims.Subject
+	} else if claims := authorization.RecordedClaims(ctx); claims != nil {
+		event.Subject = claims.Subject
+	}
+
+	if r, ok := req.(NamespaceNameGetter); ok {
+		event.Namespace = r.GetNamespace()
+	}
+	if r, ok := req.(NamespaceIDGetter); ok {
+		event.NamespaceId = r.GetNamespaceId()
+	}
+	if r, ok := req.(identityGetter); ok {
+		event.Identity = r.GetIdentity()
+	}
+	if r, ok := req.(scheduleIDGetter); ok {
+		event.ScheduleId = r.GetScheduleId()
+	}
+
+	var execution *commonpb.WorkflowExecution
+	switch r := req.(type) {
+	case workflowExecutionGetter:
+		execution = r.GetWorkflowExecution()
+	case executionGetter:
+		execution = r.GetExecution()
+	}
+	if execution != nil {
+		event.WorkflowId = execution.GetWorkflowId()
+		event.RunId = execution.GetRunId()
+	} else if r, ok := req.(workflowIDGetter); ok {
+		event.WorkflowId = r.GetWorkflowId()
+	}
+	// e.g. StartWorkflowExecution only returns the run ID in the response
+	if r, ok := resp.(runIDGetter); ok && event.RunId == "" {
+		event.RunId = r.GetRunId()
+	}
+	return event
+}
+
+// NewLogAuditSink returns a sink that logs each event at info level.
+func NewLogAuditSink(logger log.Logger) AuditSink {
+	return &logAuditSink{logger: log.With(logger, tag.NewStringTag("log-type", "audit"))}
+}
+
+func (s *logAuditSink) Write(_ context.Context, event *persistencespb.AuditEvent) error {
+	s.logger.Info("Audit event",
+		tag.NewStringTag("audit-subject", event.Subject),
+		tag.NewStringTag("api-name", event.ApiName),
+		tag.WorkflowNamespace(event.Namespace),
+		tag.WorkflowNamespaceID(event.NamespaceId),
+		tag.WorkflowID(event.WorkflowId),
+		tag.WorkflowRunID(event.RunId),
+		tag.ScheduleID(event.ScheduleId),
+		tag.NewStringTag("identity", event.Identity),
+		tag.NewStringTag("status-code", event.StatusCode),
+		tag.NewStringTag("error-message", event.ErrorMessage),
+	)
+	return nil
+}
+
+// NewFileAuditSink returns a sink that appends each event to a file as a line of JSON.
+func NewFileAuditSink(path string) (AuditSink, error) {
+	if path == "" {
+		return nil, fmt.Errorf("audit sink filepath is not set")
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open audit log file: %w", err)
+	}
+	return &fileAuditSink{file: file}, nil
+}
+
+func (s *fileAuditSink) Write(_ context.Context, event *persistencespb.AuditEvent) error {
+	line, err := protojson.Marshal(event)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, err = s.file.Write(line)
+	return err
+}
+
+func (s *fileAuditSink) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.file.Close()
+}
+
+// NewPersistenceAuditSink returns a sink that appends events to the audit log queue of their
+// namespace from a background goroutine. If this host is elected in the frontend membership
+// ring, it also deletes events older than retention. Events are dropped if the buffer is full.
+// Close must be called to stop the background goroutines.
+func NewPersistenceAuditSink(
+	manager persistence.AuditLogManager,
+	logger log.Logger,
+	timeSource clock.TimeSource,
+	retention time.Duration,
+	frontendResolver membership.ServiceResolver,
+	hostInfoProvider membership.HostInfoProvider,
+) AuditSink {
+	s := &persistenceAuditSink{
+		manager:          manager,
+		logger:           log.NewThrottledLogger(logger, func() float64 { return 1 }),
+		timeSource:       timeSource,
+		retention:        retention,
+		frontendResolver: frontendResolver,
+		hostInfoProvider: hostInfoProvider,
+		events:           make(chan *persistencespb.AuditEvent, auditPersistenceBufferSize),
+		shutdownCh:       make(chan struct{}),
+	}
+	s.wg.Add(2)
+	go s.writeLoop()
+	go s.trimLoop()
+	return s
+}
+
+func (s *persistenceAuditSink) Write(_ context.Context, event *persistencespb.AuditEvent) error {
+	select {
+	case s.events <- event:
+		return nil
+	default:
+		// Not returned as an error: the interceptor would log every dropped event.
+		s.logger.Warn("Audit event buffer is full, dropping event.",
+			tag.NewStringTag("api-name", event.ApiName),
+			tag.WorkflowNamespace(event.Namespace))
+		return nil
+	}
+}
+
+// Close writes the events that are already buffered and stops the background goroutines.
+func (s *persistenceAuditSink) Close() error {
+	s.closeOnce.Do(func() {
+		close(s.shutdownCh)
+	})
+	s.wg.Wait()
+	return nil
+}
+
+func (s *persistenceAuditSink) writeLoop() {
+	defer s.wg.Done()
+
+	batch := make([]*persistencespb.AuditEvent, 0, auditPersistenceBatchSize)
+	for {
+		select {
+		case <-s.shutdownCh:
+			for {
+				batch = s.nextBatch(batch[:0])
+				if len(batch) == 0 {
+					return
+				}
+				s.writeBatch(batch)
+			}
+		case event := <-s.events:
+			batch = s.nextBatch(append(batch[:0], event))
+			s.writeBatch(batch)
+		}
+	}
+}
+
+// nextBatch adds the buffered events to batch, up to the batch size, without blocking.
+func (s *persistenceAuditSink) nextBatch(batch []*persistencespb.AuditEvent) []*persistencespb.AuditEvent {
+	for len(batch) < auditPersistenceBatchSize {
+		select {
+		case event := <-s.events:
+			batch = append(batch, event)
+		default:
+			return batch
+		}
+	}
+	return batch
+}
+
+func (s *persistenceAuditSink) writeBatch(batch []*persistencespb.AuditEvent) {
+	ctx, cancel := context.WithTimeout(context.Background(), auditPersistenceTimeout)
+	defer cancel()
+	ctx = headers.SetCallerInfo(ctx, headers.SystemBackgroundCallerInfo)
+	for _, event := range batch {
+		if _, err := s.manager.AppendAuditEvent(ctx, &persistence.AppendAuditEventRequest{Event: event}); err != nil {
+			s.logger.Error("Unable to write audit event.",
+				tag.NewStringTag("api-name", event.ApiName),
+				tag.WorkflowNamespace(event.Namespace),
+				tag.Error(err))
+		}
+	}
+}
+
+func (s *persistenceAuditSink) trimLoop() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(auditTrimInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.shutdownCh:
+			return
+		case <-ticker.C:
+			if s.isTrimOwner() {
+				s.trim()
+			}
+		}
+	}
+}
+
+// isTrimOwner returns true if this host owns auditTrimOwnerKey in the frontend membership ring,
+// so that a single frontend host trims the audit log.
+func (s *persistenceAuditSink) isTrimOwner() bool {
+	owner, err := s.frontendResolver.Lookup(auditTrimOwnerKey)
+	if err != nil {
+		s.logger.Error("Unable to look up the audit log trim owner.", tag.Error(err))
+		return false
+	}
+	return owner.Identity() == s.hostInfoProvider.HostInfo().Identity()
+}
+
+func (s *persistenceAuditSink) trim() {
+	ctx, cancel := context.WithTimeout(context.Background(), auditTrimTimeout)
+	defer cancel()
+	ctx = headers.SetCallerInfo(ctx, headers.SystemBackgroundCallerInfo)
+	_, err := s.manager.TrimAuditEvents(ctx, &persistence.TrimAuditEventsRequest{
+		ExclusiveMaxEventTime: s.timeSource.Now().Add(-s.retention),
+	})
+	if err != nil {
+		s.logger.Error("Unable to trim audit log.", tag.Error(err))
+	}
+}
@@ -0,0 +1,361 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/api"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+type (
+	auditSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		sink        *captureAuditSink
+		timeSource  *clock.EventTimeSource
+		interceptor *AuditInterceptor
+	}
+
+	captureAuditSink struct {
+		events []*persistencespb.AuditEvent
+		err    error
+	}
+)
+
+func (c *captureAuditSink) Write(_ context.Context, event *persistencespb.AuditEvent) error {
+	c.events = append(c.events, event)
+	return c.err
+}
+
+func TestAuditSuite(t *testing.T) {
+	suite.Run(t, new(auditSuite))
+}
+
+func (s *auditSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.sink = &captureAuditSink{}
+	s.timeSource = clock.NewEventTimeSource().Update(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC))
+	s.interceptor = NewAuditInterceptor(s.sink, log.NewNoopLogger(), s.timeSource)
+}
+
+func (s *auditSuite) TestIsAuditedMethod() {
+	testCases := []struct {
+		method  string
+		audited bool
+	}{
+		{api.WorkflowServicePrefix + "TerminateWorkflowExecution", true},
+		{api.WorkflowServicePrefix + "ResetWorkflowExecution", true},
+		{api.WorkflowServicePrefix + "StartWorkflowExecution", true},
+		{api.WorkflowServicePrefix + "UpdateNamespace", true},
+		{api.WorkflowServicePrefix + "CreateSchedule", true},
+		{api.WorkflowServicePrefix + "DescribeWorkflowExecution", false},
+		{api.WorkflowServicePrefix + "ListWorkflowExecutions", false},
+		{api.WorkflowServicePrefix + "GetWorkflowExecutionHistory", false},
+		{api.WorkflowServicePrefix + "QueryWorkflow", false},
+		{api.WorkflowServicePrefix + "PollWorkflowTaskQueue", false},
+		{api.WorkflowServicePrefix + "RespondActivityTaskCompleted", false},
+		{api.WorkflowServicePrefix + "RecordActivityTaskHeartbeat", false},
+		{api.OperatorServicePrefix + "DeleteNamespace", true},
+		{api.OperatorServicePrefix + "AddSearchAttributes", true},
+		{api.OperatorServicePrefix + "ListSearchAttributes", false},
+		{api.AdminServicePrefix + "DeleteWorkflowExecution", true},
+		{api.AdminServicePrefix + "UpsertBusinessCalendar", true},
+		{api.AdminServicePrefix + "DescribeMutableState", false},
+		{api.AdminServicePrefix + "ListAuditEvents", false},
+		{api.AdminServicePrefix + "DeepHealthCheck", false},
+		{api.HistoryServicePrefix + "TerminateWorkflowExecution", false},
+		{"/grpc.health.v1.Health/Check", false},
+	}
+	for _, tc := range testCases {
+		s.Equal(tc.audited, IsAuditedMethod(tc.method), tc.method)
+	}
+}
+
+func (s *auditSuite) TestIntercept() {
+	ctx := context.WithValue(context.Background(), authorization.MappedClaims, &authorization.Claims{Subject: "alice"})
+	req := &workflowservice.TerminateWorkflowExecutionRequest{
+		Namespace:         "ns",
+		WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: "wf", RunId: "run"},
+		Identity:          "cli",
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: api.WorkflowServicePrefix + "TerminateWorkflowExecution"}
+
+	resp, err := s.interceptor.Intercept(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+		return &workflowservice.TerminateWorkflowExecutionResponse{}, nil
+	})
+	s.NoError(err)
+	s.NotNil(resp)
+
+	s.Len(s.sink.events, 1)
+	event := s.sink.events[0]
+	s.Equal("alice", event.Subject)
+	s.Equal(info.FullMethod, event.ApiName)
+	s.Equal("ns", event.Namespace)
+	s.Equal("wf", event.WorkflowId)
+	s.Equal("run", event.RunId)
+	s.Equal("cli", event.Identity)
+	s.Equal("OK", event.StatusCode)
+	s.Empty(event.ErrorMessage)
+	s.Equal(s.timeSource.Now(), event.Time.AsTime())
+}
+
+func (s *auditSuite) TestIntercept_Error() {
+	handlerErr := serviceerror.NewNotFound("workflow not found")
+	info := &grpc.UnaryServerInfo{FullMethod: api.WorkflowServicePrefix + "SignalWorkflowExecution"}
+
+	_, err := s.interceptor.Intercept(context.Background(), &workflowservice.SignalWorkflowExecutionRequest{Namespace: "ns"}, info,
+		func(ctx context.Context, req any) (any, error) {
+			return nil, handlerErr
+		})
+	s.Equal(handlerErr, err)
+
+	s.Len(s.sink.events, 1)
+	s.Empty(s.sink.events[0].Subject)
+	s.Equal("NotFound", s.sink.events[0].StatusCode)
+	s.Equal("workflow not found", s.sink.events[0].ErrorMessage)
+}
+
+func (s *auditSuite) TestIntercept_DeniedByAuthorization() {
+	controller := gomock.NewController(s.T())
+	claimMapper := authorization.NewMockClaimMapper(controller)
+	claimMapper.EXPECT().GetClaims(gomock.Any()).Return(&authorization.Claims{Subject: "mallory"}, nil)
+	authorizer := authorization.NewMockAuthorizer(controller)
+	authorizer.EXPECT().Authorize(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(authorization.Result{Decision: authorization.DecisionDeny}, nil)
+	authInterceptor := authorization.NewInterceptor(
+		claimMapper, authorizer, metrics.NoopMetricsHandler, log.NewNoopLogger(), nil, nil, "", "")
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token"))
+	info := &grpc.UnaryServerInfo{FullMethod: api.WorkflowServicePrefix + "TerminateWorkflowExecution"}
+	// no namespace, so that the authorization interceptor doesn't need a namespace checker
+	req := &workflowservice.TerminateWorkflowExecutionRequest{
+		WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: "wf"},
+	}
+
+	// the audit interceptor runs outside the authorization interceptor
+	_, err := s.interceptor.Intercept(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+		return authInterceptor.Intercept(ctx, req, info, func(context.Context, any) (any, error) {
+			s.Fail("handler must not be called")
+			return nil, nil
+		})
+	})
+	var permissionDenied *serviceerror.PermissionDenied
+	s.ErrorAs(err, &permissionDenied)
+
+	s.Len(s.sink.events, 1)
+	s.Equal("mallory", s.sink.events[0].Subject)
+	s.Equal("wf", s.sink.events[0].WorkflowId)
+	s.Equal("PermissionDenied", s.sink.events[0].StatusCode)
+}
+
+func (s *auditSuite) TestIntercept_SinkErrorIgnored() {
+	s.sink.err = errors.New("sink unavailable")
+	info := &grpc.UnaryServerInfo{FullMethod: api.WorkflowServicePrefix + "SignalWorkflowExecution"}
+
+	_, err := s.interceptor.Intercept(context.Background(), &workflowservice.SignalWorkflowExecutionRequest{}, info,
+		func(ctx context.Context, req any) (any, error) {
+			return &workflowservice.SignalWorkflowExecutionResponse{}, nil
+		})
+	s.NoError(err)
+	s.Len(s.sink.events, 1)
+}
+
+func (s *auditSuite) TestIntercept_NotAudited() {
+	info := &grpc.UnaryServerInfo{FullMethod: api.WorkflowServicePrefix + "DescribeWorkflowExecution"}
+	_, err := s.interceptor.Intercept(context.Background(), &workflowservice.DescribeWorkflowExecutionRequest{}, info,
+		func(ctx context.Context, req any) (any, error) {
+			return &workflowservice.DescribeWorkflowExecutionResponse{}, nil
+		})
+	s.NoError(err)
+	s.Empty(s.sink.events)
+
+	// no sink configured
+	interceptor := NewAuditInterceptor(nil, log.NewNoopLogger(), s.timeSource)
+	info = &grpc.UnaryServerInfo{FullMethod: api.WorkflowServicePrefix + "TerminateWorkflowExecution"}
+	_, err = interceptor.Intercept(context.Background(), &workflowservice.TerminateWorkflowExecutionRequest{}, info,
+		func(ctx context.Context, req any) (any, error) {
+			return &workflowservice.TerminateWorkflowExecutionResponse{}, nil
+		})
+	s.NoError(err)
+}
+
+func (s *auditSuite) TestNewAuditEvent_Targets() {
+	// workflow ID from the request, run ID from the response
+	event := NewAuditEvent(context.Background(), api.WorkflowServicePrefix+"StartWorkflowExecution",
+		&workflowservice.StartWorkflowExecutionRequest{Namespace: "ns", WorkflowId: "wf"},
+		&workflowservice.StartWorkflowExecutionResponse{RunId: "run"},
+		nil)
+	s.Equal("wf", event.WorkflowId)
+	s.Equal("run", event.RunId)
+
+	// admin APIs use an Execution field
+	event = NewAuditEvent(context.Background(), api.AdminServicePrefix+"RefreshWorkflowTasks",
+		&adminservice.RefreshWorkflowTasksRequest{
+			NamespaceId: "ns-id",
+			Execution:   &commonpb.WorkflowExecution{WorkflowId: "wf2", RunId: "run2"},
+		},
+		nil, nil)
+	s.Equal("ns-id", event.NamespaceId)
+	s.Equal("wf2", event.WorkflowId)
+	s.Equal("run2", event.RunId)
+
+	event = NewAuditEvent(context.Background(), api.WorkflowServicePrefix+"DeleteSchedule",
+		&workflowservice.DeleteScheduleRequest{Namespace: "ns", ScheduleId: "sched"},
+		nil, nil)
+	s.Equal("sched", event.ScheduleId)
+	s.Empty(event.WorkflowId)
+}
+
+func (s *auditSuite) TestFileSink() {
+	path := filepath.Join(s.T().TempDir(), "audit.jsonl")
+	sink, err := NewAuditSinkFromConfig(&config.Audit{Sink: AuditSinkFile, Filepath: path}, log.NewNoopLogger(), nil, s.timeSource, nil, nil)
+	s.NoError(err)
+
+	s.NoError(sink.Write(context.Background(), &persistencespb.AuditEvent{Subject: "a", Namespace: "ns1"}))
+	s.NoError(sink.Write(context.Background(), &persistencespb.AuditEvent{Subject: "b", Namespace: "ns2"}))
+	s.NoError(sink.(interface{ Close() error }).Close())
+
+	contents, err := os.ReadFile(path)
+	s.NoError(err)
+	lines := strings.Split(strings.TrimSpace(string(contents)), "\n")
+	s.Len(lines, 2)
+	var event persistencespb.AuditEvent
+	s.NoError(protojson.Unmarshal([]byte(lines[1]), &event))
+	s.Equal("b", event.Subject)
+	s.Equal("ns2", event.Namespace)
+}
+
+func (s *auditSuite) TestPersistenceSink() {
+	controller := gomock.NewController(s.T())
+	manager := persistence.NewMockAuditLogManager(controller)
+	event := &persistencespb.AuditEvent{Subject: "a"}
+	written := make(chan struct{})
+	manager.EXPECT().AppendAuditEvent(gomock.Any(), &persistence.AppendAuditEventRequest{Event: event}).
+		DoAndReturn(func(context.Context, *persistence.AppendAuditEventRequest) (*persistence.AppendAuditEventResponse, error) {
+			close(written)
+			return &persistence.AppendAuditEventResponse{}, nil
+		})
+
+	sink, err := NewAuditSinkFromConfig(&config.Audit{Sink: AuditSinkPersistence}, log.NewNoopLogger(), manager, s.timeSource, nil, nil)
+	s.NoError(err)
+	defer func() { s.NoError(sink.(io.Closer).Close()) }()
+	s.NoError(sink.Write(context.Background(), event))
+	select {
+	case <-written:
+	case <-time.After(5 * time.Second):
+		s.Fail("audit event was not written")
+	}
+}
+
+func (s *auditSuite) TestPersistenceSink_CloseWritesBufferedEvents() {
+	controller := gomock.NewController(s.T())
+	manager := persistence.NewMockAuditLogManager(controller)
+	manager.EXPECT().AppendAuditEvent(gomock.Any(), gomock.Any()).
+		Return(&persistence.AppendAuditEventResponse{}, nil).Times(3)
+
+	sink := NewPersistenceAuditSink(manager, log.NewNoopLogger(), s.timeSource, time.Hour, nil, nil)
+	for i := 0; i < 3; i++ {
+		s.NoError(sink.Write(context.Background(), &persistencespb.AuditEvent{}))
+	}
+	s.NoError(sink.(io.Closer).Close())
+}
+
+func (s *auditSuite) TestPersistenceSink_Trim() {
+	controller := gomock.NewController(s.T())
+	manager := persistence.NewMockAuditLogManager(controller)
+	manager.EXPECT().TrimAuditEvents(gomock.Any(), &persistence.TrimAuditEventsRequest{
+		ExclusiveMaxEventTime: s.timeSource.Now().Add(-time.Hour),
+	}).Return(&persistence.TrimAuditEventsResponse{}, nil)
+
+	sink := &persistenceAuditSink{
+		manager:    manager,
+		logger:     log.NewNoopLogger(),
+		timeSource: s.timeSource,
+		retention:  time.Hour,
+	}
+	sink.trim()
+}
+
+func (s *auditSuite) TestPersistenceSink_TrimOwner() {
+	controller := gomock.NewController(s.T())
+	resolver := membership.NewMockServiceResolver(controller)
+	hostInfoProvider := membership.NewMockHostInfoProvider(controller)
+	hostInfoProvider.EXPECT().HostInfo().Return(membership.NewHostInfoFromAddress("frontend-1")).AnyTimes()
+	sink := &persistenceAuditSink{
+		logger:           log.NewNoopLogger(),
+		frontendResolver: resolver,
+		hostInfoProvider: hostInfoProvider,
+	}
+
+	resolver.EXPECT().Lookup(auditTrimOwnerKey).Return(membership.NewHostInfoFromAddress("frontend-1"), nil)
+	s.True(sink.isTrimOwner())
+	resolver.EXPECT().Lookup(auditTrimOwnerKey).Return(membership.NewHostInfoFromAddress("frontend-2"), nil)
+	s.False(sink.isTrimOwner())
+	resolver.EXPECT().Lookup(auditTrimOwnerKey).Return(nil, errors.New("no hosts"))
+	s.False(sink.isTrimOwner())
+}
+
+func (s *auditSuite) TestSinkFromConfig() {
+	sink, err := NewAuditSinkFromConfig(&config.Audit{}, log.NewNoopLogger(), nil, s.timeSource, nil, nil)
+	s.NoError(err)
+	s.Nil(sink)
+
+	sink, err = NewAuditSinkFromConfig(&config.Audit{Sink: "LOG"}, log.NewNoopLogger(), nil, s.timeSource, nil, nil)
+	s.NoError(err)
+	s.NoError(sink.Write(context.Background(), &persistencespb.AuditEvent{}))
+
+	_, err = NewAuditSinkFromConfig(&config.Audit{Sink: AuditSinkFile}, log.NewNoopLogger(), nil, s.timeSource, nil, nil)
+	s.Error(err)
+
+	_, err = NewAuditSinkFromConfig(&config.Audit{Sink: "kafka"}, log.NewNoopLogger(), nil, s.timeSource, nil, nil)
+	s.Error(err)
+}
@@ -45,6 +45,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.AddTasksResponse:
 		return nil
+	case *adminservice.AggregateWorkflowExecutionsRequest:
+		return nil
+	case *adminservice.AggregateWorkflowExecutionsResponse:
+		return nil
 	case *adminservice.CancelDLQJobRequest:
 		return nil
 	case *adminservice.CancelDLQJobResponse:
@@ -57,6 +61,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.DeepHealthCheckResponse:
 		return nil
+	case *adminservice.DeleteBusinessCalendarRequest:
+		return nil
+	case *adminservice.DeleteBusinessCalendarResponse:
+		return nil
 	case *adminservice.DeleteWorkflowExecutionRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -101,6 +109,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.GenerateLastHistoryReplicationTasksResponse:
 		return nil
+	case *adminservice.GetBatchOperationReportRequest:
+		return nil
+	case *adminservice.GetBatchOperationReportResponse:
+		return nil
 	case *adminservice.GetDLQMessagesRequest:
 		return nil
 	case *adminservice.GetDLQMessagesResponse:
@@ -158,6 +170,14 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.ImportWorkflowExecutionResponse:
 		return nil
+	case *adminservice.ListAuditEventsRequest:
+		return nil
+	case *adminservice.ListAuditEventsResponse:
+		return nil
+	case *adminservice.ListBusinessCalendarsRequest:
+		return nil
+	case *adminservice.ListBusinessCalendarsResponse:
+		return nil
 	case *adminservice.ListClusterMembersRequest:
 		return nil
 	case *adminservice.ListClusterMembersResponse:
@@ -182,6 +202,12 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.MergeDLQTasksResponse:
 		return nil
+	case *adminservice.MigrateTaskQueueBacklogRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetWorkflowId()),
+		}
+	case *adminservice.MigrateTaskQueueBacklogResponse:
+		return nil
 	case *adminservice.PurgeDLQMessagesRequest:
 		return nil
 	case *adminservice.PurgeDLQMessagesResponse:
@@ -230,6 +256,18 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.ResendReplicationTasksResponse:
 		return nil
+	case *adminservice.SetScheduleBusinessCalendarsRequest:
+		return nil
+	case *adminservice.SetScheduleBusinessCalendarsResponse:
+		return nil
+	case *adminservice.SimulateScheduleRequest:
+		return nil
+	case *adminservice.SimulateScheduleResponse:
+		return nil
+	case *adminservice.StartBatchOperationRequest:
+		return nil
+	case *adminservice.StartBatchOperationResponse:
+		return nil
 	case *adminservice.SyncWorkflowStateRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -237,6 +275,14 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.SyncWorkflowStateResponse:
 		return nil
+	case *adminservice.UpdateActivityTypeRateLimitsRequest:
+		return nil
+	case *adminservice.UpdateActivityTypeRateLimitsResponse:
+		return nil
+	case *adminservice.UpsertBusinessCalendarRequest:
+		return nil
+	case *adminservice.UpsertBusinessCalendarResponse:
+		return nil
 	default:
 		return nil
 	}
@@ -452,6 +452,13 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		}
 	case *historyservice.UpdateWorkflowExecutionOptionsResponse:
 		return nil
+	case *historyservice.UpsertWorkflowExecutionPropertiesRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *historyservice.UpsertWorkflowExecutionPropertiesResponse:
+		return nil
 	case *historyservice.VerifyChildExecutionCompletionRecordedRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetParentExecution().GetWorkflowId()),
@@ -115,6 +115,12 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		return nil
 	case *matchingservice.ListTaskQueuePartitionsResponse:
 		return nil
+	case *matchingservice.MigrateTaskQueueBacklogRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetWorkflowId()),
+		}
+	case *matchingservice.MigrateTaskQueueBacklogResponse:
+		return nil
 	case *matchingservice.PollActivityTaskQueueRequest:
 		return nil
 	case *matchingservice.PollActivityTaskQueueResponse:
@@ -160,6 +166,10 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		return nil
 	case *matchingservice.SyncDeploymentUserDataResponse:
 		return nil
+	case *matchingservice.UpdateActivityTypeRateLimitsRequest:
+		return nil
+	case *matchingservice.UpdateActivityTypeRateLimitsResponse:
+		return nil
 	case *matchingservice.UpdateNexusEndpointRequest:
 		return nil
 	case *matchingservice.UpdateNexusEndpointResponse:
@@ -33,6 +33,7 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/service/frontend/configs"
@@ -41,6 +42,11 @@ import (
 
 const (
 	NamespaceRateLimitDefaultToken = 1
+
+	// CallerFairnessKeyIdentity and CallerFairnessKeyClientName are the supported values of
+	// the frontend.callerFairnessKey dynamic config
+	CallerFairnessKeyIdentity   = "identity"
+	CallerFairnessKeyClientName = "clientName"
 )
 
 var (
@@ -49,6 +55,11 @@ var (
 		Scope:   enumspb.RESOURCE_EXHAUSTED_SCOPE_NAMESPACE,
 		Message: "namespace rate limit exceeded",
 	}
+	ErrCallerRateLimitServerBusy = &serviceerror.ResourceExhausted{
+		Cause:   enumspb.RESOURCE_EXHAUSTED_CAUSE_RPS_LIMIT,
+		Scope:   enumspb.RESOURCE_EXHAUSTED_SCOPE_NAMESPACE,
+		Message: "caller exceeded its share of the namespace rate limit",
+	}
 )
 
 type (
@@ -62,6 +73,18 @@ type (
 		rateLimiter                       quotas.RequestRateLimiter
 		tokens                            map[string]int
 		reducePollWorkflowHistoryPriority dynamicconfig.BoolPropertyFn
+		callerFairness                    CallerFairness
+	}
+
+	// CallerFairness limits each caller within a namespace to its share of the namespace rate
+	// limits. RateLimiter is keyed by namespace (Request.Caller) and caller (Request.Source).
+	// Only the callers listed in Weights are tagged by name on metrics.
+	CallerFairness struct {
+		RateLimiter    quotas.RequestRateLimiter
+		Enabled        dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		Key            dynamicconfig.StringPropertyFnWithNamespaceFilter
+		Weights        dynamicconfig.TypedPropertyFnWithNamespaceFilter[map[string]float64]
+		MetricsHandler metrics.Handler
 	}
 )
 
@@ -73,12 +96,14 @@ func NewNamespaceRateLimitInterceptor(
 	rateLimiter quotas.RequestRateLimiter,
 	tokens map[string]int,
 	reducePollWorkflowHistoryPriority dynamicconfig.BoolPropertyFn,
+	callerFairness CallerFairness,
 ) NamespaceRateLimitInterceptor {
 	return &NamespaceRateLimitInterceptorImpl{
 		namespaceRegistry:                 namespaceRegistry,
 		rateLimiter:                       rateLimiter,
 		tokens:                            tokens,
 		reducePollWorkflowHistoryPriority: reducePollWorkflowHistoryPriority,
+		callerFairness:                    callerFairness,
 	}
 }
 
@@ -93,7 +118,8 @@ func (ni *NamespaceRateLimitInterceptorImpl) Intercept(
 		if ni.reducePollWorkflowHistoryPriority() && isLongPollGetHistoryRequest(req) {
 			method = configs.PollWorkflowHistoryAPIName
 		}
-		if err := ni.Allow(ns, method, headers.NewGRPCHeaderGetter(ctx)); err != nil {
+		headerGetter := headers.NewGRPCHeaderGetter(ctx)
+		if err := ni.allow(ns, method, ni.caller(ns, req, headerGetter), headerGetter); err != nil {
 			return nil, err
 		}
 	}
@@ -102,24 +128,83 @@ func (ni *NamespaceRateLimitInterceptorImpl) Intercept(
 }
 
 func (ni *NamespaceRateLimitInterceptorImpl) Allow(namespaceName namespace.Name, methodName string, headerGetter headers.HeaderGetter) error {
+	return ni.allow(namespaceName, methodName, ni.caller(namespaceName, nil, headerGetter), headerGetter)
+}
+
+func (ni *NamespaceRateLimitInterceptorImpl) allow(
+	namespaceName namespace.Name,
+	methodName string,
+	caller string,
+	headerGetter headers.HeaderGetter,
+) error {
 	token, ok := ni.tokens[methodName]
 	if !ok {
 		token = NamespaceRateLimitDefaultToken
 	}
 
-	if !ni.rateLimiter.Allow(time.Now().UTC(), quotas.NewRequest(
+	now := time.Now().UTC()
+	request := quotas.NewRequest(
 		methodName,
 		token,
 		namespaceName.String(),
 		headerGetter.Get(headers.CallerTypeHeaderName),
 		0,  // this interceptor layer does not throttle based on caller segment
 		"", // this interceptor layer does not throttle based on call initiation
-	)) {
+	)
+	request.Source = caller
+
+	// the caller's share is checked first, so that a caller over its share doesn't use up
+	// namespace tokens that other callers could use
+	if caller != "" && !ni.callerFairness.RateLimiter.Allow(now, request) {
+		metrics.CallerRateLimitedCounter.With(ni.callerFairness.MetricsHandler).Record(
+			1,
+			metrics.NamespaceTag(namespaceName.String()),
+			ni.callerTag(namespaceName, caller),
+			metrics.OperationTag(methodName),
+		)
+		return ErrCallerRateLimitServerBusy
+	}
+	if !ni.rateLimiter.Allow(now, request) {
 		return ErrNamespaceRateLimitServerBusy
 	}
 	return nil
 }
 
+// caller returns the caller the request is attributed to for fair sharing, or an empty string
+// if caller fairness is disabled or the caller is unknown. req may be nil.
+func (ni *NamespaceRateLimitInterceptorImpl) caller(
+	namespaceName namespace.Name,
+	req any,
+	headerGetter headers.HeaderGetter,
+) string {
+	if ni.callerFairness.RateLimiter == nil || !ni.callerFairness.Enabled(namespaceName.String()) {
+		return ""
+	}
+	switch ni.callerFairness.Key(namespaceName.String()) {
+	case CallerFairnessKeyIdentity:
+		if r, ok := req.(identityGetter); ok {
+			return r.GetIdentity()
+		}
+	case CallerFairnessKeyClientName:
+		return headerGetter.Get(headers.ClientNameHeaderName)
+	}
+	return ""
+}
+
+// callerTag tags the callers that have a configured weight by name. The caller is a
+// client-supplied string, so all other callers share one tag value to bound the cardinality.
+func (ni *NamespaceRateLimitInterceptorImpl) callerTag(
+	namespaceName namespace.Name,
+	caller string,
+) metrics.Tag {
+	if ni.callerFairness.Weights != nil {
+		if _, ok := ni.callerFairness.Weights(namespaceName.String())[caller]; ok {
+			return metrics.CallerTag(caller)
+		}
+	}
+	return metrics.CallerOtherTag()
+}
+
 func isLongPollGetHistoryRequest(
 	req interface{},
 ) bool {
@@ -115,7 +115,7 @@ func newSchedulerTree(
 	patch *schedulepb.SchedulePatch,
 ) *hsm.Node {
 	// Add Scheduler root node
-	s := scheduler.NewScheduler(namespace, namespaceID, scheduleID, sched, patch)
+	s := scheduler.NewScheduler(namespace, namespaceID, scheduleID, sched, patch, nil)
 	schedulerNode, err := root.AddChild(hsm.Key{
 		Type: scheduler.SchedulerMachineType,
 		ID:   scheduleID,
@@ -77,11 +77,13 @@ var (
 )
 
 // NewScheduler returns an initialized Scheduler state machine (without any sub
-// state machines).
+// state machines). The definitions of the referenced business calendars are
+// resolved from the namespace whenever the schedule is evaluated.
 func NewScheduler(
 	namespace, namespaceID, scheduleID string,
 	sched *schedulepb.Schedule,
 	patch *schedulepb.SchedulePatch,
+	businessCalendars []*schedulespb.ScheduleBusinessCalendar,
 ) *Scheduler {
 	var zero time.Time
 	return &Scheduler{
@@ -99,11 +101,12 @@ func NewScheduler(
 				CreateTime:          timestamppb.Now(),
 				UpdateTime:          timestamppb.New(zero),
 			},
-			InitialPatch:  patch,
-			Namespace:     namespace,
-			NamespaceId:   namespaceID,
-			ScheduleId:    scheduleID,
-			ConflictToken: scheduler.InitialConflictToken,
+			InitialPatch:      patch,
+			Namespace:         namespace,
+			NamespaceId:       namespaceID,
+			ScheduleId:        scheduleID,
+			ConflictToken:     scheduler.InitialConflictToken,
+			BusinessCalendars: businessCalendars,
 		},
 		cacheConflictToken: scheduler.InitialConflictToken,
 		compiledSpec:       nil,
@@ -199,7 +202,7 @@ func (s *Scheduler) getCompiledSpec(specBuilder *scheduler.SpecBuilder) (*schedu
 
 	// Cache compiled spec.
 	if s.compiledSpec == nil {
-		cspec, err := specBuilder.NewCompiledSpec(s.Schedule.Spec)
+		cspec, err := specBuilder.NewCompiledSpecWithBusinessCalendars(s.Schedule.Spec, s.BusinessCalendars)
 		if err != nil {
 			return nil, err
 		}
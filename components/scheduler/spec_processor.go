@@ -25,14 +25,18 @@
 package scheduler
 
 import (
+	"slices"
 	"time"
 
 	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
 	scheduler1 "go.temporal.io/server/service/worker/scheduler"
 	"go.uber.org/fx"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -59,10 +63,11 @@ type (
 	SpecProcessorImpl struct {
 		fx.In
 
-		Config         *Config
-		MetricsHandler metrics.Handler
-		Logger         log.Logger
-		SpecBuilder    *scheduler1.SpecBuilder
+		Config            *Config
+		MetricsHandler    metrics.Handler
+		Logger            log.Logger
+		SpecBuilder       *scheduler1.SpecBuilder
+		NamespaceRegistry namespace.Registry
 	}
 
 	ProcessedTimeRange struct {
@@ -80,6 +85,7 @@ func (s SpecProcessorImpl) ProcessTimeRange(
 ) (*ProcessedTimeRange, error) {
 	tweakables := s.Config.Tweakables(scheduler.Namespace)
 	overlapPolicy := scheduler.overlapPolicy()
+	scheduler = s.resolveBusinessCalendars(scheduler)
 
 	s.Logger.Debug("ProcessTimeRange",
 		tag.NewTimeTag("start", start),
@@ -153,6 +159,34 @@ func (s SpecProcessorImpl) ProcessTimeRange(
 	}, nil
 }
 
+// resolveBusinessCalendars returns the scheduler with the definitions of its business calendars
+// resolved from the namespace, so that calendar updates apply to existing schedules.
+func (s SpecProcessorImpl) resolveBusinessCalendars(scheduler Scheduler) Scheduler {
+	if len(scheduler.BusinessCalendars) == 0 {
+		return scheduler
+	}
+	ns, err := s.NamespaceRegistry.GetNamespaceByID(namespace.ID(scheduler.NamespaceId))
+	if err != nil {
+		// keep using the last known definitions
+		s.Logger.Warn("Failed to resolve business calendars", tag.Error(err))
+		return scheduler
+	}
+	resolved, err := scheduler1.ResolveBusinessCalendars(ns, scheduler.BusinessCalendars)
+	if err != nil {
+		s.Logger.Warn("Schedule references missing business calendars", tag.Error(err))
+	}
+	if slices.EqualFunc(scheduler.BusinessCalendars, resolved, func(a, b *schedulespb.ScheduleBusinessCalendar) bool {
+		return proto.Equal(a, b)
+	}) {
+		return scheduler
+	}
+
+	scheduler.SchedulerInternal = common.CloneProto(scheduler.SchedulerInternal)
+	scheduler.BusinessCalendars = resolved
+	scheduler.compiledSpec = nil
+	return scheduler
+}
+
 func catchupWindow(s Scheduler, tweakables Tweakables) time.Duration {
 	cw := s.Schedule.Policies.CatchupWindow
 	if cw == nil {
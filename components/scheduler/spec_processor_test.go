@@ -30,8 +30,12 @@ import (
 
 	"github.com/stretchr/testify/require"
 	enumspb "go.temporal.io/api/enums/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	namespacepkg "go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/components/scheduler"
 	scheduler1 "go.temporal.io/server/service/worker/scheduler"
 	"go.uber.org/mock/gomock"
@@ -42,7 +46,8 @@ type (
 	testSpecProcessor struct {
 		scheduler.SpecProcessor
 
-		mockMetrics *metrics.MockHandler
+		mockMetrics  *metrics.MockHandler
+		mockRegistry *namespacepkg.MockRegistry
 	}
 )
 
@@ -51,6 +56,7 @@ func newTestSpecProcessor(ctrl *gomock.Controller) *testSpecProcessor {
 	mockMetrics.EXPECT().Counter(gomock.Any()).Return(metrics.NoopCounterMetricFunc).AnyTimes()
 	mockMetrics.EXPECT().WithTags(gomock.Any()).Return(mockMetrics).AnyTimes()
 	mockMetrics.EXPECT().Timer(gomock.Any()).Return(metrics.NoopTimerMetricFunc).AnyTimes()
+	mockRegistry := namespacepkg.NewMockRegistry(ctrl)
 
 	return &testSpecProcessor{
 		SpecProcessor: scheduler.SpecProcessorImpl{
@@ -59,11 +65,13 @@ func newTestSpecProcessor(ctrl *gomock.Controller) *testSpecProcessor {
 					return scheduler.DefaultTweakables
 				},
 			},
-			MetricsHandler: mockMetrics,
-			Logger:         log.NewTestLogger(),
-			SpecBuilder:    scheduler1.NewSpecBuilder(),
+			MetricsHandler:    mockMetrics,
+			Logger:            log.NewTestLogger(),
+			SpecBuilder:       scheduler1.NewSpecBuilder(),
+			NamespaceRegistry: mockRegistry,
 		},
-		mockMetrics: mockMetrics,
+		mockMetrics:  mockMetrics,
+		mockRegistry: mockRegistry,
 	}
 }
 
@@ -74,7 +82,7 @@ func setupSpecProcessor(t *testing.T) *testSpecProcessor {
 
 func TestProcessTimeRange_LimitedActions(t *testing.T) {
 	processor := setupSpecProcessor(t)
-	s := *scheduler.NewScheduler(namespace, namespaceID, scheduleID, defaultSchedule(), nil)
+	s := *scheduler.NewScheduler(namespace, namespaceID, scheduleID, defaultSchedule(), nil, nil)
 	end := time.Now()
 	start := end.Add(-defaultInterval)
 
@@ -103,7 +111,7 @@ func TestProcessTimeRange_LimitedActions(t *testing.T) {
 
 func TestProcessTimeRange_UpdateAfterHighWatermark(t *testing.T) {
 	processor := setupSpecProcessor(t)
-	s := *scheduler.NewScheduler(namespace, namespaceID, scheduleID, defaultSchedule(), nil)
+	s := *scheduler.NewScheduler(namespace, namespaceID, scheduleID, defaultSchedule(), nil, nil)
 
 	// Below window would give 6 actions, but the update time halves that.
 	base := time.Now()
@@ -120,7 +128,7 @@ func TestProcessTimeRange_UpdateAfterHighWatermark(t *testing.T) {
 
 func TestProcessTimeRange_CatchupWindow(t *testing.T) {
 	processor := setupSpecProcessor(t)
-	s := *scheduler.NewScheduler(namespace, namespaceID, scheduleID, defaultSchedule(), nil)
+	s := *scheduler.NewScheduler(namespace, namespaceID, scheduleID, defaultSchedule(), nil, nil)
 
 	// When an action would fall outside of the schedule's catchup window, it should
 	// be dropped.
@@ -132,9 +140,53 @@ func TestProcessTimeRange_CatchupWindow(t *testing.T) {
 	require.Equal(t, 5, len(res.BufferedStarts))
 }
 
+func TestProcessTimeRange_BusinessCalendar(t *testing.T) {
+	processor := setupSpecProcessor(t)
+	s := *scheduler.NewScheduler(namespace, namespaceID, scheduleID, defaultSchedule(), nil,
+		[]*schedulespb.ScheduleBusinessCalendar{{
+			Name:   "holidays",
+			Action: enumsspb.BUSINESS_CALENDAR_ACTION_SKIP,
+		}})
+	end := time.Date(2024, 12, 25, 12, 0, 0, 0, time.UTC)
+	start := end.Add(-defaultInterval * 3)
+
+	expectCalendar := func(holidays ...*schedulespb.BusinessCalendarDate) {
+		processor.mockRegistry.EXPECT().GetNamespaceByID(namespacepkg.ID(namespaceID)).Return(namespacepkg.NewLocalNamespaceForTest(
+			&persistencespb.NamespaceInfo{Id: namespaceID, Name: namespace},
+			&persistencespb.NamespaceConfig{
+				BusinessCalendars: map[string]*schedulespb.BusinessCalendar{
+					"holidays": {Name: "holidays", Holidays: holidays},
+				},
+			},
+			"active",
+		), nil)
+	}
+
+	// Calendar definitions are resolved from the namespace, so updates apply to existing schedules.
+	expectCalendar()
+	res, err := processor.ProcessTimeRange(s, start, end, false, nil)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(res.BufferedStarts))
+
+	// Actions on holidays are skipped.
+	expectCalendar(&schedulespb.BusinessCalendarDate{Month: 12, Day: 25})
+	res, err = processor.ProcessTimeRange(s, start, end, false, nil)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(res.BufferedStarts))
+	require.Equal(t, time.Date(2024, 12, 26, 0, 0, 0, 0, time.UTC), res.NextWakeupTime)
+
+	// Or moved to the next business day.
+	s.BusinessCalendars[0].Action = enumsspb.BUSINESS_CALENDAR_ACTION_SHIFT_NEXT_BUSINESS_DAY
+	expectCalendar(&schedulespb.BusinessCalendarDate{Month: 12, Day: 25})
+	res, err = processor.ProcessTimeRange(s, start, end, false, nil)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(res.BufferedStarts))
+	require.Equal(t, start.Add(defaultInterval).AddDate(0, 0, 1), res.NextWakeupTime)
+}
+
 func TestProcessTimeRange_Limit(t *testing.T) {
 	processor := setupSpecProcessor(t)
-	s := *scheduler.NewScheduler(namespace, namespaceID, scheduleID, defaultSchedule(), nil)
+	s := *scheduler.NewScheduler(namespace, namespaceID, scheduleID, defaultSchedule(), nil, nil)
 	end := time.Now()
 	start := end.Add(-defaultInterval * 5)
 
@@ -151,7 +203,7 @@ func TestProcessTimeRange_Limit(t *testing.T) {
 
 func TestProcessTimeRange_OverlapPolicy(t *testing.T) {
 	processor := setupSpecProcessor(t)
-	s := *scheduler.NewScheduler(namespace, namespaceID, scheduleID, defaultSchedule(), nil)
+	s := *scheduler.NewScheduler(namespace, namespaceID, scheduleID, defaultSchedule(), nil, nil)
 	end := time.Now()
 	start := end.Add(-defaultInterval * 5)
 
@@ -179,7 +231,7 @@ func TestProcessTimeRange_OverlapPolicy(t *testing.T) {
 
 func TestProcessTimeRange_Basic(t *testing.T) {
 	processor := setupSpecProcessor(t)
-	s := *scheduler.NewScheduler(namespace, namespaceID, scheduleID, defaultSchedule(), nil)
+	s := *scheduler.NewScheduler(namespace, namespaceID, scheduleID, defaultSchedule(), nil, nil)
 	end := time.Now()
 	start := end.Add(-defaultInterval * 5)
 
@@ -31,17 +31,21 @@ import (
 	"io"
 	"maps"
 	"net"
+	"slices"
+	"strconv"
 	"strings"
 	"sync/atomic"
 	"time"
 
 	"github.com/pborman/uuid"
+	"github.com/temporalio/sqlparser"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	namespacepb "go.temporal.io/api/namespace/v1"
 	replicationpb "go.temporal.io/api/replication/v1"
 	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	sdkclient "go.temporal.io/sdk/client"
@@ -54,6 +58,7 @@ import (
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
 	serverClient "go.temporal.io/server/client"
 	"go.temporal.io/server/client/admin"
 	"go.temporal.io/server/client/frontend"
@@ -71,6 +76,8 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/namespace/nsreplication"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility"
@@ -85,7 +92,9 @@ import (
 	"go.temporal.io/server/common/xdc"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/worker/addsearchattributes"
+	"go.temporal.io/server/service/worker/batcher"
 	"go.temporal.io/server/service/worker/dlq"
+	"go.temporal.io/server/service/worker/scheduler"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -95,6 +104,7 @@ const (
 	getNamespaceReplicationMessageBatchSize = 100
 	defaultLastMessageID                    = -1
 	listClustersPageSize                    = 100
+	listAuditEventsPageSize                 = 100
 )
 
 type (
@@ -109,6 +119,7 @@ type (
 		ESClient                   esclient.Client
 		config                     *Config
 		namespaceDLQHandler        nsreplication.DLQMessageHandler
+		namespaceReplicator        nsreplication.Replicator
 		eventSerializer            serialization.Serializer
 		visibilityMgr              manager.VisibilityManager
 		persistenceExecutionName   string
@@ -131,6 +142,10 @@ type (
 		clusterMetadata            cluster.Metadata
 		healthServer               *health.Server
 		historyHealthChecker       HealthChecker
+		scheduleSpecBuilder        *scheduler.SpecBuilder
+		timeSource                 clock.TimeSource
+		auditLogManager            persistence.AuditLogManager
+		batchFailureManifestMgr    persistence.BatchFailureManifestManager
 
 		// DEPRECATED: only history service on server side is supposed to
 		// use the following components.
@@ -165,6 +180,9 @@ type (
 		HealthServer                        *health.Server
 		EventSerializer                     serialization.Serializer
 		TimeSource                          clock.TimeSource
+		ScheduleSpecBuilder                 *scheduler.SpecBuilder
+		AuditLogManager                     persistence.AuditLogManager
+		BatchFailureManifestManager         persistence.BatchFailureManifestManager
 
 		// DEPRECATED: only history service on server side is supposed to
 		// use the following components.
@@ -213,6 +231,7 @@ func NewAdminHandler(
 			args.NamespaceReplicationQueue,
 			args.Logger,
 		),
+		namespaceReplicator:        nsreplication.NewReplicator(args.ReplicatorNamespaceReplicationQueue, args.Logger),
 		eventSerializer:            args.EventSerializer,
 		visibilityMgr:              args.visibilityMgr,
 		ESClient:                   args.EsClient,
@@ -245,11 +264,15 @@ func NewAdminHandler(
 			),
 			args.Config.SuppressErrorSetSystemSearchAttribute,
 		),
-		clusterMetadata:      args.ClusterMetadata,
-		healthServer:         args.HealthServer,
-		historyHealthChecker: historyHealthChecker,
-		taskCategoryRegistry: args.CategoryRegistry,
-		matchingClient:       args.matchingClient,
+		clusterMetadata:         args.ClusterMetadata,
+		healthServer:            args.HealthServer,
+		historyHealthChecker:    historyHealthChecker,
+		scheduleSpecBuilder:     args.ScheduleSpecBuilder,
+		timeSource:              args.TimeSource,
+		auditLogManager:         args.AuditLogManager,
+		batchFailureManifestMgr: args.BatchFailureManifestManager,
+		taskCategoryRegistry:    args.CategoryRegistry,
+		matchingClient:          args.matchingClient,
 	}
 }
 
@@ -1755,6 +1778,815 @@ func (adh *AdminHandler) ForceUnloadTaskQueuePartition(
 	}, nil
 }
 
+// MigrateTaskQueueBacklog copies or moves one batch of backlog tasks from a task queue partition
+// to another task queue
+func (adh *AdminHandler) MigrateTaskQueueBacklog(
+	ctx context.Context,
+	request *adminservice.MigrateTaskQueueBacklogRequest,
+) (_ *adminservice.MigrateTaskQueueBacklogResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	// validate request
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.Namespace) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if request.GetTaskQueuePartition() == nil {
+		return nil, errTaskQueueNotSet
+	}
+	if len(request.GetTargetTaskQueue()) == 0 {
+		return nil, serviceerror.NewInvalidArgument("Target task queue is not set on request.")
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.matchingClient.MigrateTaskQueueBacklog(ctx, &matchingservice.MigrateTaskQueueBacklogRequest{
+		NamespaceId:        namespaceID.String(),
+		TaskQueuePartition: request.GetTaskQueuePartition(),
+		BuildId:            request.GetBuildId(),
+		TargetTaskQueue:    request.GetTargetTaskQueue(),
+		Copy:               request.GetCopy(),
+		WorkflowId:         request.GetWorkflowId(),
+		Subqueue:           request.GetSubqueue(),
+		LastTaskId:         request.GetLastTaskId(),
+		BatchSize:          request.GetBatchSize(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminservice.MigrateTaskQueueBacklogResponse{
+		Subqueue:      resp.GetSubqueue(),
+		LastTaskId:    resp.GetLastTaskId(),
+		TasksScanned:  resp.GetTasksScanned(),
+		TasksMigrated: resp.GetTasksMigrated(),
+		Done:          resp.GetDone(),
+	}, nil
+}
+
+// UpdateActivityTypeRateLimits adds, replaces or removes per-activity-type dispatch rate limits of a
+// task queue
+func (adh *AdminHandler) UpdateActivityTypeRateLimits(
+	ctx context.Context,
+	request *adminservice.UpdateActivityTypeRateLimitsRequest,
+) (_ *adminservice.UpdateActivityTypeRateLimitsResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	// validate request
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.Namespace) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetTaskQueue()) == 0 {
+		return nil, errTaskQueueNotSet
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.matchingClient.UpdateActivityTypeRateLimits(ctx, &matchingservice.UpdateActivityTypeRateLimitsRequest{
+		NamespaceId:         namespaceID.String(),
+		TaskQueue:           request.GetTaskQueue(),
+		SetRateLimits:       request.GetSetRateLimits(),
+		RemoveActivityTypes: request.GetRemoveActivityTypes(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminservice.UpdateActivityTypeRateLimitsResponse{
+		RateLimits: resp.GetRateLimits(),
+	}, nil
+}
+
+// AggregateWorkflowExecutions computes a histogram and/or percentiles over the workflow executions
+// matching a visibility query
+func (adh *AdminHandler) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.AggregateWorkflowExecutionsRequest,
+) (_ *adminservice.AggregateWorkflowExecutionsResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	// validate request
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.Namespace) == 0 {
+		return nil, errNamespaceNotSet
+	}
+
+	nsName := namespace.Name(request.GetNamespace())
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(nsName)
+	if err != nil {
+		return nil, err
+	}
+
+	aggRequest := &manager.AggregateWorkflowExecutionsRequest{
+		NamespaceID: namespaceID,
+		Namespace:   nsName,
+		Query:       request.GetQuery(),
+	}
+	if h := request.GetHistogram(); h != nil {
+		aggRequest.Histogram = &manager.HistogramAggregation{
+			Field:      h.GetField(),
+			Interval:   h.GetInterval().AsDuration(),
+			GroupBy:    h.GetGroupBy(),
+			MaxBuckets: adh.config.VisibilityMaxHistogramBuckets(request.GetNamespace()),
+		}
+	}
+	if p := request.GetPercentiles(); p != nil {
+		aggRequest.Percentiles = &manager.PercentilesAggregation{
+			Field:    p.GetField(),
+			Percents: p.GetPercents(),
+		}
+	}
+	resp, err := adh.visibilityMgr.AggregateWorkflowExecutions(ctx, aggRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &adminservice.AggregateWorkflowExecutionsResponse{}
+	for _, bucket := range resp.Buckets {
+		resultBucket := &adminservice.AggregateWorkflowExecutionsResponse_HistogramBucket{
+			StartTime: timestamppb.New(bucket.StartTime),
+			Count:     bucket.Count,
+		}
+		for _, group := range bucket.Groups {
+			resultBucket.Groups = append(resultBucket.Groups, &adminservice.AggregateWorkflowExecutionsResponse_AggregationGroup{
+				GroupValue: group.GetGroupValues()[0],
+				Count:      group.GetCount(),
+			})
+		}
+		result.Buckets = append(result.Buckets, resultBucket)
+	}
+	for _, percentile := range resp.Percentiles {
+		result.Percentiles = append(result.Percentiles, &adminservice.AggregateWorkflowExecutionsResponse_PercentileValue{
+			Percent: percentile.Percent,
+			Value:   percentile.Value,
+		})
+	}
+	return result, nil
+}
+
+// StartBatchOperation starts a batch operation of a type that is not available in the public API, or
+// any batch operation as a dry run
+func (adh *AdminHandler) StartBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartBatchOperationRequest,
+) (_ *adminservice.StartBatchOperationResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	// validate request
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetJobId()) == 0 {
+		return nil, errBatchJobIDNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetVisibilityQuery()) == 0 && len(request.GetExecutions()) == 0 {
+		return nil, errBatchOpsWorkflowFilterNotSet
+	}
+	if len(request.GetVisibilityQuery()) != 0 && len(request.GetExecutions()) != 0 {
+		return nil, errBatchOpsWorkflowFiltersNotAllowed
+	}
+	if len(request.GetExecutions()) > adh.config.MaxExecutionCountBatchOperation(request.GetNamespace()) {
+		return nil, errBatchOpsMaxWorkflowExecutionCount
+	}
+	if len(request.GetReason()) == 0 {
+		return nil, errReasonNotSet
+	}
+	if request.GetOperation() == nil {
+		return nil, errBatchOperationNotSet
+	}
+	if !adh.config.EnableBatcher(request.GetNamespace()) {
+		return nil, errBatchAPINotAllowed
+	}
+
+	nsName := namespace.Name(request.GetNamespace())
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(nsName)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &batcher.BatchParams{
+		Namespace:  request.GetNamespace(),
+		Query:      request.GetVisibilityQuery(),
+		Executions: request.GetExecutions(),
+		Reason:     request.GetReason(),
+		RPS:        float64(request.GetMaxOperationsPerSecond()),
+		DryRun:     request.GetDryRun(),
+	}
+	switch op := request.GetOperation().(type) {
+	case *adminservice.StartBatchOperationRequest_UpsertPropertiesOperation:
+		input.BatchType = batcher.BatchTypeUpsertProperties
+		searchAttributes := op.UpsertPropertiesOperation.GetSearchAttributes()
+		memo := op.UpsertPropertiesOperation.GetMemo()
+		if len(searchAttributes.GetIndexedFields()) == 0 && len(memo.GetFields()) == 0 {
+			return nil, serviceerror.NewInvalidArgument("SearchAttributes or Memo must be set.")
+		}
+		searchAttributes, err = searchattribute.UnaliasFields(adh.saMapperProvider, searchAttributes, request.GetNamespace())
+		if err != nil {
+			return nil, err
+		}
+		if err := adh.saValidator.Validate(searchAttributes, request.GetNamespace()); err != nil {
+			return nil, err
+		}
+		if err := adh.saValidator.ValidateSize(searchAttributes, request.GetNamespace()); err != nil {
+			return nil, err
+		}
+		if len(searchAttributes.GetIndexedFields()) > 0 {
+			if err := adh.validateBatchTargetsNotRunning(ctx, namespaceID, nsName, request); err != nil {
+				return nil, err
+			}
+		}
+		input.UpsertPropertiesParams = batcher.UpsertPropertiesParams{
+			SearchAttributes: searchAttributes,
+			Memo:             memo,
+		}
+	case *adminservice.StartBatchOperationRequest_SignalWithStartOperation:
+		input.BatchType = batcher.BatchTypeSignalWithStart
+		signalWithStart := op.SignalWithStartOperation
+		if signalWithStart.GetSignalName() == "" {
+			return nil, serviceerror.NewInvalidArgument("SignalName is not set on request.")
+		}
+		if signalWithStart.GetWorkflowType().GetName() == "" {
+			return nil, serviceerror.NewInvalidArgument("WorkflowType is not set on request.")
+		}
+		if signalWithStart.GetTaskQueue().GetName() == "" {
+			return nil, serviceerror.NewInvalidArgument("TaskQueue is not set on request.")
+		}
+		// Search attributes are unaliased and validated by SignalWithStartWorkflowExecution for each execution.
+		input.SignalWithStartParams = batcher.SignalWithStartParams{
+			WorkflowType:             signalWithStart.GetWorkflowType(),
+			TaskQueue:                signalWithStart.GetTaskQueue(),
+			Input:                    signalWithStart.GetInput(),
+			WorkflowExecutionTimeout: signalWithStart.GetWorkflowExecutionTimeout().AsDuration(),
+			WorkflowRunTimeout:       signalWithStart.GetWorkflowRunTimeout().AsDuration(),
+			WorkflowTaskTimeout:      signalWithStart.GetWorkflowTaskTimeout().AsDuration(),
+			WorkflowIDReusePolicy:    signalWithStart.GetWorkflowIdReusePolicy(),
+			SignalName:               signalWithStart.GetSignalName(),
+			SignalInput:              signalWithStart.GetSignalInput(),
+			Memo:                     signalWithStart.GetMemo(),
+			SearchAttributes:         signalWithStart.GetSearchAttributes(),
+			Header:                   signalWithStart.GetHeader(),
+		}
+	case *adminservice.StartBatchOperationRequest_TerminationOperation:
+		input.BatchType = batcher.BatchTypeTerminate
+	case *adminservice.StartBatchOperationRequest_SignalOperation:
+		input.BatchType = batcher.BatchTypeSignal
+		input.SignalParams = batcher.SignalParams{
+			SignalName: op.SignalOperation.GetSignal(),
+			Input:      op.SignalOperation.GetInput(),
+		}
+	case *adminservice.StartBatchOperationRequest_CancellationOperation:
+		input.BatchType = batcher.BatchTypeCancel
+	case *adminservice.StartBatchOperationRequest_DeletionOperation:
+		input.BatchType = batcher.BatchTypeDelete
+	case *adminservice.StartBatchOperationRequest_ResetOperation:
+		input.BatchType = batcher.BatchTypeReset
+		input.ResetParams, err = newBatchResetParams(op.ResetOperation)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("The operation type %T is not supported", op))
+	}
+
+	// Validate concurrent batch operation
+	countResp, err := adh.visibilityMgr.CountWorkflowExecutions(ctx, &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: namespaceID,
+		Namespace:   nsName,
+		Query:       batcher.OpenBatchOperationQuery,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if int(countResp.Count) >= adh.config.MaxConcurrentBatchOperation(request.GetNamespace()) {
+		return nil, &serviceerror.ResourceExhausted{
+			Cause:   enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT,
+			Scope:   enumspb.RESOURCE_EXHAUSTED_SCOPE_NAMESPACE,
+			Message: "Max concurrent batch operations is reached",
+		}
+	}
+
+	inputPayload, err := sdk.PreferProtoDataConverter.ToPayloads(input)
+	if err != nil {
+		return nil, err
+	}
+
+	memo := &commonpb.Memo{
+		Fields: map[string]*commonpb.Payload{
+			batcher.BatchOperationTypeMemo: payload.EncodeString(input.BatchType),
+			batcher.BatchReasonMemo:        payload.EncodeString(request.GetReason()),
+		},
+	}
+	if input.DryRun {
+		dryRunPayload, err := payload.Encode(true)
+		if err != nil {
+			return nil, err
+		}
+		memo.Fields[batcher.BatchOperationDryRunMemo] = dryRunPayload
+	}
+
+	// Add pre-define search attributes
+	var searchAttributes *commonpb.SearchAttributes
+	searchattribute.AddSearchAttribute(&searchAttributes, searchattribute.BatcherUser, payload.EncodeString(request.GetIdentity()))
+	searchattribute.AddSearchAttribute(&searchAttributes, searchattribute.TemporalNamespaceDivision, payload.EncodeString(batcher.NamespaceDivision))
+
+	startReq := &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:                request.GetNamespace(),
+		WorkflowId:               request.GetJobId(),
+		WorkflowType:             &commonpb.WorkflowType{Name: batcher.BatchWFTypeName},
+		TaskQueue:                &taskqueuepb.TaskQueue{Name: primitives.PerNSWorkerTaskQueue},
+		Input:                    inputPayload,
+		Identity:                 request.GetIdentity(),
+		RequestId:                uuid.New(),
+		WorkflowIdConflictPolicy: enumspb.WORKFLOW_ID_CONFLICT_POLICY_FAIL,
+		WorkflowIdReusePolicy:    enumspb.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+		Memo:                     memo,
+		SearchAttributes:         searchAttributes,
+		Priority:                 &commonpb.Priority{}, // ie default priority
+	}
+
+	_, err = adh.historyClient.StartWorkflowExecution(
+		ctx,
+		common.CreateHistoryStartWorkflowRequest(
+			namespaceID.String(),
+			startReq,
+			nil,
+			nil,
+			time.Now().UTC(),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.StartBatchOperationResponse{}, nil
+}
+
+// validateBatchTargetsNotRunning rejects a batch operation that targets running executions. It's used for
+// search attribute upserts, which have no history event when they don't come from the workflow itself.
+// Visibility is eventually consistent, so executions that start after this check are still rejected one by
+// one by the batch job.
+func (adh *AdminHandler) validateBatchTargetsNotRunning(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	nsName namespace.Name,
+	request *adminservice.StartBatchOperationRequest,
+) error {
+	targetFilter := request.GetVisibilityQuery()
+	if len(targetFilter) == 0 {
+		var workflowIDs, runIDs []string
+		for _, execution := range request.GetExecutions() {
+			if execution.GetRunId() != "" {
+				runIDs = append(runIDs, sqlparser.String(sqlparser.NewStrVal([]byte(execution.GetRunId()))))
+			} else {
+				workflowIDs = append(workflowIDs, sqlparser.String(sqlparser.NewStrVal([]byte(execution.GetWorkflowId()))))
+			}
+		}
+		var filters []string
+		if len(workflowIDs) > 0 {
+			filters = append(filters, fmt.Sprintf("%s IN (%s)", searchattribute.WorkflowID, strings.Join(workflowIDs, ",")))
+		}
+		if len(runIDs) > 0 {
+			filters = append(filters, fmt.Sprintf("%s IN (%s)", searchattribute.RunID, strings.Join(runIDs, ",")))
+		}
+		targetFilter = strings.Join(filters, " OR ")
+	}
+
+	countResp, err := adh.visibilityMgr.CountWorkflowExecutions(ctx, &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: namespaceID,
+		Namespace:   nsName,
+		Query: fmt.Sprintf("(%s) AND %s = %d",
+			targetFilter,
+			searchattribute.ExecutionStatus,
+			int(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING),
+		),
+	})
+	if err != nil {
+		return err
+	}
+	if countResp.Count > 0 {
+		return serviceerror.NewFailedPrecondition(fmt.Sprintf(
+			"Search attributes of running workflow executions can only be upserted by the workflow itself, and %d of the target executions are running. Restrict the batch operation to closed executions.",
+			countResp.Count,
+		))
+	}
+	return nil
+}
+
+// GetBatchOperationReport returns the progress of a batch job and a page of its failure manifest
+func (adh *AdminHandler) GetBatchOperationReport(
+	ctx context.Context,
+	request *adminservice.GetBatchOperationReportRequest,
+) (_ *adminservice.GetBatchOperationReportResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	// validate request
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetJobId()) == 0 {
+		return nil, errBatchJobIDNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if !adh.config.EnableBatcher(request.GetNamespace()) {
+		return nil, errBatchAPINotAllowed
+	}
+	if len(request.GetNextPageToken()) > 0 {
+		pageIndex, err := strconv.Atoi(string(request.GetNextPageToken()))
+		if err != nil || pageIndex <= 0 {
+			return nil, errInvalidNextPageToken
+		}
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	describeResp, err := adh.historyClient.DescribeWorkflowExecution(ctx, &historyservice.DescribeWorkflowExecutionRequest{
+		NamespaceId: namespaceID.String(),
+		Request: &workflowservice.DescribeWorkflowExecutionRequest{
+			Namespace: request.GetNamespace(),
+			Execution: &commonpb.WorkflowExecution{WorkflowId: request.GetJobId()},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	executionInfo := describeResp.GetWorkflowExecutionInfo()
+	if executionInfo.GetType().GetName() != batcher.BatchWFTypeName {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Workflow %v is not a batch job.", request.GetJobId()))
+	}
+
+	response := &adminservice.GetBatchOperationReportResponse{
+		State: getBatchOperationState(executionInfo.GetStatus()),
+	}
+	if dryRunMemo, ok := executionInfo.GetMemo().GetFields()[batcher.BatchOperationDryRunMemo]; ok {
+		if err := payload.Decode(dryRunMemo, &response.DryRun); err != nil {
+			return nil, err
+		}
+	}
+	// The stats memo is recorded when the job completes, or when its activity times out. Other jobs, running or
+	// terminated ones included, still have the last heartbeat details of their batch activity.
+	if statsMemo, ok := executionInfo.GetMemo().GetFields()[batcher.BatchOperationStatsMemo]; ok {
+		var stats batcher.BatchOperationStats
+		if err := payload.Decode(statsMemo, &stats); err != nil {
+			return nil, err
+		}
+		// Jobs completed before resolved count was recorded only have success and failure counts.
+		response.TotalOperationCount = int64(max(stats.NumResolved, stats.NumSuccess+stats.NumFailure))
+		response.ResolvedOperationCount = int64(stats.NumResolved)
+		response.CompleteOperationCount = int64(stats.NumSuccess)
+		response.FailureOperationCount = int64(stats.NumFailure)
+		response.FailureManifestTruncated = stats.FailureManifestTruncated
+	} else if len(describeResp.GetPendingActivities()) > 0 {
+		var hbd batcher.HeartBeatDetails
+		if err := payloads.Decode(describeResp.GetPendingActivities()[0].GetHeartbeatDetails(), &hbd); err != nil {
+			return nil, err
+		}
+		response.TotalOperationCount = hbd.TotalEstimate
+		response.ResolvedOperationCount = int64(hbd.ResolvedCount)
+		response.CompleteOperationCount = int64(hbd.SuccessCount)
+		response.FailureOperationCount = int64(hbd.ErrorCount)
+		response.FailureManifestTruncated = hbd.FailureManifestTruncated
+	}
+
+	// The failure manifest is stored outside of the history of the batch workflow, one page per processed page
+	// of workflows with failures.
+	manifestResp, err := adh.batchFailureManifestMgr.ReadBatchFailures(ctx, &persistence.ReadBatchFailuresRequest{
+		NamespaceID:   namespaceID.String(),
+		JobRunID:      executionInfo.GetExecution().GetRunId(),
+		PageSize:      1,
+		NextPageToken: request.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range manifestResp.Entries {
+		for _, failure := range entry.GetFailures() {
+			response.Failures = append(response.Failures, &adminservice.BatchOperationFailure{
+				Execution: failure.GetExecution(),
+				Error:     failure.GetError(),
+				Attempts:  failure.GetAttempts(),
+			})
+		}
+	}
+	response.NextPageToken = manifestResp.NextPageToken
+	return response, nil
+}
+
+// SimulateSchedule projects the actions a proposed schedule would take over a time range
+func (adh *AdminHandler) SimulateSchedule(
+	ctx context.Context,
+	request *adminservice.SimulateScheduleRequest,
+) (_ *adminservice.SimulateScheduleResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	// validate request
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.Namespace) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if request.Schedule == nil {
+		return nil, errScheduleNotSet
+	}
+	if request.StartTime == nil || request.EndTime == nil {
+		return nil, errSimulationRangeNotSet
+	}
+	if request.EndTime.AsTime().Before(request.StartTime.AsTime()) {
+		return nil, errInvalidSimulationRange
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	var jitterSeed string
+	if request.GetScheduleId() != "" {
+		jitterSeed = scheduler.JitterSeed(namespaceID.String(), request.GetScheduleId())
+	}
+	var resumeTime time.Time
+	if request.SchedulerResumeTime != nil {
+		resumeTime = request.SchedulerResumeTime.AsTime()
+	}
+
+	result, err := scheduler.Simulate(adh.scheduleSpecBuilder, scheduler.SimulationParams{
+		Schedule:    request.GetSchedule(),
+		JitterSeed:  jitterSeed,
+		StartTime:   request.StartTime.AsTime(),
+		EndTime:     request.EndTime.AsTime(),
+		RunDuration: request.GetWorkflowRunDuration().AsDuration(),
+		ResumeTime:  resumeTime,
+		MaxActions:  int(request.GetMaximumActions()),
+	})
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid schedule spec: %v", err))
+	}
+
+	return &adminservice.SimulateScheduleResponse{
+		Actions:             result.Actions,
+		ActionCount:         result.ActionCount,
+		OverlapSkipped:      result.OverlapSkipped,
+		BufferDropped:       result.BufferDropped,
+		MissedCatchupWindow: result.MissedCatchupWindow,
+		Truncated:           result.Truncated,
+	}, nil
+}
+
+// UpsertBusinessCalendar creates or replaces a business calendar of a namespace
+func (adh *AdminHandler) UpsertBusinessCalendar(
+	ctx context.Context,
+	request *adminservice.UpsertBusinessCalendarRequest,
+) (_ *adminservice.UpsertBusinessCalendarResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	// validate request
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.Namespace) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if request.Calendar == nil {
+		return nil, errBusinessCalendarNotSet
+	}
+	if err := adh.scheduleSpecBuilder.ValidateBusinessCalendar(request.Calendar); err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid business calendar: %v", err))
+	}
+
+	calendar := common.CloneProto(request.Calendar)
+	calendar.UpdateTime = timestamppb.New(adh.timeSource.Now())
+	err = adh.updateBusinessCalendars(ctx, request.GetNamespace(), func(calendars map[string]*schedulespb.BusinessCalendar) error {
+		calendars[calendar.GetName()] = calendar
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UpsertBusinessCalendarResponse{Calendar: calendar}, nil
+}
+
+// DeleteBusinessCalendar deletes a business calendar of a namespace. Schedules that reference
+// it keep using its last definition.
+func (adh *AdminHandler) DeleteBusinessCalendar(
+	ctx context.Context,
+	request *adminservice.DeleteBusinessCalendarRequest,
+) (_ *adminservice.DeleteBusinessCalendarResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	// validate request
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.Namespace) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.Name) == 0 {
+		return nil, errBusinessCalendarNameNotSet
+	}
+
+	err = adh.updateBusinessCalendars(ctx, request.GetNamespace(), func(calendars map[string]*schedulespb.BusinessCalendar) error {
+		if _, ok := calendars[request.GetName()]; !ok {
+			return serviceerror.NewNotFound(fmt.Sprintf("Business calendar %s not found.", request.GetName()))
+		}
+		delete(calendars, request.GetName())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DeleteBusinessCalendarResponse{}, nil
+}
+
+// ListBusinessCalendars returns the business calendars of a namespace
+func (adh *AdminHandler) ListBusinessCalendars(
+	ctx context.Context,
+	request *adminservice.ListBusinessCalendarsRequest,
+) (_ *adminservice.ListBusinessCalendarsResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	// validate request
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.Namespace) == 0 {
+		return nil, errNamespaceNotSet
+	}
+
+	resp, err := adh.persistenceMetadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: request.GetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+	calendars := resp.Namespace.Config.GetBusinessCalendars()
+	names := slices.Sorted(maps.Keys(calendars))
+	result := make([]*schedulespb.BusinessCalendar, len(names))
+	for i, name := range names {
+		result[i] = calendars[name]
+	}
+	return &adminservice.ListBusinessCalendarsResponse{Calendars: result}, nil
+}
+
+// SetScheduleBusinessCalendars replaces the business calendars that a schedule references
+func (adh *AdminHandler) SetScheduleBusinessCalendars(
+	ctx context.Context,
+	request *adminservice.SetScheduleBusinessCalendarsRequest,
+) (_ *adminservice.SetScheduleBusinessCalendarsResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	// validate request
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.Namespace) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.ScheduleId) == 0 {
+		return nil, errScheduleIDNotSet
+	}
+	if len(request.GetRequestId()) > adh.config.MaxIDLengthLimit() {
+		return nil, errRequestIDTooLong
+	}
+	if err := scheduler.ValidateBusinessCalendarRefs(request.GetBusinessCalendars()); err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid business calendars: %v", err))
+	}
+	if !adh.config.EnableSchedules(request.GetNamespace()) {
+		return nil, errSchedulesNotAllowed
+	}
+
+	ns, err := adh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	resolved, err := scheduler.ResolveBusinessCalendars(ns, request.GetBusinessCalendars())
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+
+	input, err := sdk.PreferProtoDataConverter.ToPayloads(&schedulespb.SetBusinessCalendarsRequest{
+		BusinessCalendars: resolved,
+	})
+	if err != nil {
+		return nil, err
+	}
+	_, err = adh.historyClient.SignalWorkflowExecution(ctx, &historyservice.SignalWorkflowExecutionRequest{
+		NamespaceId: ns.ID().String(),
+		SignalRequest: &workflowservice.SignalWorkflowExecutionRequest{
+			Namespace:         request.GetNamespace(),
+			WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: scheduler.WorkflowIDPrefix + request.GetScheduleId()},
+			SignalName:        scheduler.SignalNameSetBusinessCalendars,
+			Input:             input,
+			Identity:          request.GetIdentity(),
+			RequestId:         request.GetRequestId(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.SetScheduleBusinessCalendarsResponse{BusinessCalendars: resolved}, nil
+}
+
+// ListAuditEvents returns audit events written by the persistence audit sink, oldest first.
+func (adh *AdminHandler) ListAuditEvents(
+	ctx context.Context,
+	request *adminservice.ListAuditEventsRequest,
+) (_ *adminservice.ListAuditEventsResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetPageSize() <= 0 {
+		request.PageSize = listAuditEventsPageSize
+	}
+
+	resp, err := adh.auditLogManager.ReadAuditEvents(ctx, &persistence.ReadAuditEventsRequest{
+		Namespace:     request.GetNamespace(),
+		PageSize:      int(request.GetPageSize()),
+		NextPageToken: request.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ListAuditEventsResponse{
+		Events:        resp.Events,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+// updateBusinessCalendars applies a change to the business calendars of a namespace.
+func (adh *AdminHandler) updateBusinessCalendars(
+	ctx context.Context,
+	namespaceName string,
+	update func(map[string]*schedulespb.BusinessCalendar) error,
+) error {
+	metadata, err := adh.persistenceMetadataManager.GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	resp, err := adh.persistenceMetadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: namespaceName})
+	if err != nil {
+		return err
+	}
+
+	existingNamespace := resp.Namespace
+	config := existingNamespace.Config
+	if config.BusinessCalendars == nil {
+		config.BusinessCalendars = make(map[string]*schedulespb.BusinessCalendar)
+	}
+	if err := update(config.BusinessCalendars); err != nil {
+		return err
+	}
+
+	configVersion := existingNamespace.ConfigVersion + 1
+	err = adh.persistenceMetadataManager.UpdateNamespace(ctx, &persistence.UpdateNamespaceRequest{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:                        existingNamespace.Info,
+			Config:                      config,
+			ReplicationConfig:           existingNamespace.ReplicationConfig,
+			ConfigVersion:               configVersion,
+			FailoverVersion:             existingNamespace.FailoverVersion,
+			FailoverNotificationVersion: existingNamespace.FailoverNotificationVersion,
+		},
+		IsGlobalNamespace:   resp.IsGlobalNamespace,
+		NotificationVersion: metadata.NotificationVersion,
+	})
+	if err != nil {
+		return err
+	}
+
+	// business calendars are replicated as part of the namespace config, same as namespace updates
+	return adh.namespaceReplicator.HandleTransmissionTask(
+		ctx,
+		enumsspb.NAMESPACE_OPERATION_UPDATE,
+		existingNamespace.Info,
+		config,
+		existingNamespace.ReplicationConfig,
+		false,
+		configVersion,
+		existingNamespace.FailoverVersion,
+		resp.IsGlobalNamespace,
+		existingNamespace.ReplicationConfig.GetFailoverHistory(),
+	)
+}
+
 func (adh *AdminHandler) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
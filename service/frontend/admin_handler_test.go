@@ -32,15 +32,18 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	batchpb "go.temporal.io/api/batch/v1"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	namespacepb "go.temporal.io/api/namespace/v1"
+	schedulepb "go.temporal.io/api/schedule/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
@@ -54,6 +57,8 @@ import (
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/api/matchingservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	clientmocks "go.temporal.io/server/client"
 	historyclient "go.temporal.io/server/client/history"
@@ -63,6 +68,8 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility/manager"
@@ -70,6 +77,7 @@ import (
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resourcetest"
+	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/searchattribute"
 	serviceerror2 "go.temporal.io/server/common/serviceerror"
 	test "go.temporal.io/server/common/testing"
@@ -78,12 +86,16 @@ import (
 	"go.temporal.io/server/common/testing/protorequire"
 	"go.temporal.io/server/common/testing/testvars"
 	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/worker/batcher"
 	"go.temporal.io/server/service/worker/dlq"
+	"go.temporal.io/server/service/worker/scheduler"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type (
@@ -107,6 +119,8 @@ type (
 		mockProducer               *persistence.MockNamespaceReplicationQueue
 		mockMatchingClient         *matchingservicemock.MockMatchingServiceClient
 		mockSaMapper               *searchattribute.MockMapper
+		mockAuditLogManager        *persistence.MockAuditLogManager
+		mockFailureManifestManager *persistence.MockBatchFailureManifestManager
 
 		namespace      namespace.Name
 		namespaceID    namespace.ID
@@ -150,6 +164,8 @@ func (s *adminHandlerSuite) SetupTest() {
 	s.mockMetadata = s.mockResource.ClusterMetadata
 	s.mockVisibilityMgr = s.mockResource.VisibilityManager
 	s.mockProducer = persistence.NewMockNamespaceReplicationQueue(s.controller)
+	s.mockAuditLogManager = persistence.NewMockAuditLogManager(s.controller)
+	s.mockFailureManifestManager = persistence.NewMockBatchFailureManifestManager(s.controller)
 	s.mockMatchingClient = s.mockResource.MatchingClient
 
 	mockSaMapperProvider := searchattribute.NewMockMapperProvider(s.controller)
@@ -164,8 +180,8 @@ func (s *adminHandlerSuite) SetupTest() {
 		NumHistoryShards: 4,
 
 		SearchAttributesNumberOfKeysLimit:     dynamicconfig.GetIntPropertyFnFilteredByNamespace(10),
-		SearchAttributesSizeOfValueLimit:      dynamicconfig.GetIntPropertyFnFilteredByNamespace(10),
-		SearchAttributesTotalSizeLimit:        dynamicconfig.GetIntPropertyFnFilteredByNamespace(10),
+		SearchAttributesSizeOfValueLimit:      dynamicconfig.GetIntPropertyFnFilteredByNamespace(1000),
+		SearchAttributesTotalSizeLimit:        dynamicconfig.GetIntPropertyFnFilteredByNamespace(1000),
 		VisibilityAllowList:                   dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
 		SuppressErrorSetSystemSearchAttribute: dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
 	}
@@ -196,6 +212,9 @@ func (s *adminHandlerSuite) SetupTest() {
 		health.NewServer(),
 		serialization.NewSerializer(),
 		clock.NewRealTimeSource(),
+		scheduler.NewSpecBuilder(),
+		s.mockAuditLogManager,
+		s.mockFailureManifestManager,
 		tasks.NewDefaultTaskCategoryRegistry(),
 		s.mockResource.GetMatchingClient(),
 	}
@@ -1745,6 +1764,797 @@ func (s *adminHandlerSuite) TestForceUnloadTaskQueuePartition() {
 	s.True(resp.WasLoaded)
 }
 
+func (s *adminHandlerSuite) TestMigrateTaskQueueBacklog() {
+	handler := s.handler
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(gomock.Any()).Return(s.namespaceID, nil).AnyTimes()
+
+	tqPartitionRequest := &taskqueuespb.TaskQueuePartition{
+		TaskQueue:     "hello-world",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		PartitionId:   &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: 1},
+	}
+
+	type test struct {
+		Name     string
+		Request  *adminservice.MigrateTaskQueueBacklogRequest
+		Expected error
+	}
+	// request validation tests
+	errorCases := []test{
+		{
+			Name:     "nil request",
+			Request:  nil,
+			Expected: &serviceerror.InvalidArgument{Message: "Request is nil."},
+		},
+		{
+			Name:     "empty request",
+			Request:  &adminservice.MigrateTaskQueueBacklogRequest{},
+			Expected: &serviceerror.InvalidArgument{Message: "Namespace is not set on request."},
+		},
+		{
+			Name: "no task queue",
+			Request: &adminservice.MigrateTaskQueueBacklogRequest{
+				Namespace: s.namespace.String(),
+			},
+			Expected: &serviceerror.InvalidArgument{Message: "TaskQueue is not set on request."},
+		},
+		{
+			Name: "no target task queue",
+			Request: &adminservice.MigrateTaskQueueBacklogRequest{
+				Namespace:          s.namespace.String(),
+				TaskQueuePartition: tqPartitionRequest,
+			},
+			Expected: &serviceerror.InvalidArgument{Message: "Target task queue is not set on request."},
+		},
+	}
+	for _, test := range errorCases {
+		s.T().Run(test.Name, func(t *testing.T) {
+			resp, err := handler.MigrateTaskQueueBacklog(ctx, test.Request)
+			s.Equal(test.Expected, err)
+			s.Nil(resp)
+		})
+	}
+
+	// valid request
+	matchingMockRequest := &matchingservice.MigrateTaskQueueBacklogRequest{
+		NamespaceId:        s.namespaceID.String(),
+		TaskQueuePartition: tqPartitionRequest,
+		TargetTaskQueue:    "hello-world-2",
+		WorkflowId:         "wf",
+		Subqueue:           1,
+		LastTaskId:         100,
+		BatchSize:          10,
+	}
+	matchingMockResponse := &matchingservice.MigrateTaskQueueBacklogResponse{
+		Subqueue:      1,
+		LastTaskId:    110,
+		TasksScanned:  10,
+		TasksMigrated: 3,
+	}
+	s.mockMatchingClient.EXPECT().MigrateTaskQueueBacklog(ctx, matchingMockRequest).Return(matchingMockResponse, nil).Times(1)
+
+	resp, err := handler.MigrateTaskQueueBacklog(ctx, &adminservice.MigrateTaskQueueBacklogRequest{
+		Namespace:          s.namespace.String(),
+		TaskQueuePartition: tqPartitionRequest,
+		TargetTaskQueue:    "hello-world-2",
+		WorkflowId:         "wf",
+		Subqueue:           1,
+		LastTaskId:         100,
+		BatchSize:          10,
+	})
+
+	s.NoError(err)
+	s.Equal(int64(110), resp.GetLastTaskId())
+	s.Equal(int32(10), resp.GetTasksScanned())
+	s.Equal(int32(3), resp.GetTasksMigrated())
+	s.False(resp.GetDone())
+}
+
+func (s *adminHandlerSuite) TestUpdateActivityTypeRateLimits() {
+	handler := s.handler
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(gomock.Any()).Return(s.namespaceID, nil).AnyTimes()
+
+	type test struct {
+		Name     string
+		Request  *adminservice.UpdateActivityTypeRateLimitsRequest
+		Expected error
+	}
+	// request validation tests
+	errorCases := []test{
+		{
+			Name:     "nil request",
+			Request:  nil,
+			Expected: &serviceerror.InvalidArgument{Message: "Request is nil."},
+		},
+		{
+			Name:     "empty request",
+			Request:  &adminservice.UpdateActivityTypeRateLimitsRequest{},
+			Expected: &serviceerror.InvalidArgument{Message: "Namespace is not set on request."},
+		},
+		{
+			Name: "no task queue",
+			Request: &adminservice.UpdateActivityTypeRateLimitsRequest{
+				Namespace: s.namespace.String(),
+			},
+			Expected: &serviceerror.InvalidArgument{Message: "TaskQueue is not set on request."},
+		},
+	}
+	for _, test := range errorCases {
+		s.T().Run(test.Name, func(t *testing.T) {
+			resp, err := handler.UpdateActivityTypeRateLimits(ctx, test.Request)
+			s.Equal(test.Expected, err)
+			s.Nil(resp)
+		})
+	}
+
+	// valid request
+	matchingMockRequest := &matchingservice.UpdateActivityTypeRateLimitsRequest{
+		NamespaceId:         s.namespaceID.String(),
+		TaskQueue:           "hello-world",
+		SetRateLimits:       map[string]float64{"charge": 5},
+		RemoveActivityTypes: []string{"refund"},
+	}
+	matchingMockResponse := &matchingservice.UpdateActivityTypeRateLimitsResponse{
+		RateLimits: map[string]float64{"charge": 5, "ship": 10},
+	}
+	s.mockMatchingClient.EXPECT().UpdateActivityTypeRateLimits(ctx, matchingMockRequest).Return(matchingMockResponse, nil).Times(1)
+
+	resp, err := handler.UpdateActivityTypeRateLimits(ctx, &adminservice.UpdateActivityTypeRateLimitsRequest{
+		Namespace:           s.namespace.String(),
+		TaskQueue:           "hello-world",
+		SetRateLimits:       map[string]float64{"charge": 5},
+		RemoveActivityTypes: []string{"refund"},
+	})
+
+	s.NoError(err)
+	s.Equal(map[string]float64{"charge": 5, "ship": 10}, resp.GetRateLimits())
+}
+
+func (s *adminHandlerSuite) TestAggregateWorkflowExecutions() {
+	handler := s.handler
+	ctx := context.Background()
+	handler.config.VisibilityMaxHistogramBuckets = dynamicconfig.GetIntPropertyFnFilteredByNamespace(500)
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+
+	resp, err := handler.AggregateWorkflowExecutions(ctx, nil)
+	s.Equal(&serviceerror.InvalidArgument{Message: "Request is nil."}, err)
+	s.Nil(resp)
+	resp, err = handler.AggregateWorkflowExecutions(ctx, &adminservice.AggregateWorkflowExecutionsRequest{})
+	s.Equal(&serviceerror.InvalidArgument{Message: "Namespace is not set on request."}, err)
+	s.Nil(resp)
+
+	bucketStart := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	statusPayload := payload.EncodeString("Completed")
+	s.mockVisibilityMgr.EXPECT().AggregateWorkflowExecutions(ctx, &manager.AggregateWorkflowExecutionsRequest{
+		NamespaceID: s.namespaceID,
+		Namespace:   s.namespace,
+		Query:       "WorkflowType = 'wf'",
+		Histogram: &manager.HistogramAggregation{
+			Field:      "CloseTime",
+			Interval:   24 * time.Hour,
+			GroupBy:    "ExecutionStatus",
+			MaxBuckets: 500,
+		},
+		Percentiles: &manager.PercentilesAggregation{
+			Field:    "ExecutionDuration",
+			Percents: []float64{50, 99},
+		},
+	}).Return(&manager.AggregateWorkflowExecutionsResponse{
+		Buckets: []*manager.HistogramBucket{
+			{
+				StartTime: bucketStart,
+				Count:     7,
+				Groups: []*workflowservice.CountWorkflowExecutionsResponse_AggregationGroup{
+					{GroupValues: []*commonpb.Payload{statusPayload}, Count: 7},
+				},
+			},
+		},
+		Percentiles: []*manager.PercentileValue{
+			{Percent: 50, Value: 1e9},
+			{Percent: 99, Value: 5e9},
+		},
+	}, nil)
+
+	resp, err = handler.AggregateWorkflowExecutions(ctx, &adminservice.AggregateWorkflowExecutionsRequest{
+		Namespace: s.namespace.String(),
+		Query:     "WorkflowType = 'wf'",
+		Histogram: &adminservice.AggregateWorkflowExecutionsRequest_HistogramAggregation{
+			Field:    "CloseTime",
+			Interval: durationpb.New(24 * time.Hour),
+			GroupBy:  "ExecutionStatus",
+		},
+		Percentiles: &adminservice.AggregateWorkflowExecutionsRequest_PercentilesAggregation{
+			Field:    "ExecutionDuration",
+			Percents: []float64{50, 99},
+		},
+	})
+	s.NoError(err)
+	s.ProtoEqual(&adminservice.AggregateWorkflowExecutionsResponse{
+		Buckets: []*adminservice.AggregateWorkflowExecutionsResponse_HistogramBucket{
+			{
+				StartTime: timestamppb.New(bucketStart),
+				Count:     7,
+				Groups: []*adminservice.AggregateWorkflowExecutionsResponse_AggregationGroup{
+					{GroupValue: statusPayload, Count: 7},
+				},
+			},
+		},
+		Percentiles: []*adminservice.AggregateWorkflowExecutionsResponse_PercentileValue{
+			{Percent: 50, Value: 1e9},
+			{Percent: 99, Value: 5e9},
+		},
+	}, resp)
+}
+
+func (s *adminHandlerSuite) TestStartBatchOperation() {
+	handler := s.handler
+	ctx := context.Background()
+	handler.config.EnableBatcher = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+	handler.config.MaxConcurrentBatchOperation = dynamicconfig.GetIntPropertyFnFilteredByNamespace(1)
+	handler.config.MaxExecutionCountBatchOperation = dynamicconfig.GetIntPropertyFnFilteredByNamespace(10)
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+
+	baseRequest := func() *adminservice.StartBatchOperationRequest {
+		return &adminservice.StartBatchOperationRequest{
+			Namespace:       s.namespace.String(),
+			VisibilityQuery: "WorkflowType = 'wf'",
+			JobId:           "job-id",
+			Reason:          "reason",
+			Identity:        "identity",
+		}
+	}
+
+	_, err := handler.StartBatchOperation(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+
+	request := baseRequest()
+	request.Reason = ""
+	_, err = handler.StartBatchOperation(ctx, request)
+	s.Equal(errReasonNotSet, err)
+
+	_, err = handler.StartBatchOperation(ctx, baseRequest())
+	s.Equal(errBatchOperationNotSet, err)
+
+	request = baseRequest()
+	request.Operation = &adminservice.StartBatchOperationRequest_UpsertPropertiesOperation{
+		UpsertPropertiesOperation: &adminservice.BatchOperationUpsertProperties{},
+	}
+	_, err = handler.StartBatchOperation(ctx, request)
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+
+	request = baseRequest()
+	request.Operation = &adminservice.StartBatchOperationRequest_SignalWithStartOperation{
+		SignalWithStartOperation: &adminservice.BatchOperationSignalWithStart{
+			WorkflowType: &commonpb.WorkflowType{Name: "wf"},
+			TaskQueue:    &taskqueuepb.TaskQueue{Name: "tq"},
+		},
+	}
+	_, err = handler.StartBatchOperation(ctx, request)
+	s.ErrorAs(err, &invalidArgument)
+
+	request.GetSignalWithStartOperation().SignalName = "signal"
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(
+		&manager.CountWorkflowExecutionsResponse{Count: 1}, nil,
+	)
+	_, err = handler.StartBatchOperation(ctx, request)
+	var resourceExhausted *serviceerror.ResourceExhausted
+	s.ErrorAs(err, &resourceExhausted)
+
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(
+		&manager.CountWorkflowExecutionsResponse{Count: 0}, nil,
+	)
+	s.mockHistoryClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *historyservice.StartWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.StartWorkflowExecutionResponse, error) {
+			s.Equal(s.namespaceID.String(), req.GetNamespaceId())
+			s.Equal("job-id", req.GetStartRequest().GetWorkflowId())
+			s.Equal(batcher.BatchWFTypeName, req.GetStartRequest().GetWorkflowType().GetName())
+
+			var params batcher.BatchParams
+			s.NoError(sdk.PreferProtoDataConverter.FromPayloads(req.GetStartRequest().GetInput(), &params))
+			s.Equal(batcher.BatchTypeSignalWithStart, params.BatchType)
+			s.Equal("signal", params.SignalWithStartParams.SignalName)
+			s.Equal("tq", params.SignalWithStartParams.TaskQueue.GetName())
+			s.True(params.DryRun)
+			var dryRun bool
+			s.NoError(payload.Decode(req.GetStartRequest().GetMemo().GetFields()[batcher.BatchOperationDryRunMemo], &dryRun))
+			s.True(dryRun)
+			return &historyservice.StartWorkflowExecutionResponse{}, nil
+		},
+	)
+	request.DryRun = true
+	resp, err := handler.StartBatchOperation(ctx, request)
+	s.NoError(err)
+	s.NotNil(resp)
+
+	request = baseRequest()
+	request.Operation = &adminservice.StartBatchOperationRequest_TerminationOperation{
+		TerminationOperation: &batchpb.BatchOperationTermination{},
+	}
+	request.DryRun = true
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(
+		&manager.CountWorkflowExecutionsResponse{Count: 0}, nil,
+	)
+	s.mockHistoryClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *historyservice.StartWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.StartWorkflowExecutionResponse, error) {
+			var params batcher.BatchParams
+			s.NoError(sdk.PreferProtoDataConverter.FromPayloads(req.GetStartRequest().GetInput(), &params))
+			s.Equal(batcher.BatchTypeTerminate, params.BatchType)
+			s.True(params.DryRun)
+			return &historyservice.StartWorkflowExecutionResponse{}, nil
+		},
+	)
+	resp, err = handler.StartBatchOperation(ctx, request)
+	s.NoError(err)
+	s.NotNil(resp)
+}
+
+func (s *adminHandlerSuite) TestStartBatchOperation_UpsertSearchAttributesOfRunningExecutions() {
+	handler := s.handler
+	ctx := context.Background()
+	handler.config.EnableBatcher = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+	handler.config.MaxConcurrentBatchOperation = dynamicconfig.GetIntPropertyFnFilteredByNamespace(1)
+	handler.config.MaxExecutionCountBatchOperation = dynamicconfig.GetIntPropertyFnFilteredByNamespace(10)
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+	s.mockVisibilityMgr.EXPECT().GetIndexName().Return("").AnyTimes()
+	s.mockResource.SearchAttributesProvider.EXPECT().GetSearchAttributes("", false).Return(searchattribute.TestNameTypeMap, nil).AnyTimes()
+	s.mockSaMapper.EXPECT().GetFieldName("CustomKeywordField", s.namespace.String()).Return("CustomKeywordField", nil).AnyTimes()
+	s.mockSaMapper.EXPECT().GetAlias("CustomKeywordField", s.namespace.String()).Return("CustomKeywordField", nil).AnyTimes()
+	s.mockVisibilityMgr.EXPECT().ValidateCustomSearchAttributes(gomock.Any()).DoAndReturn(
+		func(searchAttributes map[string]any) (map[string]any, error) {
+			return searchAttributes, nil
+		},
+	).AnyTimes()
+
+	request := &adminservice.StartBatchOperationRequest{
+		Namespace: s.namespace.String(),
+		Executions: []*commonpb.WorkflowExecution{
+			{WorkflowId: "wf-1"},
+			{WorkflowId: "wf-2", RunId: "run-2"},
+		},
+		JobId:    "job-id",
+		Reason:   "reason",
+		Identity: "identity",
+		Operation: &adminservice.StartBatchOperationRequest_UpsertPropertiesOperation{
+			UpsertPropertiesOperation: &adminservice.BatchOperationUpsertProperties{
+				SearchAttributes: &commonpb.SearchAttributes{
+					IndexedFields: map[string]*commonpb.Payload{"CustomKeywordField": payload.EncodeString("fixed")},
+				},
+			},
+		},
+	}
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *manager.CountWorkflowExecutionsRequest) (*manager.CountWorkflowExecutionsResponse, error) {
+			s.Equal("(WorkflowId IN ('wf-1') OR RunId IN ('run-2')) AND ExecutionStatus = 1", req.Query)
+			return &manager.CountWorkflowExecutionsResponse{Count: 1}, nil
+		},
+	)
+	_, err := handler.StartBatchOperation(ctx, request)
+	var failedPrecondition *serviceerror.FailedPrecondition
+	s.ErrorAs(err, &failedPrecondition)
+
+	// A query restricted to closed executions is accepted.
+	request.Executions = nil
+	request.VisibilityQuery = "ExecutionStatus = 'Completed'"
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *manager.CountWorkflowExecutionsRequest) (*manager.CountWorkflowExecutionsResponse, error) {
+			s.Equal("(ExecutionStatus = 'Completed') AND ExecutionStatus = 1", req.Query)
+			return &manager.CountWorkflowExecutionsResponse{Count: 0}, nil
+		},
+	)
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(
+		&manager.CountWorkflowExecutionsResponse{Count: 0}, nil,
+	)
+	s.mockHistoryClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(&historyservice.StartWorkflowExecutionResponse{}, nil)
+	_, err = handler.StartBatchOperation(ctx, request)
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestGetBatchOperationReport() {
+	handler := s.handler
+	ctx := context.Background()
+	handler.config.EnableBatcher = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+
+	_, err := handler.GetBatchOperationReport(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+	_, err = handler.GetBatchOperationReport(ctx, &adminservice.GetBatchOperationReportRequest{Namespace: s.namespace.String()})
+	s.Equal(errBatchJobIDNotSet, err)
+	_, err = handler.GetBatchOperationReport(ctx, &adminservice.GetBatchOperationReportRequest{
+		Namespace:     s.namespace.String(),
+		JobId:         "job-id",
+		NextPageToken: []byte("invalid"),
+	})
+	s.Equal(errInvalidNextPageToken, err)
+
+	execution := &commonpb.WorkflowExecution{WorkflowId: "job-id", RunId: "run-id"}
+	hbdPayloads, err := payloads.Encode(batcher.HeartBeatDetails{
+		TotalEstimate:            10,
+		ResolvedCount:            4,
+		SuccessCount:             3,
+		ErrorCount:               1,
+		FailureManifestTruncated: true,
+	})
+	s.NoError(err)
+	dryRunMemo, err := payload.Encode(true)
+	s.NoError(err)
+	s.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *historyservice.DescribeWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.DescribeWorkflowExecutionResponse, error) {
+			s.Equal(s.namespaceID.String(), req.GetNamespaceId())
+			s.Equal("job-id", req.GetRequest().GetExecution().GetWorkflowId())
+			return &historyservice.DescribeWorkflowExecutionResponse{
+				WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+					Execution: execution,
+					Type:      &commonpb.WorkflowType{Name: batcher.BatchWFTypeName},
+					Status:    enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+					Memo: &commonpb.Memo{Fields: map[string]*commonpb.Payload{
+						batcher.BatchOperationDryRunMemo: dryRunMemo,
+					}},
+				},
+				PendingActivities: []*workflowpb.PendingActivityInfo{{HeartbeatDetails: hbdPayloads}},
+			}, nil
+		})
+	failure := &persistencespb.BatchFailure{
+		Execution: &commonpb.WorkflowExecution{WorkflowId: "wf-1", RunId: "run-1"},
+		Error:     "error",
+		Attempts:  2,
+	}
+	s.mockFailureManifestManager.EXPECT().ReadBatchFailures(gomock.Any(), &persistence.ReadBatchFailuresRequest{
+		NamespaceID:   s.namespaceID.String(),
+		JobRunID:      "run-id",
+		PageSize:      1,
+		NextPageToken: []byte("1"),
+	}).Return(&persistence.ReadBatchFailuresResponse{
+		Entries: []*persistencespb.BatchFailureManifestEntry{
+			{JobId: "job-id", Page: 3, Failures: []*persistencespb.BatchFailure{failure}},
+		},
+		NextPageToken: []byte("2"),
+	}, nil)
+
+	resp, err := handler.GetBatchOperationReport(ctx, &adminservice.GetBatchOperationReportRequest{
+		Namespace:     s.namespace.String(),
+		JobId:         "job-id",
+		NextPageToken: []byte("1"),
+	})
+	s.NoError(err)
+	s.ProtoEqual(&adminservice.GetBatchOperationReportResponse{
+		State:                  enumspb.BATCH_OPERATION_STATE_RUNNING,
+		TotalOperationCount:    10,
+		ResolvedOperationCount: 4,
+		CompleteOperationCount: 3,
+		FailureOperationCount:  1,
+		DryRun:                 true,
+		Failures: []*adminservice.BatchOperationFailure{
+			{Execution: failure.Execution, Error: "error", Attempts: 2},
+		},
+		FailureManifestTruncated: true,
+		NextPageToken:            []byte("2"),
+	}, resp)
+
+	// A failed job reports the counts recorded in its stats memo.
+	statsMemo, err := payload.Encode(batcher.BatchOperationStats{NumSuccess: 2, NumFailure: 1, NumResolved: 3})
+	s.NoError(err)
+	s.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+		&historyservice.DescribeWorkflowExecutionResponse{
+			WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+				Execution: execution,
+				Type:      &commonpb.WorkflowType{Name: batcher.BatchWFTypeName},
+				Status:    enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+				Memo: &commonpb.Memo{Fields: map[string]*commonpb.Payload{
+					batcher.BatchOperationStatsMemo: statsMemo,
+				}},
+			},
+		}, nil)
+	s.mockFailureManifestManager.EXPECT().ReadBatchFailures(gomock.Any(), gomock.Any()).Return(&persistence.ReadBatchFailuresResponse{}, nil)
+	resp, err = handler.GetBatchOperationReport(ctx, &adminservice.GetBatchOperationReportRequest{
+		Namespace: s.namespace.String(),
+		JobId:     "job-id",
+	})
+	s.NoError(err)
+	s.ProtoEqual(&adminservice.GetBatchOperationReportResponse{
+		State:                  enumspb.BATCH_OPERATION_STATE_FAILED,
+		TotalOperationCount:    3,
+		ResolvedOperationCount: 3,
+		CompleteOperationCount: 2,
+		FailureOperationCount:  1,
+	}, resp)
+
+	// A terminated job reports the last heartbeat details of its batch activity.
+	s.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+		&historyservice.DescribeWorkflowExecutionResponse{
+			WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+				Execution: execution,
+				Type:      &commonpb.WorkflowType{Name: batcher.BatchWFTypeName},
+				Status:    enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED,
+			},
+			PendingActivities: []*workflowpb.PendingActivityInfo{{HeartbeatDetails: hbdPayloads}},
+		}, nil)
+	s.mockFailureManifestManager.EXPECT().ReadBatchFailures(gomock.Any(), gomock.Any()).Return(&persistence.ReadBatchFailuresResponse{}, nil)
+	resp, err = handler.GetBatchOperationReport(ctx, &adminservice.GetBatchOperationReportRequest{
+		Namespace: s.namespace.String(),
+		JobId:     "job-id",
+	})
+	s.NoError(err)
+	s.ProtoEqual(&adminservice.GetBatchOperationReportResponse{
+		State:                    enumspb.BATCH_OPERATION_STATE_FAILED,
+		TotalOperationCount:      10,
+		ResolvedOperationCount:   4,
+		CompleteOperationCount:   3,
+		FailureOperationCount:    1,
+		FailureManifestTruncated: true,
+	}, resp)
+}
+
+func (s *adminHandlerSuite) TestSimulateSchedule() {
+	handler := s.handler
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(gomock.Any()).Return(s.namespaceID, nil).AnyTimes()
+
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	schedule := &schedulepb.Schedule{
+		Spec: &schedulepb.ScheduleSpec{
+			Interval: []*schedulepb.IntervalSpec{{Interval: durationpb.New(time.Minute)}},
+		},
+		Policies: &schedulepb.SchedulePolicies{
+			OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_SKIP,
+		},
+	}
+
+	type test struct {
+		Name     string
+		Request  *adminservice.SimulateScheduleRequest
+		Expected error
+	}
+	// request validation tests
+	errorCases := []test{
+		{
+			Name:     "nil request",
+			Request:  nil,
+			Expected: &serviceerror.InvalidArgument{Message: "Request is nil."},
+		},
+		{
+			Name:     "empty request",
+			Request:  &adminservice.SimulateScheduleRequest{},
+			Expected: &serviceerror.InvalidArgument{Message: "Namespace is not set on request."},
+		},
+		{
+			Name: "no schedule",
+			Request: &adminservice.SimulateScheduleRequest{
+				Namespace: s.namespace.String(),
+			},
+			Expected: &serviceerror.InvalidArgument{Message: "Schedule is not set on request."},
+		},
+		{
+			Name: "no time range",
+			Request: &adminservice.SimulateScheduleRequest{
+				Namespace: s.namespace.String(),
+				Schedule:  schedule,
+			},
+			Expected: &serviceerror.InvalidArgument{Message: "StartTime and EndTime must be set on request."},
+		},
+		{
+			Name: "invalid time range",
+			Request: &adminservice.SimulateScheduleRequest{
+				Namespace: s.namespace.String(),
+				Schedule:  schedule,
+				StartTime: timestamppb.New(startTime),
+				EndTime:   timestamppb.New(startTime.Add(-time.Hour)),
+			},
+			Expected: &serviceerror.InvalidArgument{Message: "EndTime must not be before StartTime."},
+		},
+	}
+	for _, test := range errorCases {
+		s.T().Run(test.Name, func(t *testing.T) {
+			resp, err := handler.SimulateSchedule(ctx, test.Request)
+			s.Equal(test.Expected, err)
+			s.Nil(resp)
+		})
+	}
+
+	// valid request
+	resp, err := handler.SimulateSchedule(ctx, &adminservice.SimulateScheduleRequest{
+		Namespace:           s.namespace.String(),
+		ScheduleId:          "my-schedule",
+		Schedule:            schedule,
+		StartTime:           timestamppb.New(startTime),
+		EndTime:             timestamppb.New(startTime.Add(5 * time.Minute)),
+		WorkflowRunDuration: durationpb.New(90 * time.Second),
+	})
+	s.NoError(err)
+	s.Len(resp.Actions, 5)
+	s.Equal(int64(3), resp.ActionCount)
+	s.Equal(int64(2), resp.OverlapSkipped)
+	s.Equal(enumsspb.SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_OVERLAP, resp.Actions[1].Outcome)
+	s.False(resp.Truncated)
+}
+
+func (s *adminHandlerSuite) TestBusinessCalendars() {
+	ctx := context.Background()
+	mockMetadataMgr := s.mockResource.MetadataMgr
+	mockMetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{
+		NotificationVersion: 10,
+	}, nil).AnyTimes()
+	namespaceResponse := func(calendars ...*schedulespb.BusinessCalendar) *persistence.GetNamespaceResponse {
+		config := &persistencespb.NamespaceConfig{}
+		for _, calendar := range calendars {
+			if config.BusinessCalendars == nil {
+				config.BusinessCalendars = make(map[string]*schedulespb.BusinessCalendar)
+			}
+			config.BusinessCalendars[calendar.Name] = calendar
+		}
+		return &persistence.GetNamespaceResponse{
+			Namespace: &persistencespb.NamespaceDetail{
+				Info:          &persistencespb.NamespaceInfo{Id: s.namespaceID.String(), Name: s.namespace.String()},
+				Config:        config,
+				ConfigVersion: 3,
+			},
+		}
+	}
+	us := &schedulespb.BusinessCalendar{Name: "us", NonBusinessWeekdays: []int32{0, 6}}
+	uk := &schedulespb.BusinessCalendar{Name: "uk", NonBusinessWeekdays: []int32{0, 6}}
+
+	// request validation
+	_, err := s.handler.UpsertBusinessCalendar(ctx, &adminservice.UpsertBusinessCalendarRequest{Namespace: s.namespace.String()})
+	s.Equal(errBusinessCalendarNotSet, err)
+	_, err = s.handler.UpsertBusinessCalendar(ctx, &adminservice.UpsertBusinessCalendarRequest{
+		Namespace: s.namespace.String(),
+		Calendar:  &schedulespb.BusinessCalendar{Name: "all", NonBusinessWeekdays: []int32{0, 1, 2, 3, 4, 5, 6}},
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+	_, err = s.handler.DeleteBusinessCalendar(ctx, &adminservice.DeleteBusinessCalendarRequest{Namespace: s.namespace.String()})
+	s.Equal(errBusinessCalendarNameNotSet, err)
+
+	// upsert
+	mockMetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{Name: s.namespace.String()}).Return(namespaceResponse(uk), nil)
+	mockMetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			s.Equal(int64(4), request.Namespace.ConfigVersion)
+			s.Equal(int64(10), request.NotificationVersion)
+			s.Len(request.Namespace.Config.BusinessCalendars, 2)
+			s.Equal(us.NonBusinessWeekdays, request.Namespace.Config.BusinessCalendars["us"].NonBusinessWeekdays)
+			s.NotNil(request.Namespace.Config.BusinessCalendars["us"].UpdateTime)
+			return nil
+		})
+	upsertResp, err := s.handler.UpsertBusinessCalendar(ctx, &adminservice.UpsertBusinessCalendarRequest{
+		Namespace: s.namespace.String(),
+		Calendar:  us,
+	})
+	s.NoError(err)
+	s.Equal("us", upsertResp.Calendar.Name)
+	s.NotNil(upsertResp.Calendar.UpdateTime)
+	s.Nil(us.UpdateTime)
+
+	// list
+	mockMetadataMgr.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).Return(namespaceResponse(us, uk), nil)
+	listResp, err := s.handler.ListBusinessCalendars(ctx, &adminservice.ListBusinessCalendarsRequest{Namespace: s.namespace.String()})
+	s.NoError(err)
+	s.Len(listResp.Calendars, 2)
+	s.Equal("uk", listResp.Calendars[0].Name)
+	s.Equal("us", listResp.Calendars[1].Name)
+
+	// delete
+	mockMetadataMgr.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).Return(namespaceResponse(uk), nil)
+	_, err = s.handler.DeleteBusinessCalendar(ctx, &adminservice.DeleteBusinessCalendarRequest{
+		Namespace: s.namespace.String(),
+		Name:      "us",
+	})
+	var notFound *serviceerror.NotFound
+	s.ErrorAs(err, &notFound)
+
+	mockMetadataMgr.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).Return(namespaceResponse(us, uk), nil)
+	mockMetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			s.Len(request.Namespace.Config.BusinessCalendars, 1)
+			s.Contains(request.Namespace.Config.BusinessCalendars, "uk")
+			return nil
+		})
+	_, err = s.handler.DeleteBusinessCalendar(ctx, &adminservice.DeleteBusinessCalendarRequest{
+		Namespace: s.namespace.String(),
+		Name:      "us",
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestSetScheduleBusinessCalendars() {
+	ctx := context.Background()
+	s.handler.config.MaxIDLengthLimit = dynamicconfig.GetIntPropertyFn(1000)
+	s.handler.config.EnableSchedules = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+
+	us := &schedulespb.BusinessCalendar{Name: "us", NonBusinessWeekdays: []int32{0, 6}}
+	nsEntry := namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: s.namespaceID.String(), Name: s.namespace.String()},
+		&persistencespb.NamespaceConfig{
+			BusinessCalendars: map[string]*schedulespb.BusinessCalendar{"us": us},
+		},
+		"",
+	)
+
+	// request validation
+	_, err := s.handler.SetScheduleBusinessCalendars(ctx, &adminservice.SetScheduleBusinessCalendarsRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.Equal(errScheduleIDNotSet, err)
+	var invalidArgument *serviceerror.InvalidArgument
+	_, err = s.handler.SetScheduleBusinessCalendars(ctx, &adminservice.SetScheduleBusinessCalendarsRequest{
+		Namespace:  s.namespace.String(),
+		ScheduleId: "sched",
+		BusinessCalendars: []*schedulespb.ScheduleBusinessCalendar{
+			{Name: "us", Calendar: us},
+		},
+	})
+	s.ErrorAs(err, &invalidArgument)
+
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(nsEntry, nil).Times(2)
+	_, err = s.handler.SetScheduleBusinessCalendars(ctx, &adminservice.SetScheduleBusinessCalendarsRequest{
+		Namespace:         s.namespace.String(),
+		ScheduleId:        "sched",
+		BusinessCalendars: []*schedulespb.ScheduleBusinessCalendar{{Name: "uk"}},
+	})
+	s.ErrorAs(err, &invalidArgument)
+
+	s.mockHistoryClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.SignalWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.SignalWorkflowExecutionResponse, error) {
+			s.Equal(s.namespaceID.String(), request.NamespaceId)
+			s.Equal(scheduler.WorkflowIDPrefix+"sched", request.SignalRequest.WorkflowExecution.WorkflowId)
+			s.Equal(scheduler.SignalNameSetBusinessCalendars, request.SignalRequest.SignalName)
+			var input schedulespb.SetBusinessCalendarsRequest
+			s.NoError(sdk.PreferProtoDataConverter.FromPayloads(request.SignalRequest.Input, &input))
+			s.Len(input.BusinessCalendars, 1)
+			s.ProtoEqual(us, input.BusinessCalendars[0].Calendar)
+			return &historyservice.SignalWorkflowExecutionResponse{}, nil
+		})
+	resp, err := s.handler.SetScheduleBusinessCalendars(ctx, &adminservice.SetScheduleBusinessCalendarsRequest{
+		Namespace:  s.namespace.String(),
+		ScheduleId: "sched",
+		BusinessCalendars: []*schedulespb.ScheduleBusinessCalendar{
+			{Name: "us", Action: enumsspb.BUSINESS_CALENDAR_ACTION_SHIFT_NEXT_BUSINESS_DAY},
+		},
+	})
+	s.NoError(err)
+	s.Len(resp.BusinessCalendars, 1)
+	s.Equal(enumsspb.BUSINESS_CALENDAR_ACTION_SHIFT_NEXT_BUSINESS_DAY, resp.BusinessCalendars[0].Action)
+	s.ProtoEqual(us, resp.BusinessCalendars[0].Calendar)
+}
+
+func (s *adminHandlerSuite) TestBusinessCalendars_Replication() {
+	ctx := context.Background()
+	mockMetadataMgr := s.mockResource.MetadataMgr
+	mockMetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{
+		NotificationVersion: 10,
+	}, nil)
+	mockMetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{Name: s.namespace.String()}).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:   &persistencespb.NamespaceInfo{Id: s.namespaceID.String(), Name: s.namespace.String()},
+			Config: &persistencespb.NamespaceConfig{},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+				ActiveClusterName: "active",
+				Clusters:          []string{"active", "standby"},
+			},
+			ConfigVersion:   3,
+			FailoverVersion: 1,
+		},
+		IsGlobalNamespace: true,
+	}, nil)
+	mockMetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).Return(nil)
+	s.mockProducer.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, task *replicationspb.ReplicationTask) error {
+			attributes := task.GetNamespaceTaskAttributes()
+			s.Equal(enumsspb.NAMESPACE_OPERATION_UPDATE, attributes.GetNamespaceOperation())
+			s.Equal(int64(4), attributes.GetConfigVersion())
+			s.Contains(attributes.GetBusinessCalendars(), "us")
+			return nil
+		})
+
+	_, err := s.handler.UpsertBusinessCalendar(ctx, &adminservice.UpsertBusinessCalendarRequest{
+		Namespace: s.namespace.String(),
+		Calendar:  &schedulespb.BusinessCalendar{Name: "us", NonBusinessWeekdays: []int32{0, 6}},
+	})
+	s.NoError(err)
+}
+
 func (s *adminHandlerSuite) TestDescribeTaskQueuePartition() {
 	handler := s.handler
 	ctx := context.Background()
@@ -2114,3 +2924,25 @@ func (s *adminHandlerSuite) validatePhysicalTaskQueueInfo(expectedPhysicalTaskQu
 	s.Equal(expectedPhysicalTaskQueueInfo.GetTaskQueueStats(), responsePhysicalTaskQueueInfo.GetTaskQueueStats())
 	s.Equal(expectedPhysicalTaskQueueInfo.GetInternalTaskQueueStatus(), responsePhysicalTaskQueueInfo.GetInternalTaskQueueStatus())
 }
+
+func (s *adminHandlerSuite) TestListAuditEvents() {
+	events := []*persistencespb.AuditEvent{
+		{ApiName: "/temporal.api.workflowservice.v1.WorkflowService/TerminateWorkflowExecution", Namespace: "ns2"},
+	}
+	s.mockAuditLogManager.EXPECT().ReadAuditEvents(gomock.Any(), &persistence.ReadAuditEventsRequest{
+		Namespace:     "ns2",
+		PageSize:      listAuditEventsPageSize,
+		NextPageToken: []byte("token"),
+	}).Return(&persistence.ReadAuditEventsResponse{
+		Events:        events,
+		NextPageToken: []byte("next"),
+	}, nil)
+
+	resp, err := s.handler.ListAuditEvents(context.Background(), &adminservice.ListAuditEventsRequest{
+		NextPageToken: []byte("token"),
+		Namespace:     "ns2",
+	})
+	s.NoError(err)
+	s.Equal(events, resp.Events)
+	s.Equal([]byte("next"), resp.NextPageToken)
+}
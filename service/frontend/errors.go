@@ -110,6 +110,13 @@ var (
 	errSourceClusterNotSet    = serviceerror.NewInvalidArgument("SourceCluster is not set on request.")
 	errTargetClusterNotSet    = serviceerror.NewInvalidArgument("TargetCluster is not set on request.")
 	errInvalidDLQJobToken     = serviceerror.NewInvalidArgument("Invalid DLQ job token.")
+	errScheduleNotSet         = serviceerror.NewInvalidArgument("Schedule is not set on request.")
+	errSimulationRangeNotSet  = serviceerror.NewInvalidArgument("StartTime and EndTime must be set on request.")
+	errInvalidSimulationRange = serviceerror.NewInvalidArgument("EndTime must not be before StartTime.")
+
+	errBusinessCalendarNotSet     = serviceerror.NewInvalidArgument("Calendar is not set on request.")
+	errBusinessCalendarNameNotSet = serviceerror.NewInvalidArgument("Name is not set on request.")
+	errScheduleIDNotSet           = serviceerror.NewInvalidArgument("ScheduleId is not set on request.")
 
 	errPageSizeTooBigMessage = "PageSize is larger than allowed %d."
 
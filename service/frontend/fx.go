@@ -26,6 +26,7 @@ package frontend
 
 import (
 	"fmt"
+	"io"
 	"net"
 
 	"github.com/gorilla/mux"
@@ -118,6 +119,7 @@ var Module = fx.Options(
 	service.PersistenceLazyLoadedServiceResolverModule,
 	fx.Provide(FEReplicatorNamespaceReplicationQueueProvider),
 	fx.Provide(AuthorizationInterceptorProvider),
+	fx.Provide(AuditInterceptorProvider),
 	fx.Provide(NamespaceCheckerProvider),
 	fx.Provide(func(so GrpcServerOptions) *grpc.Server { return grpc.NewServer(so.Options...) }),
 	fx.Provide(HandlerProvider),
@@ -196,6 +198,31 @@ func AuthorizationInterceptorProvider(
 	)
 }
 
+func AuditInterceptorProvider(
+	cfg *config.Config,
+	serviceName primitives.ServiceName,
+	logger log.Logger,
+	auditLogManager persistence.AuditLogManager,
+	timeSource clock.TimeSource,
+	serviceResolver membership.ServiceResolver,
+	hostInfoProvider membership.HostInfoProvider,
+	lc fx.Lifecycle,
+) (*interceptor.AuditInterceptor, error) {
+	var sink interceptor.AuditSink
+	// Calls to the internal frontend come from the system workers and aren't audited.
+	if serviceName == primitives.FrontendService {
+		var err error
+		sink, err = interceptor.NewAuditSinkFromConfig(&cfg.Global.Audit, logger, auditLogManager, timeSource, serviceResolver, hostInfoProvider)
+		if err != nil {
+			return nil, err
+		}
+		if closer, ok := sink.(io.Closer); ok {
+			lc.Append(fx.StopHook(closer.Close))
+		}
+	}
+	return interceptor.NewAuditInterceptor(sink, logger, timeSource), nil
+}
+
 func NamespaceCheckerProvider(registry namespace.Registry) authorization.NamespaceChecker {
 	return &namespaceChecker{r: registry}
 }
@@ -229,6 +256,7 @@ func GrpcServerOptionsProvider(
 	sdkVersionInterceptor *interceptor.SDKVersionInterceptor,
 	callerInfoInterceptor *interceptor.CallerInfoInterceptor,
 	authInterceptor *authorization.Interceptor,
+	auditInterceptor *interceptor.AuditInterceptor,
 	maskInternalErrorDetailsInterceptor *interceptor.MaskInternalErrorDetailsInterceptor,
 	customInterceptors []grpc.UnaryServerInterceptor,
 	metricsHandler metrics.Handler,
@@ -267,6 +295,8 @@ func GrpcServerOptionsProvider(
 		namespaceValidatorInterceptor.NamespaceValidateIntercept,
 		namespaceLogInterceptor.Intercept, // TODO: Deprecate this with a outer custom interceptor
 		metrics.NewServerMetricsContextInjectorInterceptor(),
+		// Audit interceptor must come before the authorization interceptor so that denied calls are audited.
+		auditInterceptor.Intercept,
 		authInterceptor.Intercept,
 		// Handover interceptor has to above redirection because the request will route to the correct cluster after handover completed.
 		// And retry cannot be performed before customInterceptors.
@@ -444,6 +474,7 @@ func NamespaceRateLimitInterceptorProvider(
 	serviceConfig *Config,
 	namespaceRegistry namespace.Registry,
 	frontendServiceResolver membership.ServiceResolver,
+	metricsHandler metrics.Handler,
 	logger log.SnTaggedLogger,
 ) interceptor.NamespaceRateLimitInterceptor {
 	var globalNamespaceRPS, globalNamespaceVisibilityRPS, globalNamespaceNamespaceReplicationInducingAPIsRPS dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -496,7 +527,46 @@ func NamespaceRateLimitInterceptorProvider(
 			)
 		},
 	)
-	return interceptor.NewNamespaceRateLimitInterceptor(namespaceRegistry, namespaceRateLimiter, map[string]int{}, serviceConfig.ReducePollWorkflowHistoryRequestPriority)
+	// each caller gets a weighted share of the namespace limits above, and may borrow the part
+	// of the limits the other callers leave unused
+	callerRateLimiter := quotas.NewNamespaceRequestRateLimiter(
+		func(req quotas.Request) quotas.RequestRateLimiter {
+			namespaceName := req.Caller
+			return quotas.NewFairShareRequestRateLimiter(
+				func(req quotas.Request, shareFn func() float64) quotas.RequestRateLimiter {
+					return configs.NewRequestToRateLimiter(
+						quotas.NewScaledRateBurst(configs.NewNamespaceRateBurst(namespaceName, namespaceRateFn, serviceConfig.MaxNamespaceBurstRatioPerInstance), shareFn),
+						quotas.NewScaledRateBurst(configs.NewNamespaceRateBurst(namespaceName, visibilityRateFn, serviceConfig.MaxNamespaceVisibilityBurstRatioPerInstance), shareFn),
+						quotas.NewScaledRateBurst(configs.NewNamespaceRateBurst(namespaceName, namespaceReplicationInducingRateFn, serviceConfig.MaxNamespaceNamespaceReplicationInducingAPIsBurstRatioPerInstance), shareFn),
+						serviceConfig.OperatorRPSRatio,
+					)
+				},
+				configs.NewRequestToRateLimiter(
+					configs.NewNamespaceRateBurst(namespaceName, namespaceRateFn, serviceConfig.MaxNamespaceBurstRatioPerInstance),
+					configs.NewNamespaceRateBurst(namespaceName, visibilityRateFn, serviceConfig.MaxNamespaceVisibilityBurstRatioPerInstance),
+					configs.NewNamespaceRateBurst(namespaceName, namespaceReplicationInducingRateFn, serviceConfig.MaxNamespaceNamespaceReplicationInducingAPIsBurstRatioPerInstance),
+					serviceConfig.OperatorRPSRatio,
+				),
+				func(caller string) float64 {
+					return serviceConfig.CallerFairnessWeights(namespaceName)[caller]
+				},
+				serviceConfig.CallerFairnessActiveWindow(),
+			)
+		},
+	)
+	return interceptor.NewNamespaceRateLimitInterceptor(
+		namespaceRegistry,
+		namespaceRateLimiter,
+		map[string]int{},
+		serviceConfig.ReducePollWorkflowHistoryRequestPriority,
+		interceptor.CallerFairness{
+			RateLimiter:    callerRateLimiter,
+			Enabled:        serviceConfig.EnableCallerFairness,
+			Key:            serviceConfig.CallerFairnessKey,
+			Weights:        serviceConfig.CallerFairnessWeights,
+			MetricsHandler: metricsHandler,
+		},
+	)
 }
 
 func NamespaceCountLimitInterceptorProvider(
@@ -633,6 +703,9 @@ func AdminHandlerProvider(
 	healthServer *health.Server,
 	eventSerializer serialization.Serializer,
 	timeSource clock.TimeSource,
+	scheduleSpecBuilder *scheduler.SpecBuilder,
+	auditLogManager persistence.AuditLogManager,
+	batchFailureManifestManager persistence.BatchFailureManifestManager,
 	taskCategoryRegistry tasks.TaskCategoryRegistry,
 	matchingClient resource.MatchingClient,
 ) *AdminHandler {
@@ -663,6 +736,9 @@ func AdminHandlerProvider(
 		healthServer,
 		eventSerializer,
 		timeSource,
+		scheduleSpecBuilder,
+		auditLogManager,
+		batchFailureManifestManager,
 		taskCategoryRegistry,
 		matchingClient,
 	}
@@ -843,6 +919,7 @@ func HTTPAPIServerProvider(
 	grpcListener net.Listener,
 	tlsConfigProvider encryption.TLSConfigProvider,
 	handler Handler,
+	adminHandler *AdminHandler,
 	operatorHandler *OperatorHandlerImpl,
 	grpcServerOptions GrpcServerOptions,
 	metricsHandler metrics.Handler,
@@ -860,6 +937,7 @@ func HTTPAPIServerProvider(
 		grpcListener,
 		tlsConfigProvider,
 		handler,
+		adminHandler,
 		operatorHandler,
 		grpcServerOptions.UnaryInterceptors,
 		metricsHandler,
@@ -30,12 +30,15 @@ import (
 	"net"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/common/api"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
@@ -600,6 +603,7 @@ func TestNamespaceRateLimitInterceptorProvider(t *testing.T) {
 				&config,
 				mockRegistry,
 				serviceResolver,
+				metrics.NoopMetricsHandler,
 				log.NewTestLogger(),
 			)
 
@@ -735,9 +739,78 @@ func getTestConfig(tc namespaceRateLimitInterceptorTestCase) Config {
 		ReducePollWorkflowHistoryRequestPriority: func() bool {
 			return true
 		},
+		EnableCallerFairness:       dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+		CallerFairnessKey:          dynamicconfig.GetStringPropertyFnFilteredByNamespace(interceptor.CallerFairnessKeyIdentity),
+		CallerFairnessWeights:      dynamicconfig.GetTypedPropertyFnFilteredByNamespace(map[string]float64(nil)),
+		CallerFairnessActiveWindow: dynamicconfig.GetDurationPropertyFn(time.Minute),
 	}
 }
 
+func TestNamespaceRateLimitInterceptorCallerFairness(t *testing.T) {
+	namespaceName := "test-namespace"
+	mockRegistry := namespace.NewMockRegistry(gomock.NewController(t))
+	mockRegistry.EXPECT().GetNamespace(namespace.Name(namespaceName)).Return(&namespace.Namespace{}, nil).AnyTimes()
+	serviceResolver := membership.NewMockServiceResolver(gomock.NewController(t))
+	serviceResolver.EXPECT().AvailableMemberCount().Return(1).AnyTimes()
+
+	config := getTestConfig(namespaceRateLimitInterceptorTestCase{
+		globalNamespaceRPS:                12,
+		maxNamespaceRPSPerInstance:        12,
+		maxNamespaceBurstRatioPerInstance: 1,
+	})
+	config.EnableCallerFairness = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+	config.CallerFairnessWeights = dynamicconfig.GetTypedPropertyFnFilteredByNamespace(map[string]float64{"heavy-worker": 3, "noisy-worker": 1})
+	metricsHandler := metricstest.NewCaptureHandler()
+	capture := metricsHandler.StartCapture()
+	defer metricsHandler.StopCapture(capture)
+
+	rateLimitInterceptor := NamespaceRateLimitInterceptorProvider(
+		primitives.FrontendService,
+		&config,
+		mockRegistry,
+		serviceResolver,
+		metricsHandler,
+		log.NewTestLogger(),
+	)
+
+	start := func(identity string) error {
+		_, err := rateLimitInterceptor.Intercept(
+			context.Background(),
+			&workflowservice.StartWorkflowExecutionRequest{Namespace: namespaceName, Identity: identity},
+			&grpc.UnaryServerInfo{FullMethod: api.WorkflowServicePrefix + "StartWorkflowExecution"},
+			func(ctx context.Context, req any) (any, error) { return nil, nil },
+		)
+		return err
+	}
+
+	// the heavy worker is active, so the noisy worker only gets a quarter of the namespace burst,
+	// but it may borrow the rest of the burst while the heavy worker leaves it unused
+	require.NoError(t, start("heavy-worker"))
+	var err error
+	allowed := 0
+	for ; allowed < 12; allowed++ {
+		if err = start("noisy-worker"); err != nil {
+			break
+		}
+	}
+	assert.Equal(t, 11, allowed)
+	assert.ErrorIs(t, err, interceptor.ErrCallerRateLimitServerBusy)
+
+	// a caller within its share still passes its own limit, but the namespace limit is used up
+	assert.ErrorIs(t, start("heavy-worker"), interceptor.ErrNamespaceRateLimitServerBusy)
+	for i := 0; i < 3; i++ {
+		assert.ErrorIs(t, start("unlisted-worker"), interceptor.ErrNamespaceRateLimitServerBusy)
+	}
+	assert.ErrorIs(t, start("unlisted-worker"), interceptor.ErrCallerRateLimitServerBusy)
+
+	// callers with a configured weight are tagged by name, all others share one tag
+	recordings := capture.Snapshot()[metrics.CallerRateLimitedCounter.Name()]
+	require.Len(t, recordings, 2)
+	assert.Equal(t, "noisy-worker", recordings[0].Tags["caller"])
+	assert.Equal(t, "_other_", recordings[1].Tags["caller"])
+	assert.Equal(t, namespaceName, recordings[0].Tags["namespace"])
+}
+
 func TestNamespaceRateLimitMetrics(t *testing.T) {
 	testCases := []rateLimitMetricsTestcase{
 		{
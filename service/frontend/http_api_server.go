@@ -29,6 +29,7 @@ import (
 	"crypto/tls"
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"reflect"
@@ -41,6 +42,7 @@ import (
 	"go.temporal.io/api/operatorservice/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
@@ -82,6 +84,13 @@ var defaultForwardedHeaders = []string{
 
 type httpRemoteAddrContextKey struct{}
 
+const (
+	simulateScheduleMethod = "/temporal.server.api.adminservice.v1.AdminService/SimulateSchedule"
+	// SimulateScheduleHTTPPath is the HTTP API path of schedule simulation. There is no WorkflowService RPC for it,
+	// the request and response are the ones of the AdminService SimulateSchedule RPC.
+	SimulateScheduleHTTPPath = "/api/v1/namespaces/{namespace}/schedule-simulation"
+)
+
 var (
 	errHTTPGRPCListenerNotTCP     = errors.New("must use TCP for gRPC listener to support HTTP API")
 	errHTTPGRPCStreamNotSupported = errors.New("stream not supported")
@@ -96,6 +105,7 @@ func NewHTTPAPIServer(
 	grpcListener net.Listener,
 	tlsConfigProvider encryption.TLSConfigProvider,
 	handler Handler,
+	adminHandler *AdminHandler,
 	operatorHandler *OperatorHandlerImpl,
 	interceptors []grpc.UnaryServerInterceptor,
 	metricsHandler metrics.Handler,
@@ -171,6 +181,8 @@ func NewHTTPAPIServer(
 		map[string]any{
 			"temporal.api.workflowservice.v1.WorkflowService": handler,
 			"temporal.api.operatorservice.v1.OperatorService": operatorHandler,
+			// Only the AdminService APIs registered below are reachable through the HTTP API.
+			"temporal.server.api.adminservice.v1.AdminService": adminHandler,
 		},
 		interceptors,
 		metricsHandler,
@@ -198,6 +210,15 @@ func NewHTTPAPIServer(
 		return nil, fmt.Errorf("failed registering operatorservice HTTP API handler: %w", err)
 	}
 
+	err = h.serveMux.HandlePath(
+		http.MethodPost,
+		SimulateScheduleHTTPPath,
+		h.simulateScheduleHandler(adminservice.NewAdminServiceClient(clientConn)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed registering schedule simulation HTTP API handler: %w", err)
+	}
+
 	// Set the / handler as our function that wraps serve mux.
 	router.PathPrefix("/").HandlerFunc(h.serveHTTP)
 	// Register the router as the HTTP server handler.
@@ -295,6 +316,37 @@ func (h *HTTPAPIServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
 	h.serveMux.ServeHTTP(w, r)
 }
 
+// simulateScheduleHandler serves schedule simulation, so that schedule UIs can preview a spec without access to
+// the AdminService. The call goes through the same interceptors as every other HTTP API call.
+func (h *HTTPAPIServer) simulateScheduleHandler(client adminservice.AdminServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(h.serveMux, r)
+		ctx, err := runtime.AnnotateContext(ctx, h.serveMux, r, simulateScheduleMethod, runtime.WithHTTPPathPattern(SimulateScheduleHTTPPath))
+		if err != nil {
+			runtime.HTTPError(ctx, h.serveMux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		var request adminservice.SimulateScheduleRequest
+		if err := inboundMarshaler.NewDecoder(r.Body).Decode(&request); err != nil && !errors.Is(err, io.EOF) {
+			runtime.HTTPError(ctx, h.serveMux, outboundMarshaler, w, r, status.Errorf(codes.InvalidArgument, "%v", err))
+			return
+		}
+		request.Namespace = pathParams["namespace"]
+
+		var md runtime.ServerMetadata
+		response, err := client.SimulateSchedule(ctx, &request, grpc.Header(&md.HeaderMD), grpc.Trailer(&md.TrailerMD))
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, h.serveMux, outboundMarshaler, w, r, err)
+			return
+		}
+		runtime.ForwardResponseMessage(ctx, h.serveMux, outboundMarshaler, w, r, response, h.serveMux.GetForwardResponseOptions()...)
+	}
+}
+
 func (h *HTTPAPIServer) allowedHostsMiddleware(hf runtime.HandlerFunc) runtime.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
 		allowedHosts := h.allowedHosts.Get()
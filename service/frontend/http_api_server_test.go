@@ -0,0 +1,87 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/adminservicemock/v1"
+	"go.temporal.io/server/common/log"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+)
+
+func TestHTTPAPIServer_SimulateSchedule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := adminservicemock.NewMockAdminServiceClient(ctrl)
+	h := &HTTPAPIServer{logger: log.NewNoopLogger()}
+	h.serveMux = runtime.NewServeMux(
+		runtime.WithMarshalerOption(newTemporalProtoMarshaler("", true)),
+		runtime.WithErrorHandler(h.errorHandler),
+	)
+	require.NoError(t, h.serveMux.HandlePath(http.MethodPost, SimulateScheduleHTTPPath, h.simulateScheduleHandler(client)))
+
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	client.EXPECT().SimulateSchedule(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *adminservice.SimulateScheduleRequest, _ ...grpc.CallOption) (*adminservice.SimulateScheduleResponse, error) {
+			require.Equal(t, "test-namespace", request.GetNamespace())
+			require.Equal(t, startTime, request.GetStartTime().AsTime())
+			require.Equal(t, int32(3), request.GetMaximumActions())
+			return &adminservice.SimulateScheduleResponse{ActionCount: 3, Truncated: true}, nil
+		})
+	body := `{"startTime": "2024-01-01T00:00:00Z", "endTime": "2024-01-02T00:00:00Z", "maximumActions": 3}`
+	recorder := httptest.NewRecorder()
+	h.serveMux.ServeHTTP(recorder, httptest.NewRequest(
+		http.MethodPost,
+		"/api/v1/namespaces/test-namespace/schedule-simulation",
+		strings.NewReader(body),
+	))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var response map[string]any
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	require.Equal(t, "3", response["actionCount"])
+	require.Equal(t, true, response["truncated"])
+
+	client.EXPECT().SimulateSchedule(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		nil, serviceerror.NewPermissionDenied("denied", ""),
+	)
+	recorder = httptest.NewRecorder()
+	h.serveMux.ServeHTTP(recorder, httptest.NewRequest(
+		http.MethodPost,
+		"/api/v1/namespaces/test-namespace/schedule-simulation",
+		strings.NewReader(body),
+	))
+	require.Equal(t, http.StatusForbidden, recorder.Code)
+}
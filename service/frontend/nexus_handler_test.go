@@ -156,6 +156,7 @@ func newOperationContext(options contextOptions) *operationContext {
 		mockRateLimiter{options.namespaceRateLimitAllow},
 		make(map[string]int),
 		func() bool { return true },
+		interceptor.CallerFairness{},
 	)
 	oc.rateLimitInterceptor = interceptor.NewRateLimitInterceptor(
 		mockRateLimiter{options.rateLimitAllow},
@@ -71,6 +71,7 @@ type Config struct {
 	VisibilityPersistenceMaxWriteQPS        dynamicconfig.IntPropertyFn
 	VisibilityPersistenceSlowQueryThreshold dynamicconfig.DurationPropertyFn
 	VisibilityMaxPageSize                   dynamicconfig.IntPropertyFnWithNamespaceFilter
+	VisibilityMaxHistogramBuckets           dynamicconfig.IntPropertyFnWithNamespaceFilter
 	EnableReadFromSecondaryVisibility       dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	VisibilityEnableShadowReadMode          dynamicconfig.BoolPropertyFn
 	VisibilityDisableOrderByClause          dynamicconfig.BoolPropertyFnWithNamespaceFilter
@@ -88,6 +89,10 @@ type Config struct {
 	MaxNamespaceBurstRatioPerInstance                                 dynamicconfig.FloatPropertyFnWithNamespaceFilter
 	MaxConcurrentLongRunningRequestsPerInstance                       dynamicconfig.IntPropertyFnWithNamespaceFilter
 	ReducePollWorkflowHistoryRequestPriority                          dynamicconfig.BoolPropertyFn
+	EnableCallerFairness                                              dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	CallerFairnessKey                                                 dynamicconfig.StringPropertyFnWithNamespaceFilter
+	CallerFairnessWeights                                             dynamicconfig.TypedPropertyFnWithNamespaceFilter[map[string]float64]
+	CallerFairnessActiveWindow                                        dynamicconfig.DurationPropertyFn
 	MaxGlobalConcurrentLongRunningRequests                            dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MaxNamespaceVisibilityRPSPerInstance                              dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MaxNamespaceVisibilityBurstRatioPerInstance                       dynamicconfig.FloatPropertyFnWithNamespaceFilter
@@ -258,6 +263,7 @@ func NewConfig(
 		VisibilityPersistenceMaxWriteQPS:        dynamicconfig.VisibilityPersistenceMaxWriteQPS.Get(dc),
 		VisibilityPersistenceSlowQueryThreshold: dynamicconfig.VisibilityPersistenceSlowQueryThreshold.Get(dc),
 		VisibilityMaxPageSize:                   dynamicconfig.FrontendVisibilityMaxPageSize.Get(dc),
+		VisibilityMaxHistogramBuckets:           dynamicconfig.FrontendVisibilityMaxHistogramBuckets.Get(dc),
 		EnableReadFromSecondaryVisibility:       dynamicconfig.EnableReadFromSecondaryVisibility.Get(dc),
 		VisibilityEnableShadowReadMode:          dynamicconfig.VisibilityEnableShadowReadMode.Get(dc),
 		VisibilityDisableOrderByClause:          dynamicconfig.VisibilityDisableOrderByClause.Get(dc),
@@ -275,6 +281,10 @@ func NewConfig(
 		MaxNamespaceBurstRatioPerInstance:                                 dynamicconfig.FrontendMaxNamespaceBurstRatioPerInstance.Get(dc),
 		MaxConcurrentLongRunningRequestsPerInstance:                       dynamicconfig.FrontendMaxConcurrentLongRunningRequestsPerInstance.Get(dc),
 		ReducePollWorkflowHistoryRequestPriority:                          dynamicconfig.ReducePollWorkflowHistoryRequestPriority.Get(dc),
+		EnableCallerFairness:                                              dynamicconfig.FrontendEnableCallerFairness.Get(dc),
+		CallerFairnessKey:                                                 dynamicconfig.FrontendCallerFairnessKey.Get(dc),
+		CallerFairnessWeights:                                             dynamicconfig.FrontendCallerFairnessWeights.Get(dc),
+		CallerFairnessActiveWindow:                                        dynamicconfig.FrontendCallerFairnessActiveWindow.Get(dc),
 		MaxGlobalConcurrentLongRunningRequests:                            dynamicconfig.FrontendGlobalMaxConcurrentLongRunningRequests.Get(dc),
 		MaxNamespaceVisibilityRPSPerInstance:                              dynamicconfig.FrontendMaxNamespaceVisibilityRPSPerInstance.Get(dc),
 		MaxNamespaceVisibilityBurstRatioPerInstance:                       dynamicconfig.FrontendMaxNamespaceVisibilityBurstRatioPerInstance.Get(dc),
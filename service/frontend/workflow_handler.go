@@ -4558,23 +4558,9 @@ func (wh *WorkflowHandler) StartBatchOperation(
 	case *workflowservice.StartBatchOperationRequest_ResetOperation:
 		identity = op.ResetOperation.GetIdentity()
 		operationType = batcher.BatchTypeReset
-		if op.ResetOperation.Options != nil {
-			if op.ResetOperation.Options.Target == nil {
-				return nil, serviceerror.NewInvalidArgument("batch reset missing target")
-			}
-			encoded, err := op.ResetOperation.Options.Marshal()
-			if err != nil {
-				return nil, err
-			}
-			resetParams.ResetOptions = encoded
-		} else {
-			// TODO: remove support for old fields later
-			resetType := op.ResetOperation.GetResetType()
-			if _, ok := enumspb.ResetType_name[int32(resetType)]; !ok || resetType == enumspb.RESET_TYPE_UNSPECIFIED {
-				return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("unknown batch reset type %v", resetType))
-			}
-			resetParams.ResetType = resetType
-			resetParams.ResetReapplyType = op.ResetOperation.GetResetReapplyType()
+		resetParams, err = newBatchResetParams(op.ResetOperation)
+		if err != nil {
+			return nil, err
 		}
 	case *workflowservice.StartBatchOperationRequest_UpdateWorkflowOptionsOperation:
 		identity = op.UpdateWorkflowOptionsOperation.GetIdentity()
@@ -4677,6 +4663,31 @@ func (wh *WorkflowHandler) StartBatchOperation(
 	return &workflowservice.StartBatchOperationResponse{}, nil
 }
 
+// newBatchResetParams validates the reset operation of a batch request and converts it to the
+// params of the batch workflow.
+func newBatchResetParams(op *batchpb.BatchOperationReset) (batcher.ResetParams, error) {
+	var resetParams batcher.ResetParams
+	if op.GetOptions() != nil {
+		if op.GetOptions().GetTarget() == nil {
+			return resetParams, serviceerror.NewInvalidArgument("batch reset missing target")
+		}
+		encoded, err := op.GetOptions().Marshal()
+		if err != nil {
+			return resetParams, err
+		}
+		resetParams.ResetOptions = encoded
+	} else {
+		// TODO: remove support for old fields later
+		resetType := op.GetResetType()
+		if _, ok := enumspb.ResetType_name[int32(resetType)]; !ok || resetType == enumspb.RESET_TYPE_UNSPECIFIED {
+			return resetParams, serviceerror.NewInvalidArgument(fmt.Sprintf("unknown batch reset type %v", resetType))
+		}
+		resetParams.ResetType = resetType
+		resetParams.ResetReapplyType = op.GetResetReapplyType()
+	}
+	return resetParams, nil
+}
+
 func (wh *WorkflowHandler) StopBatchOperation(
 	ctx context.Context,
 	request *workflowservice.StopBatchOperationRequest,
@@ -4793,6 +4804,9 @@ func (wh *WorkflowHandler) DescribeBatchOperation(
 		operationType = enumspb.BATCH_OPERATION_TYPE_RESET
 	case batcher.BatchTypeUpdateOptions:
 		operationType = enumspb.BATCH_OPERATION_TYPE_UPDATE_EXECUTION_OPTIONS
+	case batcher.BatchTypeUpsertProperties, batcher.BatchTypeSignalWithStart:
+		// Started by admin StartBatchOperation, there is no public operation type for them.
+		operationType = enumspb.BATCH_OPERATION_TYPE_UNSPECIFIED
 	default:
 		operationType = enumspb.BATCH_OPERATION_TYPE_UNSPECIFIED
 		wh.throttledLogger.Warn("Unknown batch operation type", tag.NewStringTag("batch-operation-type", operationTypeString))
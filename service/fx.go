@@ -56,6 +56,7 @@ type (
 		OperatorRPSRatio                   persistenceClient.OperatorRPSRatio
 		PersistenceBurstRatio              persistenceClient.PersistenceBurstRatio
 		DynamicRateLimitingParams          persistenceClient.DynamicRateLimitingParams
+		AdaptiveRateLimitingEnabled        persistenceClient.PersistenceAdaptiveRateLimitingEnabled
 	}
 
 	GrpcServerOptionsParams struct {
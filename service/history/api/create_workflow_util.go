@@ -27,6 +27,7 @@ package api
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"time"
 
 	commonpb "go.temporal.io/api/common/v1"
@@ -37,6 +38,7 @@ import (
 	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
@@ -53,8 +55,14 @@ import (
 const (
 	// maxWorkflowTaskStartToCloseTimeout sets the Max Workflow Task start to close timeout for a Workflow
 	maxWorkflowTaskStartToCloseTimeout = 120 * time.Second
+
+	maxFairnessKeyLength = 64
 )
 
+// fairnessKeyRegexp matches the characters allowed in fairness keys. Keys are stored in task
+// metadata and used to name matching subqueues, so they are kept short and plain.
+var fairnessKeyRegexp = regexp.MustCompile(`^[a-zA-Z0-9._:-]*$`)
+
 type (
 	VersionedRunID struct {
 		RunID            string
@@ -67,6 +75,26 @@ type (
 	) (WorkflowLease, error)
 )
 
+// SetFairnessKey validates and sets the fairness key of a new workflow: the fairness key request
+// header if set, otherwise the key already on the request, e.g. one inherited from a parent
+// workflow.
+func SetFairnessKey(ctx context.Context, startRequest *historyservice.StartWorkflowExecutionRequest) error {
+	key := headers.GetValues(ctx, headers.FairnessKeyHeaderName)[0]
+	if key == "" {
+		key = startRequest.GetFairnessKey()
+	}
+	if len(key) > maxFairnessKeyLength {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf(
+			"fairness key is too long: %d bytes, the limit is %d", len(key), maxFairnessKeyLength))
+	}
+	if !fairnessKeyRegexp.MatchString(key) {
+		return serviceerror.NewInvalidArgument(
+			"fairness key may only contain letters, digits, '.', '_', ':' and '-'")
+	}
+	startRequest.FairnessKey = key
+	return nil
+}
+
 func NewWorkflowWithSignal(
 	shard historyi.ShardContext,
 	namespaceEntry *namespace.Namespace,
@@ -86,7 +114,6 @@ func NewWorkflowWithSignal(
 	if err != nil {
 		return nil, err
 	}
-
 	startEvent, err := newMutableState.AddWorkflowExecutionStartedEvent(
 		&commonpb.WorkflowExecution{
 			WorkflowId: workflowID,
@@ -25,11 +25,17 @@
 package api
 
 import (
+	"context"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
+	"google.golang.org/grpc/metadata"
 )
 
 func TestOverrideWorkflowRunTimeout_InfiniteRunTimeout_InfiniteExecutionTimeout(t *testing.T) {
@@ -111,3 +117,24 @@ func TestOverrideWorkflowTaskTimeout_Finite(t *testing.T) {
 	defaultTimeoutFn = dynamicconfig.GetDurationPropertyFnFilteredByNamespace(defaultTimeout)
 	require.Equal(t, maxWorkflowTaskStartToCloseTimeout, overrideWorkflowTaskTimeout("random domain", taskTimeout, runTimeout, defaultTimeoutFn))
 }
+
+func TestSetFairnessKey(t *testing.T) {
+	request := &historyservice.StartWorkflowExecutionRequest{FairnessKey: "tenant-1"}
+	require.NoError(t, SetFairnessKey(context.Background(), request))
+	require.Equal(t, "tenant-1", request.FairnessKey)
+
+	// the request header takes precedence over the inherited key
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(headers.FairnessKeyHeaderName, "tenant-2"))
+	require.NoError(t, SetFairnessKey(ctx, request))
+	require.Equal(t, "tenant-2", request.FairnessKey)
+
+	request = &historyservice.StartWorkflowExecutionRequest{}
+	require.NoError(t, SetFairnessKey(context.Background(), request))
+	require.Empty(t, request.FairnessKey)
+
+	var invalidArgument *serviceerror.InvalidArgument
+	request = &historyservice.StartWorkflowExecutionRequest{FairnessKey: strings.Repeat("a", maxFairnessKeyLength+1)}
+	require.ErrorAs(t, SetFairnessKey(context.Background(), request), &invalidArgument)
+	request = &historyservice.StartWorkflowExecutionRequest{FairnessKey: "tenant 1"}
+	require.ErrorAs(t, SetFairnessKey(context.Background(), request), &invalidArgument)
+}
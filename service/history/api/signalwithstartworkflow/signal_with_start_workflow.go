@@ -87,6 +87,9 @@ func startAndSignalWorkflow(
 ) (string, bool, error) {
 	workflowID := signalWithStartRequest.GetWorkflowId()
 	runID := uuid.New().String()
+	if err := api.SetFairnessKey(ctx, startRequest); err != nil {
+		return "", false, err
+	}
 	// TODO(bergundy): Support eager workflow task
 	newMutableState, err := api.NewWorkflowWithSignal(
 		shard,
@@ -134,6 +134,9 @@ func NewStarter(
 // prepare applies request overrides, validates the request, and records eager execution metrics.
 func (s *Starter) prepare(ctx context.Context) error {
 	request := s.request.StartRequest
+	if err := api.SetFairnessKey(ctx, s.request); err != nil {
+		return err
+	}
 
 	// TODO: remove this call in 1.25
 	enums.SetDefaultWorkflowIdConflictPolicy(
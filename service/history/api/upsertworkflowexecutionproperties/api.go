@@ -0,0 +1,122 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package upsertworkflowexecutionproperties
+
+import (
+	"context"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/locks"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/service/history/api"
+	historyi "go.temporal.io/server/service/history/interfaces"
+)
+
+var errSearchAttributesOfRunningExecution = serviceerror.NewFailedPrecondition(
+	"Search attributes of a running workflow execution can only be upserted by the workflow itself.",
+)
+
+func Invoke(
+	ctx context.Context,
+	request *historyservice.UpsertWorkflowExecutionPropertiesRequest,
+	shardContext historyi.ShardContext,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+	searchAttributesValidator *searchattribute.Validator,
+) (resp *historyservice.UpsertWorkflowExecutionPropertiesResponse, retError error) {
+	namespaceEntry, err := api.GetActiveNamespace(shardContext, namespace.ID(request.GetNamespaceId()))
+	if err != nil {
+		return nil, err
+	}
+	namespaceName := namespaceEntry.Name().String()
+
+	if len(request.GetSearchAttributes().GetIndexedFields()) == 0 && len(request.GetMemo().GetFields()) == 0 {
+		return nil, serviceerror.NewInvalidArgument("SearchAttributes or Memo must be set.")
+	}
+	if err := searchAttributesValidator.Validate(request.GetSearchAttributes(), namespaceName); err != nil {
+		return nil, err
+	}
+	if err := searchAttributesValidator.ValidateSize(request.GetSearchAttributes(), namespaceName); err != nil {
+		return nil, err
+	}
+
+	workflowLease, err := workflowConsistencyChecker.GetWorkflowLease(
+		ctx,
+		nil,
+		definition.NewWorkflowKey(
+			request.GetNamespaceId(),
+			request.GetExecution().GetWorkflowId(),
+			request.GetExecution().GetRunId(),
+		),
+		locks.PriorityHigh,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { workflowLease.GetReleaseFn()(retError) }()
+
+	mutableState := workflowLease.GetMutableState()
+	closed := mutableState.GetExecutionState().GetState() == enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED
+	if !closed && len(request.GetSearchAttributes().GetIndexedFields()) > 0 {
+		// Changes to running executions must be in history to be replicated and to survive rebuilds,
+		// and the only event with search attributes is generated from a workflow command.
+		return nil, errSearchAttributesOfRunningExecution
+	}
+	mergedMemo := &commonpb.Memo{
+		Fields: payload.MergeMapOfPayload(mutableState.GetExecutionInfo().GetMemo(), request.GetMemo().GetFields()),
+	}
+	if mergedMemo.Size() > shardContext.GetConfig().MemoSizeLimitError(namespaceName) {
+		// in-memory mutable state is still clean, let retError=nil in the defer func()
+		// to prevent clearing and reloading mutable state while releasing the lock
+		return nil, common.ErrMemoSizeExceedsLimit
+	}
+
+	if closed {
+		// Closed executions have no more history events, so only their snapshot is updated.
+		if err := mutableState.UpsertVisibilityProperties(
+			request.GetSearchAttributes().GetIndexedFields(),
+			request.GetMemo().GetFields(),
+		); err != nil {
+			return nil, err
+		}
+		// Can't use UpdateWorkflowExecutionAsActive since it updates the current run, and execution is closed.
+		err = workflowLease.GetContext().SubmitClosedWorkflowSnapshot(ctx, shardContext, historyi.TransactionPolicyActive)
+	} else {
+		if _, err := mutableState.AddWorkflowPropertiesModifiedExternallyEvent(request.GetMemo()); err != nil {
+			return nil, err
+		}
+		err = workflowLease.GetContext().UpdateWorkflowExecutionAsActive(ctx, shardContext)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &historyservice.UpsertWorkflowExecutionPropertiesResponse{}, nil
+}
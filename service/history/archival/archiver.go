@@ -37,6 +37,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	archiverspb "go.temporal.io/server/api/archiver/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	carchiver "go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/log"
@@ -75,6 +76,8 @@ type (
 		HistoryLength     int64
 		Memo              *commonpb.Memo
 		SearchAttributes  *commonpb.SearchAttributes
+		// ChasmNodes is the final state of a closed CHASM entity, it's empty for workflows.
+		ChasmNodes map[string]*persistencespb.ChasmNode
 		// VisibilityURI is the URI of the visibility archival backend.
 		VisibilityURI carchiver.URI
 
@@ -269,6 +272,7 @@ func (a *archiver) archiveVisibility(ctx context.Context, request *Request, logg
 		Memo:               request.Memo,
 		SearchAttributes:   searchAttributes,
 		HistoryArchivalUri: historyArchivalUri,
+		ChasmNodes:         request.ChasmNodes,
 	})
 }
 
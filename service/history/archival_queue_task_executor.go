@@ -30,6 +30,7 @@ import (
 	"fmt"
 	"time"
 
+	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	carchiver "go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/log"
@@ -215,6 +216,26 @@ func (e *archivalQueueTaskExecutor) getArchiveTaskRequest(
 	if err != nil {
 		return nil, err
 	}
+	// Search attributes and memo declared by the CHASM root component are archived together with the
+	// ones of the workflow, and the serialized tree itself is archived with the visibility record,
+	// so that the archived record reflects the final state of the entity.
+	chasmTree := mutableState.ChasmTree()
+	chasmSearchAttributes, chasmMemo, err := chasmTree.VisibilityAttributes()
+	if err != nil {
+		return nil, err
+	}
+	memo := workflowAttributes.Memo
+	if len(chasmMemo) > 0 {
+		memo = &commonpb.Memo{
+			Fields: mergeMapPayload(copyMapPayload(memo.GetFields()), chasmMemo),
+		}
+	}
+	searchAttributes := workflowAttributes.SearchAttributes
+	if len(chasmSearchAttributes) > 0 {
+		searchAttributes = &commonpb.SearchAttributes{
+			IndexedFields: mergeMapPayload(copyMapPayload(searchAttributes.GetIndexedFields()), chasmSearchAttributes),
+		}
+	}
 
 	request = &archival.Request{
 		ShardID:              e.shardContext.GetShardID(),
@@ -234,8 +255,9 @@ func (e *archivalQueueTaskExecutor) getArchiveTaskRequest(
 		ExecutionDuration:    durationpb.New(executionDuration),
 		Status:               executionState.Status,
 		HistoryLength:        nextEventID - 1,
-		Memo:                 workflowAttributes.Memo,
-		SearchAttributes:     workflowAttributes.SearchAttributes,
+		Memo:                 memo,
+		SearchAttributes:     searchAttributes,
+		ChasmNodes:           chasmTree.Snapshot(nil).Nodes,
 		Targets:              targets,
 		CallerService:        string(primitives.HistoryService),
 	}
@@ -32,10 +32,12 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/chasm"
 	carchiver "go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
@@ -43,9 +45,11 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/telemetry"
+	"go.temporal.io/server/common/testing/protoassert"
 	"go.temporal.io/server/service/history"
 	"go.temporal.io/server/service/history/archival"
 	historyi "go.temporal.io/server/service/history/interfaces"
@@ -295,6 +299,25 @@ func TestArchivalQueueTaskExecutor(t *testing.T) {
 				p.RelocatableAttributesRemoved = true
 			},
 		},
+		{
+			Name: "chasm visibility attributes",
+			Configure: func(p *params) {
+				p.ChasmSearchAttributes = map[string]*commonpb.Payload{
+					"TestKeyword": payload.EncodeString("test-keyword"),
+				}
+				p.ChasmMemo = map[string]*commonpb.Payload{
+					"TestMemo": payload.EncodeString("test-memo"),
+				}
+			},
+		},
+		{
+			Name: "chasm entity state",
+			Configure: func(p *params) {
+				p.ChasmNodes = map[string]*persistencespb.ChasmNode{
+					"": {Data: &commonpb.DataBlob{Data: []byte("test-root")}},
+				}
+			},
+		},
 		{
 			Name: "get workflow execution from visibility error",
 			Configure: func(p *params) {
@@ -435,6 +458,10 @@ func TestArchivalQueueTaskExecutor(t *testing.T) {
 					RelocatableAttributesRemoved: p.RelocatableAttributesRemoved,
 				}
 				mutableState.EXPECT().GetExecutionInfo().Return(executionInfo).AnyTimes()
+				chasmTree := historyi.NewMockChasmTree(p.Controller)
+				chasmTree.EXPECT().VisibilityAttributes().Return(p.ChasmSearchAttributes, p.ChasmMemo, nil).AnyTimes()
+				chasmTree.EXPECT().Snapshot(nil).Return(chasm.NodesSnapshot{Nodes: p.ChasmNodes}).AnyTimes()
+				mutableState.EXPECT().ChasmTree().Return(chasmTree).AnyTimes()
 				executionState := &persistencespb.WorkflowExecutionState{
 					State:     0,
 					Status:    0,
@@ -500,6 +527,13 @@ func TestArchivalQueueTaskExecutor(t *testing.T) {
 					assert.Equal(t, p.CloseTime, request.CloseTime.AsTime())
 					assert.Equal(t, p.ExecutionDuration, request.ExecutionDuration.AsDuration())
 					assert.ElementsMatch(t, p.ExpectedTargets, request.Targets)
+					for key, value := range p.ChasmSearchAttributes {
+						protoassert.ProtoEqual(t, value, request.SearchAttributes.GetIndexedFields()[key])
+					}
+					for key, value := range p.ChasmMemo {
+						protoassert.ProtoEqual(t, value, request.Memo.GetFields()[key])
+					}
+					assert.Equal(t, p.ChasmNodes, request.ChasmNodes)
 
 					return &archival.Response{}, p.ArchiveError
 				})
@@ -595,6 +629,9 @@ type params struct {
 	GetCloseVersionBeforeArchivalError error
 	CloseVersionAfterArchival          int64
 	GetCloseVersionAfterArchivalError  error
+	ChasmSearchAttributes              map[string]*commonpb.Payload
+	ChasmMemo                          map[string]*commonpb.Payload
+	ChasmNodes                         map[string]*persistencespb.ChasmNode
 }
 
 // archivalConfig represents the user configuration of archival for the cluster and namespace
@@ -253,6 +253,7 @@ func PersistenceRateLimitingParamsProvider(
 		OperatorRPSRatio:                   persistenceClient.OperatorRPSRatio(serviceConfig.OperatorRPSRatio),
 		PersistenceBurstRatio:              persistenceClient.PersistenceBurstRatio(serviceConfig.PersistenceQPSBurstRatio),
 		DynamicRateLimitingParams:          persistenceClient.DynamicRateLimitingParams(serviceConfig.PersistenceDynamicRateLimitingParams),
+		AdaptiveRateLimitingEnabled:        persistenceClient.PersistenceAdaptiveRateLimitingEnabled(serviceConfig.PersistenceAdaptiveLimitEnabled),
 	}
 }
 
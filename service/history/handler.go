@@ -2609,3 +2609,31 @@ func (h *Handler) ResetActivity(
 	}
 	return response, nil
 }
+
+func (h *Handler) UpsertWorkflowExecutionProperties(
+	ctx context.Context, request *historyservice.UpsertWorkflowExecutionPropertiesRequest,
+) (response *historyservice.UpsertWorkflowExecutionPropertiesResponse, retError error) {
+	defer metrics.CapturePanic(h.logger, h.metricsHandler, &retError)
+	h.startWG.Wait()
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	workflowID := request.GetExecution().GetWorkflowId()
+	if request.GetNamespaceId() == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	response, err = engine.UpsertWorkflowExecutionProperties(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	return response, nil
+}
@@ -100,6 +100,7 @@ import (
 	"go.temporal.io/server/service/history/api/updateactivityoptions"
 	"go.temporal.io/server/service/history/api/updateworkflow"
 	"go.temporal.io/server/service/history/api/updateworkflowoptions"
+	"go.temporal.io/server/service/history/api/upsertworkflowexecutionproperties"
 	"go.temporal.io/server/service/history/api/verifychildworkflowcompletionrecorded"
 	"go.temporal.io/server/service/history/api/verifyfirstworkflowtaskscheduled"
 	"go.temporal.io/server/service/history/circuitbreakerpool"
@@ -1104,3 +1105,10 @@ func (e *historyEngineImpl) ResetActivity(
 ) (*historyservice.ResetActivityResponse, error) {
 	return resetactivity.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker)
 }
+
+func (e *historyEngineImpl) UpsertWorkflowExecutionProperties(
+	ctx context.Context,
+	request *historyservice.UpsertWorkflowExecutionPropertiesRequest,
+) (*historyservice.UpsertWorkflowExecutionPropertiesResponse, error) {
+	return upsertworkflowexecutionproperties.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker, e.searchAttributesValidator)
+}
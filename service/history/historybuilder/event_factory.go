@@ -740,6 +740,18 @@ func (b *EventFactory) CreateWorkflowPropertiesModifiedEvent(
 	return event
 }
 
+func (b *EventFactory) CreateWorkflowPropertiesModifiedExternallyEvent(
+	upsertedMemo *commonpb.Memo,
+) *historypb.HistoryEvent {
+	event := b.createHistoryEvent(enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY, b.timeSource.Now())
+	event.Attributes = &historypb.HistoryEvent_WorkflowPropertiesModifiedExternallyEventAttributes{
+		WorkflowPropertiesModifiedExternallyEventAttributes: &historypb.WorkflowPropertiesModifiedExternallyEventAttributes{
+			UpsertedMemo: upsertedMemo,
+		},
+	}
+	return event
+}
+
 func (b *EventFactory) CreateSignalExternalWorkflowExecutionFailedEvent(
 	workflowTaskCompletedEventID int64,
 	initiatedEventID int64,
@@ -635,6 +635,14 @@ func (b *HistoryBuilder) AddWorkflowPropertiesModifiedEvent(
 	return event
 }
 
+func (b *HistoryBuilder) AddWorkflowPropertiesModifiedExternallyEvent(
+	upsertedMemo *commonpb.Memo,
+) *historypb.HistoryEvent {
+	event := b.EventFactory.CreateWorkflowPropertiesModifiedExternallyEvent(upsertedMemo)
+	event, _ = b.EventStore.add(event)
+	return event
+}
+
 func (b *HistoryBuilder) AddSignalExternalWorkflowExecutionFailedEvent(
 	workflowTaskCompletedEventID int64,
 	initiatedEventID int64,
@@ -25,6 +25,7 @@
 package interfaces
 
 import (
+	commonpb "go.temporal.io/api/common/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/chasm"
 )
@@ -37,4 +38,5 @@ type ChasmTree interface {
 	ApplyMutation(chasm.NodesMutation) error
 	ApplySnapshot(chasm.NodesSnapshot) error
 	IsDirty() bool
+	VisibilityAttributes() (searchAttributes map[string]*commonpb.Payload, memo map[string]*commonpb.Payload, err error)
 }
@@ -36,6 +36,7 @@ package interfaces
 import (
 	reflect "reflect"
 
+	common "go.temporal.io/api/common/v1"
 	persistence "go.temporal.io/server/api/persistence/v1"
 	chasm "go.temporal.io/server/chasm"
 	gomock "go.uber.org/mock/gomock"
@@ -135,3 +136,19 @@ func (mr *MockChasmTreeMockRecorder) Snapshot(arg0 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshot", reflect.TypeOf((*MockChasmTree)(nil).Snapshot), arg0)
 }
+
+// VisibilityAttributes mocks base method.
+func (m *MockChasmTree) VisibilityAttributes() (map[string]*common.Payload, map[string]*common.Payload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VisibilityAttributes")
+	ret0, _ := ret[0].(map[string]*common.Payload)
+	ret1, _ := ret[1].(map[string]*common.Payload)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// VisibilityAttributes indicates an expected call of VisibilityAttributes.
+func (mr *MockChasmTreeMockRecorder) VisibilityAttributes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VisibilityAttributes", reflect.TypeOf((*MockChasmTree)(nil).VisibilityAttributes))
+}
@@ -119,6 +119,7 @@ type (
 		PauseActivity(ctx context.Context, request *historyservice.PauseActivityRequest) (*historyservice.PauseActivityResponse, error)
 		UnpauseActivity(ctx context.Context, request *historyservice.UnpauseActivityRequest) (*historyservice.UnpauseActivityResponse, error)
 		ResetActivity(ctx context.Context, request *historyservice.ResetActivityRequest) (*historyservice.ResetActivityResponse, error)
+		UpsertWorkflowExecutionProperties(ctx context.Context, request *historyservice.UpsertWorkflowExecutionPropertiesRequest) (*historyservice.UpsertWorkflowExecutionPropertiesResponse, error)
 
 		NotifyNewHistoryEvent(event *events.Notification)
 		NotifyNewTasks(tasks map[tasks.Category][]tasks.Task)
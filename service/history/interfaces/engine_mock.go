@@ -1113,6 +1113,21 @@ func (mr *MockEngineMockRecorder) UpdateWorkflowExecutionOptions(ctx, request an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecutionOptions", reflect.TypeOf((*MockEngine)(nil).UpdateWorkflowExecutionOptions), ctx, request)
 }
 
+// UpsertWorkflowExecutionProperties mocks base method.
+func (m *MockEngine) UpsertWorkflowExecutionProperties(ctx context.Context, request *historyservice.UpsertWorkflowExecutionPropertiesRequest) (*historyservice.UpsertWorkflowExecutionPropertiesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertWorkflowExecutionProperties", ctx, request)
+	ret0, _ := ret[0].(*historyservice.UpsertWorkflowExecutionPropertiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertWorkflowExecutionProperties indicates an expected call of UpsertWorkflowExecutionProperties.
+func (mr *MockEngineMockRecorder) UpsertWorkflowExecutionProperties(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkflowExecutionProperties", reflect.TypeOf((*MockEngine)(nil).UpsertWorkflowExecutionProperties), ctx, request)
+}
+
 // VerifyChildExecutionCompletionRecorded mocks base method.
 func (m *MockEngine) VerifyChildExecutionCompletionRecorded(ctx context.Context, request *historyservice.VerifyChildExecutionCompletionRecordedRequest) (*historyservice.VerifyChildExecutionCompletionRecordedResponse, error) {
 	m.ctrl.T.Helper()
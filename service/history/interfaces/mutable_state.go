@@ -113,6 +113,7 @@ type (
 		AddTimerStartedEvent(int64, *commandpb.StartTimerCommandAttributes) (*historypb.HistoryEvent, *persistencespb.TimerInfo, error)
 		AddUpsertWorkflowSearchAttributesEvent(int64, *commandpb.UpsertWorkflowSearchAttributesCommandAttributes) (*historypb.HistoryEvent, error)
 		AddWorkflowPropertiesModifiedEvent(int64, *commandpb.ModifyWorkflowPropertiesCommandAttributes) (*historypb.HistoryEvent, error)
+		AddWorkflowPropertiesModifiedExternallyEvent(*commonpb.Memo) (*historypb.HistoryEvent, error)
 		AddWorkflowExecutionCancelRequestedEvent(*historyservice.RequestCancelWorkflowExecutionRequest) (*historypb.HistoryEvent, error)
 		AddWorkflowExecutionCanceledEvent(int64, *commandpb.CancelWorkflowExecutionCommandAttributes) (*historypb.HistoryEvent, error)
 		AddWorkflowExecutionSignaled(
@@ -269,6 +270,7 @@ type (
 		ApplyTimerStartedEvent(*historypb.HistoryEvent) (*persistencespb.TimerInfo, error)
 		ApplyTransientWorkflowTaskScheduled() (*WorkflowTaskInfo, error)
 		ApplyWorkflowPropertiesModifiedEvent(*historypb.HistoryEvent)
+		ApplyWorkflowPropertiesModifiedExternallyEvent(*historypb.HistoryEvent)
 		ApplyUpsertWorkflowSearchAttributesEvent(*historypb.HistoryEvent)
 		ApplyWorkflowExecutionCancelRequestedEvent(*historypb.HistoryEvent) error
 		ApplyWorkflowExecutionCanceledEvent(int64, *historypb.HistoryEvent) error
@@ -297,6 +299,9 @@ type (
 		UpdateUserTimerTaskStatus(timerId string, status int64) error
 		UpdateCurrentVersion(version int64, forceUpdate bool) error
 		UpdateWorkflowStateStatus(state enumsspb.WorkflowExecutionState, status enumspb.WorkflowExecutionStatus) error
+		// UpsertVisibilityProperties merges search attributes and memo into a closed execution without a history
+		// event and generates a visibility task to rewrite the visibility record.
+		UpsertVisibilityProperties(searchAttributes map[string]*commonpb.Payload, memo map[string]*commonpb.Payload) error
 		UpdateBuildIdAssignment(buildId string) error
 		ApplyBuildIdRedirect(startingTaskScheduledEventId int64, buildId string, redirectCounter int64) error
 		RefreshExpirationTimeoutTask(ctx context.Context) error
@@ -809,6 +809,21 @@ func (mr *MockMutableStateMockRecorder) AddWorkflowPropertiesModifiedEvent(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowPropertiesModifiedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowPropertiesModifiedEvent), arg0, arg1)
 }
 
+// AddWorkflowPropertiesModifiedExternallyEvent mocks base method.
+func (m *MockMutableState) AddWorkflowPropertiesModifiedExternallyEvent(arg0 *common.Memo) (*history.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowPropertiesModifiedExternallyEvent", arg0)
+	ret0, _ := ret[0].(*history.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowPropertiesModifiedExternallyEvent indicates an expected call of AddWorkflowPropertiesModifiedExternallyEvent.
+func (mr *MockMutableStateMockRecorder) AddWorkflowPropertiesModifiedExternallyEvent(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowPropertiesModifiedExternallyEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowPropertiesModifiedExternallyEvent), arg0)
+}
+
 // AddWorkflowTaskCompletedEvent mocks base method.
 func (m *MockMutableState) AddWorkflowTaskCompletedEvent(arg0 *WorkflowTaskInfo, arg1 *workflowservice.RespondWorkflowTaskCompletedRequest, arg2 WorkflowTaskCompletionLimits) (*history.HistoryEvent, error) {
 	m.ctrl.T.Helper()
@@ -1519,6 +1534,18 @@ func (mr *MockMutableStateMockRecorder) ApplyWorkflowPropertiesModifiedEvent(arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyWorkflowPropertiesModifiedEvent", reflect.TypeOf((*MockMutableState)(nil).ApplyWorkflowPropertiesModifiedEvent), arg0)
 }
 
+// ApplyWorkflowPropertiesModifiedExternallyEvent mocks base method.
+func (m *MockMutableState) ApplyWorkflowPropertiesModifiedExternallyEvent(arg0 *history.HistoryEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ApplyWorkflowPropertiesModifiedExternallyEvent", arg0)
+}
+
+// ApplyWorkflowPropertiesModifiedExternallyEvent indicates an expected call of ApplyWorkflowPropertiesModifiedExternallyEvent.
+func (mr *MockMutableStateMockRecorder) ApplyWorkflowPropertiesModifiedExternallyEvent(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyWorkflowPropertiesModifiedExternallyEvent", reflect.TypeOf((*MockMutableState)(nil).ApplyWorkflowPropertiesModifiedExternallyEvent), arg0)
+}
+
 // ApplyWorkflowTaskCompletedEvent mocks base method.
 func (m *MockMutableState) ApplyWorkflowTaskCompletedEvent(arg0 *history.HistoryEvent) error {
 	m.ctrl.T.Helper()
@@ -3394,6 +3421,20 @@ func (mr *MockMutableStateMockRecorder) UpdateWorkflowStateStatus(state, status
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowStateStatus", reflect.TypeOf((*MockMutableState)(nil).UpdateWorkflowStateStatus), state, status)
 }
 
+// UpsertVisibilityProperties mocks base method.
+func (m *MockMutableState) UpsertVisibilityProperties(searchAttributes, memo map[string]*common.Payload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertVisibilityProperties", searchAttributes, memo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertVisibilityProperties indicates an expected call of UpsertVisibilityProperties.
+func (mr *MockMutableStateMockRecorder) UpsertVisibilityProperties(searchAttributes, memo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertVisibilityProperties", reflect.TypeOf((*MockMutableState)(nil).UpsertVisibilityProperties), searchAttributes, memo)
+}
+
 // VisitUpdates mocks base method.
 func (m *MockMutableState) VisitUpdates(visitor func(string, *persistence.UpdateInfo)) {
 	m.ctrl.T.Helper()
@@ -242,6 +242,8 @@ func (r *workflowResetterImpl) ResetWorkflow(
 	defer func() { resetWorkflow.GetReleaseFn()(retError) }()
 
 	resetMS := resetWorkflow.GetMutableState()
+	// the fairness key isn't part of history, so it's carried over from the base run
+	resetMS.GetExecutionInfo().FairnessKey = baseWorkflow.GetMutableState().GetExecutionInfo().GetFairnessKey()
 	if err := reapplyEventsFn(ctx, resetMS); err != nil {
 		return err
 	}
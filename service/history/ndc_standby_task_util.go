@@ -157,6 +157,8 @@ type (
 		activityTaskScheduleToStartTimeout time.Duration
 		versionDirective                   *taskqueuespb.TaskVersionDirective
 		priority                           *commonpb.Priority
+		fairnessKey                        string
+		activityType                       string
 	}
 
 	verifyCompletionRecordedPostActionInfo struct {
@@ -168,6 +170,7 @@ type (
 		taskqueue                          *taskqueuepb.TaskQueue
 		versionDirective                   *taskqueuespb.TaskVersionDirective
 		priority                           *commonpb.Priority
+		fairnessKey                        string
 	}
 )
 
@@ -190,6 +193,8 @@ func newActivityTaskPostActionInfo(
 		activityTaskScheduleToStartTimeout: activityInfo.ScheduleToStartTimeout.AsDuration(),
 		versionDirective:                   directive,
 		priority:                           priority,
+		fairnessKey:                        mutableState.GetExecutionInfo().FairnessKey,
+		activityType:                       activityInfo.GetActivityType().GetName(),
 	}, nil
 }
 
@@ -207,6 +212,8 @@ func newActivityRetryTimePostActionInfo(
 		activityTaskScheduleToStartTimeout: activityScheduleToStartTimeout,
 		versionDirective:                   directive,
 		priority:                           priority,
+		fairnessKey:                        mutableState.GetExecutionInfo().FairnessKey,
+		activityType:                       activityInfo.GetActivityType().GetName(),
 	}, nil
 }
 
@@ -223,6 +230,7 @@ func newWorkflowTaskPostActionInfo(
 		taskqueue:                          taskqueue,
 		versionDirective:                   directive,
 		priority:                           priority,
+		fairnessKey:                        mutableState.GetExecutionInfo().FairnessKey,
 	}, nil
 }
 
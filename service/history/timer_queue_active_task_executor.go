@@ -569,6 +569,8 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 	directive := MakeDirectiveForActivityTask(mutableState, activityInfo)
 	useWfBuildId := activityInfo.GetUseWorkflowBuildIdInfo() != nil
 	priority := priorities.Merge(mutableState.GetExecutionInfo().Priority, activityInfo.Priority)
+	fairnessKey := mutableState.GetExecutionInfo().FairnessKey
+	activityType := activityInfo.GetActivityType().GetName()
 
 	// NOTE: do not access anything related mutable state after this lock release
 	release(nil) // release earlier as we don't need the lock anymore
@@ -586,6 +588,8 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 		VersionDirective:       directive,
 		Stamp:                  task.Stamp,
 		Priority:               priority,
+		FairnessKey:            fairnessKey,
+		ActivityType:           activityType,
 	})
 	if err != nil {
 		return err
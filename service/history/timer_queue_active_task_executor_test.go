@@ -1393,6 +1393,7 @@ func (s *timerQueueActiveTaskExecutorSuite) TestActivityRetryTimer_Fire() {
 			ScheduleToStartTimeout: activityInfo.ScheduleToStartTimeout,
 			Clock:                  vclock.NewVectorClock(s.mockClusterMetadata.GetClusterID(), s.mockShard.GetShardID(), timerTask.TaskID),
 			VersionDirective:       worker_versioning.MakeUseAssignmentRulesDirective(),
+			ActivityType:           activityInfo.GetActivityType().GetName(),
 		}),
 		gomock.Any(),
 	).Return(&matchingservice.AddActivityTaskResponse{}, nil)
@@ -634,6 +634,8 @@ func (t *timerQueueStandbyTaskExecutor) pushActivity(
 		Clock:                  vclock.NewVectorClock(t.shardContext.GetClusterMetadata().GetClusterID(), t.shardContext.GetShardID(), activityTask.TaskID),
 		VersionDirective:       pushActivityInfo.versionDirective,
 		Stamp:                  activityTask.Stamp,
+		FairnessKey:            pushActivityInfo.fairnessKey,
+		ActivityType:           pushActivityInfo.activityType,
 	})
 
 	if err != nil {
@@ -218,13 +218,15 @@ func (t *transferQueueActiveTaskExecutor) processActivityTask(
 	timeout := timestamp.DurationValue(ai.ScheduleToStartTimeout)
 	directive := MakeDirectiveForActivityTask(mutableState, ai)
 	priority := priorities.Merge(mutableState.GetExecutionInfo().Priority, ai.Priority)
+	fairnessKey := mutableState.GetExecutionInfo().FairnessKey
+	activityType := ai.GetActivityType().GetName()
 
 	// NOTE: do not access anything related mutable state after this lock release
 	// release the context lock since we no longer need mutable state and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
 
-	return t.pushActivity(ctx, task, timeout, directive, priority, historyi.TransactionPolicyActive)
+	return t.pushActivity(ctx, task, timeout, directive, priority, fairnessKey, activityType, historyi.TransactionPolicyActive)
 }
 
 func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
@@ -267,6 +269,7 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 
 	directive := MakeDirectiveForWorkflowTask(mutableState)
 	priority := mutableState.GetExecutionInfo().Priority
+	fairnessKey := mutableState.GetExecutionInfo().FairnessKey
 
 	// NOTE: Do not access mutableState after this lock is released.
 	// It is important to release the workflow lock here, because pushWorkflowTask will call matching,
@@ -280,6 +283,7 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 		scheduleToStartTimeout.AsDuration(),
 		directive,
 		priority,
+		fairnessKey,
 		historyi.TransactionPolicyActive,
 	)
 
@@ -303,6 +307,7 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 			scheduleToStartTimeout.AsDuration(),
 			directive,
 			priority,
+			fairnessKey,
 			historyi.TransactionPolicyActive,
 		)
 	}
@@ -942,6 +947,7 @@ func (t *transferQueueActiveTaskExecutor) processStartChildExecution(
 		parentPinnedVersion,
 		parentPinnedOverride,
 		priorities.Merge(mutableState.GetExecutionInfo().Priority, attributes.Priority),
+		executionInfo.FairnessKey,
 	)
 	if err != nil {
 		t.logger.Debug("Failed to start child workflow execution", tag.Error(err))
@@ -1515,6 +1521,7 @@ func (t *transferQueueActiveTaskExecutor) startWorkflow(
 	parentPinnedVersion string,
 	parentPinnedOverride *workflowpb.VersioningOverride,
 	priority *commonpb.Priority,
+	fairnessKey string,
 ) (string, *clockspb.VectorClock, error) {
 	startRequest := &workflowservice.StartWorkflowExecutionRequest{
 		Namespace:                targetNamespace.String(),
@@ -1560,6 +1567,8 @@ func (t *transferQueueActiveTaskExecutor) startWorkflow(
 
 	request.SourceVersionStamp = sourceVersionStamp
 	request.InheritedBuildId = inheritedBuildId
+	// the child inherits the parent's fairness key
+	request.FairnessKey = fairnessKey
 
 	if shouldTerminateAndStartChild {
 		request.StartRequest.WorkflowIdReusePolicy = enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE
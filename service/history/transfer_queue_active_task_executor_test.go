@@ -2641,6 +2641,7 @@ func (s *transferQueueActiveTaskExecutorSuite) createAddActivityTaskRequest(
 		Clock:                  vclock.NewVectorClock(s.mockClusterMetadata.GetClusterID(), s.mockShard.GetShardID(), task.TaskID),
 		VersionDirective:       worker_versioning.MakeUseAssignmentRulesDirective(),
 		Stamp:                  ai.Stamp,
+		ActivityType:           ai.GetActivityType().GetName(),
 	}
 }
 
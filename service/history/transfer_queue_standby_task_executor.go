@@ -530,6 +530,8 @@ func (t *transferQueueStandbyTaskExecutor) pushActivity(
 		pushActivityInfo.activityTaskScheduleToStartTimeout,
 		pushActivityInfo.versionDirective,
 		pushActivityInfo.priority,
+		pushActivityInfo.fairnessKey,
+		pushActivityInfo.activityType,
 		historyi.TransactionPolicyPassive,
 	)
 }
@@ -552,6 +554,7 @@ func (t *transferQueueStandbyTaskExecutor) pushWorkflowTask(
 		pushwtInfo.workflowTaskScheduleToStartTimeout,
 		pushwtInfo.versionDirective,
 		pushwtInfo.priority,
+		pushwtInfo.fairnessKey,
 		historyi.TransactionPolicyPassive,
 	)
 }
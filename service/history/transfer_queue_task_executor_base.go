@@ -118,6 +118,8 @@ func (t *transferQueueTaskExecutorBase) pushActivity(
 	activityScheduleToStartTimeout time.Duration,
 	directive *taskqueuespb.TaskVersionDirective,
 	priority *commonpb.Priority,
+	fairnessKey string,
+	activityType string,
 	transactionPolicy historyi.TransactionPolicy,
 ) error {
 	resp, err := t.matchingRawClient.AddActivityTask(ctx, &matchingservice.AddActivityTaskRequest{
@@ -136,6 +138,8 @@ func (t *transferQueueTaskExecutorBase) pushActivity(
 		VersionDirective:       directive,
 		Stamp:                  task.Stamp,
 		Priority:               priority,
+		FairnessKey:            fairnessKey,
+		ActivityType:           activityType,
 	})
 	if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
 		// NotFound error is not expected for AddTasks calls
@@ -171,6 +175,7 @@ func (t *transferQueueTaskExecutorBase) pushWorkflowTask(
 	workflowTaskScheduleToStartTimeout time.Duration,
 	directive *taskqueuespb.TaskVersionDirective,
 	priority *commonpb.Priority,
+	fairnessKey string,
 	transactionPolicy historyi.TransactionPolicy,
 ) error {
 	var sst *durationpb.Duration
@@ -189,6 +194,7 @@ func (t *transferQueueTaskExecutorBase) pushWorkflowTask(
 		Clock:                  vclock.NewVectorClock(t.shardContext.GetClusterMetadata().GetClusterID(), t.shardContext.GetShardID(), task.TaskID),
 		VersionDirective:       directive,
 		Priority:               priority,
+		FairnessKey:            fairnessKey,
 	})
 	if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
 		// NotFound error is not expected for AddTasks calls
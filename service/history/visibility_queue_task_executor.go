@@ -26,6 +26,7 @@ package history
 
 import (
 	"context"
+	"maps"
 	"time"
 
 	commonpb "go.temporal.io/api/common/v1"
@@ -175,7 +176,10 @@ func (t *visibilityQueueTaskExecutor) processStartExecution(
 		return err
 	}
 
-	requestBase := t.getVisibilityRequestBase(task, namespaceEntry, mutableState)
+	requestBase, err := t.getVisibilityRequestBase(task, namespaceEntry, mutableState)
+	if err != nil {
+		return err
+	}
 
 	// NOTE: do not access anything related mutable state after this lock release
 	// release the context lock since we no longer need mutable state and
@@ -217,7 +221,10 @@ func (t *visibilityQueueTaskExecutor) processUpsertExecution(
 		return nil
 	}
 
-	requestBase := t.getVisibilityRequestBase(task, namespaceEntry, mutableState)
+	requestBase, err := t.getVisibilityRequestBase(task, namespaceEntry, mutableState)
+	if err != nil {
+		return err
+	}
 
 	// NOTE: do not access anything related mutable state after this lock release
 	// release the context lock since we no longer need mutable state and
@@ -280,7 +287,10 @@ func (t *visibilityQueueTaskExecutor) processCloseExecution(
 	executionInfo := mutableState.GetExecutionInfo()
 	stateTransitionCount := executionInfo.GetStateTransitionCount()
 	historySizeBytes := executionInfo.GetExecutionStats().GetHistorySize()
-	requestBase := t.getVisibilityRequestBase(task, namespaceEntry, mutableState)
+	requestBase, err := t.getVisibilityRequestBase(task, namespaceEntry, mutableState)
+	if err != nil {
+		return err
+	}
 
 	// NOTE: do not access anything related mutable state after this lock release
 	// release the context lock since we no longer need mutable state and
@@ -363,15 +373,22 @@ func (t *visibilityQueueTaskExecutor) getVisibilityRequestBase(
 	task tasks.Task,
 	namespaceEntry *namespace.Namespace,
 	mutableState historyi.MutableState,
-) *manager.VisibilityRequestBase {
+) (*manager.VisibilityRequestBase, error) {
 	var (
-		executionInfo    = mutableState.GetExecutionInfo()
-		startTime        = timestamp.TimeValue(mutableState.GetExecutionState().GetStartTime())
-		executionTime    = timestamp.TimeValue(executionInfo.GetExecutionTime())
-		visibilityMemo   = getWorkflowMemo(copyMapPayload(executionInfo.Memo))
-		searchAttributes = getSearchAttributes(copyMapPayload(executionInfo.SearchAttributes))
+		executionInfo = mutableState.GetExecutionInfo()
+		startTime     = timestamp.TimeValue(mutableState.GetExecutionState().GetStartTime())
+		executionTime = timestamp.TimeValue(executionInfo.GetExecutionTime())
 	)
 
+	// Search attributes and memo declared by the CHASM root component are added on top of the ones
+	// of the workflow. Values computed from the CHASM tree are new maps, so they don't need to be copied.
+	chasmSearchAttributes, chasmMemo, err := mutableState.ChasmTree().VisibilityAttributes()
+	if err != nil {
+		return nil, err
+	}
+	visibilityMemo := getWorkflowMemo(mergeMapPayload(copyMapPayload(executionInfo.Memo), chasmMemo))
+	searchAttributes := getSearchAttributes(mergeMapPayload(copyMapPayload(executionInfo.SearchAttributes), chasmSearchAttributes))
+
 	var parentExecution *commonpb.WorkflowExecution
 	if executionInfo.ParentWorkflowId != "" && executionInfo.ParentRunId != "" {
 		parentExecution = &commonpb.WorkflowExecution{
@@ -404,7 +421,7 @@ func (t *visibilityQueueTaskExecutor) getVisibilityRequestBase(
 			WorkflowId: executionInfo.RootWorkflowId,
 			RunId:      executionInfo.RootRunId,
 		},
-	}
+	}, nil
 }
 
 func (t *visibilityQueueTaskExecutor) isCloseExecutionVisibilityTaskPending(task *tasks.DeleteExecutionVisibilityTask) bool {
@@ -482,6 +499,18 @@ func getSearchAttributes(
 	return &commonpb.SearchAttributes{IndexedFields: indexedFields}
 }
 
+// mergeMapPayload adds the entries of src to dst, allocating dst if needed.
+func mergeMapPayload(dst map[string]*commonpb.Payload, src map[string]*commonpb.Payload) map[string]*commonpb.Payload {
+	if len(src) == 0 {
+		return dst
+	}
+	if dst == nil {
+		dst = make(map[string]*commonpb.Payload, len(src))
+	}
+	maps.Copy(dst, src)
+	return dst
+}
+
 func copyMapPayload(input map[string]*commonpb.Payload) map[string]*commonpb.Payload {
 	if input == nil {
 		return nil
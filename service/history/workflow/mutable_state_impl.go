@@ -270,6 +270,7 @@ type (
 )
 
 var _ historyi.MutableState = (*MutableStateImpl)(nil)
+var _ chasm.NodeBackend = (*MutableStateImpl)(nil)
 
 func NewMutableState(
 	shard historyi.ShardContext,
@@ -2228,6 +2229,7 @@ func (ms *MutableStateImpl) addWorkflowExecutionStartedEventForContinueAsNew(
 		SourceVersionStamp:       sourceVersionStamp,
 		RootExecutionInfo:        rootExecutionInfo,
 		InheritedBuildId:         inheritedBuildId,
+		FairnessKey:              previousExecutionInfo.FairnessKey,
 	}
 	if command.GetInitiator() == enumspb.CONTINUE_AS_NEW_INITIATOR_RETRY {
 		req.Attempt = previousExecutionState.GetExecutionInfo().Attempt + 1
@@ -2331,6 +2333,9 @@ func (ms *MutableStateImpl) AddWorkflowExecutionStartedEventWithOptions(
 	); err != nil {
 		return nil, err
 	}
+	// the fairness key isn't part of the started event, it has to be set before the first
+	// workflow task is generated
+	ms.executionInfo.FairnessKey = startRequest.GetFairnessKey()
 
 	// TODO merge active & passive task generation
 	var err error
@@ -4213,6 +4218,37 @@ func (ms *MutableStateImpl) ApplyWorkflowPropertiesModifiedEvent(
 	}
 }
 
+// AddWorkflowPropertiesModifiedExternallyEvent records a memo change of a running execution that
+// was not made by the workflow itself, e.g. by a batch operation, so that it is replicated and
+// survives mutable state rebuilds.
+func (ms *MutableStateImpl) AddWorkflowPropertiesModifiedExternallyEvent(
+	upsertedMemo *commonpb.Memo,
+) (*historypb.HistoryEvent, error) {
+	opTag := tag.WorkflowActionWorkflowPropertiesModifiedExternally
+	if err := ms.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := ms.hBuilder.AddWorkflowPropertiesModifiedExternallyEvent(upsertedMemo)
+	ms.ApplyWorkflowPropertiesModifiedExternallyEvent(event)
+	if err := ms.taskGenerator.GenerateUpsertVisibilityTask(); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (ms *MutableStateImpl) ApplyWorkflowPropertiesModifiedExternallyEvent(
+	event *historypb.HistoryEvent,
+) {
+	attr := event.GetWorkflowPropertiesModifiedExternallyEventAttributes()
+	if attr.UpsertedMemo != nil {
+		upsertMemo := attr.GetUpsertedMemo().GetFields()
+		ms.approximateSize -= ms.executionInfo.Size()
+		ms.updateMemo(upsertMemo)
+		ms.approximateSize += ms.executionInfo.Size()
+	}
+}
+
 func (ms *MutableStateImpl) AddExternalWorkflowExecutionSignaled(
 	initiatedID int64,
 	targetNamespace namespace.Name,
@@ -5767,6 +5803,57 @@ func (ms *MutableStateImpl) UpdateWorkflowStateStatus(
 	return setStateStatus(ms.executionState, state, status)
 }
 
+// GenerateUpsertVisibilityTask implements chasm.NodeBackend.
+func (ms *MutableStateImpl) GenerateUpsertVisibilityTask() error {
+	return ms.taskGenerator.GenerateUpsertVisibilityTask()
+}
+
+// UpsertVisibilityProperties merges search attributes and memo into a closed execution without a
+// history event, and schedules a close visibility task to rewrite its visibility record. Changes to
+// running executions must be recorded in history instead, see AddWorkflowPropertiesModifiedExternallyEvent.
+func (ms *MutableStateImpl) UpsertVisibilityProperties(
+	searchAttributes map[string]*commonpb.Payload,
+	memo map[string]*commonpb.Payload,
+) error {
+	if ms.IsWorkflowExecutionRunning() {
+		return serviceerror.NewInternal("UpsertVisibilityProperties is only supported for closed workflow executions")
+	}
+	closeVersion, err := ms.GetCloseVersion()
+	if err != nil {
+		return err
+	}
+	if len(searchAttributes) > 0 {
+		ms.updateSearchAttributes(searchAttributes)
+	}
+	if len(memo) > 0 {
+		ms.updateMemo(memo)
+	}
+	// Upsert visibility task is ignored for closed executions, visibility record is rewritten by close task instead.
+	ms.AddTasks(&tasks.CloseExecutionVisibilityTask{
+		// TaskID, VisibilityTimestamp is set by shard
+		WorkflowKey: ms.GetWorkflowKey(),
+		Version:     closeVersion,
+	})
+	return nil
+}
+
+// CloseEntity implements chasm.NodeBackend. It closes the execution backing a CHASM entity and
+// schedules its visibility, archival and retention tasks.
+func (ms *MutableStateImpl) CloseEntity(entityClose chasm.EntityClose) error {
+	status := enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+	if entityClose.LifecycleState == chasm.LifecycleStateFailed {
+		status = enumspb.WORKFLOW_EXECUTION_STATUS_FAILED
+	}
+	if err := ms.UpdateWorkflowStateStatus(
+		enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED,
+		status,
+	); err != nil {
+		return err
+	}
+	ms.executionInfo.CloseTime = timestamppb.New(entityClose.CloseTime)
+	return ms.taskGenerator.GenerateChasmEntityCloseTasks(entityClose.CloseTime, entityClose.Archive)
+}
+
 // IsDirty is used for sanity check that mutable state is "clean" after mutable state lock is released.
 // However, certain in-memory changes (e.g. speculative workflow task) won't be cleared before releasing
 // the lock and have to be excluded from the check.
@@ -61,6 +61,7 @@ import (
 	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence/transitionhistory"
 	"go.temporal.io/server/common/persistence/versionhistory"
@@ -269,6 +270,25 @@ func (s *mutableStateSuite) TestTransientWorkflowTaskCompletionFirstBatchApplied
 	s.Equal(0, s.mutableState.hBuilder.NumBufferedEvents())
 }
 
+func (s *mutableStateSuite) TestAddWorkflowExecutionStartedEvent_FairnessKey() {
+	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).AnyTimes()
+
+	event, err := s.mutableState.AddWorkflowExecutionStartedEvent(
+		&commonpb.WorkflowExecution{
+			WorkflowId: tests.WorkflowID,
+			RunId:      tests.RunID,
+		},
+		&historyservice.StartWorkflowExecutionRequest{
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{},
+			FairnessKey:  "tenant-1",
+		},
+	)
+	s.NoError(err)
+	s.Equal("tenant-1", s.mutableState.GetExecutionInfo().FairnessKey)
+	// the key is server-side state, it's not exposed in the workflow header
+	s.Empty(event.GetWorkflowExecutionStartedEventAttributes().GetHeader().GetFields())
+}
+
 func (s *mutableStateSuite) TestTransientWorkflowTaskCompletionFirstBatchApplied_FailoverWorkflowTaskTimeout() {
 	version := int64(12)
 	workflowID := "some random workflow ID"
@@ -3309,6 +3329,85 @@ func (s *mutableStateSuite) TestGetCloseVersion() {
 	s.Equal(expectedVersion, closeVersion)
 }
 
+func (s *mutableStateSuite) TestUpsertVisibilityProperties() {
+	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).AnyTimes()
+
+	_, err := s.mutableState.AddWorkflowExecutionStartedEvent(
+		&commonpb.WorkflowExecution{
+			WorkflowId: tests.WorkflowID,
+			RunId:      tests.RunID,
+		},
+		&historyservice.StartWorkflowExecutionRequest{
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{},
+		},
+	)
+	s.NoError(err)
+	_, err = s.mutableState.AddWorkflowTaskScheduledEvent(false, enumsspb.WORKFLOW_TASK_TYPE_NORMAL)
+	s.NoError(err)
+	_, _, err = s.mutableState.CloseTransactionAsMutation(historyi.TransactionPolicyActive)
+	s.NoError(err)
+
+	searchAttributes := map[string]*commonpb.Payload{"CustomKeywordField": payload.EncodeString("value")}
+	memo := map[string]*commonpb.Payload{"memo-key": payload.EncodeString("memo-value")}
+
+	// running execution must record changes in history
+	err = s.mutableState.UpsertVisibilityProperties(searchAttributes, memo)
+	var internalErr *serviceerror.Internal
+	s.ErrorAs(err, &internalErr)
+	s.Nil(s.mutableState.GetExecutionInfo().Memo["memo-key"])
+
+	_, err = s.mutableState.AddCompletedWorkflowEvent(
+		5,
+		&commandpb.CompleteWorkflowExecutionCommandAttributes{},
+		"",
+	)
+	s.NoError(err)
+	_, _, err = s.mutableState.CloseTransactionAsMutation(historyi.TransactionPolicyActive)
+	s.NoError(err)
+
+	// closed execution gets its close visibility record rewritten
+	s.mutableState.InsertTasks[tasks.CategoryVisibility] = []tasks.Task{}
+	err = s.mutableState.UpsertVisibilityProperties(searchAttributes, memo)
+	s.NoError(err)
+	protorequire.ProtoEqual(s.T(), searchAttributes["CustomKeywordField"], s.mutableState.GetExecutionInfo().SearchAttributes["CustomKeywordField"])
+	protorequire.ProtoEqual(s.T(), memo["memo-key"], s.mutableState.GetExecutionInfo().Memo["memo-key"])
+	visTasks := s.mutableState.InsertTasks[tasks.CategoryVisibility]
+	s.Len(visTasks, 1)
+	s.Equal(enumsspb.TASK_TYPE_VISIBILITY_CLOSE_EXECUTION, visTasks[0].GetType())
+}
+
+func (s *mutableStateSuite) TestAddWorkflowPropertiesModifiedExternallyEvent() {
+	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).AnyTimes()
+
+	_, err := s.mutableState.AddWorkflowExecutionStartedEvent(
+		&commonpb.WorkflowExecution{
+			WorkflowId: tests.WorkflowID,
+			RunId:      tests.RunID,
+		},
+		&historyservice.StartWorkflowExecutionRequest{
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{},
+		},
+	)
+	s.NoError(err)
+	_, _, err = s.mutableState.CloseTransactionAsMutation(historyi.TransactionPolicyActive)
+	s.NoError(err)
+
+	memo := &commonpb.Memo{Fields: map[string]*commonpb.Payload{"memo-key": payload.EncodeString("memo-value")}}
+	event, err := s.mutableState.AddWorkflowPropertiesModifiedExternallyEvent(memo)
+	s.NoError(err)
+	s.Equal(enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY, event.GetEventType())
+	protorequire.ProtoEqual(s.T(), memo, event.GetWorkflowPropertiesModifiedExternallyEventAttributes().GetUpsertedMemo())
+	protorequire.ProtoEqual(s.T(), memo.Fields["memo-key"], s.mutableState.GetExecutionInfo().Memo["memo-key"])
+	visTasks := s.mutableState.InsertTasks[tasks.CategoryVisibility]
+	s.Len(visTasks, 1)
+	s.Equal(enumsspb.TASK_TYPE_VISIBILITY_UPSERT_EXECUTION, visTasks[0].GetType())
+
+	// the change is replayed from history
+	s.mutableState.executionInfo.Memo = nil
+	s.mutableState.ApplyWorkflowPropertiesModifiedExternallyEvent(event)
+	protorequire.ProtoEqual(s.T(), memo.Fields["memo-key"], s.mutableState.GetExecutionInfo().Memo["memo-key"])
+}
+
 func (s *mutableStateSuite) TestCloseTransactionPrepareReplicationTasks_HistoryTask() {
 	version := int64(777)
 	firstEventID := int64(2)
@@ -4722,3 +4821,48 @@ func (s *mutableStateSuite) TestUpdateActivityTaskStatusWithTimerHeartbeat() {
 	s.Equal(status, dbState.ActivityInfos[scheduleEventId].TimerTaskStatus)
 	s.Equal(originalTime, mutableState.pendingActivityTimerHeartbeats[scheduleEventId])
 }
+
+func (s *mutableStateSuite) TestCloseEntity() {
+	testCases := []struct {
+		name           string
+		lifecycleState chasm.LifecycleState
+		expectedStatus enumspb.WorkflowExecutionStatus
+	}{
+		{
+			name:           "completed",
+			lifecycleState: chasm.LifecycleStateCompleted,
+			expectedStatus: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		},
+		{
+			name:           "failed",
+			lifecycleState: chasm.LifecycleStateFailed,
+			expectedStatus: enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.NoError(s.mutableState.UpdateWorkflowStateStatus(
+				enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+				enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			))
+			mockTaskGenerator := NewMockTaskGenerator(s.controller)
+			s.mutableState.taskGenerator = mockTaskGenerator
+
+			closeTime := time.Now().UTC()
+			mockTaskGenerator.EXPECT().GenerateChasmEntityCloseTasks(closeTime, true).Return(nil).Times(1)
+
+			err := s.mutableState.CloseEntity(chasm.EntityClose{
+				CloseTime:      closeTime,
+				LifecycleState: tc.lifecycleState,
+				Archive:        true,
+			})
+			s.NoError(err)
+
+			state, status := s.mutableState.GetWorkflowStateStatus()
+			s.Equal(enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, state)
+			s.Equal(tc.expectedStatus, status)
+			s.Equal(closeTime, s.mutableState.GetExecutionInfo().GetCloseTime().AsTime())
+		})
+	}
+}
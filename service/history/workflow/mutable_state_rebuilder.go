@@ -671,9 +671,14 @@ func (b *MutableStateRebuilderImpl) applyEvents(
 				return nil, err
 			}
 
-		case enumspb.EVENT_TYPE_ACTIVITY_PROPERTIES_MODIFIED_EXTERNALLY,
-			enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY:
-			return nil, serviceerror.NewUnimplemented("Workflow/activity property modification not implemented")
+		case enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY:
+			b.mutableState.ApplyWorkflowPropertiesModifiedExternallyEvent(event)
+			if err := taskGenerator.GenerateUpsertVisibilityTask(); err != nil {
+				return nil, err
+			}
+
+		case enumspb.EVENT_TYPE_ACTIVITY_PROPERTIES_MODIFIED_EXTERNALLY:
+			return nil, serviceerror.NewUnimplemented("Activity property modification not implemented")
 
 		case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_OPTIONS_UPDATED:
 			if err := b.mutableState.ApplyWorkflowExecutionOptionsUpdatedEvent(event); err != nil {
@@ -980,6 +980,37 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowPropertiesModified(
 	s.Equal(event.TaskId, s.executionInfo.LastEventTaskId)
 }
 
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowPropertiesModifiedExternally() {
+	version := int64(1)
+	requestID := uuid.New()
+
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      tests.RunID,
+	}
+
+	now := time.Now().UTC()
+	evenType := enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY
+	event := &historypb.HistoryEvent{
+		TaskId:    rand.Int63(),
+		Version:   version,
+		EventId:   130,
+		EventTime: timestamppb.New(now),
+		EventType: evenType,
+		Attributes: &historypb.HistoryEvent_WorkflowPropertiesModifiedExternallyEventAttributes{
+			WorkflowPropertiesModifiedExternallyEventAttributes: &historypb.WorkflowPropertiesModifiedExternallyEventAttributes{},
+		},
+	}
+	s.mockMutableState.EXPECT().ApplyWorkflowPropertiesModifiedExternallyEvent(protomock.Eq(event)).Return()
+	s.mockUpdateVersion(event)
+	s.mockTaskGenerator.EXPECT().GenerateUpsertVisibilityTask().Return(nil)
+	s.mockMutableState.EXPECT().ClearStickyTaskQueue()
+
+	_, err := s.stateRebuilder.ApplyEvents(context.Background(), tests.NamespaceID, requestID, execution, s.toHistory(event), nil, "")
+	s.Nil(err)
+	s.Equal(event.TaskId, s.executionInfo.LastEventTaskId)
+}
+
 func (s *stateBuilderSuite) TestApplyEvents_EventTypeMarkerRecorded() {
 	version := int64(1)
 	requestID := uuid.New()
@@ -23,6 +23,7 @@
 package workflow
 
 import (
+	commonpb "go.temporal.io/api/common/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/chasm"
 	historyi "go.temporal.io/server/service/history/interfaces"
@@ -51,3 +52,7 @@ func (*noopChasmTree) ApplySnapshot(chasm.NodesSnapshot) error {
 func (*noopChasmTree) IsDirty() bool {
 	return false
 }
+
+func (*noopChasmTree) VisibilityAttributes() (map[string]*commonpb.Payload, map[string]*commonpb.Payload, error) {
+	return nil, nil, nil
+}
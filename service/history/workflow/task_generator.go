@@ -56,6 +56,8 @@ type (
 			closedTime time.Time,
 			deleteAfterClose bool,
 		) error
+		// GenerateChasmEntityCloseTasks adds the visibility, archival and retention tasks of a closed CHASM entity.
+		GenerateChasmEntityCloseTasks(closedTime time.Time, archive bool) error
 		// GenerateDeleteHistoryEventTask adds a tasks.DeleteHistoryEventTask to the mutable state.
 		// This task is used to delete the history events of the workflow execution after the retention period expires.
 		GenerateDeleteHistoryEventTask(closeTime time.Time) error
@@ -243,26 +245,10 @@ func (r *TaskGeneratorImpl) GenerateWorkflowCloseTasks(
 			},
 		)
 		if r.archivalEnabled() {
-			retention, err := r.getRetention()
+			task, err := r.newArchiveExecutionTask(closedTime, closeVersion)
 			if err != nil {
 				return err
 			}
-			// We schedule the archival task for a random time in the near future to avoid sending a surge of tasks
-			// to the archival system at the same time
-
-			delay := backoff.FullJitter(r.config.ArchivalProcessorArchiveDelay())
-			if delay > retention {
-				delay = retention
-			}
-			// archiveTime is the time when the archival queue recognizes the ArchiveExecutionTask as ready-to-process
-			archiveTime := closedTime.Add(delay)
-
-			task := &tasks.ArchiveExecutionTask{
-				// TaskID is set by the shard
-				WorkflowKey:         r.mutableState.GetWorkflowKey(),
-				VisibilityTimestamp: archiveTime,
-				Version:             closeVersion,
-			}
 			closeTasks = append(closeTasks, task)
 		} else if err := r.GenerateDeleteHistoryEventTask(closedTime); err != nil {
 			return err
@@ -274,6 +260,66 @@ func (r *TaskGeneratorImpl) GenerateWorkflowCloseTasks(
 	return nil
 }
 
+// GenerateChasmEntityCloseTasks is the counterpart of GenerateWorkflowCloseTasks for CHASM entities.
+// There is no parent or child to notify, so no CloseExecutionTask is needed. Entities are archived
+// if requested by their root component and archival is enabled, and deleted by retention afterward.
+func (r *TaskGeneratorImpl) GenerateChasmEntityCloseTasks(
+	closedTime time.Time,
+	archive bool,
+) error {
+	closeVersion, err := r.mutableState.GetCloseVersion()
+	if err != nil {
+		return err
+	}
+
+	closeTasks := []tasks.Task{
+		&tasks.CloseExecutionVisibilityTask{
+			// TaskID, VisibilityTimestamp is set by shard
+			WorkflowKey: r.mutableState.GetWorkflowKey(),
+			Version:     closeVersion,
+		},
+	}
+	if archive && r.archivalEnabled() {
+		task, err := r.newArchiveExecutionTask(closedTime, closeVersion)
+		if err != nil {
+			return err
+		}
+		closeTasks = append(closeTasks, task)
+	} else if err := r.GenerateDeleteHistoryEventTask(closedTime); err != nil {
+		return err
+	}
+
+	r.mutableState.AddTasks(closeTasks...)
+
+	return nil
+}
+
+func (r *TaskGeneratorImpl) newArchiveExecutionTask(
+	closedTime time.Time,
+	closeVersion int64,
+) (*tasks.ArchiveExecutionTask, error) {
+	retention, err := r.getRetention()
+	if err != nil {
+		return nil, err
+	}
+	// We schedule the archival task for a random time in the near future to avoid sending a surge of tasks
+	// to the archival system at the same time
+
+	delay := backoff.FullJitter(r.config.ArchivalProcessorArchiveDelay())
+	if delay > retention {
+		delay = retention
+	}
+	// archiveTime is the time when the archival queue recognizes the ArchiveExecutionTask as ready-to-process
+	archiveTime := closedTime.Add(delay)
+
+	return &tasks.ArchiveExecutionTask{
+		// TaskID is set by the shard
+		WorkflowKey:         r.mutableState.GetWorkflowKey(),
+		VisibilityTimestamp: archiveTime,
+		Version:             closeVersion,
+	}, nil
+}
+
 // getRetention returns the retention period for this task generator's workflow execution.
 // The retention period represents how long the workflow data should exist in primary storage after the workflow closes.
 // If the workflow namespace is not found, the default retention period is returned.
@@ -111,6 +111,20 @@ func (mr *MockTaskGeneratorMockRecorder) GenerateActivityTimerTasks() *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateActivityTimerTasks", reflect.TypeOf((*MockTaskGenerator)(nil).GenerateActivityTimerTasks))
 }
 
+// GenerateChasmEntityCloseTasks mocks base method.
+func (m *MockTaskGenerator) GenerateChasmEntityCloseTasks(closedTime time.Time, archive bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateChasmEntityCloseTasks", closedTime, archive)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GenerateChasmEntityCloseTasks indicates an expected call of GenerateChasmEntityCloseTasks.
+func (mr *MockTaskGeneratorMockRecorder) GenerateChasmEntityCloseTasks(closedTime, archive any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateChasmEntityCloseTasks", reflect.TypeOf((*MockTaskGenerator)(nil).GenerateChasmEntityCloseTasks), closedTime, archive)
+}
+
 // GenerateChildWorkflowTasks mocks base method.
 func (m *MockTaskGenerator) GenerateChildWorkflowTasks(event *history.HistoryEvent) error {
 	m.ctrl.T.Helper()
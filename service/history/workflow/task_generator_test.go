@@ -333,6 +333,131 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 	}
 }
 
+func TestTaskGeneratorImpl_GenerateChasmEntityCloseTasks(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range []struct {
+		Name                       string
+		Archive                    bool
+		ArchivalEnabled            bool
+		ExpectArchiveExecutionTask bool
+	}{
+		{
+			Name:                       "archival requested and enabled",
+			Archive:                    true,
+			ArchivalEnabled:            true,
+			ExpectArchiveExecutionTask: true,
+		},
+		{
+			Name:                       "archival requested but disabled",
+			Archive:                    true,
+			ArchivalEnabled:            false,
+			ExpectArchiveExecutionTask: false,
+		},
+		{
+			Name:                       "archival not requested",
+			Archive:                    false,
+			ArchivalEnabled:            true,
+			ExpectArchiveExecutionTask: false,
+		},
+	} {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			retention := 24 * time.Hour
+			archivalState := enumspb.ARCHIVAL_STATE_DISABLED
+			if c.ArchivalEnabled {
+				archivalState = enumspb.ARCHIVAL_STATE_ENABLED
+			}
+			namespaceEntry := namespace.NewGlobalNamespaceForTest(
+				&persistencespb.NamespaceInfo{Id: tests.NamespaceID.String(), Name: tests.Namespace.String()},
+				&persistencespb.NamespaceConfig{
+					Retention:               durationpb.New(retention),
+					HistoryArchivalState:    archivalState,
+					HistoryArchivalUri:      "test:///history/archival/",
+					VisibilityArchivalState: archivalState,
+					VisibilityArchivalUri:   "test:///visibility/archival",
+				},
+				&persistencespb.NamespaceReplicationConfig{
+					ActiveClusterName: cluster.TestCurrentClusterName,
+					Clusters: []string{
+						cluster.TestCurrentClusterName,
+					},
+				},
+				tests.Version,
+			)
+			namespaceRegistry := namespace.NewMockRegistry(ctrl)
+			namespaceRegistry.EXPECT().GetNamespaceByID(namespaceEntry.ID()).Return(namespaceEntry, nil).AnyTimes()
+
+			mutableState := historyi.NewMockMutableState(ctrl)
+			mutableState.EXPECT().GetNamespaceEntry().Return(namespaceEntry).AnyTimes()
+			mutableState.EXPECT().GetCloseVersion().Return(tests.Version, nil).AnyTimes()
+			mutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
+				NamespaceId: namespaceEntry.ID().String(),
+			}).AnyTimes()
+			mutableState.EXPECT().GetWorkflowKey().Return(definition.NewWorkflowKey(
+				namespaceEntry.ID().String(), tests.WorkflowID, tests.RunID,
+			)).AnyTimes()
+			mutableState.EXPECT().GetCurrentBranchToken().Return(nil, nil).AnyTimes()
+			var allTasks []tasks.Task
+			mutableState.EXPECT().AddTasks(gomock.Any()).Do(func(ts ...tasks.Task) {
+				allTasks = append(allTasks, ts...)
+			}).AnyTimes()
+
+			archivalConfig := archiver.NewMockArchivalConfig(ctrl)
+			archivalConfig.EXPECT().ClusterConfiguredForArchival().Return(true).AnyTimes()
+			archivalMetadata := archiver.NewMockArchivalMetadata(ctrl)
+			archivalMetadata.EXPECT().GetHistoryConfig().Return(archivalConfig).AnyTimes()
+			archivalMetadata.EXPECT().GetVisibilityConfig().Return(archivalConfig).AnyTimes()
+
+			cfg := &configs.Config{
+				RetentionTimerJitterDuration: func() time.Duration {
+					return 0
+				},
+				ArchivalProcessorArchiveDelay: func() time.Duration {
+					return 0
+				},
+			}
+			closeTime := time.Unix(0, 0)
+
+			taskGenerator := NewTaskGenerator(namespaceRegistry, mutableState, cfg, archivalMetadata)
+			err := taskGenerator.GenerateChasmEntityCloseTasks(closeTime, c.Archive)
+			require.NoError(t, err)
+
+			var (
+				closeExecutionVisibilityTask *tasks.CloseExecutionVisibilityTask
+				archiveExecutionTask         *tasks.ArchiveExecutionTask
+				deleteHistoryEventTask       *tasks.DeleteHistoryEventTask
+			)
+			for _, task := range allTasks {
+				switch task := task.(type) {
+				case *tasks.CloseExecutionTask:
+					assert.Fail(t, "unexpected CloseExecutionTask for CHASM entity")
+				case *tasks.CloseExecutionVisibilityTask:
+					closeExecutionVisibilityTask = task
+				case *tasks.ArchiveExecutionTask:
+					archiveExecutionTask = task
+				case *tasks.DeleteHistoryEventTask:
+					deleteHistoryEventTask = task
+				}
+			}
+			require.NotNil(t, closeExecutionVisibilityTask)
+			assert.Equal(t, tests.Version, closeExecutionVisibilityTask.Version)
+			if c.ExpectArchiveExecutionTask {
+				require.NotNil(t, archiveExecutionTask)
+				assert.Equal(t, closeTime, archiveExecutionTask.VisibilityTimestamp)
+				assert.Nil(t, deleteHistoryEventTask)
+			} else {
+				assert.Nil(t, archiveExecutionTask)
+				require.NotNil(t, deleteHistoryEventTask)
+				assert.Equal(t, closeTime.Add(retention), deleteHistoryEventTask.VisibilityTimestamp)
+			}
+		})
+	}
+}
+
 func TestTaskGenerator_GenerateDirtySubStateMachineTasks(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	namespaceRegistry := namespace.NewMockRegistry(ctrl)
@@ -0,0 +1,97 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"fmt"
+	"maps"
+	"math"
+
+	"go.temporal.io/api/serviceerror"
+	clockspb "go.temporal.io/server/api/clock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+)
+
+var errActivityTypeRateLimitsNeedNewMatcher = serviceerror.NewFailedPrecondition(
+	"per-activity-type rate limits are only enforced by the new matcher; enable matching.useNewMatcher for the activity task queue")
+
+// UpdateActivityTypeRateLimits returns a copy of limits with the limits in set added or replaced and
+// the activity types in remove deleted. Changed limits are stamped with timestamp.
+// Returns a FailedPrecondition error if the result would have more than maxLimits entries.
+func UpdateActivityTypeRateLimits(
+	timestamp *clockspb.HybridLogicalClock,
+	limits map[string]*persistencespb.ActivityTypeRateLimit,
+	set map[string]float64,
+	remove []string,
+	maxLimits int,
+) (map[string]*persistencespb.ActivityTypeRateLimit, error) {
+	for activityType, rps := range set {
+		if activityType == "" {
+			return nil, serviceerror.NewInvalidArgument("activity type must not be empty")
+		}
+		if rps <= 0 || math.IsInf(rps, 0) || math.IsNaN(rps) {
+			return nil, serviceerror.NewInvalidArgument(
+				fmt.Sprintf("rate limit for activity type %q must be a positive number, got %v", activityType, rps))
+		}
+	}
+
+	ret := make(map[string]*persistencespb.ActivityTypeRateLimit, len(limits)+len(set))
+	for activityType, limit := range limits {
+		ret[activityType] = common.CloneProto(limit)
+	}
+	for _, activityType := range remove {
+		delete(ret, activityType)
+	}
+	for activityType, rps := range set {
+		ret[activityType] = &persistencespb.ActivityTypeRateLimit{
+			RequestsPerSecond: rps,
+			UpdateTime:        timestamp,
+		}
+	}
+
+	if len(ret) > len(limits) && len(ret) > maxLimits {
+		return nil, serviceerror.NewFailedPrecondition(
+			fmt.Sprintf("update exceeds number of activity type rate limits permitted in namespace (%v/%v)", len(ret), maxLimits))
+	}
+	return ret, nil
+}
+
+// activityTypeRateLimitsFromUserData returns the per-activity-type rates in tasks per second. The
+// result is never nil.
+func activityTypeRateLimitsFromUserData(perType *persistencespb.TaskQueueTypeUserData) map[string]float64 {
+	limits := perType.GetActivityTypeRateLimits()
+	ret := make(map[string]float64, len(limits))
+	for activityType, limit := range limits {
+		ret[activityType] = limit.GetRequestsPerSecond()
+	}
+	return ret
+}
+
+func activityTypeRateLimitsEqual(a, b map[string]*persistencespb.ActivityTypeRateLimit) bool {
+	return maps.EqualFunc(a, b, func(x, y *persistencespb.ActivityTypeRateLimit) bool {
+		return x.GetRequestsPerSecond() == y.GetRequestsPerSecond()
+	})
+}
@@ -0,0 +1,74 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	commonclock "go.temporal.io/server/common/clock"
+	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
+)
+
+func TestUpdateActivityTypeRateLimits(t *testing.T) {
+	t.Parallel()
+	clk1 := hlc.Zero(1)
+	clk2 := hlc.Next(clk1, commonclock.NewRealTimeSource())
+
+	limits, err := UpdateActivityTypeRateLimits(clk1, nil, map[string]float64{"a": 10, "b": 20}, nil, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]float64{"a": 10, "b": 20}, activityTypeRateLimitsFromUserData(
+		&persistencespb.TaskQueueTypeUserData{ActivityTypeRateLimits: limits}))
+
+	// replace and remove in one update, unchanged entries keep their timestamp
+	updated, err := UpdateActivityTypeRateLimits(clk2, limits, map[string]float64{"c": 5}, []string{"a"}, 2)
+	assert.NoError(t, err)
+	assert.Len(t, updated, 2)
+	assert.Equal(t, 5.0, updated["c"].GetRequestsPerSecond())
+	assert.Equal(t, clk2, updated["c"].GetUpdateTime())
+	assert.Equal(t, clk1, updated["b"].GetUpdateTime())
+	// input is not mutated
+	assert.Len(t, limits, 2)
+	assert.True(t, activityTypeRateLimitsEqual(limits, limits))
+	assert.False(t, activityTypeRateLimitsEqual(limits, updated))
+
+	// too many limits
+	_, err = UpdateActivityTypeRateLimits(clk2, limits, map[string]float64{"c": 5}, nil, 2)
+	var failedPrecondition *serviceerror.FailedPrecondition
+	assert.ErrorAs(t, err, &failedPrecondition)
+
+	// invalid rates
+	for _, rps := range []float64{0, -1, math.Inf(1), math.NaN()} {
+		_, err = UpdateActivityTypeRateLimits(clk2, limits, map[string]float64{"a": rps}, nil, 2)
+		var invalidArgument *serviceerror.InvalidArgument
+		assert.ErrorAs(t, err, &invalidArgument)
+	}
+	_, err = UpdateActivityTypeRateLimits(clk2, limits, map[string]float64{"": 1}, nil, 2)
+	var invalidArgument *serviceerror.InvalidArgument
+	assert.ErrorAs(t, err, &invalidArgument)
+}
@@ -283,6 +283,72 @@ func (s *BacklogManagerTestSuite) TestApproximateBacklogCount_IncrementedBySpool
 		"backlog count should match the number of tasks")
 }
 
+func (s *BacklogManagerTestSuite) TestSpoolTask_FairnessKeySubqueues() {
+	if !s.newMatcher {
+		s.T().Skip("fairness keys require the new backlog manager")
+	}
+	blm := s.blm.(*priBacklogManagerImpl)
+	blm.config.EnableFairness = func() bool { return true }
+	blm.config.MaxFairnessKeysPerPriority = func() int { return 2 }
+
+	s.blm.Start()
+	defer s.blm.Stop()
+	s.NoError(s.blm.WaitUntilInitialized(context.Background()))
+
+	s.ptqMgr.EXPECT().AddSpooledTask(gomock.Any()).Return(nil).AnyTimes()
+	for _, key := range []string{"a", "b", "c", "a"} {
+		s.NoError(s.blm.SpoolTask(&persistencespb.TaskInfo{
+			ExpiryTime:  timestamp.TimeNowPtrUtcAddSeconds(3000),
+			CreateTime:  timestamp.TimeNowPtrUtc(),
+			FairnessKey: key,
+		}))
+	}
+
+	// "c" is over the limit of tracked keys, so it shares the default subqueue
+	queueInfo := blm.db.cachedQueueInfo()
+	s.Len(queueInfo.Subqueues, 3)
+	s.Equal("", queueInfo.Subqueues[0].Key.FairnessKey)
+	s.Equal(int64(1), queueInfo.Subqueues[0].ApproximateBacklogCount)
+	s.Equal("a", queueInfo.Subqueues[1].Key.FairnessKey)
+	s.Equal(int64(2), queueInfo.Subqueues[1].ApproximateBacklogCount)
+	s.Equal("b", queueInfo.Subqueues[2].Key.FairnessKey)
+	s.Equal(int64(1), queueInfo.Subqueues[2].ApproximateBacklogCount)
+}
+
+func (s *BacklogManagerTestSuite) TestSpoolTask_ReassignsIdleFairnessKeySubqueue() {
+	if !s.newMatcher {
+		s.T().Skip("fairness keys require the new backlog manager")
+	}
+	blm := s.blm.(*priBacklogManagerImpl)
+	blm.config.EnableFairness = func() bool { return true }
+	blm.config.MaxFairnessKeysPerPriority = func() int { return 2 }
+	blm.config.FairnessSubqueueIdleTime = func() time.Duration { return 0 }
+
+	s.blm.Start()
+	defer s.blm.Stop()
+	s.NoError(s.blm.WaitUntilInitialized(context.Background()))
+
+	s.ptqMgr.EXPECT().AddSpooledTask(gomock.Any()).Return(nil).AnyTimes()
+	s.NoError(s.blm.SpoolTask(&persistencespb.TaskInfo{
+		ExpiryTime:  timestamp.TimeNowPtrUtcAddSeconds(3000),
+		CreateTime:  timestamp.TimeNowPtrUtc(),
+		FairnessKey: "a",
+	}))
+	priority := defaultPriorityLevel(blm.config.PriorityLevels())
+	idle := blm.getSubqueueForTask(priority, "b")
+
+	// "a" has a backlog, but "b" never had a task, so its subqueue is given to "c"
+	s.Equal(idle, blm.getSubqueueForTask(priority, "c"))
+	queueInfo := blm.db.cachedQueueInfo()
+	s.Len(queueInfo.Subqueues, 3)
+	s.Equal("a", queueInfo.Subqueues[1].Key.FairnessKey)
+	s.Equal("c", queueInfo.Subqueues[idle].Key.FairnessKey)
+
+	// "c" was just used, so "d" shares the default subqueue
+	blm.config.FairnessSubqueueIdleTime = func() time.Duration { return time.Hour }
+	s.Equal(subqueueZero, blm.getSubqueueForTask(priority, "d"))
+}
+
 func (s *BacklogManagerTestSuite) TestApproximateBacklogCount_IncrementedBySpoolTask_ServiceError() {
 	s.logger.Expect(testlogger.Error, "Persistent store operation failure")
 	s.taskMgr.dbServiceError = true
@@ -0,0 +1,218 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	defaultMigrateBacklogBatchSize = 100
+	maxMigrateBacklogBatchSize     = 1000
+)
+
+// MigrateBacklog reads one batch of backlog tasks, starting after the request's position (or
+// the ack level of the subqueue, whichever is greater), and adds them to the target task queue.
+//
+// When moving (as opposed to copying), the task id ranges of the migrated tasks are written to
+// the task queue metadata before returning, so that the tasks are dropped instead of dispatched
+// when they reach the matcher, also after the queue is reloaded. Dropped tasks are acked
+// normally, which advances the ack level and lets task GC delete them. A task that was already
+// being dispatched when it was migrated, or whose range failed to be written, may be delivered
+// twice; history rejects the duplicate start.
+func (c *physicalTaskQueueManagerImpl) MigrateBacklog(
+	ctx context.Context,
+	request *matchingservice.MigrateTaskQueueBacklogRequest,
+) (*matchingservice.MigrateTaskQueueBacklogResponse, error) {
+	if err := c.WaitUntilInitialized(ctx); err != nil {
+		return nil, err
+	}
+
+	db := c.backlogMgr.getDB()
+	ackLevels := db.GetAckLevels()
+
+	resp := &matchingservice.MigrateTaskQueueBacklogResponse{
+		Subqueue:   request.GetSubqueue(),
+		LastTaskId: request.GetLastTaskId(),
+	}
+	for ; int(resp.Subqueue) < len(ackLevels); resp.Subqueue, resp.LastTaskId = resp.Subqueue+1, 0 {
+		subqueue := int(resp.Subqueue)
+		minTaskID := max(resp.LastTaskId, ackLevels[subqueue]) + 1
+		maxTaskID := db.GetMaxReadLevel(subqueue) + 1
+		if minTaskID >= maxTaskID {
+			continue
+		}
+		tasks, err := db.GetTasks(ctx, subqueue, minTaskID, maxTaskID, int(request.GetBatchSize()))
+		if err != nil {
+			return nil, err
+		}
+		if len(tasks.Tasks) == 0 {
+			continue
+		}
+
+		// Tasks are read in id order, so consecutive tasks that are gone from this queue (moved
+		// now, moved before or expired) form a range with no other task in it.
+		var ranges []*persistencespb.MigratedTaskRange
+		var current *persistencespb.MigratedTaskRange
+		for _, t := range tasks.Tasks {
+			resp.TasksScanned++
+			migrate := c.shouldMigrateTask(request, t)
+			if migrate {
+				if err := c.migrateTask(ctx, request.GetTargetTaskQueue(), t); err != nil {
+					if len(ranges) > 0 {
+						// keep what was moved so far, the caller resumes from the returned error
+						_ = db.AddMigratedTaskRanges(ctx, subqueue, ranges)
+					}
+					return nil, err
+				}
+				resp.TasksMigrated++
+			}
+			resp.LastTaskId = t.GetTaskId()
+
+			if request.GetCopy() {
+				continue
+			}
+			if !migrate && !IsTaskExpired(t) && !db.IsMigratedTask(t.GetTaskId()) {
+				current = nil
+				continue
+			}
+			if current == nil {
+				current = &persistencespb.MigratedTaskRange{FirstTaskId: t.GetTaskId()}
+				ranges = append(ranges, current)
+			}
+			current.LastTaskId = t.GetTaskId()
+		}
+		if resp.TasksMigrated > 0 && !request.GetCopy() {
+			if err := db.AddMigratedTaskRanges(ctx, subqueue, ranges); err != nil {
+				return nil, err
+			}
+			c.dropMigratedTasksFromMatcher()
+		}
+		return resp, nil
+	}
+
+	resp.Done = true
+	return resp, nil
+}
+
+func (c *physicalTaskQueueManagerImpl) shouldMigrateTask(
+	request *matchingservice.MigrateTaskQueueBacklogRequest,
+	t *persistencespb.AllocatedTaskInfo,
+) bool {
+	if IsTaskExpired(t) {
+		return false
+	}
+	if request.GetWorkflowId() != "" && t.GetData().GetWorkflowId() != request.GetWorkflowId() {
+		return false
+	}
+	// already moved by a previous call but not yet dropped
+	return !c.backlogMgr.getDB().IsMigratedTask(t.GetTaskId())
+}
+
+func (c *physicalTaskQueueManagerImpl) migrateTask(
+	ctx context.Context,
+	targetTaskQueue string,
+	t *persistencespb.AllocatedTaskInfo,
+) error {
+	data := t.GetData()
+	var scheduleToStartTimeout *durationpb.Duration
+	if data.ExpiryTime != nil {
+		remaining := time.Until(data.ExpiryTime.AsTime())
+		if remaining <= 0 {
+			return nil
+		}
+		scheduleToStartTimeout = durationpb.New(remaining)
+	}
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: data.GetWorkflowId(),
+		RunId:      data.GetRunId(),
+	}
+	taskQueue := &taskqueuepb.TaskQueue{
+		Name: targetTaskQueue,
+		Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+	}
+
+	var err error
+	switch c.queue.TaskType() {
+	case enumspb.TASK_QUEUE_TYPE_WORKFLOW:
+		_, err = c.matchingClient.AddWorkflowTask(ctx, &matchingservice.AddWorkflowTaskRequest{
+			NamespaceId:            data.GetNamespaceId(),
+			Execution:              execution,
+			TaskQueue:              taskQueue,
+			ScheduledEventId:       data.GetScheduledEventId(),
+			ScheduleToStartTimeout: scheduleToStartTimeout,
+			Clock:                  data.GetClock(),
+			VersionDirective:       data.GetVersionDirective(),
+			Priority:               data.GetPriority(),
+			FairnessKey:            data.GetFairnessKey(),
+		})
+	case enumspb.TASK_QUEUE_TYPE_ACTIVITY:
+		_, err = c.matchingClient.AddActivityTask(ctx, &matchingservice.AddActivityTaskRequest{
+			NamespaceId:            data.GetNamespaceId(),
+			Execution:              execution,
+			TaskQueue:              taskQueue,
+			ScheduledEventId:       data.GetScheduledEventId(),
+			ScheduleToStartTimeout: scheduleToStartTimeout,
+			Clock:                  data.GetClock(),
+			VersionDirective:       data.GetVersionDirective(),
+			Stamp:                  data.GetStamp(),
+			Priority:               data.GetPriority(),
+			FairnessKey:            data.GetFairnessKey(),
+			ActivityType:           data.GetActivityType(),
+		})
+	default:
+		err = errInvalidTaskQueueType
+	}
+	return err
+}
+
+// isMigratedBacklogTask returns true if the given backlog task was moved to another task queue and
+// should be dropped.
+func (c *physicalTaskQueueManagerImpl) isMigratedBacklogTask(task *internalTask) bool {
+	if task.isSyncMatchTask() || task.event == nil {
+		return false
+	}
+	return c.backlogMgr.getDB().IsMigratedTask(task.event.GetTaskId())
+}
+
+// dropMigratedTasksFromMatcher finishes migrated tasks that were already loaded into the matcher.
+// Tasks loaded later are dropped when they are added to the matcher.
+func (c *physicalTaskQueueManagerImpl) dropMigratedTasksFromMatcher() {
+	if c.priMatcher == nil {
+		return
+	}
+	tasks := c.priMatcher.data.ReprocessTasks(c.isMigratedBacklogTask)
+	for _, task := range tasks {
+		task.finish(nil, false)
+	}
+}
@@ -80,6 +80,7 @@ type (
 		AssignmentRuleLimitPerQueue              dynamicconfig.IntPropertyFnWithNamespaceFilter
 		RedirectRuleLimitPerQueue                dynamicconfig.IntPropertyFnWithNamespaceFilter
 		RedirectRuleMaxUpstreamBuildIDsPerQueue  dynamicconfig.IntPropertyFnWithNamespaceFilter
+		ActivityTypeRateLimitsPerQueue           dynamicconfig.IntPropertyFnWithNamespaceFilter
 		DeletedRuleRetentionTime                 dynamicconfig.DurationPropertyFnWithNamespaceFilter
 		PollerHistoryTTL                         dynamicconfig.DurationPropertyFnWithNamespaceFilter
 		ReachabilityBuildIdVisibilityGracePeriod dynamicconfig.DurationPropertyFnWithNamespaceFilter
@@ -95,6 +96,18 @@ type (
 		MembershipUnloadDelay                    dynamicconfig.DurationPropertyFn
 		TaskQueueInfoByBuildIdTTL                dynamicconfig.DurationPropertyFnWithTaskQueueFilter
 		PriorityLevels                           dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		EnableFairness                           dynamicconfig.BoolPropertyFnWithTaskQueueFilter
+		FairnessKeyWeights                       dynamicconfig.TypedPropertyFnWithTaskQueueFilter[map[string]float64]
+		MaxFairnessKeysPerPriority               dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		FairnessSubqueueIdleTime                 dynamicconfig.DurationPropertyFnWithTaskQueueFilter
+		EnablePartitionAutoscaling               dynamicconfig.BoolPropertyFnWithTaskQueueFilter
+		PartitionAutoscalingInterval             dynamicconfig.DurationPropertyFnWithTaskQueueFilter
+		PartitionAutoscalingMinPartitions        dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		PartitionAutoscalingMaxPartitions        dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		PartitionAutoscalingTasksPerSecond       dynamicconfig.FloatPropertyFnWithTaskQueueFilter
+		PartitionAutoscalingPollersPerPartition  dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		PartitionAutoscalingBacklogAge           dynamicconfig.DurationPropertyFnWithTaskQueueFilter
+		PartitionCountsCacheTTL                  dynamicconfig.DurationPropertyFnWithTaskQueueFilter
 
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval dynamicconfig.DurationPropertyFnWithTaskQueueFilter
@@ -158,6 +171,10 @@ type (
 		MaxTaskDeleteBatchSize     func() int
 		TaskDeleteInterval         func() time.Duration
 		PriorityLevels             func() int32
+		EnableFairness             func() bool
+		FairnessKeyWeights         func() map[string]float64
+		MaxFairnessKeysPerPriority func() int
+		FairnessSubqueueIdleTime   func() time.Duration
 
 		GetUserDataLongPollTimeout dynamicconfig.DurationPropertyFn
 		GetUserDataMinWaitTime     time.Duration
@@ -171,6 +188,16 @@ type (
 		NumWritePartitions              func() int
 		NumReadPartitions               func() int
 
+		// partition autoscaling configuration
+		EnablePartitionAutoscaling              func() bool
+		PartitionAutoscalingInterval            func() time.Duration
+		PartitionAutoscalingMinPartitions       func() int
+		PartitionAutoscalingMaxPartitions       func() int
+		PartitionAutoscalingTasksPerSecond      func() float64
+		PartitionAutoscalingPollersPerPartition func() int
+		PartitionAutoscalingBacklogAge          func() time.Duration
+		PartitionCountsCacheTTL                 func() time.Duration
+
 		// partition qps = AdminNamespaceToPartitionDispatchRate(namespace)
 		AdminNamespaceToPartitionDispatchRate func() float64
 		AdminNamespaceToPartitionRateSub      func(func(float64)) (float64, func())
@@ -276,6 +303,7 @@ func NewConfig(
 		AssignmentRuleLimitPerQueue:              dynamicconfig.AssignmentRuleLimitPerQueue.Get(dc),
 		RedirectRuleLimitPerQueue:                dynamicconfig.RedirectRuleLimitPerQueue.Get(dc),
 		RedirectRuleMaxUpstreamBuildIDsPerQueue:  dynamicconfig.RedirectRuleMaxUpstreamBuildIDsPerQueue.Get(dc),
+		ActivityTypeRateLimitsPerQueue:           dynamicconfig.ActivityTypeRateLimitsPerQueue.Get(dc),
 		DeletedRuleRetentionTime:                 dynamicconfig.MatchingDeletedRuleRetentionTime.Get(dc),
 		PollerHistoryTTL:                         dynamicconfig.PollerHistoryTTL.Get(dc),
 		ReachabilityBuildIdVisibilityGracePeriod: dynamicconfig.ReachabilityBuildIdVisibilityGracePeriod.Get(dc),
@@ -291,6 +319,18 @@ func NewConfig(
 		MembershipUnloadDelay:                    dynamicconfig.MatchingMembershipUnloadDelay.Get(dc),
 		TaskQueueInfoByBuildIdTTL:                dynamicconfig.TaskQueueInfoByBuildIdTTL.Get(dc),
 		PriorityLevels:                           dynamicconfig.MatchingPriorityLevels.Get(dc),
+		EnableFairness:                           dynamicconfig.MatchingEnableFairness.Get(dc),
+		FairnessKeyWeights:                       dynamicconfig.MatchingFairnessKeyWeights.Get(dc),
+		MaxFairnessKeysPerPriority:               dynamicconfig.MatchingMaxFairnessKeysPerPriority.Get(dc),
+		FairnessSubqueueIdleTime:                 dynamicconfig.MatchingFairnessSubqueueIdleTime.Get(dc),
+		EnablePartitionAutoscaling:               dynamicconfig.MatchingEnablePartitionAutoscaling.Get(dc),
+		PartitionAutoscalingInterval:             dynamicconfig.MatchingPartitionAutoscalingInterval.Get(dc),
+		PartitionAutoscalingMinPartitions:        dynamicconfig.MatchingPartitionAutoscalingMinPartitions.Get(dc),
+		PartitionAutoscalingMaxPartitions:        dynamicconfig.MatchingPartitionAutoscalingMaxPartitions.Get(dc),
+		PartitionAutoscalingTasksPerSecond:       dynamicconfig.MatchingPartitionAutoscalingTasksPerSecond.Get(dc),
+		PartitionAutoscalingPollersPerPartition:  dynamicconfig.MatchingPartitionAutoscalingPollersPerPartition.Get(dc),
+		PartitionAutoscalingBacklogAge:           dynamicconfig.MatchingPartitionAutoscalingBacklogAge.Get(dc),
+		PartitionCountsCacheTTL:                  dynamicconfig.MatchingPartitionCountsCacheTTL.Get(dc),
 		MatchingDropNonRetryableTasks:            dynamicconfig.MatchingDropNonRetryableTasks.Get(dc),
 		MaxIDLengthLimit:                         dynamicconfig.MaxIDLengthLimit.Get(dc),
 
@@ -367,6 +407,18 @@ func newTaskQueueConfig(tq *tqid.TaskQueue, config *Config, ns namespace.Name) *
 		PriorityLevels: func() int32 {
 			return int32(config.PriorityLevels(ns.String(), taskQueueName, taskType))
 		},
+		EnableFairness: func() bool {
+			return config.EnableFairness(ns.String(), taskQueueName, taskType)
+		},
+		FairnessKeyWeights: func() map[string]float64 {
+			return config.FairnessKeyWeights(ns.String(), taskQueueName, taskType)
+		},
+		MaxFairnessKeysPerPriority: func() int {
+			return config.MaxFairnessKeysPerPriority(ns.String(), taskQueueName, taskType)
+		},
+		FairnessSubqueueIdleTime: func() time.Duration {
+			return config.FairnessSubqueueIdleTime(ns.String(), taskQueueName, taskType)
+		},
 		GetUserDataLongPollTimeout: config.GetUserDataLongPollTimeout,
 		GetUserDataMinWaitTime:     1 * time.Second,
 		GetUserDataReturnBudget:    returnEmptyTaskTimeBudget,
@@ -384,6 +436,30 @@ func newTaskQueueConfig(tq *tqid.TaskQueue, config *Config, ns namespace.Name) *
 		NumReadPartitions: func() int {
 			return max(1, config.NumTaskqueueReadPartitions(ns.String(), taskQueueName, taskType))
 		},
+		EnablePartitionAutoscaling: func() bool {
+			return config.EnablePartitionAutoscaling(ns.String(), taskQueueName, taskType)
+		},
+		PartitionAutoscalingInterval: func() time.Duration {
+			return config.PartitionAutoscalingInterval(ns.String(), taskQueueName, taskType)
+		},
+		PartitionAutoscalingMinPartitions: func() int {
+			return max(1, config.PartitionAutoscalingMinPartitions(ns.String(), taskQueueName, taskType))
+		},
+		PartitionAutoscalingMaxPartitions: func() int {
+			return max(1, config.PartitionAutoscalingMaxPartitions(ns.String(), taskQueueName, taskType))
+		},
+		PartitionAutoscalingTasksPerSecond: func() float64 {
+			return config.PartitionAutoscalingTasksPerSecond(ns.String(), taskQueueName, taskType)
+		},
+		PartitionAutoscalingPollersPerPartition: func() int {
+			return config.PartitionAutoscalingPollersPerPartition(ns.String(), taskQueueName, taskType)
+		},
+		PartitionAutoscalingBacklogAge: func() time.Duration {
+			return config.PartitionAutoscalingBacklogAge(ns.String(), taskQueueName, taskType)
+		},
+		PartitionCountsCacheTTL: func() time.Duration {
+			return config.PartitionCountsCacheTTL(ns.String(), taskQueueName, taskType)
+		},
 		BreakdownMetricsByTaskQueue: func() bool {
 			return config.BreakdownMetricsByTaskQueue(ns.String(), taskQueueName, taskType)
 		},
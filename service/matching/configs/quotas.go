@@ -54,6 +54,7 @@ var (
 		"/temporal.server.api.matchingservice.v1.MatchingService/ForceUnloadTaskQueuePartition":          1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/ForceUnloadTaskQueue":                   1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/ForceLoadTaskQueuePartition":            1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/MigrateTaskQueueBacklog":                1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/UpdateTaskQueueUserData":                1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/ReplicateTaskQueueUserData":             1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/CheckTaskQueueUserDataPropagation":      1,
@@ -69,6 +70,7 @@ var (
 		"/temporal.server.api.matchingservice.v1.MatchingService/GetWorkerVersioningRules":               1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/DescribeTaskQueuePartition":             1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/SyncDeploymentUserData":                 1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/UpdateActivityTypeRateLimits":           1,
 	}
 
 	APIPrioritiesOrdered = []int{0, 1}
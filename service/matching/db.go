@@ -25,7 +25,9 @@
 package matching
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"sync"
@@ -53,6 +55,8 @@ const (
 	subqueueZero = 0
 )
 
+var errSubqueueHasBacklog = errors.New("subqueue has backlog")
+
 type (
 	taskQueueDB struct {
 		sync.Mutex
@@ -136,6 +140,17 @@ func (db *taskQueueDB) getMaxReadLevelLocked(subqueue int) int64 {
 	return db.subqueues[subqueue].maxReadLevel
 }
 
+// GetAckLevels returns the current ack level of each subqueue
+func (db *taskQueueDB) GetAckLevels() []int64 {
+	db.Lock()
+	defer db.Unlock()
+	levels := make([]int64, len(db.subqueues))
+	for i, s := range db.subqueues {
+		levels[i] = s.AckLevel
+	}
+	return levels
+}
+
 // This is only exposed for testing!
 func (db *taskQueueDB) setMaxReadLevelForTesting(subqueue int, level int64) {
 	db.Lock()
@@ -261,7 +276,9 @@ func (db *taskQueueDB) OldUpdateState(
 		PrevRangeID:   db.rangeID,
 	})
 	if err == nil {
-		db.subqueues[subqueueZero].AckLevel = ackLevel
+		dbQueue := db.subqueues[subqueueZero]
+		dbQueue.AckLevel = ackLevel
+		dbQueue.MigratedTaskRanges = pruneMigratedTaskRanges(dbQueue.MigratedTaskRanges, ackLevel)
 	}
 	db.emitBacklogGaugesLocked()
 	return err
@@ -295,6 +312,7 @@ func (db *taskQueueDB) updateAckLevelAndBacklogStats(subqueue int, newAckLevel i
 				subqueue, dbQueue.AckLevel, newAckLevel))
 	}
 	dbQueue.AckLevel = newAckLevel
+	dbQueue.MigratedTaskRanges = pruneMigratedTaskRanges(dbQueue.MigratedTaskRanges, newAckLevel)
 
 	if newAckLevel == db.getMaxReadLevelLocked(subqueue) {
 		// Reset approximateBacklogCount to fix the count divergence issue
@@ -484,6 +502,78 @@ func (db *taskQueueDB) AllocateSubqueue(
 	return db.cloneSubqueues(), nil
 }
 
+// ReassignSubqueue changes the key of a subqueue whose tasks were all acked, so that it can be
+// used for tasks of another key. Subqueues can't be removed since tasks refer to them by index.
+func (db *taskQueueDB) ReassignSubqueue(
+	ctx context.Context,
+	subqueue int,
+	key *persistencespb.SubqueueKey,
+) ([]persistencespb.SubqueueInfo, error) {
+	db.Lock()
+	defer db.Unlock()
+
+	s := db.subqueues[subqueue]
+	if s.AckLevel < s.maxReadLevel {
+		return nil, errSubqueueHasBacklog
+	}
+	oldKey := s.Key
+	s.Key = key
+
+	// ensure written to metadata before returning
+	err := db.updateTaskQueueLocked(ctx, false)
+	if err != nil {
+		s.Key = oldKey
+		return nil, err
+	}
+
+	return db.cloneSubqueues(), nil
+}
+
+// AddMigratedTaskRanges records task id ranges of a subqueue whose tasks were moved to another
+// task queue, and writes them to metadata before returning so that the tasks stay dropped after
+// the queue is reloaded.
+func (db *taskQueueDB) AddMigratedTaskRanges(
+	ctx context.Context,
+	subqueue int,
+	ranges []*persistencespb.MigratedTaskRange,
+) error {
+	db.Lock()
+	defer db.Unlock()
+
+	s := db.subqueues[subqueue]
+	oldRanges := s.MigratedTaskRanges
+	s.MigratedTaskRanges = pruneMigratedTaskRanges(
+		mergeMigratedTaskRanges(slices.Concat(oldRanges, ranges)),
+		s.AckLevel,
+	)
+
+	// ensure written to metadata before returning
+	err := db.updateTaskQueueLocked(ctx, false)
+	if err != nil {
+		s.MigratedTaskRanges = oldRanges
+		return err
+	}
+	return nil
+}
+
+// IsMigratedTask returns true if the backlog task with the given id was moved to another task
+// queue. Task ids are unique across subqueues, so all subqueues are checked.
+func (db *taskQueueDB) IsMigratedTask(taskID int64) bool {
+	db.Lock()
+	defer db.Unlock()
+
+	for _, s := range db.subqueues {
+		ranges := s.MigratedTaskRanges
+		i, _ := slices.BinarySearchFunc(ranges, taskID, func(r *persistencespb.MigratedTaskRange, id int64) int {
+			return cmp.Compare(r.GetLastTaskId(), id)
+		})
+		if i < len(ranges) && ranges[i].GetFirstTaskId() <= taskID {
+			return true
+		}
+	}
+	return false
+}
+
 func (db *taskQueueDB) expiryTime() *timestamppb.Timestamp {
 	switch db.queue.Partition().Kind() {
 	case enumspb.TASK_QUEUE_KIND_NORMAL:
@@ -591,3 +681,34 @@ func (db *taskQueueDB) cloneSubqueues() []persistencespb.SubqueueInfo {
 	}
 	return infos
 }
+
+// mergeMigratedTaskRanges sorts ranges and merges overlapping or adjacent ones.
+func mergeMigratedTaskRanges(ranges []*persistencespb.MigratedTaskRange) []*persistencespb.MigratedTaskRange {
+	slices.SortFunc(ranges, func(a, b *persistencespb.MigratedTaskRange) int {
+		return cmp.Compare(a.GetFirstTaskId(), b.GetFirstTaskId())
+	})
+	var merged []*persistencespb.MigratedTaskRange
+	for _, r := range ranges {
+		if n := len(merged); n > 0 && r.GetFirstTaskId() <= merged[n-1].GetLastTaskId()+1 {
+			merged[n-1] = &persistencespb.MigratedTaskRange{
+				FirstTaskId: merged[n-1].GetFirstTaskId(),
+				LastTaskId:  max(merged[n-1].GetLastTaskId(), r.GetLastTaskId()),
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// pruneMigratedTaskRanges removes ranges at or below the ack level: their tasks have been acked
+// and will not be read again.
+func pruneMigratedTaskRanges(ranges []*persistencespb.MigratedTaskRange, ackLevel int64) []*persistencespb.MigratedTaskRange {
+	i := slices.IndexFunc(ranges, func(r *persistencespb.MigratedTaskRange) bool {
+		return r.GetLastTaskId() > ackLevel
+	})
+	if i < 0 {
+		return nil
+	}
+	return ranges[i:]
+}
@@ -0,0 +1,109 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/tqid"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestTaskQueueDBWithStore(t *testing.T, store persistence.TaskManager) *taskQueueDB {
+	cfg := NewConfig(dynamicconfig.NewNoopCollection())
+	f, err := tqid.NewTaskQueueFamily("", "test-queue")
+	require.NoError(t, err)
+	prtn := f.TaskQueue(enumspb.TASK_QUEUE_TYPE_WORKFLOW).NormalPartition(0)
+	tlCfg := newTaskQueueConfig(prtn.TaskQueue(), cfg, "test-namespace")
+	return newTaskQueueDB(tlCfg, store, UnversionedQueueKey(prtn), log.NewNoopLogger(), metrics.NoopMetricsHandler)
+}
+
+func TestTaskQueueDB_MigratedTaskRanges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := persistence.NewMockTaskManager(ctrl)
+	db := newTestTaskQueueDBWithStore(t, store)
+
+	// ranges written by a previous owner are loaded with the metadata
+	store.EXPECT().GetTaskQueue(gomock.Any(), gomock.Any()).Return(&persistence.GetTaskQueueResponse{
+		TaskQueueInfo: &persistencespb.TaskQueueInfo{
+			Subqueues: []*persistencespb.SubqueueInfo{{
+				Key:                &persistencespb.SubqueueKey{Priority: defaultPriorityLevel(db.config.PriorityLevels())},
+				AckLevel:           3,
+				MigratedTaskRanges: []*persistencespb.MigratedTaskRange{{FirstTaskId: 10, LastTaskId: 12}},
+			}},
+		},
+		RangeID: 1,
+	}, nil)
+	var written []*persistencespb.MigratedTaskRange
+	store.EXPECT().UpdateTaskQueue(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateTaskQueueRequest) (*persistence.UpdateTaskQueueResponse, error) {
+			written = request.TaskQueueInfo.GetSubqueues()[0].GetMigratedTaskRanges()
+			return &persistence.UpdateTaskQueueResponse{}, nil
+		}).Times(2)
+	_, err := db.RenewLease(context.Background())
+	require.NoError(t, err)
+	require.True(t, db.IsMigratedTask(10))
+	require.True(t, db.IsMigratedTask(12))
+	require.False(t, db.IsMigratedTask(13))
+
+	// new ranges are merged with adjacent and overlapping ones, and written before returning
+	err = db.AddMigratedTaskRanges(context.Background(), subqueueZero, []*persistencespb.MigratedTaskRange{
+		{FirstTaskId: 20, LastTaskId: 20},
+		{FirstTaskId: 1, LastTaskId: 2},
+		{FirstTaskId: 13, LastTaskId: 15},
+		{FirstTaskId: 5, LastTaskId: 11},
+	})
+	require.NoError(t, err)
+	expected := []*persistencespb.MigratedTaskRange{
+		{FirstTaskId: 5, LastTaskId: 15},
+		{FirstTaskId: 20, LastTaskId: 20},
+	}
+	require.Equal(t, expected, written)
+	require.True(t, db.IsMigratedTask(14))
+	require.False(t, db.IsMigratedTask(2))
+
+	// a failed write is not kept
+	store.EXPECT().UpdateTaskQueue(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable"))
+	err = db.AddMigratedTaskRanges(context.Background(), subqueueZero, []*persistencespb.MigratedTaskRange{
+		{FirstTaskId: 30, LastTaskId: 31},
+	})
+	require.Error(t, err)
+	require.False(t, db.IsMigratedTask(30))
+
+	// ranges are pruned once acked
+	db.updateAckLevelAndBacklogStats(subqueueZero, 15, 0, time.Time{})
+	require.False(t, db.IsMigratedTask(14))
+	require.True(t, db.IsMigratedTask(20))
+}
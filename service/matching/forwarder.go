@@ -155,6 +155,7 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 				ForwardInfo:            fwdr.getForwardInfo(task),
 				VersionDirective:       task.event.Data.GetVersionDirective(),
 				Priority:               task.event.Data.GetPriority(),
+				FairnessKey:            task.event.Data.GetFairnessKey(),
 			},
 		)
 	case enumspb.TASK_QUEUE_TYPE_ACTIVITY:
@@ -173,6 +174,8 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 				Stamp:                  task.event.Data.GetStamp(),
 				VersionDirective:       task.event.Data.GetVersionDirective(),
 				Priority:               task.event.Data.GetPriority(),
+				FairnessKey:            task.event.Data.GetFairnessKey(),
+				ActivityType:           task.event.Data.GetActivityType(),
 			},
 		)
 	default:
@@ -190,7 +190,11 @@ func (h *Handler) AddActivityTask(
 	if syncMatch {
 		metrics.SyncMatchLatencyPerTaskQueue.With(opMetrics).Record(time.Since(startT))
 	}
-	return &matchingservice.AddActivityTaskResponse{AssignedBuildId: assignedBuildId}, err
+	resp := &matchingservice.AddActivityTaskResponse{AssignedBuildId: assignedBuildId}
+	if err == nil && request.GetForwardInfo() == nil {
+		resp.PartitionCounts = h.engine.PartitionCountsHint(request.GetNamespaceId(), request.GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	}
+	return resp, err
 }
 
 // AddWorkflowTask - adds a workflow task.
@@ -215,7 +219,11 @@ func (h *Handler) AddWorkflowTask(
 	if syncMatch {
 		metrics.SyncMatchLatencyPerTaskQueue.With(opMetrics).Record(time.Since(startT))
 	}
-	return &matchingservice.AddWorkflowTaskResponse{AssignedBuildId: assignedBuildId}, err
+	resp := &matchingservice.AddWorkflowTaskResponse{AssignedBuildId: assignedBuildId}
+	if err == nil && request.GetForwardInfo() == nil {
+		resp.PartitionCounts = h.engine.PartitionCountsHint(request.GetNamespaceId(), request.GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	}
+	return resp, err
 }
 
 // PollActivityTaskQueue - long poll for an activity task.
@@ -243,7 +251,18 @@ func (h *Handler) PollActivityTaskQueue(
 		return nil, err
 	}
 
-	return h.engine.PollActivityTaskQueue(ctx, request, opMetrics)
+	resp, err := h.engine.PollActivityTaskQueue(ctx, request, opMetrics)
+	if err != nil || request.GetForwardedSource() != "" {
+		return resp, err
+	}
+	if counts := h.engine.PartitionCountsHint(request.GetNamespaceId(), request.GetPollRequest().GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_ACTIVITY); counts != nil {
+		if resp == emptyPollActivityTaskQueueResponse {
+			// don't modify the shared empty response
+			resp = &matchingservice.PollActivityTaskQueueResponse{}
+		}
+		resp.PartitionCounts = counts
+	}
+	return resp, nil
 }
 
 // PollWorkflowTaskQueue - long poll for a workflow task.
@@ -271,7 +290,18 @@ func (h *Handler) PollWorkflowTaskQueue(
 		return nil, err
 	}
 
-	return h.engine.PollWorkflowTaskQueue(ctx, request, opMetrics)
+	resp, err := h.engine.PollWorkflowTaskQueue(ctx, request, opMetrics)
+	if err != nil || request.GetForwardedSource() != "" {
+		return resp, err
+	}
+	if counts := h.engine.PartitionCountsHint(request.GetNamespaceId(), request.GetPollRequest().GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_WORKFLOW); counts != nil {
+		if resp == emptyPollWorkflowTaskQueueResponse {
+			// don't modify the shared empty response
+			resp = &matchingservice.PollWorkflowTaskQueueResponse{}
+		}
+		resp.PartitionCounts = counts
+	}
+	return resp, nil
 }
 
 // QueryWorkflow queries a given workflow synchronously and return the query result.
@@ -386,6 +416,15 @@ func (h *Handler) GetWorkerVersioningRules(
 	return h.engine.GetWorkerVersioningRules(ctx, request)
 }
 
+// UpdateActivityTypeRateLimits adds, replaces or removes per-activity-type dispatch rate limits for a Task Queue.
+func (h *Handler) UpdateActivityTypeRateLimits(
+	ctx context.Context,
+	request *matchingservice.UpdateActivityTypeRateLimitsRequest,
+) (_ *matchingservice.UpdateActivityTypeRateLimitsResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.UpdateActivityTypeRateLimits(ctx, request)
+}
+
 // UpdateWorkerBuildIdCompatibility allows changing the worker versioning graph for a task queue
 func (h *Handler) UpdateWorkerBuildIdCompatibility(
 	ctx context.Context,
@@ -460,6 +499,14 @@ func (h *Handler) ForceLoadTaskQueuePartition(
 	return h.engine.ForceLoadTaskQueuePartition(ctx, request)
 }
 
+func (h *Handler) MigrateTaskQueueBacklog(
+	ctx context.Context,
+	request *matchingservice.MigrateTaskQueueBacklogRequest,
+) (_ *matchingservice.MigrateTaskQueueBacklogResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.MigrateTaskQueueBacklog(ctx, request)
+}
+
 func (h *Handler) UpdateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.UpdateTaskQueueUserDataRequest,
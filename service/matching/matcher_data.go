@@ -89,9 +89,71 @@ func (p *pollerPQ) Pop() any {
 	return poller
 }
 
+// fairnessLevel holds the stride scheduling state of one priority level.
+type fairnessLevel struct {
+	// pass of the most recently removed task
+	pass float64
+	// pass of the most recently added task of each fairness key that has tasks in the queue
+	lastPass map[string]float64
+}
+
+// fairness assigns each task a pass for weighted round-robin ordering across fairness keys
+// within a priority level (stride scheduling). A task's pass is one stride (1/weight) after the
+// previous task of the same key, or after the level's current pass if the key has no tasks
+// queued, so keys with a backlog take turns in proportion to their weights.
+type fairness struct {
+	enabled func() bool
+	weights func() map[string]float64
+	levels  map[int32]*fairnessLevel
+}
+
+func (f *fairness) assignPass(task *internalTask) {
+	task.fairnessPass = 0
+	if f.enabled == nil || !f.enabled() || task.isPollForwarder {
+		return
+	}
+
+	priority := task.getPriority().GetPriorityKey()
+	level, ok := f.levels[priority]
+	if !ok {
+		level = &fairnessLevel{lastPass: make(map[string]float64)}
+		f.levels[priority] = level
+	}
+
+	key := task.getFairnessKey()
+	weight := 1.0
+	if w, ok := f.weights()[key]; ok && w > 0 {
+		weight = w
+	}
+	pass := max(level.pass, level.lastPass[key]) + 1/weight
+	level.lastPass[key] = pass
+	task.fairnessPass = pass
+}
+
+// taskRemoved should be called when a task leaves the queue. If it was removed to be dispatched,
+// the level's pass advances to the task's pass.
+func (f *fairness) taskRemoved(task *internalTask, dispatched bool) {
+	level, ok := f.levels[task.getPriority().GetPriorityKey()]
+	if !ok {
+		return
+	}
+	if dispatched {
+		level.pass = max(level.pass, task.fairnessPass)
+	}
+
+	key := task.getFairnessKey()
+	if lastPass, ok := level.lastPass[key]; ok && lastPass <= level.pass {
+		// no more tasks of this key are queued
+		delete(level.lastPass, key)
+	}
+}
+
 type taskPQ struct {
 	heap []*internalTask
 
+	// fairness orders tasks across fairness keys within each priority level
+	fairness fairness
+
 	// ages holds task create time for tasks from merged local backlogs (not forwarded).
 	// note that matcherData may get tasks from multiple versioned backlogs due to
 	// versioning redirection.
@@ -99,9 +161,15 @@ type taskPQ struct {
 
 	// rate limiter for overall queue
 	wholeQueueLimiter simpleLimiter
+
+	// rate limiters for individual activity types. only set on the root partition.
+	// they don't affect heap order: tasks of a limited type that isn't ready are skipped when
+	// matching.
+	activityTypeLimiters map[string]*simpleLimiter
 }
 
 func (t *taskPQ) Add(task *internalTask) {
+	t.fairness.assignPass(task)
 	heap.Push(t, task)
 }
 
@@ -117,11 +185,24 @@ func (t *taskPQ) readyTimeForTask(task *internalTask) int64 {
 	// }
 	return max(
 		t.wholeQueueLimiter.ready,
+		t.activityTypeReadyTime(task),
 		// TODO(pri): add more times here, e.g. fairness key limit, per-task backoff
 	)
 }
 
+func (t *taskPQ) activityTypeReadyTime(task *internalTask) int64 {
+	if lim := t.activityTypeLimiters[task.getActivityType()]; lim != nil {
+		return lim.ready
+	}
+	return 0
+}
+
 func (t *taskPQ) consumeTokens(now int64, task *internalTask, tokens int64) {
+	// activity type limits are only enforced on the root, so count them for forwarded tasks too
+	if lim := t.activityTypeLimiters[task.getActivityType()]; lim != nil {
+		lim.consume(now, tokens)
+	}
+
 	if task.isForwarded() {
 		// don't count any rate limit for forwarded tasks, it was counted on the child
 		return
@@ -130,6 +211,27 @@ func (t *taskPQ) consumeTokens(now int64, task *internalTask, tokens int64) {
 	t.wholeQueueLimiter.consume(now, tokens)
 }
 
+// setActivityTypeLimits replaces the set of activity type limiters, keeping the state of
+// limiters for activity types that are still limited.
+func (t *taskPQ) setActivityTypeLimits(limits map[string]float64, burstDuration time.Duration) {
+	for activityType := range t.activityTypeLimiters {
+		if _, ok := limits[activityType]; !ok {
+			delete(t.activityTypeLimiters, activityType)
+		}
+	}
+	for activityType, rate := range limits {
+		lim := t.activityTypeLimiters[activityType]
+		if lim == nil {
+			if t.activityTypeLimiters == nil {
+				t.activityTypeLimiters = make(map[string]*simpleLimiter)
+			}
+			lim = &simpleLimiter{}
+			t.activityTypeLimiters[activityType] = lim
+		}
+		lim.set(rate, burstDuration)
+	}
+}
+
 // implements heap.Interface
 func (t *taskPQ) Len() int {
 	return len(t.heap)
@@ -138,7 +240,6 @@ func (t *taskPQ) Len() int {
 // implements heap.Interface, do not call directly
 func (t *taskPQ) Less(i int, j int) bool {
 	// Overall priority key will eventually look something like:
-	// - ready time: to sort all ready tasks ahead of others, or else find the earliest ready task
 	// - isPollForwarder: forwarding polls should happen only if there are no other tasks
 	// - priority key: to sort tasks by priority
 	// - fairness key pass: to arrange tasks fairly by key
@@ -147,15 +248,8 @@ func (t *taskPQ) Less(i int, j int) bool {
 
 	a, b := t.heap[i], t.heap[j]
 
-	// TODO(pri): ready time is not task-specific yet, we only have whole-queue, so we don't
-	// need to consider this here yet.
-	// // ready time
-	// aready, bready := max(t.now, t.readyTimeForTask(a)), max(t.now, t.readyTimeForTask(b))
-	// if aready < bready {
-	// 	return true
-	// } else if aready > bready {
-	// 	return false
-	// }
+	// Ready time isn't part of the order: it changes with time and consumed tokens, so the heap
+	// would have to be rebuilt on every match. findMatch skips tasks that aren't ready instead.
 
 	// poll forwarder is always last
 	if !a.isPollForwarder && b.isPollForwarder {
@@ -173,6 +267,13 @@ func (t *taskPQ) Less(i int, j int) bool {
 		return false
 	}
 
+	// try fairness key pass, this is always zero if fairness is disabled
+	if a.fairnessPass < b.fairnessPass {
+		return true
+	} else if a.fairnessPass > b.fairnessPass {
+		return false
+	}
+
 	// Note: sync match tasks have a fixed negative id.
 	// Query tasks will get 0 here.
 	var aid, bid int64
@@ -213,6 +314,7 @@ func (t *taskPQ) Pop() any {
 	if task.source == enumsspb.TASK_SOURCE_DB_BACKLOG && task.forwardInfo == nil {
 		t.ages.record(task.event.Data.CreateTime, -1)
 	}
+	t.fairness.taskRemoved(task, true)
 
 	return task
 }
@@ -229,6 +331,7 @@ func (t *taskPQ) ForEachTask(pred func(*internalTask) bool, post func(*internalT
 		if task.source == enumsspb.TASK_SOURCE_DB_BACKLOG && task.forwardInfo == nil {
 			t.ages.record(task.event.Data.CreateTime, -1)
 		}
+		t.fairness.taskRemoved(task, false)
 		post(task)
 		return true
 	})
@@ -250,6 +353,10 @@ type matcherData struct {
 	rateLimitTimer         resettableTimer
 	reconsiderForwardTimer resettableTimer
 
+	// activity types with limits enforced by the root partition. only set on child partitions,
+	// tasks of these types are matched only by task forwarders.
+	rootLimitedActivityTypes map[string]struct{}
+
 	// waiting pollers and tasks
 	// invariant: all pollers and tasks in these data structures have matchResult == nil
 	pollers pollerPQ
@@ -266,6 +373,11 @@ func newMatcherData(config *taskQueueConfig, logger log.Logger, timeSource clock
 		canForward: canForward,
 		tasks: taskPQ{
 			ages: newBacklogAgeTracker(),
+			fairness: fairness{
+				enabled: config.EnableFairness,
+				weights: config.FairnessKeyWeights,
+				levels:  make(map[int32]*fairnessLevel),
+			},
 		},
 	}
 }
@@ -277,6 +389,23 @@ func (d *matcherData) UpdateRateLimit(rate float64, burstDuration time.Duration)
 	d.tasks.wholeQueueLimiter.set(rate, burstDuration)
 }
 
+// UpdateActivityTypeRateLimits sets per-activity-type dispatch rate limits. The root partition
+// enforces them, child partitions forward tasks of limited activity types to the root.
+func (d *matcherData) UpdateActivityTypeRateLimits(limits map[string]float64, burstDuration time.Duration) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if d.canForward {
+		d.rootLimitedActivityTypes = make(map[string]struct{}, len(limits))
+		for activityType := range limits {
+			d.rootLimitedActivityTypes[activityType] = struct{}{}
+		}
+	} else {
+		d.tasks.setActivityTypeLimits(limits, burstDuration)
+	}
+	d.findAndWakeMatches()
+}
+
 func (d *matcherData) EnqueueTaskNoWait(task *internalTask) {
 	d.lock.Lock()
 	defer d.lock.Unlock()
@@ -406,17 +535,27 @@ func (d *matcherData) ReprocessTasks(pred func(*internalTask) bool) []*internalT
 	return reprocess
 }
 
-// findMatch should return the highest priority task+poller match even if the per-task rate
-// limit doesn't allow the task to be matched yet.
+// findMatch should return the highest priority task+poller match even if the whole-queue rate
+// limit doesn't allow the task to be matched yet. Tasks whose activity type limit isn't ready at
+// now are skipped; notReadyUntil is the earliest time one of them will be ready, or zero.
 // call with lock held
 // nolint:revive // will improve later
-func (d *matcherData) findMatch(allowForwarding bool) (*internalTask, *waitingPoller) {
+func (d *matcherData) findMatch(allowForwarding bool, now int64) (_ *internalTask, _ *waitingPoller, notReadyUntil int64) {
 	// TODO(pri): optimize so it's not O(d*n) worst case
 	// TODO(pri): this iterates over heap as slice, which isn't quite correct, but okay for now
 	for _, task := range d.tasks.heap {
 		if !allowForwarding && task.isPollForwarder {
 			continue
 		}
+		if ready := d.tasks.activityTypeReadyTime(task); ready > now {
+			if notReadyUntil == 0 || ready < notReadyUntil {
+				notReadyUntil = ready
+			}
+			continue
+		}
+
+		// tasks with activity type limits have to be dispatched by the root partition
+		_, mustForward := d.rootLimitedActivityTypes[task.getActivityType()]
 
 		for _, poller := range d.pollers.heap {
 			// can't match cases:
@@ -424,16 +563,18 @@ func (d *matcherData) findMatch(allowForwarding bool) (*internalTask, *waitingPo
 				continue
 			} else if task.isPollForwarder && poller.forwardCtx == nil {
 				continue
-			} else if poller.isTaskForwarder && !allowForwarding {
+			} else if mustForward && !poller.isTaskForwarder {
+				continue
+			} else if poller.isTaskForwarder && !allowForwarding && !mustForward {
 				continue
 			} else if poller.isTaskValidator && task.forwardCtx != nil {
 				continue
 			}
 
-			return task, poller
+			return task, poller, notReadyUntil
 		}
 	}
-	return nil, nil
+	return nil, nil, notReadyUntil
 }
 
 // call with lock held
@@ -474,14 +615,18 @@ func (d *matcherData) findAndWakeMatches() {
 	allowForwarding := d.canForward && d.allowForwarding()
 
 	now := d.timeSource.Now().UnixNano()
-	// TODO(pri): for task-specific ready time, we need to do a full/partial re-heapify here
 
 	for {
 		// search for highest priority match
-		task, poller := d.findMatch(allowForwarding)
+		task, poller, notReadyUntil := d.findMatch(allowForwarding, now)
 		if task == nil || poller == nil {
-			// no more current matches, stop rate limit timer if was running
-			d.rateLimitTimer.unset()
+			if notReadyUntil > 0 {
+				// tasks of a rate limited activity type will be ready later
+				d.rateLimitTimer.set(d.timeSource, d.rematchAfterTimer, time.Duration(notReadyUntil-now))
+			} else {
+				// no more current matches, stop rate limit timer if was running
+				d.rateLimitTimer.unset()
+			}
 			return
 		}
 
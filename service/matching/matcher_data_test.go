@@ -387,6 +387,94 @@ func (s *MatcherDataSuite) TestOrder() {
 	// poll forwarder is last to match, but it does a half-match so we won't see it here
 }
 
+func (s *MatcherDataSuite) TestFairnessOrder() {
+	s.md.tasks.fairness.enabled = func() bool { return true }
+	s.md.tasks.fairness.weights = func() map[string]float64 { return map[string]float64{"b": 2} }
+
+	newTask := func(id int64, key string, priority int32) *internalTask {
+		t := s.newBacklogTaskWithPriority(id, 0, nil, &commonpb.Priority{PriorityKey: priority})
+		t.event.Data.FairnessKey = key
+		return t
+	}
+
+	var tasks []*internalTask
+	for i := range 4 {
+		tasks = append(tasks, newTask(int64(1+i), "a", 3))
+	}
+	for i := range 4 {
+		tasks = append(tasks, newTask(int64(5+i), "b", 3))
+	}
+	high := newTask(9, "a", 1)
+	tasks = append(tasks, high)
+	for _, t := range tasks {
+		s.md.EnqueueTaskNoWait(t)
+	}
+
+	// priority is still considered first
+	s.Equal(high, s.pollFakeTime(time.Second).task)
+
+	// "b" has twice the weight of "a", so it gets two turns for each turn of "a", ties are
+	// broken by task id
+	for _, id := range []int64{5, 1, 6, 7, 2, 8, 3, 4} {
+		s.Equal(id, s.pollFakeTime(time.Second).task.event.TaskId)
+	}
+
+	// a key that had no tasks queued starts at the current pass instead of getting a burst
+	s.md.EnqueueTaskNoWait(newTask(10, "a", 3))
+	s.md.EnqueueTaskNoWait(newTask(11, "a", 3))
+	s.md.EnqueueTaskNoWait(newTask(12, "c", 3))
+	s.Equal(int64(10), s.pollFakeTime(time.Second).task.event.TaskId)
+	s.Equal(int64(12), s.pollFakeTime(time.Second).task.event.TaskId)
+	s.Equal(int64(11), s.pollFakeTime(time.Second).task.event.TaskId)
+}
+
+func (s *MatcherDataSuite) TestActivityTypeRateLimitOnRoot() {
+	s.md.canForward = false
+	s.md.UpdateActivityTypeRateLimits(map[string]float64{"slow": 1}, 0)
+
+	newTask := func(id int64, activityType string) *internalTask {
+		t := s.newBacklogTask(id, 0, nil)
+		t.event.Data.ActivityType = activityType
+		return t
+	}
+	s.md.EnqueueTaskNoWait(newTask(1, "slow"))
+	s.md.EnqueueTaskNoWait(newTask(2, "slow"))
+	s.md.EnqueueTaskNoWait(newTask(3, "fast"))
+
+	s.Equal(int64(1), s.pollFakeTime(time.Second).task.event.TaskId)
+	// next "slow" task isn't ready yet, so it doesn't block other activity types
+	s.Equal(int64(3), s.pollFakeTime(time.Second).task.event.TaskId)
+
+	resC := make(chan *matchResult, 1)
+	go func() { resC <- s.pollFakeTime(5 * time.Second) }()
+	s.waitForPollers(1)
+	s.ts.Advance(500 * time.Millisecond)
+	gosched(3)
+	s.Empty(resC)
+	s.ts.Advance(600 * time.Millisecond)
+	s.Equal(int64(2), (<-resC).task.event.TaskId)
+}
+
+func (s *MatcherDataSuite) TestActivityTypeRateLimitForwardedToRoot() {
+	s.md.UpdateActivityTypeRateLimits(map[string]float64{"slow": 1}, 0)
+
+	slow := s.newBacklogTask(1, time.Hour, nil)
+	slow.event.Data.ActivityType = "slow"
+	fast := s.newBacklogTask(2, time.Hour, nil)
+	s.md.EnqueueTaskNoWait(slow)
+	s.md.EnqueueTaskNoWait(fast)
+
+	// local pollers only get tasks without activity type limits
+	s.Equal(fast, s.pollFakeTime(time.Second).task)
+	s.Error(s.pollRealTime(10 * time.Millisecond).ctxErr)
+
+	// there's a backlog and a recent poller, but limited tasks are still forwarded
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	res := s.md.EnqueuePollerAndWait([]context.Context{ctx}, &waitingPoller{isTaskForwarder: true})
+	s.Equal(slow, res.task)
+}
+
 func (s *MatcherDataSuite) TestPollForwardSuccess() {
 	t1 := s.newBacklogTask(1, 0, nil)
 	t2 := s.newBacklogTask(2, 0, nil)
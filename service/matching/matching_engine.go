@@ -553,6 +553,7 @@ func (e *matchingEngineImpl) AddWorkflowTask(
 		CreateTime:       timestamppb.New(now),
 		VersionDirective: addRequest.VersionDirective,
 		Priority:         addRequest.Priority,
+		FairnessKey:      addRequest.FairnessKey,
 	}
 
 	return pm.AddTask(ctx, addTaskParams{
@@ -592,6 +593,8 @@ func (e *matchingEngineImpl) AddActivityTask(
 		VersionDirective: addRequest.VersionDirective,
 		Stamp:            addRequest.Stamp,
 		Priority:         addRequest.Priority,
+		FairnessKey:      addRequest.FairnessKey,
+		ActivityType:     addRequest.ActivityType,
 	}
 
 	return pm.AddTask(ctx, addTaskParams{
@@ -1162,9 +1165,13 @@ func (e *matchingEngineImpl) DescribeTaskQueue(
 		physicalInfoByBuildId := make(map[string]map[enumspb.TaskQueueType]*taskqueuespb.PhysicalTaskQueueInfo)
 		if timeSinceLastFanOut > lastFanOutTTL {
 			// collect internal info
-			numPartitions := max(tqConfig.NumWritePartitions(), tqConfig.NumReadPartitions())
-
 			for _, taskQueueType := range req.TaskQueueTypes {
+				numPartitions := max(tqConfig.NumWritePartitions(), tqConfig.NumReadPartitions())
+				if e.config.EnablePartitionAutoscaling(req.GetNamespace(), req.GetTaskQueue().GetName(), taskQueueType) {
+					if counts := userData.GetPerType()[int32(taskQueueType)].GetPartitionCounts(); counts != nil {
+						numPartitions = max(1, int(counts.GetReadPartitions()))
+					}
+				}
 				for i := 0; i < numPartitions; i++ {
 					partitionResp, err := e.matchingRawClient.DescribeTaskQueuePartition(ctx, &matchingservice.DescribeTaskQueuePartitionRequest{
 						NamespaceId: request.GetNamespaceId(),
@@ -1300,6 +1307,26 @@ func (e *matchingEngineImpl) DescribeTaskQueuePartition(
 	return pm.Describe(ctx, buildIds, request.GetVersions().GetAllActive(), request.GetReportStats(), request.GetReportPollers(), request.GetReportInternalTaskQueueStatus())
 }
 
+// PartitionCountsHint returns the partition counts chosen by partition autoscaling for an already
+// loaded partition, so that they can be returned to the matching client for load balancing.
+func (e *matchingEngineImpl) PartitionCountsHint(
+	namespaceId string,
+	taskQueue *taskqueuepb.TaskQueue,
+	taskType enumspb.TaskQueueType,
+) *persistencespb.TaskQueuePartitionCounts {
+	partition, err := tqid.PartitionFromProto(taskQueue, namespaceId, taskType)
+	if err != nil || partition.Kind() != enumspb.TASK_QUEUE_KIND_NORMAL {
+		return nil
+	}
+	e.partitionsLock.RLock()
+	pm, ok := e.partitions[partition.Key()]
+	e.partitionsLock.RUnlock()
+	if !ok {
+		return nil
+	}
+	return pm.AutoscaledPartitionCounts()
+}
+
 func (e *matchingEngineImpl) getBuildIds(versions *taskqueuepb.TaskQueueVersionSelection) (map[string]bool, error) {
 	buildIds := make(map[string]bool)
 	if versions != nil {
@@ -1542,6 +1569,93 @@ func (e *matchingEngineImpl) GetWorkerVersioningRules(
 	return GetTimestampedWorkerVersioningRules(userData.GetVersioningData(), clk)
 }
 
+// UpdateActivityTypeRateLimits adds, replaces or removes per-activity-type dispatch rate limits for a
+// task queue. The limits are stored in the activity type section of the task queue user data.
+func (e *matchingEngineImpl) UpdateActivityTypeRateLimits(
+	ctx context.Context,
+	req *matchingservice.UpdateActivityTypeRateLimitsRequest,
+) (*matchingservice.UpdateActivityTypeRateLimitsResponse, error) {
+	ns, err := e.namespaceRegistry.GetNamespaceByID(namespace.ID(req.GetNamespaceId()))
+	if err != nil {
+		return nil, err
+	}
+	// We only expect to receive task queue family name (root partition) here.
+	taskQueueFamily, err := tqid.NewTaskQueueFamily(req.GetNamespaceId(), req.GetTaskQueue())
+	if err != nil {
+		return nil, err
+	}
+	rootPartition := taskQueueFamily.TaskQueue(enumspb.TASK_QUEUE_TYPE_WORKFLOW).RootPartition()
+	activityType := int32(enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+
+	if len(req.GetSetRateLimits()) == 0 && len(req.GetRemoveActivityTypes()) == 0 {
+		userData, err := e.getUserDataClone(ctx, rootPartition, loadCauseOtherRead)
+		if err != nil {
+			return nil, err
+		}
+		return &matchingservice.UpdateActivityTypeRateLimitsResponse{
+			RateLimits: activityTypeRateLimitsFromUserData(userData.GetPerType()[activityType]),
+		}, nil
+	}
+
+	// Only the new matcher enforces the limits, so don't accept limits that would be ignored.
+	newMatcher, cancel := e.config.NewMatcher(ns.Name().String(), req.GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_ACTIVITY, func(bool) {})
+	cancel()
+	if !newMatcher && len(req.GetSetRateLimits()) > 0 {
+		return nil, errActivityTypeRateLimitsNeedNewMatcher
+	}
+
+	tqMgr, _, err := e.getTaskQueuePartitionManager(ctx, rootPartition, true, loadCauseOtherWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	updateOptions := UserDataUpdateOptions{Source: "UpdateActivityTypeRateLimits"}
+	var rateLimits map[string]float64
+
+	_, err = tqMgr.GetUserDataManager().UpdateUserData(ctx, updateOptions, func(data *persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, bool, error) {
+		clk := data.GetClock()
+		if clk == nil {
+			clk = hlc.Zero(e.clusterMeta.GetClusterID())
+		}
+		now := hlc.Next(clk, e.timeSource)
+
+		current := data.GetPerType()[activityType].GetActivityTypeRateLimits()
+		updated, err := UpdateActivityTypeRateLimits(
+			now,
+			current,
+			req.GetSetRateLimits(),
+			req.GetRemoveActivityTypes(),
+			e.config.ActivityTypeRateLimitsPerQueue(ns.Name().String()),
+		)
+		if err != nil {
+			return nil, false, err
+		}
+		rateLimits = activityTypeRateLimitsFromUserData(&persistencespb.TaskQueueTypeUserData{ActivityTypeRateLimits: updated})
+		if activityTypeRateLimitsEqual(current, updated) {
+			return nil, false, errUserDataUnmodified
+		}
+
+		// clone the whole thing so we can just mutate
+		data = common.CloneProto(data)
+		if data == nil {
+			data = &persistencespb.TaskQueueUserData{}
+		}
+		if data.PerType == nil {
+			data.PerType = make(map[int32]*persistencespb.TaskQueueTypeUserData)
+		}
+		if data.PerType[activityType] == nil {
+			data.PerType[activityType] = &persistencespb.TaskQueueTypeUserData{}
+		}
+		data.PerType[activityType].ActivityTypeRateLimits = updated
+		data.Clock = now
+		return data, true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.UpdateActivityTypeRateLimitsResponse{RateLimits: rateLimits}, nil
+}
+
 func (e *matchingEngineImpl) getUserDataClone(
 	ctx context.Context,
 	rootPartition tqid.Partition,
@@ -1944,6 +2058,48 @@ func (e *matchingEngineImpl) ForceUnloadTaskQueuePartition(
 	return &matchingservice.ForceUnloadTaskQueuePartitionResponse{WasLoaded: wasLoaded}, nil
 }
 
+func (e *matchingEngineImpl) MigrateTaskQueueBacklog(
+	ctx context.Context,
+	req *matchingservice.MigrateTaskQueueBacklogRequest,
+) (*matchingservice.MigrateTaskQueueBacklogResponse, error) {
+	partition := tqid.PartitionFromPartitionProto(req.GetTaskQueuePartition(), req.GetNamespaceId())
+	if partition.Kind() == enumspb.TASK_QUEUE_KIND_STICKY {
+		return nil, serviceerror.NewInvalidArgument("cannot migrate the backlog of a sticky task queue")
+	}
+	switch partition.TaskType() {
+	case enumspb.TASK_QUEUE_TYPE_WORKFLOW, enumspb.TASK_QUEUE_TYPE_ACTIVITY:
+	default:
+		return nil, serviceerror.NewInvalidArgument("only workflow and activity task queue backlogs can be migrated")
+	}
+	if req.GetTargetTaskQueue() == "" {
+		return nil, serviceerror.NewInvalidArgument("target task queue is not set")
+	}
+	target, err := tqid.NormalPartitionFromRpcName(req.GetTargetTaskQueue(), req.GetNamespaceId(), partition.TaskType())
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("invalid target task queue: %v", err))
+	}
+	// Writes to a root partition name are spread over all partitions, so tasks could land back
+	// in the source. Within the same task queue only a specific non-root partition is allowed.
+	if target.TaskQueue().Name() == partition.TaskQueue().Name() &&
+		(target.IsRoot() || target.RpcName() == partition.RpcName()) {
+		return nil, serviceerror.NewInvalidArgument("target must be another task queue or another non-root partition of the source task queue")
+	}
+	if req.GetSubqueue() < 0 {
+		return nil, serviceerror.NewInvalidArgument("subqueue must not be negative")
+	}
+	if req.GetBatchSize() <= 0 {
+		req.BatchSize = defaultMigrateBacklogBatchSize
+	} else if req.GetBatchSize() > maxMigrateBacklogBatchSize {
+		req.BatchSize = maxMigrateBacklogBatchSize
+	}
+
+	pm, _, err := e.getTaskQueuePartitionManager(ctx, partition, true, loadCauseOtherWrite)
+	if err != nil {
+		return nil, err
+	}
+	return pm.MigrateBacklog(ctx, req.GetBuildId(), req)
+}
+
 func (e *matchingEngineImpl) UpdateTaskQueueUserData(ctx context.Context, request *matchingservice.UpdateTaskQueueUserDataRequest) (*matchingservice.UpdateTaskQueueUserDataResponse, error) {
 	namespaceId := namespace.ID(request.NamespaceId)
 	var applied, conflicting bool
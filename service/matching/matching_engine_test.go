@@ -1574,6 +1574,48 @@ func (s *matchingEngineSuite) TestForceUnloadTaskQueue() {
 	s.ErrorAs(err, new(*serviceerrors.StickyWorkerUnavailable))
 }
 
+func (s *matchingEngineSuite) TestMigrateTaskQueueBacklogValidation() {
+	namespaceId := uuid.New()
+	tqName := "migrate-backlog-tq"
+	partition := func(tqType enumspb.TaskQueueType, partitionId int32) *taskqueuespb.TaskQueuePartition {
+		return &taskqueuespb.TaskQueuePartition{
+			TaskQueue:     tqName,
+			TaskQueueType: tqType,
+			PartitionId:   &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: partitionId},
+		}
+	}
+	otherPartition := tqid.UnsafeTaskQueueFamily(namespaceId, tqName).TaskQueue(enumspb.TASK_QUEUE_TYPE_WORKFLOW).NormalPartition(1)
+
+	invalid := []*matchingservice.MigrateTaskQueueBacklogRequest{
+		{
+			TaskQueuePartition: &taskqueuespb.TaskQueuePartition{
+				TaskQueue:     tqName,
+				TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+				PartitionId:   &taskqueuespb.TaskQueuePartition_StickyName{StickyName: "sticky"},
+			},
+			TargetTaskQueue: "other",
+		},
+		{TaskQueuePartition: partition(enumspb.TASK_QUEUE_TYPE_NEXUS, 0), TargetTaskQueue: "other"},
+		{TaskQueuePartition: partition(enumspb.TASK_QUEUE_TYPE_WORKFLOW, 0)},
+		{TaskQueuePartition: partition(enumspb.TASK_QUEUE_TYPE_WORKFLOW, 1), TargetTaskQueue: tqName},
+		{TaskQueuePartition: partition(enumspb.TASK_QUEUE_TYPE_WORKFLOW, 1), TargetTaskQueue: otherPartition.RpcName()},
+	}
+	for _, req := range invalid {
+		req.NamespaceId = namespaceId
+		_, err := s.matchingEngine.MigrateTaskQueueBacklog(context.Background(), req)
+		s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+	}
+
+	resp, err := s.matchingEngine.MigrateTaskQueueBacklog(context.Background(), &matchingservice.MigrateTaskQueueBacklogRequest{
+		NamespaceId:        namespaceId,
+		TaskQueuePartition: partition(enumspb.TASK_QUEUE_TYPE_WORKFLOW, 0),
+		TargetTaskQueue:    otherPartition.RpcName(),
+	})
+	s.NoError(err)
+	s.True(resp.GetDone())
+	s.Zero(resp.GetTasksScanned())
+}
+
 func (s *matchingEngineSuite) TestMultipleEnginesActivitiesRangeStealing() {
 	if s.newMatcher {
 		s.T().Skip("test is flaky with new matcher")
@@ -2495,6 +2537,33 @@ func (s *matchingEngineSuite) TestGetTaskQueueUserData_LongPoll_Closes() {
 	s.Nil(res.UserData)
 }
 
+func (s *matchingEngineSuite) TestUpdateActivityTypeRateLimits_RequiresNewMatcher() {
+	ctx := context.Background()
+	req := &matchingservice.UpdateActivityTypeRateLimitsRequest{
+		NamespaceId:   namespace.ID(uuid.New()).String(),
+		TaskQueue:     "rate-limited",
+		SetRateLimits: map[string]float64{"slow": 1},
+	}
+
+	s.matchingEngine.config.NewMatcher = func(_ string, _ string, _ enumspb.TaskQueueType, _ func(bool)) (bool, func()) {
+		return false, func() {}
+	}
+	_, err := s.matchingEngine.UpdateActivityTypeRateLimits(ctx, req)
+	s.ErrorIs(err, errActivityTypeRateLimitsNeedNewMatcher)
+	// reads and removals are allowed
+	res, err := s.matchingEngine.UpdateActivityTypeRateLimits(ctx, &matchingservice.UpdateActivityTypeRateLimitsRequest{
+		NamespaceId: req.NamespaceId,
+		TaskQueue:   req.TaskQueue,
+	})
+	s.NoError(err)
+	s.Empty(res.RateLimits)
+
+	useNewMatcher(s.matchingEngine.config)
+	res, err = s.matchingEngine.UpdateActivityTypeRateLimits(ctx, req)
+	s.NoError(err)
+	s.Equal(map[string]float64{"slow": 1}, res.RateLimits)
+}
+
 func (s *matchingEngineSuite) TestUpdateUserData_FailsOnKnownVersionMismatch() {
 	namespaceID := namespace.ID(uuid.New())
 	tq := "tupac"
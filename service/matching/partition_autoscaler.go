@@ -0,0 +1,271 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"math"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common"
+	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
+	"go.temporal.io/server/common/goro"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type (
+	// partitionAutoscaler periodically adjusts the number of read and write partitions of a task
+	// queue based on its load. It runs on the root partition of the workflow task queue and stores
+	// the chosen counts in the task queue user data, from where they propagate to all partitions and,
+	// through matching responses, to the matching clients that load balance across partitions.
+	//
+	// Write partitions are scaled up immediately and scaled down one at a time. Read partitions are
+	// only scaled down once the partitions that no longer receive tasks have drained their backlog,
+	// and clients had time to let the counts they learned before the write partitions were scaled
+	// down expire (see matching.partitionCountsCacheTTL).
+	partitionAutoscaler struct {
+		pm        *taskQueuePartitionManagerImpl
+		goroGroup goro.Group
+	}
+
+	// partitionLoad is the load of a task queue aggregated over its read partitions.
+	partitionLoad struct {
+		tasksPerSecond float64
+		pollers        int
+		backlogAge     time.Duration
+		// backlog of the read partitions that are not write partitions
+		drainingBacklog int64
+	}
+
+	partitionAutoscalingParams struct {
+		minPartitions       int
+		maxPartitions       int
+		tasksPerSecond      float64
+		pollersPerPartition int
+		backlogAge          time.Duration
+		// minimum time since the last change of the counts before read partitions are removed
+		readScaleDownDelay time.Duration
+	}
+)
+
+func newPartitionAutoscaler(pm *taskQueuePartitionManagerImpl) *partitionAutoscaler {
+	return &partitionAutoscaler{pm: pm}
+}
+
+func (a *partitionAutoscaler) Start() {
+	a.goroGroup.Go(a.run)
+}
+
+func (a *partitionAutoscaler) Stop() {
+	a.goroGroup.Cancel()
+}
+
+func (a *partitionAutoscaler) run(ctx context.Context) error {
+	for {
+		timer := time.NewTimer(a.pm.config.PartitionAutoscalingInterval())
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-timer.C:
+		}
+		for _, taskType := range []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_WORKFLOW, enumspb.TASK_QUEUE_TYPE_ACTIVITY} {
+			if err := a.evaluate(ctx, taskType); err != nil && ctx.Err() == nil {
+				a.pm.logger.Warn("Failed to autoscale task queue partitions",
+					tag.WorkflowTaskQueueType(taskType), tag.Error(err))
+			}
+		}
+	}
+}
+
+func (a *partitionAutoscaler) evaluate(ctx context.Context, taskType enumspb.TaskQueueType) error {
+	// Use a fresh config: the partition manager config reports the autoscaled counts.
+	tqConfig := newTaskQueueConfig(a.pm.partition.TaskQueue().Family().TaskQueue(taskType), a.pm.engine.config, a.pm.ns.Name())
+	if !tqConfig.EnablePartitionAutoscaling() {
+		return nil
+	}
+
+	userData, _, err := a.pm.userDataManager.GetUserData()
+	if err != nil {
+		return err
+	}
+	read, write := tqConfig.NumReadPartitions(), tqConfig.NumWritePartitions()
+	var sinceUpdate time.Duration
+	counts := userData.GetData().GetPerType()[int32(taskType)].GetPartitionCounts()
+	if counts != nil {
+		read, write = max(1, int(counts.GetReadPartitions())), max(1, int(counts.GetWritePartitions()))
+		sinceUpdate = a.pm.engine.timeSource.Now().Sub(hlc.UTC(counts.GetUpdateTime()))
+	}
+	read = max(read, write)
+
+	ctx, cancel := context.WithTimeout(a.pm.callerInfoContext(ctx), ioTimeout)
+	defer cancel()
+
+	load, err := a.collectLoad(ctx, taskType, read, write)
+	if err != nil {
+		return err
+	}
+	newRead, newWrite := computePartitionCounts(read, write, sinceUpdate, load, partitionAutoscalingParams{
+		minPartitions:       tqConfig.PartitionAutoscalingMinPartitions(),
+		maxPartitions:       tqConfig.PartitionAutoscalingMaxPartitions(),
+		tasksPerSecond:      tqConfig.PartitionAutoscalingTasksPerSecond(),
+		pollersPerPartition: tqConfig.PartitionAutoscalingPollersPerPartition(),
+		backlogAge:          tqConfig.PartitionAutoscalingBacklogAge(),
+		// leave an interval for the counts to propagate to all partitions
+		readScaleDownDelay: tqConfig.PartitionCountsCacheTTL() + tqConfig.PartitionAutoscalingInterval(),
+	})
+	// Clients only add to non-root partitions while they have counts, so write the counts even if
+	// unchanged the first time.
+	if newRead == read && newWrite == write && counts != nil {
+		return nil
+	}
+
+	a.pm.logger.Info("Autoscaling task queue partitions",
+		tag.WorkflowTaskQueueType(taskType),
+		tag.NewInt("read-partitions", newRead),
+		tag.NewInt("write-partitions", newWrite),
+		tag.NewInt("previous-read-partitions", read),
+		tag.NewInt("previous-write-partitions", write))
+	return a.updatePartitionCounts(ctx, taskType, newRead, newWrite)
+}
+
+// collectLoad describes all read partitions of the task queue and aggregates their load.
+func (a *partitionAutoscaler) collectLoad(
+	ctx context.Context,
+	taskType enumspb.TaskQueueType,
+	read, write int,
+) (partitionLoad, error) {
+	var load partitionLoad
+	var addRate, dispatchRate float64
+	pollers := make(map[string]struct{})
+	for i := 0; i < read; i++ {
+		resp, err := a.pm.matchingClient.DescribeTaskQueuePartition(ctx, &matchingservice.DescribeTaskQueuePartitionRequest{
+			NamespaceId: a.pm.partition.NamespaceId(),
+			TaskQueuePartition: &taskqueuespb.TaskQueuePartition{
+				TaskQueue:     a.pm.partition.TaskQueue().Name(),
+				TaskQueueType: taskType,
+				PartitionId:   &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: int32(i)},
+			},
+			Versions:      &taskqueuepb.TaskQueueVersionSelection{Unversioned: true, AllActive: true},
+			ReportStats:   true,
+			ReportPollers: true,
+		})
+		if err != nil {
+			return partitionLoad{}, err
+		}
+		for _, vii := range resp.GetVersionsInfoInternal() {
+			stats := vii.GetPhysicalTaskQueueInfo().GetTaskQueueStats()
+			addRate += float64(stats.GetTasksAddRate())
+			dispatchRate += float64(stats.GetTasksDispatchRate())
+			load.backlogAge = max(load.backlogAge, stats.GetApproximateBacklogAge().AsDuration())
+			if i >= write {
+				load.drainingBacklog += stats.GetApproximateBacklogCount()
+			}
+			for _, poller := range vii.GetPhysicalTaskQueueInfo().GetPollers() {
+				pollers[poller.GetIdentity()] = struct{}{}
+			}
+		}
+	}
+	load.tasksPerSecond = max(addRate, dispatchRate)
+	load.pollers = len(pollers)
+	return load, nil
+}
+
+func (a *partitionAutoscaler) updatePartitionCounts(ctx context.Context, taskType enumspb.TaskQueueType, read, write int) error {
+	updateOptions := UserDataUpdateOptions{Source: "PartitionAutoscaling"}
+	_, err := a.pm.userDataManager.UpdateUserData(ctx, updateOptions, func(data *persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, bool, error) {
+		clk := data.GetClock()
+		if clk == nil {
+			clk = hlc.Zero(a.pm.engine.clusterMeta.GetClusterID())
+		}
+		now := hlc.Next(clk, a.pm.engine.timeSource)
+
+		// clone the whole thing so we can just mutate
+		data = common.CloneProto(data)
+		if data == nil {
+			data = &persistencespb.TaskQueueUserData{}
+		}
+		if data.PerType == nil {
+			data.PerType = make(map[int32]*persistencespb.TaskQueueTypeUserData)
+		}
+		if data.PerType[int32(taskType)] == nil {
+			data.PerType[int32(taskType)] = &persistencespb.TaskQueueTypeUserData{}
+		}
+		data.PerType[int32(taskType)].PartitionCounts = &persistencespb.TaskQueuePartitionCounts{
+			ReadPartitions:  int32(read),
+			WritePartitions: int32(write),
+			UpdateTime:      now,
+		}
+		data.Clock = now
+		// partition counts reflect the load in this cluster and are not replicated
+		return data, false, nil
+	})
+	return err
+}
+
+// computePartitionCounts returns the read and write partition counts for the given load.
+//
+// The desired write partition count is the count needed to keep the tasks per second and the
+// pollers per partition under their targets, plus one if the backlog is older than the target
+// and there are enough pollers to benefit from another partition. Write partitions are added
+// immediately and removed one at a time. Read partitions are never fewer than write partitions;
+// extra read partitions are removed once they have no backlog left and the counts did not change
+// for readScaleDownDelay, so that clients routing with stale counts don't leave tasks behind.
+func computePartitionCounts(
+	read, write int,
+	sinceUpdate time.Duration,
+	load partitionLoad,
+	params partitionAutoscalingParams,
+) (int, int) {
+	desired := 1
+	if params.tasksPerSecond > 0 {
+		desired = max(desired, int(math.Ceil(load.tasksPerSecond/params.tasksPerSecond)))
+	}
+	if params.pollersPerPartition > 0 {
+		desired = max(desired, int(math.Ceil(float64(load.pollers)/float64(params.pollersPerPartition))))
+	}
+	if params.backlogAge > 0 && load.backlogAge > params.backlogAge && load.pollers >= write {
+		desired = max(desired, write+1)
+	}
+	desired = min(max(desired, params.minPartitions), params.maxPartitions)
+
+	newWrite := write
+	if desired > write {
+		newWrite = desired
+	} else if desired < write {
+		newWrite = write - 1
+	}
+
+	newRead := max(read, newWrite)
+	if newWrite == write && read > write && load.drainingBacklog == 0 && sinceUpdate >= params.readScaleDownDelay {
+		newRead = write
+	}
+	return newRead, newWrite
+}
@@ -0,0 +1,151 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputePartitionCounts(t *testing.T) {
+	t.Parallel()
+	params := partitionAutoscalingParams{
+		minPartitions:       1,
+		maxPartitions:       8,
+		tasksPerSecond:      100,
+		pollersPerPartition: 10,
+		backlogAge:          30 * time.Second,
+		readScaleDownDelay:  2 * time.Minute,
+	}
+
+	testCases := []struct {
+		name          string
+		read, write   int
+		sinceUpdate   time.Duration
+		load          partitionLoad
+		expectedRead  int
+		expectedWrite int
+	}{
+		{
+			name:          "idle queue stays at minimum",
+			read:          1,
+			write:         1,
+			expectedRead:  1,
+			expectedWrite: 1,
+		},
+		{
+			name:          "scale up by task rate immediately",
+			read:          1,
+			write:         1,
+			load:          partitionLoad{tasksPerSecond: 450},
+			expectedRead:  5,
+			expectedWrite: 5,
+		},
+		{
+			name:          "scale up by pollers",
+			read:          2,
+			write:         2,
+			load:          partitionLoad{pollers: 35},
+			expectedRead:  4,
+			expectedWrite: 4,
+		},
+		{
+			name:          "old backlog adds a partition when there are enough pollers",
+			read:          2,
+			write:         2,
+			load:          partitionLoad{pollers: 2, backlogAge: time.Minute},
+			expectedRead:  3,
+			expectedWrite: 3,
+		},
+		{
+			name:          "old backlog without enough pollers scales down",
+			read:          2,
+			write:         2,
+			load:          partitionLoad{pollers: 1, backlogAge: time.Minute},
+			expectedRead:  2,
+			expectedWrite: 1,
+		},
+		{
+			name:          "scale up is capped at maximum",
+			read:          4,
+			write:         4,
+			load:          partitionLoad{tasksPerSecond: 5000},
+			expectedRead:  8,
+			expectedWrite: 8,
+		},
+		{
+			name:          "scale down one write partition at a time and keep read partitions",
+			read:          6,
+			write:         6,
+			load:          partitionLoad{tasksPerSecond: 50},
+			expectedRead:  6,
+			expectedWrite: 5,
+		},
+		{
+			name:          "keep read partitions while they have backlog",
+			read:          6,
+			write:         5,
+			load:          partitionLoad{tasksPerSecond: 450, drainingBacklog: 10},
+			expectedRead:  6,
+			expectedWrite: 5,
+		},
+		{
+			name:          "keep read partitions until clients' counts expired",
+			read:          6,
+			write:         5,
+			sinceUpdate:   time.Minute,
+			load:          partitionLoad{tasksPerSecond: 450},
+			expectedRead:  6,
+			expectedWrite: 5,
+		},
+		{
+			name:          "drop read partitions once drained",
+			read:          6,
+			write:         5,
+			sinceUpdate:   3 * time.Minute,
+			load:          partitionLoad{tasksPerSecond: 450},
+			expectedRead:  5,
+			expectedWrite: 5,
+		},
+		{
+			name:          "scale up while draining keeps read partitions",
+			read:          6,
+			write:         4,
+			load:          partitionLoad{tasksPerSecond: 550},
+			expectedRead:  6,
+			expectedWrite: 6,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			read, write := computePartitionCounts(tc.read, tc.write, tc.sinceUpdate, tc.load, params)
+			assert.Equal(t, tc.expectedRead, read)
+			assert.Equal(t, tc.expectedWrite, write)
+		})
+	}
+}
@@ -265,6 +265,7 @@ func (c *physicalTaskQueueManagerImpl) Start() {
 	}
 	c.liveness.Start()
 	c.backlogMgr.Start()
+	c.updateActivityTypeRateLimits()
 	c.matcher.Start()
 	c.logger.Info("Started physicalTaskQueueManager", tag.LifeCycleStarted, tag.Cause(c.config.loadCause.String()))
 	c.metricsHandler.Counter(metrics.TaskQueueStartedCounter.Name()).Record(1)
@@ -363,6 +364,10 @@ func (c *physicalTaskQueueManagerImpl) PollTask(
 			task.finish(nil, false)
 			continue
 		}
+		if task.event != nil && c.isMigratedBacklogTask(task) {
+			task.finish(nil, false)
+			continue
+		}
 
 		task.namespace = c.partitionMgr.ns.Name()
 		task.backlogCountHint = c.backlogCountHint
@@ -406,6 +411,10 @@ func (c *physicalTaskQueueManagerImpl) ProcessSpooledTask(
 		// Don't try to set read level here because it may have been advanced already.
 		return nil
 	}
+	if c.isMigratedBacklogTask(task) {
+		task.finish(nil, false)
+		return nil
+	}
 	return c.partitionMgr.ProcessSpooledTask(ctx, task, c.queue)
 }
 
@@ -414,13 +423,32 @@ func (c *physicalTaskQueueManagerImpl) AddSpooledTask(task *internalTask) error
 }
 
 func (c *physicalTaskQueueManagerImpl) AddSpooledTaskToMatcher(task *internalTask) {
+	if c.isMigratedBacklogTask(task) {
+		task.finish(nil, false)
+		return
+	}
 	c.priMatcher.AddTask(task)
 }
 
 func (c *physicalTaskQueueManagerImpl) UserDataChanged() {
+	c.updateActivityTypeRateLimits()
 	c.matcher.ReprocessAllTasks()
 }
 
+// updateActivityTypeRateLimits passes per-activity-type limits from user data to the matcher.
+// Only the priority matcher supports them; UpdateActivityTypeRateLimits rejects new limits while
+// it's disabled, but limits set before it was disabled are ignored.
+func (c *physicalTaskQueueManagerImpl) updateActivityTypeRateLimits() {
+	if c.priMatcher == nil || c.queue.TaskType() != enumspb.TASK_QUEUE_TYPE_ACTIVITY {
+		return
+	}
+	perType, _, err := c.partitionMgr.getPerTypeUserData()
+	if err != nil {
+		return
+	}
+	c.priMatcher.UpdateActivityTypeRateLimits(activityTypeRateLimitsFromUserData(perType))
+}
+
 // DispatchQueryTask will dispatch query to local or remote poller. If forwarded then result or error is returned,
 // if dispatched to local poller then nil and nil is returned.
 func (c *physicalTaskQueueManagerImpl) DispatchQueryTask(
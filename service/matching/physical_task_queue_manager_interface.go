@@ -78,5 +78,7 @@ type (
 		// MakePollerScalingDecision makes a decision on whether to scale pollers up or down based on the current state
 		// of the task queue and the task about to be returned.
 		MakePollerScalingDecision(pollStartTime time.Time) *taskqueuepb.PollerScalingDecision
+		// MigrateBacklog copies or moves one batch of backlog tasks to another task queue.
+		MigrateBacklog(ctx context.Context, request *matchingservice.MigrateTaskQueueBacklogRequest) (*matchingservice.MigrateTaskQueueBacklogResponse, error)
 	}
 )
@@ -235,6 +235,21 @@ func (mr *MockphysicalTaskQueueManagerMockRecorder) MarkAlive() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAlive", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).MarkAlive))
 }
 
+// MigrateBacklog mocks base method.
+func (m *MockphysicalTaskQueueManager) MigrateBacklog(ctx context.Context, request *matchingservice.MigrateTaskQueueBacklogRequest) (*matchingservice.MigrateTaskQueueBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateBacklog", ctx, request)
+	ret0, _ := ret[0].(*matchingservice.MigrateTaskQueueBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateBacklog indicates an expected call of MigrateBacklog.
+func (mr *MockphysicalTaskQueueManagerMockRecorder) MigrateBacklog(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateBacklog", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).MigrateBacklog), ctx, request)
+}
+
 // PollTask mocks base method.
 func (m *MockphysicalTaskQueueManager) PollTask(ctx context.Context, pollMetadata *pollMetadata) (*internalTask, error) {
 	m.ctrl.T.Helper()
@@ -26,6 +26,7 @@ package matching
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"math/rand"
 	"sync/atomic"
@@ -37,6 +38,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	enumspb "go.temporal.io/api/enums/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/api/matchingservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
@@ -51,6 +53,7 @@ import (
 	"go.temporal.io/server/common/testing/testlogger"
 	"go.temporal.io/server/common/tqid"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -416,6 +419,73 @@ func (s *PhysicalTaskQueueManagerTestSuite) TestTQMDoesNotDoFinalUpdateOnOwnersh
 	s.Equal(1, tm.getUpdateCount(s.physicalTaskQueueKey))
 }
 
+func (s *PhysicalTaskQueueManagerTestSuite) TestMigrateBacklog() {
+	s.tqMgr.Start()
+	defer s.tqMgr.Stop(unloadCauseShuttingDown)
+	s.NoError(s.tqMgr.WaitUntilInitialized(context.Background()))
+
+	for i := range 3 {
+		s.NoError(s.tqMgr.SpoolTask(&persistencespb.TaskInfo{
+			NamespaceId:      defaultNamespaceId,
+			WorkflowId:       fmt.Sprintf("wf-%d", i),
+			RunId:            uuid.New(),
+			ScheduledEventId: int64(i),
+			CreateTime:       timestamp.TimePtr(time.Now().UTC()),
+		}))
+	}
+	tm, _ := s.tqMgr.partitionMgr.engine.taskManager.(*testTaskManager)
+	s.Eventually(func() bool {
+		return tm.getTaskCount(s.physicalTaskQueueKey) == 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	matchingClient := matchingservicemock.NewMockMatchingServiceClient(s.controller)
+	s.tqMgr.matchingClient = matchingClient
+	matchingClient.EXPECT().AddWorkflowTask(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *matchingservice.AddWorkflowTaskRequest, _ ...grpc.CallOption) (*matchingservice.AddWorkflowTaskResponse, error) {
+			s.Equal("target", req.GetTaskQueue().GetName())
+			s.Equal("wf-1", req.GetExecution().GetWorkflowId())
+			s.Equal(int64(1), req.GetScheduledEventId())
+			return &matchingservice.AddWorkflowTaskResponse{}, nil
+		})
+
+	req := &matchingservice.MigrateTaskQueueBacklogRequest{
+		TargetTaskQueue: "target",
+		WorkflowId:      "wf-1",
+		BatchSize:       10,
+	}
+	resp, err := s.tqMgr.MigrateBacklog(context.Background(), req)
+	s.NoError(err)
+	s.Equal(int32(3), resp.GetTasksScanned())
+	s.Equal(int32(1), resp.GetTasksMigrated())
+	s.False(resp.GetDone())
+	migratedTaskID := resp.GetLastTaskId() - 1
+
+	s.True(s.tqMgr.backlogMgr.getDB().IsMigratedTask(migratedTaskID))
+
+	req.Subqueue, req.LastTaskId = resp.GetSubqueue(), resp.GetLastTaskId()
+	resp, err = s.tqMgr.MigrateBacklog(context.Background(), req)
+	s.NoError(err)
+	s.True(resp.GetDone())
+
+	// the moved task is dropped and the other two are dispatched
+	for range 2 {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		task, err := s.tqMgr.PollTask(ctx, makePollMetadata(rpsInf))
+		cancel()
+		s.NoError(err)
+		s.NotEqual("wf-1", task.event.Data.GetWorkflowId())
+		task.finish(nil, true)
+	}
+	// the range is forgotten once the ack level passes it
+	s.Eventually(func() bool {
+		if blm, ok := s.tqMgr.backlogMgr.(*backlogManagerImpl); ok {
+			// the old backlog manager only persists the ack level periodically
+			s.NoError(blm.taskReader.persistAckBacklogCountLevel(context.Background()))
+		}
+		return !s.tqMgr.backlogMgr.getDB().IsMigratedTask(migratedTaskID)
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
 func (s *PhysicalTaskQueueManagerTestSuite) TestTQMInterruptsPollOnClose() {
 	s.tqMgr.Start()
 
@@ -66,6 +66,12 @@ type (
 	// 	BacklogHeadAge() time.Duration
 	// }
 
+	// subqueueKey is the in-memory form of persistencespb.SubqueueKey
+	subqueueKey struct {
+		priority    int32
+		fairnessKey string
+	}
+
 	priBacklogManagerImpl struct {
 		pqMgr      physicalTaskQueueManager
 		config     *taskQueueConfig
@@ -73,9 +79,11 @@ type (
 		db         *taskQueueDB
 		taskWriter *priTaskWriter
 
-		subqueueLock        sync.Mutex
-		subqueues           []*priTaskReader
-		subqueuesByPriority map[int32]int
+		subqueueLock           sync.Mutex
+		subqueues              []*priTaskReader
+		subqueuesByKey         map[subqueueKey]int
+		fairnessKeysByPriority map[int32]int // count of subqueues with a non-default fairness key
+		subqueueLastUsed       []time.Time   // last time a task was spooled to each subqueue
 
 		logger           log.Logger
 		throttledLogger  log.ThrottledLogger
@@ -101,15 +109,16 @@ func newPriBacklogManager(
 	metricsHandler metrics.Handler,
 ) *priBacklogManagerImpl {
 	bmg := &priBacklogManagerImpl{
-		pqMgr:               pqMgr,
-		config:              config,
-		tqCtx:               tqCtx,
-		subqueuesByPriority: make(map[int32]int),
-		matchingClient:      matchingClient,
-		metricsHandler:      metricsHandler,
-		logger:              logger,
-		throttledLogger:     throttledLogger,
-		initializedError:    future.NewFuture[struct{}](),
+		pqMgr:                  pqMgr,
+		config:                 config,
+		tqCtx:                  tqCtx,
+		subqueuesByKey:         make(map[subqueueKey]int),
+		fairnessKeysByPriority: make(map[int32]int),
+		matchingClient:         matchingClient,
+		metricsHandler:         metricsHandler,
+		logger:                 logger,
+		throttledLogger:        throttledLogger,
+		initializedError:       future.NewFuture[struct{}](),
 	}
 	bmg.db = newTaskQueueDB(config, taskManager, pqMgr.QueueKey(), logger, metricsHandler)
 	bmg.taskWriter = newPriTaskWriter(bmg)
@@ -174,19 +183,29 @@ func (c *priBacklogManagerImpl) WaitUntilInitialized(ctx context.Context) error
 }
 
 func (c *priBacklogManagerImpl) loadSubqueuesLocked(subqueues []persistencespb.SubqueueInfo) {
-	// TODO(pri): This assumes that subqueues never shrinks, and priority/fairness index of
-	// existing subqueues never changes. If we change that, this logic will need to change.
+	// TODO(pri): This assumes that subqueues never shrinks. The key of an existing subqueue
+	// may change when it's reassigned to another fairness key, so the index is rebuilt.
+	clear(c.subqueuesByKey)
+	clear(c.fairnessKeysByPriority)
 	for i := range subqueues {
 		if i >= len(c.subqueues) {
 			r := newPriTaskReader(c, i, subqueues[i].AckLevel)
 			r.Start()
 			c.subqueues = append(c.subqueues, r)
+			c.subqueueLastUsed = append(c.subqueueLastUsed, time.Time{})
+		}
+		key := subqueueKey{
+			priority:    subqueues[i].Key.Priority,
+			fairnessKey: subqueues[i].Key.FairnessKey,
 		}
-		c.subqueuesByPriority[subqueues[i].Key.Priority] = i
+		if _, ok := c.subqueuesByKey[key]; !ok && key.fairnessKey != "" {
+			c.fairnessKeysByPriority[key.priority]++
+		}
+		c.subqueuesByKey[key] = i
 	}
 }
 
-func (c *priBacklogManagerImpl) getSubqueueForPriority(priority int32) int {
+func (c *priBacklogManagerImpl) getSubqueueForTask(priority int32, fairnessKey string) int {
 	levels := c.config.PriorityLevels()
 	if priority == 0 {
 		priority = defaultPriorityLevel(levels)
@@ -197,19 +216,42 @@ func (c *priBacklogManagerImpl) getSubqueueForPriority(priority int32) int {
 	} else if priority > int32(levels) {
 		priority = int32(levels)
 	}
+	if !c.config.EnableFairness() {
+		fairnessKey = ""
+	}
 
 	c.subqueueLock.Lock()
 	defer c.subqueueLock.Unlock()
 
-	if i, ok := c.subqueuesByPriority[priority]; ok {
+	i := c.getSubqueueForKeyLocked(subqueueKey{priority: priority, fairnessKey: fairnessKey})
+	if i < len(c.subqueueLastUsed) {
+		c.subqueueLastUsed[i] = time.Now()
+	}
+	return i
+}
+
+func (c *priBacklogManagerImpl) getSubqueueForKeyLocked(key subqueueKey) int {
+	if i, ok := c.subqueuesByKey[key]; ok {
 		return i
 	}
+	if key.fairnessKey != "" && c.fairnessKeysByPriority[key.priority] >= c.config.MaxFairnessKeysPerPriority() {
+		if i, ok := c.reassignIdleSubqueueLocked(key); ok {
+			return i
+		}
+		// Too many keys are tracked already, share the backlog of the default key. The task keeps
+		// its fairness key, so it is still dispatched fairly once it's read.
+		key.fairnessKey = ""
+		if i, ok := c.subqueuesByKey[key]; ok {
+			return i
+		}
+	}
 
 	// We need to allocate a new subqueue. Note this is doing io under backlogLock,
 	// but we want to serialize these updates.
 	// TODO(pri): maybe we can improve that
 	subqueues, err := c.db.AllocateSubqueue(c.tqCtx, &persistencespb.SubqueueKey{
-		Priority: priority,
+		Priority:    key.priority,
+		FairnessKey: key.fairnessKey,
 	})
 	if err != nil {
 		c.signalIfFatal(err)
@@ -220,9 +262,9 @@ func (c *priBacklogManagerImpl) getSubqueueForPriority(priority int32) int {
 
 	c.loadSubqueuesLocked(subqueues)
 
-	// After AllocateSubqueue added a subqueue for this priority, and we merged the result into
+	// After AllocateSubqueue added a subqueue for this key, and we merged the result into
 	// our state with loadSubqueuesLocked, this lookup should now find a subqueue.
-	if i, ok := c.subqueuesByPriority[priority]; ok {
+	if i, ok := c.subqueuesByKey[key]; ok {
 		return i
 	}
 
@@ -230,6 +272,32 @@ func (c *priBacklogManagerImpl) getSubqueueForPriority(priority int32) int {
 	return subqueueZero
 }
 
+// reassignIdleSubqueueLocked gives the subqueue of another fairness key at the same priority to
+// key, if that key had no tasks spooled for FairnessSubqueueIdleTime and its backlog is drained.
+// This keeps the number of subqueues and readers bounded while the set of active keys changes.
+func (c *priBacklogManagerImpl) reassignIdleSubqueueLocked(key subqueueKey) (int, bool) {
+	idleSince := time.Now().Add(-c.config.FairnessSubqueueIdleTime())
+	for oldKey, i := range c.subqueuesByKey {
+		if oldKey.priority != key.priority || oldKey.fairnessKey == "" ||
+			c.subqueueLastUsed[i].After(idleSince) || c.subqueues[i].getLoadedTasks() > 0 {
+			continue
+		}
+		subqueues, err := c.db.ReassignSubqueue(c.tqCtx, i, &persistencespb.SubqueueKey{
+			Priority:    key.priority,
+			FairnessKey: key.fairnessKey,
+		})
+		if errors.Is(err, errSubqueueHasBacklog) {
+			continue
+		} else if err != nil {
+			c.signalIfFatal(err)
+			return 0, false
+		}
+		c.loadSubqueuesLocked(subqueues)
+		return i, true
+	}
+	return 0, false
+}
+
 func (c *priBacklogManagerImpl) periodicSync() {
 	for {
 		select {
@@ -245,7 +313,7 @@ func (c *priBacklogManagerImpl) periodicSync() {
 }
 
 func (c *priBacklogManagerImpl) SpoolTask(taskInfo *persistencespb.TaskInfo) error {
-	subqueue := c.getSubqueueForPriority(taskInfo.Priority.GetPriorityKey())
+	subqueue := c.getSubqueueForTask(taskInfo.Priority.GetPriorityKey(), taskInfo.GetFairnessKey())
 	err := c.taskWriter.appendTask(subqueue, taskInfo)
 	c.signalIfFatal(err)
 	return err
@@ -113,6 +113,7 @@ func (f *priForwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 				ForwardInfo:            f.getForwardInfo(task),
 				VersionDirective:       task.event.Data.GetVersionDirective(),
 				Priority:               task.event.Data.GetPriority(),
+				FairnessKey:            task.event.Data.GetFairnessKey(),
 			},
 		)
 	case enumspb.TASK_QUEUE_TYPE_ACTIVITY:
@@ -131,6 +132,8 @@ func (f *priForwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 				Stamp:                  task.event.Data.GetStamp(),
 				VersionDirective:       task.event.Data.GetVersionDirective(),
 				Priority:               task.event.Data.GetPriority(),
+				FairnessKey:            task.event.Data.GetFairnessKey(),
+				ActivityType:           task.event.Data.GetActivityType(),
 			},
 		)
 	default:
@@ -537,6 +537,12 @@ func (tm *priTaskMatcher) setLimitLocked() {
 	tm.data.UpdateRateLimit(rate, burstDuration)
 }
 
+// UpdateActivityTypeRateLimits updates the per-activity-type dispatch rates. These are not divided
+// across partitions since only the root partition enforces them.
+func (tm *priTaskMatcher) UpdateActivityTypeRateLimits(limits map[string]float64) {
+	tm.data.UpdateActivityTypeRateLimits(limits, burstDuration)
+}
+
 // Rate returns the current dynamic rate setting
 func (tm *priTaskMatcher) Rate() float64 {
 	tm.limiterLock.Lock()
@@ -90,6 +90,7 @@ type (
 		waitableMatchResult
 		forwardCtx      context.Context // non-nil for sync match task only
 		isPollForwarder bool
+		fairnessPass    float64 // assigned by taskPQ when the task is added
 	}
 
 	// taskResponse is used to report the result of either a match with a local poller,
@@ -280,6 +281,21 @@ func (task *internalTask) getPriority() *commonpb.Priority {
 	return nil
 }
 
+func (task *internalTask) getFairnessKey() string {
+	if task.event != nil {
+		return task.event.AllocatedTaskInfo.GetData().GetFairnessKey()
+	}
+	// query and nexus tasks don't have fairness keys for now
+	return ""
+}
+
+func (task *internalTask) getActivityType() string {
+	if task.event != nil {
+		return task.event.AllocatedTaskInfo.GetData().GetActivityType()
+	}
+	return ""
+}
+
 // finish marks a task as finished. Should be called after a poller picks up a task
 // and marks it as started. If the task is unable to marked as started, then this
 // method should be called with a non-nil error argument.
@@ -80,6 +80,7 @@ type (
 		cachedPhysicalInfoByBuildId     map[string]map[enumspb.TaskQueueType]*taskqueuespb.PhysicalTaskQueueInfo // non-nil for root-partition
 		cachedPhysicalInfoByBuildIdLock sync.RWMutex                                                             // locks mutation of cachedPhysicalInfoByBuildId
 		lastFanOut                      int64                                                                    // serves as a TTL for cachedPhysicalInfoByBuildId
+		autoscaler                      *partitionAutoscaler                                                     // non-nil for the root partition of normal workflow task queues
 	}
 )
 
@@ -109,6 +110,26 @@ func newTaskQueuePartitionManager(
 		cachedPhysicalInfoByBuildId: nil,
 	}
 
+	if partition.Kind() == enumspb.TASK_QUEUE_KIND_NORMAL {
+		// Counts chosen by partition autoscaling take precedence over the configured counts.
+		numReadPartitions, numWritePartitions := tqConfig.NumReadPartitions, tqConfig.NumWritePartitions
+		tqConfig.NumReadPartitions = func() int {
+			if counts := pm.AutoscaledPartitionCounts(); counts != nil {
+				return max(1, int(counts.GetReadPartitions()))
+			}
+			return numReadPartitions()
+		}
+		tqConfig.NumWritePartitions = func() int {
+			if counts := pm.AutoscaledPartitionCounts(); counts != nil {
+				return max(1, int(counts.GetWritePartitions()))
+			}
+			return numWritePartitions()
+		}
+		if partition.IsRoot() && partition.TaskType() == enumspb.TASK_QUEUE_TYPE_WORKFLOW {
+			pm.autoscaler = newPartitionAutoscaler(pm)
+		}
+	}
+
 	defaultQ, err := newPhysicalTaskQueueManager(pm, UnversionedQueueKey(partition))
 	if err != nil {
 		return nil, err
@@ -121,11 +142,17 @@ func (pm *taskQueuePartitionManagerImpl) Start() {
 	pm.engine.updateTaskQueuePartitionGauge(pm.Namespace(), pm.partition, 1)
 	pm.userDataManager.Start()
 	pm.defaultQueue.Start()
+	if pm.autoscaler != nil {
+		pm.autoscaler.Start()
+	}
 }
 
 // Stop does not unload the partition from matching engine. It is intended to be called by matching engine when
 // unloading the partition. For stopping and unloading a partition call unloadFromEngine instead.
 func (pm *taskQueuePartitionManagerImpl) Stop(unloadCause unloadCause) {
+	if pm.autoscaler != nil {
+		pm.autoscaler.Stop()
+	}
 	pm.versionedQueuesLock.Lock()
 	defer pm.versionedQueuesLock.Unlock()
 	for _, vq := range pm.versionedQueues {
@@ -783,6 +810,18 @@ func (pm *taskQueuePartitionManagerImpl) unloadFromEngine(unloadCause unloadCaus
 	pm.engine.unloadTaskQueuePartition(pm, unloadCause)
 }
 
+func (pm *taskQueuePartitionManagerImpl) MigrateBacklog(
+	ctx context.Context,
+	buildId string,
+	request *matchingservice.MigrateTaskQueueBacklogRequest,
+) (*matchingservice.MigrateTaskQueueBacklogResponse, error) {
+	pq, err := pm.getPhysicalQueue(ctx, buildId, nil)
+	if err != nil {
+		return nil, err
+	}
+	return pq.MigrateBacklog(ctx, request)
+}
+
 func (pm *taskQueuePartitionManagerImpl) getPhysicalQueue(ctx context.Context, buildId string, deployment *deploymentpb.Deployment) (physicalTaskQueueManager, error) {
 	if buildId == "" {
 		return pm.defaultQueue, nil
@@ -1148,6 +1187,17 @@ func (pm *taskQueuePartitionManagerImpl) getPerTypeUserData() (*persistencespb.T
 	return perType, userDataChanged, nil
 }
 
+func (pm *taskQueuePartitionManagerImpl) AutoscaledPartitionCounts() *persistencespb.TaskQueuePartitionCounts {
+	if pm.partition.Kind() != enumspb.TASK_QUEUE_KIND_NORMAL || !pm.config.EnablePartitionAutoscaling() {
+		return nil
+	}
+	perTypeUserData, _, err := pm.getPerTypeUserData()
+	if err != nil {
+		return nil
+	}
+	return perTypeUserData.GetPartitionCounts()
+}
+
 func (pm *taskQueuePartitionManagerImpl) userDataChanged() {
 	// Notify all queues so they can re-evaluate their backlog.
 	pm.versionedQueuesLock.RLock()
@@ -33,6 +33,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/tqid"
@@ -91,5 +92,11 @@ type (
 		UpdateTimeSinceLastFanOutAndCache(physicalInfoByBuildId map[string]map[enumspb.TaskQueueType]*taskqueuespb.PhysicalTaskQueueInfo)
 		// GetPhysicalTaskQueueInfoFromCache returns the cached physicalInfoByBuildId
 		GetPhysicalTaskQueueInfoFromCache() map[string]map[enumspb.TaskQueueType]*taskqueuespb.PhysicalTaskQueueInfo
+		// MigrateBacklog copies or moves one batch of backlog tasks of the queue associated with
+		// the given buildId (or the unversioned queue) to another task queue.
+		MigrateBacklog(ctx context.Context, buildId string, request *matchingservice.MigrateTaskQueueBacklogRequest) (*matchingservice.MigrateTaskQueueBacklogResponse, error)
+		// AutoscaledPartitionCounts returns the partition counts chosen by partition autoscaling, or nil
+		// if autoscaling is disabled or has not chosen counts for this task queue yet.
+		AutoscaledPartitionCounts() *persistencespb.TaskQueuePartitionCounts
 	}
 )
@@ -41,6 +41,7 @@ import (
 	enums "go.temporal.io/api/enums/v1"
 	taskqueue "go.temporal.io/api/taskqueue/v1"
 	matchingservice "go.temporal.io/server/api/matchingservice/v1"
+	persistence "go.temporal.io/server/api/persistence/v1"
 	taskqueue0 "go.temporal.io/server/api/taskqueue/v1"
 	namespace "go.temporal.io/server/common/namespace"
 	tqid "go.temporal.io/server/common/tqid"
@@ -101,6 +102,20 @@ func (mr *MocktaskQueuePartitionManagerMockRecorder) AddTask(ctx, params any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTask", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).AddTask), ctx, params)
 }
 
+// AutoscaledPartitionCounts mocks base method.
+func (m *MocktaskQueuePartitionManager) AutoscaledPartitionCounts() *persistence.TaskQueuePartitionCounts {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutoscaledPartitionCounts")
+	ret0, _ := ret[0].(*persistence.TaskQueuePartitionCounts)
+	return ret0
+}
+
+// AutoscaledPartitionCounts indicates an expected call of AutoscaledPartitionCounts.
+func (mr *MocktaskQueuePartitionManagerMockRecorder) AutoscaledPartitionCounts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutoscaledPartitionCounts", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).AutoscaledPartitionCounts))
+}
+
 // Describe mocks base method.
 func (m *MocktaskQueuePartitionManager) Describe(ctx context.Context, buildIds map[string]bool, includeAllActive, reportStats, reportPollers, internalTaskQueueStatus bool) (*matchingservice.DescribeTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -257,6 +272,21 @@ func (mr *MocktaskQueuePartitionManagerMockRecorder) MarkAlive() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAlive", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).MarkAlive))
 }
 
+// MigrateBacklog mocks base method.
+func (m *MocktaskQueuePartitionManager) MigrateBacklog(ctx context.Context, buildId string, request *matchingservice.MigrateTaskQueueBacklogRequest) (*matchingservice.MigrateTaskQueueBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateBacklog", ctx, buildId, request)
+	ret0, _ := ret[0].(*matchingservice.MigrateTaskQueueBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateBacklog indicates an expected call of MigrateBacklog.
+func (mr *MocktaskQueuePartitionManagerMockRecorder) MigrateBacklog(ctx, buildId, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateBacklog", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).MigrateBacklog), ctx, buildId, request)
+}
+
 // Namespace mocks base method.
 func (m *MocktaskQueuePartitionManager) Namespace() *namespace.Namespace {
 	m.ctrl.T.Helper()
@@ -38,14 +38,18 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/activity"
 	sdkclient "go.temporal.io/sdk/client"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/sdk"
+	"go.temporal.io/server/common/util"
 	"golang.org/x/time/rate"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
@@ -53,12 +57,22 @@ import (
 const (
 	pageSize                 = 1000
 	statusRunningQueryFilter = "ExecutionStatus='Running'"
+	// maxFailureErrorLength is the max length of the error recorded for a failure in the failure manifest
+	maxFailureErrorLength = 1000
+	// failureManifestListPageSize is the page size used to list the failure manifests of the namespace
+	failureManifestListPageSize = 100
 )
 
 var (
 	errNamespaceMismatch = errors.New("namespace mismatch")
 )
 
+type taskResult struct {
+	execution *commonpb.WorkflowExecution
+	attempts  int
+	err       error
+}
+
 type activities struct {
 	activityDeps
 	namespace   namespace.Name
@@ -113,6 +127,8 @@ func (a *activities) BatchActivity(ctx context.Context, batchParams BatchParams)
 	adjustedQuery := a.adjustQuery(batchParams)
 
 	if startOver {
+		a.deleteOrphanedFailureManifests(ctx, logger)
+
 		estimateCount := int64(len(batchParams.Executions))
 		if len(adjustedQuery) > 0 {
 			resp, err := sdkClient.CountWorkflow(ctx, &workflowservice.CountWorkflowExecutionsRequest{
@@ -132,9 +148,11 @@ func (a *activities) BatchActivity(ctx context.Context, batchParams BatchParams)
 	burstLimit := int(math.Ceil(rps)) // should never be zero because everything would be rejected
 	rateLimiter := rate.NewLimiter(rateLimit, burstLimit)
 	taskCh := make(chan taskDetail, pageSize)
-	respCh := make(chan error, pageSize)
-	for i := 0; i < a.getOperationConcurrency(batchParams.Concurrency); i++ {
-		go startTaskProcessor(ctx, batchParams, taskCh, respCh, rateLimiter, sdkClient, a.FrontendClient, metricsHandler, logger)
+	respCh := make(chan taskResult, pageSize)
+	if !batchParams.DryRun {
+		for i := 0; i < a.getOperationConcurrency(batchParams.Concurrency); i++ {
+			go startTaskProcessor(ctx, batchParams, a.namespaceID, taskCh, respCh, rateLimiter, sdkClient, a.FrontendClient, a.HistoryClient, metricsHandler, logger)
+		}
 	}
 
 	for {
@@ -161,34 +179,52 @@ func (a *activities) BatchActivity(ctx context.Context, batchParams BatchParams)
 		if batchCount <= 0 {
 			break
 		}
-		// send all tasks
-		for _, wf := range executions {
-			taskCh <- taskDetail{
-				execution: wf,
-				attempts:  1,
-				hbd:       hbd,
-			}
-		}
 
 		succCount := 0
 		errCount := 0
-		// wait for counters indicate this batch is done
-	Loop:
-		for {
-			select {
-			case err := <-respCh:
-				if err == nil {
-					succCount++
-				} else {
-					errCount++
+		var failures []*persistencespb.BatchFailure
+		if !batchParams.DryRun {
+			// send all tasks
+			for _, wf := range executions {
+				taskCh <- taskDetail{
+					execution: wf,
+					attempts:  1,
+					hbd:       hbd,
 				}
-				if succCount+errCount == batchCount {
-					break Loop
+			}
+
+			// wait for counters indicate this batch is done
+		Loop:
+			for {
+				select {
+				case result := <-respCh:
+					if result.err == nil {
+						succCount++
+					} else {
+						errCount++
+						failures = append(failures, &persistencespb.BatchFailure{
+							Execution: result.execution,
+							Error:     util.TruncateUTF8(result.err.Error(), maxFailureErrorLength),
+							Attempts:  int32(result.attempts),
+						})
+					}
+					if succCount+errCount == batchCount {
+						break Loop
+					}
+				case <-ctx.Done():
+					metrics.BatcherOperationFailures.With(metricsHandler).Record(1)
+					logger.Error("Failed to complete batch operation", tag.Error(ctx.Err()))
+					return HeartBeatDetails{}, ctx.Err()
 				}
-			case <-ctx.Done():
+			}
+		}
+
+		if len(failures) > 0 {
+			// Failures are recorded before the heartbeat, so that a retried page replaces them instead of losing them.
+			if err := a.recordFailures(ctx, &hbd, failures); err != nil {
 				metrics.BatcherOperationFailures.With(metricsHandler).Record(1)
-				logger.Error("Failed to complete batch operation", tag.Error(ctx.Err()))
-				return HeartBeatDetails{}, ctx.Err()
+				logger.Error("Failed to record batch operation failures", tag.Error(err))
+				return HeartBeatDetails{}, err
 			}
 		}
 
@@ -196,6 +232,7 @@ func (a *activities) BatchActivity(ctx context.Context, batchParams BatchParams)
 		hbd.PageToken = pageToken
 		hbd.SuccessCount += succCount
 		hbd.ErrorCount += errCount
+		hbd.ResolvedCount += batchCount
 		activity.RecordHeartbeat(ctx, hbd)
 
 		if len(hbd.PageToken) == 0 {
@@ -206,6 +243,103 @@ func (a *activities) BatchActivity(ctx context.Context, batchParams BatchParams)
 	return hbd, nil
 }
 
+// recordFailures adds the failures of the current page to the failure manifest of the batch job.
+// The manifest is kept outside of the history of the batch workflow, which only keeps counts.
+func (a *activities) recordFailures(
+	ctx context.Context,
+	hbd *HeartBeatDetails,
+	failures []*persistencespb.BatchFailure,
+) error {
+	room := maxFailureManifestSize - hbd.FailureManifestSize
+	if room <= 0 && hbd.FailureManifestTruncated {
+		return nil
+	}
+	wfInfo := activity.GetInfo(ctx)
+	entry := &persistencespb.BatchFailureManifestEntry{
+		JobId:    wfInfo.WorkflowExecution.ID,
+		Page:     int64(hbd.CurrentPage),
+		Failures: failures,
+	}
+	if len(failures) > room {
+		entry.Failures = failures[:max(room, 0)]
+		entry.Truncated = true
+	}
+
+	_, err := a.FailureManifestManager.AppendBatchFailures(ctx, &persistence.AppendBatchFailuresRequest{
+		NamespaceID: a.namespaceID.String(),
+		JobRunID:    wfInfo.WorkflowExecution.RunID,
+		Entry:       entry,
+	})
+	if err != nil {
+		return err
+	}
+	hbd.FailureManifestSize += len(entry.Failures)
+	hbd.FailureManifestTruncated = hbd.FailureManifestTruncated || entry.Truncated
+	return nil
+}
+
+// deleteOrphanedFailureManifests deletes the failure manifests of the batch jobs of the namespace
+// whose workflow no longer exists, e.g. because the namespace retention expired. It's best effort:
+// errors are logged and the manifests are checked again when the next batch job starts.
+func (a *activities) deleteOrphanedFailureManifests(ctx context.Context, logger log.Logger) {
+	currentRunID := activity.GetInfo(ctx).WorkflowExecution.RunID
+	var nextPageToken []byte
+	for {
+		response, err := a.FailureManifestManager.ListBatchFailureManifests(ctx, &persistence.ListBatchFailureManifestsRequest{
+			NamespaceID:   a.namespaceID.String(),
+			PageSize:      failureManifestListPageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			logger.Warn("Failed to list batch failure manifests", tag.Error(err))
+			return
+		}
+		for _, jobRunID := range response.JobRunIDs {
+			if jobRunID == currentRunID {
+				continue
+			}
+			if err := a.deleteFailureManifestIfOrphaned(ctx, jobRunID); err != nil {
+				logger.Warn("Failed to delete batch failure manifest", tag.WorkflowRunID(jobRunID), tag.Error(err))
+			}
+		}
+		nextPageToken = response.NextPageToken
+		if len(nextPageToken) == 0 {
+			return
+		}
+	}
+}
+
+func (a *activities) deleteFailureManifestIfOrphaned(ctx context.Context, jobRunID string) error {
+	entries, err := a.FailureManifestManager.ReadBatchFailures(ctx, &persistence.ReadBatchFailuresRequest{
+		NamespaceID: a.namespaceID.String(),
+		JobRunID:    jobRunID,
+		PageSize:    1,
+	})
+	if err != nil {
+		return err
+	}
+	if len(entries.Entries) == 0 {
+		// already deleted
+		return nil
+	}
+	_, err = a.FrontendClient.DescribeWorkflowExecution(ctx, &workflowservice.DescribeWorkflowExecutionRequest{
+		Namespace: a.namespace.String(),
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: entries.Entries[0].GetJobId(),
+			RunId:      jobRunID,
+		},
+	})
+	var notFound *serviceerror.NotFound
+	if !errors.As(err, &notFound) {
+		return err
+	}
+	_, err = a.FailureManifestManager.DeleteBatchFailures(ctx, &persistence.DeleteBatchFailuresRequest{
+		NamespaceID: a.namespaceID.String(),
+		JobRunID:    jobRunID,
+	})
+	return err
+}
+
 func (a *activities) getActivityLogger(ctx context.Context) log.Logger {
 	wfInfo := activity.GetInfo(ctx)
 	return log.With(
@@ -248,11 +382,13 @@ func (a *activities) getOperationConcurrency(concurrency int) int {
 func startTaskProcessor(
 	ctx context.Context,
 	batchParams BatchParams,
+	namespaceID namespace.ID,
 	taskCh chan taskDetail,
-	respCh chan error,
+	respCh chan taskResult,
 	limiter *rate.Limiter,
 	sdkClient sdkclient.Client,
 	frontendClient workflowservice.WorkflowServiceClient,
+	historyClient historyservice.HistoryServiceClient,
 	metricsHandler metrics.Handler,
 	logger log.Logger,
 ) {
@@ -379,6 +515,45 @@ func startTaskProcessor(
 						})
 						return err
 					})
+			case BatchTypeUpsertProperties:
+				err = processTask(ctx, limiter, task,
+					func(workflowID, runID string) error {
+						_, err := historyClient.UpsertWorkflowExecutionProperties(ctx, &historyservice.UpsertWorkflowExecutionPropertiesRequest{
+							NamespaceId: namespaceID.String(),
+							Execution: &commonpb.WorkflowExecution{
+								WorkflowId: workflowID,
+								RunId:      runID,
+							},
+							SearchAttributes: batchParams.UpsertPropertiesParams.SearchAttributes,
+							Memo:             batchParams.UpsertPropertiesParams.Memo,
+						})
+						return err
+					})
+			case BatchTypeSignalWithStart:
+				err = processTask(ctx, limiter, task,
+					func(workflowID, _ string) error {
+						// Run ID is ignored, signal with start always targets the current run of the workflow ID.
+						params := batchParams.SignalWithStartParams
+						_, err := frontendClient.SignalWithStartWorkflowExecution(ctx, &workflowservice.SignalWithStartWorkflowExecutionRequest{
+							Namespace:                batchParams.Namespace,
+							WorkflowId:               workflowID,
+							WorkflowType:             params.WorkflowType,
+							TaskQueue:                params.TaskQueue,
+							Input:                    params.Input,
+							WorkflowExecutionTimeout: durationpb.New(params.WorkflowExecutionTimeout),
+							WorkflowRunTimeout:       durationpb.New(params.WorkflowRunTimeout),
+							WorkflowTaskTimeout:      durationpb.New(params.WorkflowTaskTimeout),
+							Identity:                 "batch signal with start",
+							RequestId:                uuid.New(),
+							WorkflowIdReusePolicy:    params.WorkflowIDReusePolicy,
+							SignalName:               params.SignalName,
+							SignalInput:              params.SignalInput,
+							Memo:                     params.Memo,
+							SearchAttributes:         params.SearchAttributes,
+							Header:                   params.Header,
+						})
+						return err
+					})
 			}
 			if err != nil {
 				metrics.BatcherProcessorFailures.With(metricsHandler).Record(1)
@@ -386,7 +561,11 @@ func startTaskProcessor(
 
 				_, ok := batchParams._nonRetryableErrors[err.Error()]
 				if ok || task.attempts > batchParams.AttemptsOnRetryableError {
-					respCh <- err
+					respCh <- taskResult{
+						execution: task.execution,
+						attempts:  task.attempts,
+						err:       err,
+					}
 				} else {
 					// put back to the channel if less than attemptsOnError
 					task.attempts++
@@ -394,7 +573,10 @@ func startTaskProcessor(
 				}
 			} else {
 				metrics.BatcherProcessorSuccess.With(metricsHandler).Record(1)
-				respCh <- nil
+				respCh <- taskResult{
+					execution: task.execution,
+					attempts:  task.attempts,
+				}
 			}
 		}
 	}
@@ -36,12 +36,22 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/testing/mockapi/workflowservicemock/v1"
+	"go.temporal.io/server/common/testing/mocksdk"
 	"go.uber.org/mock/gomock"
 )
 
@@ -51,13 +61,37 @@ type activitiesSuite struct {
 
 	controller *gomock.Controller
 
-	mockFrontendClient *workflowservicemock.MockWorkflowServiceClient
+	mockFrontendClient         *workflowservicemock.MockWorkflowServiceClient
+	mockHistoryClient          *historyservicemock.MockHistoryServiceClient
+	mockClientFactory          *sdk.MockClientFactory
+	mockFailureManifestManager *persistence.MockBatchFailureManifestManager
 }
 
 func (s *activitiesSuite) SetupTest() {
 	s.controller = gomock.NewController(s.T())
 
 	s.mockFrontendClient = workflowservicemock.NewMockWorkflowServiceClient(s.controller)
+	s.mockHistoryClient = historyservicemock.NewMockHistoryServiceClient(s.controller)
+	s.mockClientFactory = sdk.NewMockClientFactory(s.controller)
+	s.mockFailureManifestManager = persistence.NewMockBatchFailureManifestManager(s.controller)
+}
+
+func (s *activitiesSuite) newActivities() *activities {
+	return &activities{
+		activityDeps: activityDeps{
+			MetricsHandler: metrics.NoopMetricsHandler,
+			Logger:         log.NewTestLogger(),
+			ClientFactory:  s.mockClientFactory,
+			FrontendClient: s.mockFrontendClient,
+			HistoryClient:  s.mockHistoryClient,
+
+			FailureManifestManager: s.mockFailureManifestManager,
+		},
+		namespace:   "test-namespace",
+		namespaceID: "test-namespace-id",
+		rps:         func(string) int { return 100 },
+		concurrency: func(string) int { return 2 },
+	}
 }
 
 func TestActivitiesSuite(t *testing.T) {
@@ -356,6 +390,18 @@ func (s *activitiesSuite) TestAdjustQuery() {
 			expectedResult: fmt.Sprintf("(A=B OR ExecutionStatus='Completed') AND (%s)", statusRunningQueryFilter),
 			batchType:      BatchTypeTerminate,
 		},
+		{
+			name:           "Upsert properties includes closed workflows",
+			query:          "A=B",
+			expectedResult: "A=B",
+			batchType:      BatchTypeUpsertProperties,
+		},
+		{
+			name:           "Signal with start includes closed workflows",
+			query:          "A=B",
+			expectedResult: "A=B",
+			batchType:      BatchTypeSignalWithStart,
+		},
 		{
 			name:           "Not supported batch type",
 			query:          "A=B",
@@ -372,3 +418,256 @@ func (s *activitiesSuite) TestAdjustQuery() {
 		})
 	}
 }
+
+func (s *activitiesSuite) TestBatchActivity_UpsertProperties() {
+	env := s.NewTestActivityEnvironment()
+	a := s.newActivities()
+	env.RegisterActivity(a)
+	s.expectNoFailureManifests()
+
+	searchAttributes := &commonpb.SearchAttributes{
+		IndexedFields: map[string]*commonpb.Payload{"CustomKeywordField": payload.EncodeString("fixed")},
+	}
+	memo := &commonpb.Memo{
+		Fields: map[string]*commonpb.Payload{"owner": payload.EncodeString("team-a")},
+	}
+	executions := []*commonpb.WorkflowExecution{
+		{WorkflowId: "wf-1", RunId: "run-1"},
+		{WorkflowId: "wf-2", RunId: "run-2"},
+	}
+
+	s.mockClientFactory.EXPECT().NewClient(gomock.Any()).Return(nil)
+	var upserted []string
+	s.mockHistoryClient.EXPECT().UpsertWorkflowExecutionProperties(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.UpsertWorkflowExecutionPropertiesRequest, _ ...any) (*historyservice.UpsertWorkflowExecutionPropertiesResponse, error) {
+			s.Equal("test-namespace-id", request.GetNamespaceId())
+			s.Equal(searchAttributes.GetIndexedFields(), request.GetSearchAttributes().GetIndexedFields())
+			s.Equal(memo.GetFields(), request.GetMemo().GetFields())
+			upserted = append(upserted, request.GetExecution().GetWorkflowId()+"/"+request.GetExecution().GetRunId())
+			return &historyservice.UpsertWorkflowExecutionPropertiesResponse{}, nil
+		}).Times(2)
+
+	result, err := env.ExecuteActivity(a.BatchActivity, BatchParams{
+		Namespace:  "test-namespace",
+		Executions: executions,
+		Reason:     "backfill",
+		BatchType:  BatchTypeUpsertProperties,
+		UpsertPropertiesParams: UpsertPropertiesParams{
+			SearchAttributes: searchAttributes,
+			Memo:             memo,
+		},
+	})
+	s.Require().NoError(err)
+	var hbd HeartBeatDetails
+	s.Require().NoError(result.Get(&hbd))
+	s.Equal(2, hbd.SuccessCount)
+	s.Equal(0, hbd.ErrorCount)
+	slices.Sort(upserted)
+	s.Equal([]string{"wf-1/run-1", "wf-2/run-2"}, upserted)
+}
+
+func (s *activitiesSuite) TestBatchActivity_SignalWithStart() {
+	env := s.NewTestActivityEnvironment()
+	a := s.newActivities()
+	env.RegisterActivity(a)
+	s.expectNoFailureManifests()
+
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockClientFactory.EXPECT().NewClient(gomock.Any()).Return(mockSdkClient)
+	s.mockFrontendClient.EXPECT().SignalWithStartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *workflowservice.SignalWithStartWorkflowExecutionRequest, _ ...any) (*workflowservice.SignalWithStartWorkflowExecutionResponse, error) {
+			s.Equal("test-namespace", request.GetNamespace())
+			s.Equal("wf-1", request.GetWorkflowId())
+			s.Equal("test-workflow-type", request.GetWorkflowType().GetName())
+			s.Equal("test-task-queue", request.GetTaskQueue().GetName())
+			s.Equal("test-signal", request.GetSignalName())
+			s.Equal(time.Hour, request.GetWorkflowRunTimeout().AsDuration())
+			s.Equal(enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE, request.GetWorkflowIdReusePolicy())
+			s.NotEmpty(request.GetRequestId())
+			return &workflowservice.SignalWithStartWorkflowExecutionResponse{}, nil
+		})
+	// AttemptsOnRetryableError is not set, so the error is counted as failure without retries.
+	s.mockFrontendClient.EXPECT().SignalWithStartWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("bad input"))
+	s.mockFailureManifestManager.EXPECT().AppendBatchFailures(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.AppendBatchFailuresRequest) (*persistence.AppendBatchFailuresResponse, error) {
+			s.Equal("test-namespace-id", request.NamespaceID)
+			s.Equal(int64(0), request.Entry.GetPage())
+			s.Len(request.Entry.GetFailures(), 1)
+			s.Equal("wf-1", request.Entry.GetFailures()[0].GetExecution().GetWorkflowId())
+			s.Equal("bad input", request.Entry.GetFailures()[0].GetError())
+			s.Equal(int32(1), request.Entry.GetFailures()[0].GetAttempts())
+			return &persistence.AppendBatchFailuresResponse{}, nil
+		})
+
+	result, err := env.ExecuteActivity(a.BatchActivity, BatchParams{
+		Namespace: "test-namespace",
+		Executions: []*commonpb.WorkflowExecution{
+			{WorkflowId: "wf-1"},
+			{WorkflowId: "wf-1"},
+		},
+		Reason:      "signal",
+		BatchType:   BatchTypeSignalWithStart,
+		Concurrency: 1,
+		SignalWithStartParams: SignalWithStartParams{
+			WorkflowType:          &commonpb.WorkflowType{Name: "test-workflow-type"},
+			TaskQueue:             &taskqueuepb.TaskQueue{Name: "test-task-queue"},
+			WorkflowRunTimeout:    time.Hour,
+			WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+			SignalName:            "test-signal",
+		},
+	})
+	s.Require().NoError(err)
+	var hbd HeartBeatDetails
+	s.Require().NoError(result.Get(&hbd))
+	s.Equal(1, hbd.SuccessCount)
+	s.Equal(1, hbd.ErrorCount)
+	s.Equal(1, hbd.FailureManifestSize)
+}
+
+func (s *activitiesSuite) TestBatchActivity_DryRun() {
+	env := s.NewTestActivityEnvironment()
+	a := s.newActivities()
+	env.RegisterActivity(a)
+	s.expectNoFailureManifests()
+
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockClientFactory.EXPECT().NewClient(gomock.Any()).Return(mockSdkClient)
+	mockSdkClient.EXPECT().CountWorkflow(gomock.Any(), gomock.Any()).Return(&workflowservice.CountWorkflowExecutionsResponse{Count: 3}, nil)
+	mockSdkClient.EXPECT().ListWorkflow(gomock.Any(), gomock.Any()).Return(&workflowservice.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			{Execution: &commonpb.WorkflowExecution{WorkflowId: "wf-1", RunId: "run-1"}},
+			{Execution: &commonpb.WorkflowExecution{WorkflowId: "wf-2", RunId: "run-2"}},
+		},
+		NextPageToken: []byte("next"),
+	}, nil)
+	mockSdkClient.EXPECT().ListWorkflow(gomock.Any(), gomock.Any()).Return(&workflowservice.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			{Execution: &commonpb.WorkflowExecution{WorkflowId: "wf-3", RunId: "run-3"}},
+		},
+	}, nil)
+	// No upsert is expected, dry run only resolves the target workflows.
+
+	result, err := env.ExecuteActivity(a.BatchActivity, BatchParams{
+		Namespace: "test-namespace",
+		Query:     "WorkflowType = 'wf'",
+		Reason:    "backfill",
+		BatchType: BatchTypeUpsertProperties,
+		UpsertPropertiesParams: UpsertPropertiesParams{
+			Memo: &commonpb.Memo{Fields: map[string]*commonpb.Payload{"owner": payload.EncodeString("team-a")}},
+		},
+		DryRun: true,
+	})
+	s.Require().NoError(err)
+	var hbd HeartBeatDetails
+	s.Require().NoError(result.Get(&hbd))
+	s.Equal(int64(3), hbd.TotalEstimate)
+	s.Equal(3, hbd.ResolvedCount)
+	s.Equal(2, hbd.CurrentPage)
+	s.Equal(0, hbd.SuccessCount)
+	s.Equal(0, hbd.ErrorCount)
+}
+
+func (s *activitiesSuite) TestRecordFailures() {
+	env := s.NewTestActivityEnvironment()
+	recordFailures := func(ctx context.Context, hbd HeartBeatDetails, count int) (HeartBeatDetails, error) {
+		failures := make([]*persistencespb.BatchFailure, count)
+		for i := range failures {
+			failures[i] = &persistencespb.BatchFailure{
+				Execution: &commonpb.WorkflowExecution{WorkflowId: fmt.Sprintf("wf-%d", i)},
+				Error:     "error",
+				Attempts:  1,
+			}
+		}
+		err := s.newActivities().recordFailures(ctx, &hbd, failures)
+		return hbd, err
+	}
+	env.RegisterActivity(recordFailures)
+
+	var entries []*persistencespb.BatchFailureManifestEntry
+	s.mockFailureManifestManager.EXPECT().AppendBatchFailures(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.AppendBatchFailuresRequest) (*persistence.AppendBatchFailuresResponse, error) {
+			entries = append(entries, request.Entry)
+			return &persistence.AppendBatchFailuresResponse{}, nil
+		}).Times(2)
+
+	// Manifest is filled up and truncated.
+	result, err := env.ExecuteActivity(recordFailures, HeartBeatDetails{CurrentPage: 5, FailureManifestSize: maxFailureManifestSize - 1}, 3)
+	s.Require().NoError(err)
+	var hbd HeartBeatDetails
+	s.Require().NoError(result.Get(&hbd))
+	s.Equal(maxFailureManifestSize, hbd.FailureManifestSize)
+	s.True(hbd.FailureManifestTruncated)
+
+	// Truncation is reported once when the manifest is already full.
+	result, err = env.ExecuteActivity(recordFailures, HeartBeatDetails{CurrentPage: 6, FailureManifestSize: maxFailureManifestSize}, 1)
+	s.Require().NoError(err)
+	s.Require().NoError(result.Get(&hbd))
+	s.True(hbd.FailureManifestTruncated)
+	_, err = env.ExecuteActivity(recordFailures, hbd, 1)
+	s.Require().NoError(err)
+
+	s.Len(entries, 2)
+	s.Equal(int64(5), entries[0].GetPage())
+	s.Len(entries[0].GetFailures(), 1)
+	s.True(entries[0].GetTruncated())
+	s.Equal(int64(6), entries[1].GetPage())
+	s.Empty(entries[1].GetFailures())
+	s.True(entries[1].GetTruncated())
+}
+
+func (s *activitiesSuite) TestDeleteOrphanedFailureManifests() {
+	env := s.NewTestActivityEnvironment()
+	a := s.newActivities()
+	deleteOrphanedFailureManifests := func(ctx context.Context) error {
+		a.deleteOrphanedFailureManifests(ctx, log.NewTestLogger())
+		return nil
+	}
+	env.RegisterActivity(deleteOrphanedFailureManifests)
+
+	s.mockFailureManifestManager.EXPECT().ListBatchFailureManifests(gomock.Any(), gomock.Any()).Return(&persistence.ListBatchFailureManifestsResponse{
+		JobRunIDs:     []string{"run-running"},
+		NextPageToken: []byte("next"),
+	}, nil)
+	s.mockFailureManifestManager.EXPECT().ListBatchFailureManifests(gomock.Any(), &persistence.ListBatchFailureManifestsRequest{
+		NamespaceID:   "test-namespace-id",
+		PageSize:      failureManifestListPageSize,
+		NextPageToken: []byte("next"),
+	}).Return(&persistence.ListBatchFailureManifestsResponse{
+		JobRunIDs: []string{"run-deleted", "run-empty"},
+	}, nil)
+	readEntry := func(jobID string) *persistence.ReadBatchFailuresResponse {
+		return &persistence.ReadBatchFailuresResponse{
+			Entries: []*persistencespb.BatchFailureManifestEntry{{JobId: jobID}},
+		}
+	}
+	s.mockFailureManifestManager.EXPECT().ReadBatchFailures(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.ReadBatchFailuresRequest) (*persistence.ReadBatchFailuresResponse, error) {
+			switch request.JobRunID {
+			case "run-running":
+				return readEntry("job-running"), nil
+			case "run-deleted":
+				return readEntry("job-deleted"), nil
+			default:
+				return &persistence.ReadBatchFailuresResponse{}, nil
+			}
+		}).Times(3)
+	s.mockFrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *workflowservice.DescribeWorkflowExecutionRequest, _ ...any) (*workflowservice.DescribeWorkflowExecutionResponse, error) {
+			if request.GetExecution().GetWorkflowId() == "job-running" {
+				return &workflowservice.DescribeWorkflowExecutionResponse{}, nil
+			}
+			return nil, serviceerror.NewNotFound("workflow not found")
+		}).Times(2)
+	// Only the manifest of the job whose workflow is gone is deleted.
+	s.mockFailureManifestManager.EXPECT().DeleteBatchFailures(gomock.Any(), &persistence.DeleteBatchFailuresRequest{
+		NamespaceID: "test-namespace-id",
+		JobRunID:    "run-deleted",
+	}).Return(&persistence.DeleteBatchFailuresResponse{EntriesDeleted: 1}, nil)
+
+	_, err := env.ExecuteActivity(deleteOrphanedFailureManifests)
+	s.Require().NoError(err)
+}
+
+func (s *activitiesSuite) expectNoFailureManifests() {
+	s.mockFailureManifestManager.EXPECT().ListBatchFailureManifests(gomock.Any(), gomock.Any()).Return(&persistence.ListBatchFailureManifestsResponse{}, nil)
+}
@@ -32,6 +32,8 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/sdk"
 	workercommon "go.temporal.io/server/service/worker/common"
 	"go.uber.org/fx"
@@ -56,6 +58,9 @@ type (
 		Logger         log.Logger
 		ClientFactory  sdk.ClientFactory
 		FrontendClient workflowservice.WorkflowServiceClient
+		HistoryClient  resource.HistoryClient
+		// FailureManifestManager stores the failure manifests of batch jobs
+		FailureManifestManager persistence.BatchFailureManifestManager
 	}
 
 	fxResult struct {
@@ -25,11 +25,13 @@
 package batcher
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
@@ -43,6 +45,9 @@ const (
 	infiniteDuration                = 20 * 365 * 24 * time.Hour
 	defaultAttemptsOnRetryableError = 50
 	defaultActivityHeartBeatTimeout = time.Second * 10
+	// maxFailureManifestSize is the max number of failures recorded in the failure manifest,
+	// failures beyond it are only counted.
+	maxFailureManifestSize = 10000
 )
 
 const (
@@ -52,6 +57,8 @@ const (
 	BatchReasonMemo = "batch_operation_reason"
 	// BatchOperationStatsMemo stores batch operation stats in memo
 	BatchOperationStatsMemo = "batch_operation_stats"
+	// BatchOperationDryRunMemo stores whether the batch operation is a dry run in memo
+	BatchOperationDryRunMemo = "batch_operation_dry_run"
 	// BatchTypeTerminate is batch type for terminating workflows
 	BatchTypeTerminate = "terminate"
 	// BatchTypeCancel is the batch type for canceling workflows
@@ -66,6 +73,10 @@ const (
 	BatchTypeUpdateOptions = "update_options"
 	// BatchTypePauseActivities is batch type for unpausing activities
 	BatchTypeUnpauseActivities = "unpause_activities"
+	// BatchTypeUpsertProperties is batch type for upserting search attributes and memo of workflows
+	BatchTypeUpsertProperties = "upsert_properties"
+	// BatchTypeSignalWithStart is batch type for signaling workflows and starting them if not running
+	BatchTypeSignalWithStart = "signal_with_start"
 )
 
 var (
@@ -122,6 +133,29 @@ type (
 		Jitter         time.Duration
 	}
 
+	// UpsertPropertiesParams is the parameters for upserting search attributes and memo of workflows
+	UpsertPropertiesParams struct {
+		// Search attribute field names must not be aliased.
+		SearchAttributes *commonpb.SearchAttributes
+		Memo             *commonpb.Memo
+	}
+
+	// SignalWithStartParams is the parameters for signal with start of workflows
+	SignalWithStartParams struct {
+		WorkflowType             *commonpb.WorkflowType
+		TaskQueue                *taskqueuepb.TaskQueue
+		Input                    *commonpb.Payloads
+		WorkflowExecutionTimeout time.Duration
+		WorkflowRunTimeout       time.Duration
+		WorkflowTaskTimeout      time.Duration
+		WorkflowIDReusePolicy    enumspb.WorkflowIdReusePolicy
+		SignalName               string
+		SignalInput              *commonpb.Payloads
+		Memo                     *commonpb.Memo
+		SearchAttributes         *commonpb.SearchAttributes
+		Header                   *commonpb.Header
+	}
+
 	// BatchParams is the parameters for batch operation workflow
 	BatchParams struct {
 		// Target namespace to execute batch operation
@@ -132,7 +166,7 @@ type (
 		Executions []*commonpb.WorkflowExecution
 		// Reason for the operation
 		Reason string
-		// Supporting: signal,cancel,terminate,delete,reset
+		// Supporting: signal,cancel,terminate,delete,reset,update_options,unpause_activities,upsert_properties,signal_with_start
 		BatchType string
 
 		// Below are all optional
@@ -150,7 +184,13 @@ type (
 		UpdateOptionsParams UpdateOptionsParams
 		// UnpauseActivitiesParams is params only for BatchTypeUnpauseActivities
 		UnpauseActivitiesParams UnpauseActivitiesParams
+		// UpsertPropertiesParams is params only for BatchTypeUpsertProperties
+		UpsertPropertiesParams UpsertPropertiesParams
+		// SignalWithStartParams is params only for BatchTypeSignalWithStart
+		SignalWithStartParams SignalWithStartParams
 
+		// DryRun only resolves and counts the target workflows without processing them
+		DryRun bool
 		// RPS sets the requests-per-second limit for the batch.
 		// The default (and max) is defined by `worker.BatcherRPS` in the dynamic config.
 		RPS float64
@@ -178,6 +218,12 @@ type (
 		SuccessCount int
 		// Number of workflows that give up due to errors.
 		ErrorCount int
+		// Number of workflows resolved from the query or executions
+		ResolvedCount int
+		// Number of failures recorded in the failure manifest
+		FailureManifestSize int
+		// Whether some failures were not recorded because the failure manifest is full
+		FailureManifestTruncated bool
 	}
 
 	taskDetail struct {
@@ -216,9 +262,16 @@ func BatchWorkflow(ctx workflow.Context, batchParams BatchParams) (HeartBeatDeta
 	var ac *activities
 	err = workflow.ExecuteActivity(opt, ac.BatchActivity, batchParams).Get(ctx, &result)
 	if err != nil {
+		// Keep the progress of a timed out activity, so the report of the failed job still has counts.
+		var timeoutErr *temporal.TimeoutError
+		if errors.As(err, &timeoutErr) && timeoutErr.HasLastHeartbeatDetails() {
+			var hbd HeartBeatDetails
+			if timeoutErr.LastHeartbeatDetails(&hbd) == nil {
+				_ = attachBatchOperationStats(ctx, hbd)
+			}
+		}
 		return HeartBeatDetails{}, err
 	}
-
 	err = attachBatchOperationStats(ctx, result)
 	if err != nil {
 		return HeartBeatDetails{}, err
@@ -227,8 +280,11 @@ func BatchWorkflow(ctx workflow.Context, batchParams BatchParams) (HeartBeatDeta
 }
 
 type BatchOperationStats struct {
-	NumSuccess int
-	NumFailure int
+	NumSuccess  int
+	NumFailure  int
+	NumResolved int
+	// Whether some failures were not recorded because the failure manifest is full
+	FailureManifestTruncated bool
 }
 
 // attachBatchOperationStats attaches statistics on the number of
@@ -236,8 +292,10 @@ type BatchOperationStats struct {
 func attachBatchOperationStats(ctx workflow.Context, result HeartBeatDetails) error {
 	memo := map[string]interface{}{
 		BatchOperationStatsMemo: BatchOperationStats{
-			NumSuccess: result.SuccessCount,
-			NumFailure: result.ErrorCount,
+			NumSuccess:               result.SuccessCount,
+			NumFailure:               result.ErrorCount,
+			NumResolved:              result.ResolvedCount,
+			FailureManifestTruncated: result.FailureManifestTruncated,
 		},
 	}
 	return workflow.UpsertMemo(ctx, memo)
@@ -276,6 +334,23 @@ func validateParams(params BatchParams) error {
 			return fmt.Errorf("must provide ActivityType or MatchAll")
 		}
 		return nil
+	case BatchTypeUpsertProperties:
+		if len(params.UpsertPropertiesParams.SearchAttributes.GetIndexedFields()) == 0 &&
+			len(params.UpsertPropertiesParams.Memo.GetFields()) == 0 {
+			return fmt.Errorf("must provide SearchAttributes or Memo")
+		}
+		return nil
+	case BatchTypeSignalWithStart:
+		if params.SignalWithStartParams.SignalName == "" {
+			return fmt.Errorf("must provide signal name")
+		}
+		if params.SignalWithStartParams.WorkflowType.GetName() == "" {
+			return fmt.Errorf("must provide workflow type")
+		}
+		if params.SignalWithStartParams.TaskQueue.GetName() == "" {
+			return fmt.Errorf("must provide task queue")
+		}
+		return nil
 	default:
 		return fmt.Errorf("not supported batch type: %v", params.BatchType)
 	}
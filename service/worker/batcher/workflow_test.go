@@ -31,6 +31,8 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/testsuite"
 	"go.uber.org/mock/gomock"
 )
@@ -90,6 +92,40 @@ func (s *batcherSuite) TestBatchWorkflow_ValidParams_Query() {
 	s.Require().NoError(err)
 }
 
+func (s *batcherSuite) TestBatchWorkflow_ActivityTimeout() {
+	var ac *activities
+	s.env.OnActivity(ac.BatchActivity, mock.Anything, mock.Anything).Return(
+		HeartBeatDetails{},
+		temporal.NewTimeoutError(enumspb.TIMEOUT_TYPE_SCHEDULE_TO_START, nil, HeartBeatDetails{
+			ResolvedCount:            10,
+			SuccessCount:             8,
+			ErrorCount:               2,
+			FailureManifestTruncated: true,
+		}),
+	)
+	s.env.OnUpsertMemo(mock.Anything).Run(func(args mock.Arguments) {
+		memo, ok := args.Get(0).(map[string]interface{})
+		s.Require().True(ok)
+		s.Equal(map[string]interface{}{
+			"batch_operation_stats": BatchOperationStats{
+				NumSuccess:               8,
+				NumFailure:               2,
+				NumResolved:              10,
+				FailureManifestTruncated: true,
+			},
+		}, memo)
+	}).Once()
+	s.env.ExecuteWorkflow(BatchWorkflow, BatchParams{
+		BatchType: BatchTypeTerminate,
+		Reason:    "test-reason",
+		Namespace: "test-namespace",
+		Query:     "test-query",
+	})
+	err := s.env.GetWorkflowError()
+	var timeoutErr *temporal.TimeoutError
+	s.ErrorAs(err, &timeoutErr)
+}
+
 func (s *batcherSuite) TestBatchWorkflow_ValidParams_Executions() {
 	var ac *activities
 	s.env.OnActivity(ac.BatchActivity, mock.Anything, mock.Anything).Return(HeartBeatDetails{
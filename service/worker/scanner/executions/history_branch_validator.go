@@ -0,0 +1,155 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executions
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/versionhistory"
+)
+
+const (
+	HistoryBranchMissingEventsFailureType = "history_branch_validator_missing_events"
+	HistoryBranchNextEventIDFailureType   = "history_branch_validator_next_event_id"
+
+	historyBranchPageSize = 100
+)
+
+type (
+	// historyBranchValidator is a validator that reads the whole current history branch and checks that
+	// * all events up to the last event of the current version history exist and are contiguous
+	// * next event ID of mutable state is the event ID following the last event
+	// Unlike historyEventIDValidator it reads the full history, so it's meant for offline checks.
+	historyBranchValidator struct {
+		shardID          int32
+		executionManager persistence.ExecutionManager
+	}
+)
+
+var _ Validator = (*historyBranchValidator)(nil)
+
+// NewHistoryBranchValidator returns new instance.
+func NewHistoryBranchValidator(
+	shardID int32,
+	executionManager persistence.ExecutionManager,
+) *historyBranchValidator {
+	return &historyBranchValidator{
+		shardID:          shardID,
+		executionManager: executionManager,
+	}
+}
+
+func (v *historyBranchValidator) Validate(
+	ctx context.Context,
+	mutableState *MutableState,
+) ([]MutableStateValidationResult, error) {
+	currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(
+		mutableState.GetExecutionInfo().GetVersionHistories(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	lastItem, err := versionhistory.GetLastVersionHistoryItem(currentVersionHistory)
+	if err != nil {
+		return nil, err
+	}
+
+	lastEventID := common.EmptyEventID
+	var nextPageToken []byte
+	for doContinue := true; doContinue; doContinue = len(nextPageToken) > 0 {
+		resp, err := v.executionManager.ReadHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
+			ShardID:       v.shardID,
+			BranchToken:   currentVersionHistory.BranchToken,
+			MinEventID:    common.FirstEventID,
+			MaxEventID:    lastItem.GetEventId() + 1,
+			PageSize:      historyBranchPageSize,
+			NextPageToken: nextPageToken,
+		})
+		switch err.(type) {
+		case nil:
+		case *serviceerror.NotFound, *serviceerror.DataLoss:
+			// history nodes are missing or not contiguous
+			return v.missingEventsResult(ctx, mutableState, fmt.Sprintf(
+				"unable to read history after event ID %d: %v", lastEventID, err,
+			))
+		default:
+			return nil, err
+		}
+		if len(resp.HistoryEvents) > 0 {
+			lastEventID = resp.HistoryEvents[len(resp.HistoryEvents)-1].GetEventId()
+		}
+		nextPageToken = resp.NextPageToken
+	}
+
+	if lastEventID != lastItem.GetEventId() {
+		return v.missingEventsResult(ctx, mutableState, fmt.Sprintf(
+			"last history event ID: %d is not last event ID of version history: %d",
+			lastEventID,
+			lastItem.GetEventId(),
+		))
+	}
+
+	if mutableState.GetNextEventId() != lastItem.GetEventId()+1 {
+		return []MutableStateValidationResult{{
+			failureType: HistoryBranchNextEventIDFailureType,
+			failureDetails: fmt.Sprintf(
+				"NextEventID: %d does not follow last event ID: %d",
+				mutableState.GetNextEventId(),
+				lastItem.GetEventId(),
+			),
+		}}, nil
+	}
+	return nil, nil
+}
+
+// missingEventsResult returns a missing events failure unless the mutable state was deleted in the meantime,
+// e.g. by retention, which deletes mutable state and history.
+func (v *historyBranchValidator) missingEventsResult(
+	ctx context.Context,
+	mutableState *MutableState,
+	details string,
+) ([]MutableStateValidationResult, error) {
+	_, err := v.executionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     v.shardID,
+		NamespaceID: mutableState.GetExecutionInfo().NamespaceId,
+		WorkflowID:  mutableState.GetExecutionInfo().WorkflowId,
+		RunID:       mutableState.GetExecutionState().RunId,
+	})
+	switch err.(type) {
+	case nil:
+		return []MutableStateValidationResult{{
+			failureType:    HistoryBranchMissingEventsFailureType,
+			failureDetails: details,
+		}}, nil
+	case *serviceerror.NotFound:
+		return nil, nil
+	default:
+		return nil, err
+	}
+}
@@ -34,7 +34,7 @@ import (
 )
 
 const (
-	historyEventIDFailureType   = "history_event_id_validator"
+	HistoryEventIDFailureType   = "history_event_id_validator"
 	historyEventIDFailureReason = "execution missing first event batch"
 )
 
@@ -96,7 +96,7 @@ func (v *historyEventIDValidator) Validate(
 		switch err.(type) {
 		case nil:
 			return []MutableStateValidationResult{{
-				failureType:    historyEventIDFailureType,
+				failureType:    HistoryEventIDFailureType,
 				failureDetails: historyEventIDFailureReason,
 			}}, nil
 		case *serviceerror.NotFound:
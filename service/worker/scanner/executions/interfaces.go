@@ -28,6 +28,7 @@ import (
 	"context"
 
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/namespace"
 )
 
 type (
@@ -45,4 +46,19 @@ type (
 	Validator interface {
 		Validate(ctx context.Context, mutableState *MutableState) ([]MutableStateValidationResult, error)
 	}
+
+	// NamespaceGetter looks up namespaces by ID, it is implemented by namespace.Registry.
+	NamespaceGetter interface {
+		GetNamespaceByID(id namespace.ID) (*namespace.Namespace, error)
+	}
 )
+
+// FailureType returns the type of the failed validation, e.g. MutableStateRetentionFailureType.
+func (r MutableStateValidationResult) FailureType() string {
+	return r.failureType
+}
+
+// FailureDetails returns a human-readable description of the failed validation.
+func (r MutableStateValidationResult) FailureDetails() string {
+	return r.failureDetails
+}
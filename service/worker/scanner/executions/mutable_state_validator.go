@@ -40,12 +40,12 @@ import (
 )
 
 const (
-	mutableStateActivityIDFailureType      = "mutable_state_validator_activity"
-	mutableStateTimerIDFailureType         = "mutable_state_validator_timer"
-	mutableStateChildWorkflowIDFailureType = "mutable_state_validator_child_workflow"
-	mutableStateRequestCancelIDFailureType = "mutable_state_validator_request_cancel"
-	mutableStateSignalIDFailureType        = "mutable_state_validator_signal"
-	mutableStateRetentionFailureType       = "mutable_state_validator_retention"
+	MutableStateActivityIDFailureType      = "mutable_state_validator_activity"
+	MutableStateTimerIDFailureType         = "mutable_state_validator_timer"
+	MutableStateChildWorkflowIDFailureType = "mutable_state_validator_child_workflow"
+	MutableStateRequestCancelIDFailureType = "mutable_state_validator_request_cancel"
+	MutableStateSignalIDFailureType        = "mutable_state_validator_signal"
+	MutableStateRetentionFailureType       = "mutable_state_validator_retention"
 )
 
 type (
@@ -53,7 +53,7 @@ type (
 	// * ID >= common.FirstEventID
 	// * ID <= last event ID
 	mutableStateValidator struct {
-		registry                    namespace.Registry
+		registry                    NamespaceGetter
 		executionDataDurationBuffer dynamicconfig.DurationPropertyFn
 	}
 )
@@ -62,7 +62,7 @@ var _ Validator = (*mutableStateValidator)(nil)
 
 // NewMutableStateValidator returns new instance.
 func NewMutableStateValidator(
-	registry namespace.Registry,
+	registry NamespaceGetter,
 	executionDataDurationBuffer dynamicconfig.DurationPropertyFn,
 ) *mutableStateValidator {
 	return &mutableStateValidator{
@@ -142,7 +142,7 @@ func (v *mutableStateValidator) validateActivity(
 			continue
 		}
 		results = append(results, MutableStateValidationResult{
-			failureType: mutableStateActivityIDFailureType,
+			failureType: MutableStateActivityIDFailureType,
 			failureDetails: fmt.Sprintf(
 				"ActivityEventID: %d is not less than last event ID: %d",
 				activityEventID,
@@ -163,7 +163,7 @@ func (v *mutableStateValidator) validateTimer(
 			continue
 		}
 		results = append(results, MutableStateValidationResult{
-			failureType: mutableStateTimerIDFailureType,
+			failureType: MutableStateTimerIDFailureType,
 			failureDetails: fmt.Sprintf(
 				"TimerEventID: %d is not less than last event ID: %d",
 				timer.StartedEventId,
@@ -184,7 +184,7 @@ func (v *mutableStateValidator) validateChildWorkflow(
 			continue
 		}
 		results = append(results, MutableStateValidationResult{
-			failureType: mutableStateChildWorkflowIDFailureType,
+			failureType: MutableStateChildWorkflowIDFailureType,
 			failureDetails: fmt.Sprintf(
 				"ChildWorkflowEventID: %d is not less than last event ID: %d",
 				childWorkflowEventID,
@@ -205,7 +205,7 @@ func (v *mutableStateValidator) validateRequestCancel(
 			continue
 		}
 		results = append(results, MutableStateValidationResult{
-			failureType: mutableStateRequestCancelIDFailureType,
+			failureType: MutableStateRequestCancelIDFailureType,
 			failureDetails: fmt.Sprintf(
 				"RequestCancelEventID: %d is not less than last event ID: %d",
 				requestCancelEventID,
@@ -226,7 +226,7 @@ func (v *mutableStateValidator) validateSignal(
 			continue
 		}
 		results = append(results, MutableStateValidationResult{
-			failureType: mutableStateSignalIDFailureType,
+			failureType: MutableStateSignalIDFailureType,
 			failureDetails: fmt.Sprintf(
 				"SignalEventID: %d is not less than last event ID: %d",
 				signalEventID,
@@ -267,7 +267,7 @@ func (v *mutableStateValidator) validateRetention(
 	if ttl > 0 && ttl > retention+v.executionDataDurationBuffer() {
 
 		return &MutableStateValidationResult{
-			failureType: mutableStateRetentionFailureType,
+			failureType: MutableStateRetentionFailureType,
 			failureDetails: fmt.Sprintf("Workflow Data TTL %s passed retention %s",
 				ttl.String(),
 				retention.String(),
@@ -231,7 +231,7 @@ func (t *task) handleFailures(
 ) error {
 	for _, failure := range results {
 		switch failure.failureType {
-		case mutableStateRetentionFailureType:
+		case MutableStateRetentionFailureType:
 			executionInfo := mutableState.GetExecutionInfo()
 			runID := mutableState.GetExecutionState().GetRunId()
 			ns, err := t.registry.GetNamespaceByID(namespace.ID(executionInfo.GetNamespaceId()))
@@ -109,6 +109,21 @@ func (a *activities) StartWorkflow(ctx context.Context, req *schedulespb.StartWo
 	}, nil
 }
 
+func (a *activities) ResolveBusinessCalendars(ctx context.Context, req *schedulespb.ResolveBusinessCalendarsRequest) (*schedulespb.ResolveBusinessCalendarsResponse, error) {
+	ns, err := a.NamespaceRegistry.GetNamespaceByID(a.namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	resolved, err := ResolveBusinessCalendars(ns, req.BusinessCalendars)
+	if err != nil {
+		// keep using the last known definition of calendars that were deleted
+		a.Logger.Warn("Schedule references missing business calendars", tag.Error(err))
+	}
+	return &schedulespb.ResolveBusinessCalendarsResponse{
+		BusinessCalendars: resolved,
+	}, nil
+}
+
 func (a *activities) waitForRateLimiterPermission(req *schedulespb.StartWorkflowRequest) error {
 	if req.CompletedRateLimitSleep {
 		return nil
@@ -0,0 +1,242 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common/namespace"
+)
+
+type (
+	compiledBusinessCalendar struct {
+		// Time zone that holidays and weekdays are evaluated in
+		tz     *time.Location
+		action enumsspb.BusinessCalendarAction
+		// Indexed by time.Weekday
+		nonBusinessWeekdays [7]bool
+		// Holidays that recur every year have a zero year.
+		holidays map[businessDate]struct{}
+	}
+
+	businessDate struct {
+		year  int
+		month time.Month
+		day   int
+	}
+)
+
+const (
+	// maxBusinessCalendarNameLen is the max length of a business calendar name.
+	maxBusinessCalendarNameLen = 200
+	// maxBusinessCalendarShiftDays bounds the search for the next business day.
+	maxBusinessCalendarShiftDays = 366
+)
+
+var (
+	errBusinessCalendarNameNotSet   = errors.New("business calendar name is not set")
+	errBusinessCalendarNameTooLong  = errors.New("business calendar name is too long")
+	errNoBusinessDays               = errors.New("business calendar must have at least one business weekday")
+	errDuplicateBusinessCalendarRef = errors.New("business calendar is referenced more than once")
+	errBusinessCalendarRefHasDef    = errors.New("business calendar reference must not set the calendar definition")
+)
+
+// ValidateBusinessCalendar returns an error if the business calendar definition is invalid.
+func (b *SpecBuilder) ValidateBusinessCalendar(calendar *schedulespb.BusinessCalendar) error {
+	if calendar.GetName() == "" {
+		return errBusinessCalendarNameNotSet
+	} else if len(calendar.GetName()) > maxBusinessCalendarNameLen {
+		return errBusinessCalendarNameTooLong
+	} else if len(calendar.GetDescription()) > maxCommentLen {
+		return errors.New("business calendar description is too long")
+	}
+
+	if calendar.GetTimezoneName() != "" {
+		if _, err := b.loadLocation(calendar.GetTimezoneName()); err != nil {
+			return fmt.Errorf("invalid business calendar time zone: %w", err)
+		}
+	}
+
+	var weekdays [7]bool
+	nonBusinessWeekdays := 0
+	for _, weekday := range calendar.GetNonBusinessWeekdays() {
+		if weekday < 0 || weekday > 6 {
+			return fmt.Errorf("non-business weekday %d: %w", weekday, errOutOfRange)
+		}
+		if !weekdays[weekday] {
+			weekdays[weekday] = true
+			nonBusinessWeekdays++
+		}
+	}
+	if nonBusinessWeekdays == len(weekdays) {
+		return errNoBusinessDays
+	}
+
+	for _, holiday := range calendar.GetHolidays() {
+		if err := validateBusinessCalendarDate(holiday); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateBusinessCalendarDate(date *schedulespb.BusinessCalendarDate) error {
+	year := int(date.GetYear())
+	if year != 0 && (year < minCalendarYear || year > maxCalendarYear) {
+		return fmt.Errorf("holiday year %d: %w", year, errOutOfRange)
+	}
+	if len(date.GetComment()) > maxCommentLen {
+		return errors.New("holiday comment is too long")
+	}
+	// Holidays that recur every year are checked against a leap year, so that February 29
+	// is accepted.
+	checkYear := year
+	if checkYear == 0 {
+		checkYear = 2000
+	}
+	month, day := time.Month(date.GetMonth()), int(date.GetDay())
+	t := time.Date(checkYear, month, day, 0, 0, 0, 0, time.UTC)
+	if month < time.January || month > time.December || t.Month() != month || t.Day() != day {
+		return fmt.Errorf("holiday %d-%d-%d: %w", year, date.GetMonth(), day, errOutOfRange)
+	}
+	return nil
+}
+
+func (b *SpecBuilder) newCompiledBusinessCalendar(
+	ref *schedulespb.ScheduleBusinessCalendar,
+	specTz *time.Location,
+) (*compiledBusinessCalendar, error) {
+	calendar := ref.GetCalendar()
+	tz := specTz
+	if calendar.GetTimezoneName() != "" {
+		var err error
+		if tz, err = b.loadLocation(calendar.GetTimezoneName()); err != nil {
+			return nil, err
+		}
+	}
+
+	cbc := &compiledBusinessCalendar{
+		tz:       tz,
+		action:   ref.GetAction(),
+		holidays: make(map[businessDate]struct{}, len(calendar.GetHolidays())),
+	}
+	for _, weekday := range calendar.GetNonBusinessWeekdays() {
+		if weekday >= 0 && weekday <= 6 {
+			cbc.nonBusinessWeekdays[weekday] = true
+		}
+	}
+	for _, holiday := range calendar.GetHolidays() {
+		cbc.holidays[businessDate{
+			year:  int(holiday.GetYear()),
+			month: time.Month(holiday.GetMonth()),
+			day:   int(holiday.GetDay()),
+		}] = struct{}{}
+	}
+	return cbc, nil
+}
+
+// isBusinessDay returns true if t falls on a business day in the calendar's time zone.
+func (c *compiledBusinessCalendar) isBusinessDay(t time.Time) bool {
+	local := t.In(c.tz)
+	if c.nonBusinessWeekdays[local.Weekday()] {
+		return false
+	}
+	date := businessDate{year: local.Year(), month: local.Month(), day: local.Day()}
+	if _, ok := c.holidays[date]; ok {
+		return false
+	}
+	date.year = 0
+	_, ok := c.holidays[date]
+	return !ok
+}
+
+// nextBusinessDay returns the same local time as t on the next business day.
+func (c *compiledBusinessCalendar) nextBusinessDay(t time.Time) (time.Time, bool) {
+	local := t.In(c.tz)
+	for i := 1; i <= maxBusinessCalendarShiftDays; i++ {
+		next := time.Date(
+			local.Year(), local.Month(), local.Day()+i,
+			local.Hour(), local.Minute(), local.Second(), local.Nanosecond(),
+			c.tz,
+		)
+		if c.isBusinessDay(next) {
+			return next.UTC(), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// ValidateBusinessCalendarRefs returns an error if the business calendar references of a
+// schedule are invalid. Definitions are always resolved from the namespace, so references
+// must not carry them.
+func ValidateBusinessCalendarRefs(refs []*schedulespb.ScheduleBusinessCalendar) error {
+	seen := make(map[string]struct{}, len(refs))
+	for _, ref := range refs {
+		if ref.GetName() == "" {
+			return errBusinessCalendarNameNotSet
+		} else if len(ref.GetName()) > maxBusinessCalendarNameLen {
+			return errBusinessCalendarNameTooLong
+		}
+		if ref.GetCalendar() != nil {
+			return fmt.Errorf("%w: %q", errBusinessCalendarRefHasDef, ref.GetName())
+		}
+		if _, ok := seen[ref.GetName()]; ok {
+			return fmt.Errorf("%w: %q", errDuplicateBusinessCalendarRef, ref.GetName())
+		}
+		seen[ref.GetName()] = struct{}{}
+	}
+	return nil
+}
+
+// ResolveBusinessCalendars returns copies of the business calendar references with their
+// definitions set from the namespace. References to calendars that are not defined in the
+// namespace keep their previous definition, and an error naming them is returned.
+func ResolveBusinessCalendars(
+	ns *namespace.Namespace,
+	refs []*schedulespb.ScheduleBusinessCalendar,
+) ([]*schedulespb.ScheduleBusinessCalendar, error) {
+	resolved := make([]*schedulespb.ScheduleBusinessCalendar, len(refs))
+	var missing []string
+	for i, ref := range refs {
+		resolved[i] = &schedulespb.ScheduleBusinessCalendar{
+			Name:     ref.GetName(),
+			Action:   ref.GetAction(),
+			Calendar: ref.GetCalendar(),
+		}
+		if calendar, ok := ns.BusinessCalendar(ref.GetName()); ok {
+			resolved[i].Calendar = calendar
+		} else {
+			missing = append(missing, ref.GetName())
+		}
+	}
+	if len(missing) > 0 {
+		return resolved, fmt.Errorf("business calendars not found in namespace %s: %q", ns.Name(), missing)
+	}
+	return resolved, nil
+}
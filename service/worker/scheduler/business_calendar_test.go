@@ -0,0 +1,247 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	schedulepb "go.temporal.io/api/schedule/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/testing/protorequire"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type businessCalendarSuite struct {
+	suite.Suite
+	protorequire.ProtoAssertions
+
+	specBuilder *SpecBuilder
+}
+
+func TestBusinessCalendar(t *testing.T) {
+	suite.Run(t, new(businessCalendarSuite))
+}
+
+func (s *businessCalendarSuite) SetupTest() {
+	s.ProtoAssertions = protorequire.New(s.T())
+	s.specBuilder = NewSpecBuilder()
+}
+
+func (s *businessCalendarSuite) checkSequence(
+	spec *schedulepb.ScheduleSpec,
+	calendars []*schedulespb.ScheduleBusinessCalendar,
+	start time.Time,
+	seq ...time.Time,
+) {
+	s.T().Helper()
+	cs, err := s.specBuilder.NewCompiledSpecWithBusinessCalendars(spec, calendars)
+	s.Require().NoError(err)
+	for _, exp := range seq {
+		result := cs.GetNextTime("", start)
+		s.Equal(exp, result.Nominal)
+		start = result.Next
+	}
+}
+
+func weekdaysCalendar(action enumsspb.BusinessCalendarAction, holidays ...*schedulespb.BusinessCalendarDate) *schedulespb.ScheduleBusinessCalendar {
+	return &schedulespb.ScheduleBusinessCalendar{
+		Name:   "weekdays",
+		Action: action,
+		Calendar: &schedulespb.BusinessCalendar{
+			Name:                "weekdays",
+			NonBusinessWeekdays: []int32{int32(time.Saturday), int32(time.Sunday)},
+			Holidays:            holidays,
+		},
+	}
+}
+
+func (s *businessCalendarSuite) TestSkip() {
+	// Evaluated in the time zone of the spec: 9:00 in New York is 14:00 UTC, which is still
+	// Friday/Saturday/Sunday in UTC.
+	s.checkSequence(
+		&schedulepb.ScheduleSpec{
+			Calendar:     []*schedulepb.CalendarSpec{{Hour: "9"}},
+			TimezoneName: "America/New_York",
+		},
+		[]*schedulespb.ScheduleBusinessCalendar{weekdaysCalendar(enumsspb.BUSINESS_CALENDAR_ACTION_UNSPECIFIED)},
+		time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 5, 14, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 8, 14, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 9, 14, 0, 0, 0, time.UTC),
+	)
+}
+
+func (s *businessCalendarSuite) TestShiftNextBusinessDay() {
+	s.checkSequence(
+		&schedulepb.ScheduleSpec{
+			Calendar: []*schedulepb.CalendarSpec{{Hour: "9"}},
+		},
+		[]*schedulespb.ScheduleBusinessCalendar{weekdaysCalendar(
+			enumsspb.BUSINESS_CALENDAR_ACTION_SHIFT_NEXT_BUSINESS_DAY,
+			&schedulespb.BusinessCalendarDate{Month: 12, Day: 25},
+			&schedulespb.BusinessCalendarDate{Year: 2024, Month: 12, Day: 31},
+		)},
+		time.Date(2024, 12, 24, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 12, 24, 9, 0, 0, 0, time.UTC),
+		// Christmas is moved to the 26th, which is then not repeated
+		time.Date(2024, 12, 26, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, 12, 27, 9, 0, 0, 0, time.UTC),
+		// the weekend is moved to Monday
+		time.Date(2024, 12, 30, 9, 0, 0, 0, time.UTC),
+		time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC),
+		time.Date(2025, 1, 2, 9, 0, 0, 0, time.UTC),
+	)
+}
+
+func (s *businessCalendarSuite) TestCalendarTimezone() {
+	calendar := &schedulespb.ScheduleBusinessCalendar{
+		Name:   "tokyo",
+		Action: enumsspb.BUSINESS_CALENDAR_ACTION_SKIP,
+		Calendar: &schedulespb.BusinessCalendar{
+			Name:         "tokyo",
+			TimezoneName: "Asia/Tokyo",
+			Holidays:     []*schedulespb.BusinessCalendarDate{{Year: 2024, Month: 1, Day: 1}},
+		},
+	}
+	// January 1st in Tokyo is from 15:00 UTC on December 31st to 15:00 UTC on January 1st.
+	s.checkSequence(
+		&schedulepb.ScheduleSpec{
+			Interval: []*schedulepb.IntervalSpec{{Interval: durationpb.New(time.Hour)}},
+		},
+		[]*schedulespb.ScheduleBusinessCalendar{calendar},
+		time.Date(2023, 12, 31, 13, 30, 0, 0, time.UTC),
+		time.Date(2023, 12, 31, 14, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 1, 15, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 1, 16, 0, 0, 0, time.UTC),
+	)
+}
+
+func (s *businessCalendarSuite) TestShiftPastEndTime() {
+	s.checkSequence(
+		&schedulepb.ScheduleSpec{
+			Calendar: []*schedulepb.CalendarSpec{{Hour: "9"}},
+			EndTime:  timestamppb.New(time.Date(2024, 12, 28, 0, 0, 0, 0, time.UTC)),
+		},
+		[]*schedulespb.ScheduleBusinessCalendar{weekdaysCalendar(enumsspb.BUSINESS_CALENDAR_ACTION_SHIFT_NEXT_BUSINESS_DAY)},
+		time.Date(2024, 12, 27, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 12, 27, 9, 0, 0, 0, time.UTC),
+		time.Time{},
+	)
+}
+
+func (s *businessCalendarSuite) TestUnresolved() {
+	// references without definitions are ignored
+	s.checkSequence(
+		&schedulepb.ScheduleSpec{
+			Calendar: []*schedulepb.CalendarSpec{{Hour: "9"}},
+		},
+		[]*schedulespb.ScheduleBusinessCalendar{{Name: "unknown"}},
+		time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 6, 9, 0, 0, 0, time.UTC),
+	)
+}
+
+func (s *businessCalendarSuite) TestValidate() {
+	valid := func() *schedulespb.BusinessCalendar {
+		return &schedulespb.BusinessCalendar{
+			Name:                "holidays",
+			TimezoneName:        "Europe/Paris",
+			NonBusinessWeekdays: []int32{0, 6},
+			Holidays: []*schedulespb.BusinessCalendarDate{
+				{Month: 2, Day: 29},
+				{Year: 2025, Month: 5, Day: 1, Comment: "Labour Day"},
+			},
+		}
+	}
+	s.NoError(s.specBuilder.ValidateBusinessCalendar(valid()))
+
+	for name, mutate := range map[string]func(*schedulespb.BusinessCalendar){
+		"no name":          func(c *schedulespb.BusinessCalendar) { c.Name = "" },
+		"bad timezone":     func(c *schedulespb.BusinessCalendar) { c.TimezoneName = "Mars/Olympus_Mons" },
+		"bad weekday":      func(c *schedulespb.BusinessCalendar) { c.NonBusinessWeekdays = []int32{7} },
+		"no business days": func(c *schedulespb.BusinessCalendar) { c.NonBusinessWeekdays = []int32{0, 1, 2, 3, 4, 5, 6} },
+		"bad month":        func(c *schedulespb.BusinessCalendar) { c.Holidays[0].Month = 13 },
+		"bad day":          func(c *schedulespb.BusinessCalendar) { c.Holidays[1].Month, c.Holidays[1].Day = 2, 29 },
+		"bad year":         func(c *schedulespb.BusinessCalendar) { c.Holidays[1].Year = 1999 },
+	} {
+		calendar := valid()
+		mutate(calendar)
+		s.Error(s.specBuilder.ValidateBusinessCalendar(calendar), name)
+	}
+}
+
+func (s *businessCalendarSuite) TestValidateRefs() {
+	s.NoError(ValidateBusinessCalendarRefs(nil))
+	s.NoError(ValidateBusinessCalendarRefs([]*schedulespb.ScheduleBusinessCalendar{
+		{Name: "us", Action: enumsspb.BUSINESS_CALENDAR_ACTION_SHIFT_NEXT_BUSINESS_DAY},
+		{Name: "uk"},
+	}))
+
+	s.ErrorIs(ValidateBusinessCalendarRefs([]*schedulespb.ScheduleBusinessCalendar{
+		{Name: "us"}, {Name: "us"},
+	}), errDuplicateBusinessCalendarRef)
+	s.ErrorIs(ValidateBusinessCalendarRefs([]*schedulespb.ScheduleBusinessCalendar{
+		{Action: enumsspb.BUSINESS_CALENDAR_ACTION_SKIP},
+	}), errBusinessCalendarNameNotSet)
+	s.ErrorIs(ValidateBusinessCalendarRefs([]*schedulespb.ScheduleBusinessCalendar{
+		{Name: "uk", Calendar: &schedulespb.BusinessCalendar{Name: "uk"}},
+	}), errBusinessCalendarRefHasDef)
+}
+
+func (s *businessCalendarSuite) TestResolve() {
+	us := &schedulespb.BusinessCalendar{Name: "us", NonBusinessWeekdays: []int32{0, 6}}
+	ns := namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Name: "ns"},
+		&persistencespb.NamespaceConfig{
+			BusinessCalendars: map[string]*schedulespb.BusinessCalendar{"us": us},
+		},
+		"active",
+	)
+	stale := &schedulespb.BusinessCalendar{Name: "uk"}
+	refs := []*schedulespb.ScheduleBusinessCalendar{
+		{Name: "us", Action: enumsspb.BUSINESS_CALENDAR_ACTION_SKIP},
+		{Name: "uk", Calendar: stale},
+	}
+
+	resolved, err := ResolveBusinessCalendars(ns, refs)
+	s.ErrorContains(err, `"uk"`)
+	s.Len(resolved, 2)
+	s.ProtoEqual(us, resolved[0].Calendar)
+	s.Equal(enumsspb.BUSINESS_CALENDAR_ACTION_SKIP, resolved[0].Action)
+	// missing calendars keep their last known definition
+	s.ProtoEqual(stale, resolved[1].Calendar)
+	// the input is not modified
+	s.Nil(refs[0].Calendar)
+
+	resolved, err = ResolveBusinessCalendars(ns, refs[:1])
+	s.NoError(err)
+	s.Len(resolved, 1)
+}
@@ -74,10 +74,11 @@ type (
 
 	activityDeps struct {
 		fx.In
-		MetricsHandler metrics.Handler
-		Logger         log.Logger
-		HistoryClient  resource.HistoryClient
-		FrontendClient workflowservice.WorkflowServiceClient
+		MetricsHandler    metrics.Handler
+		Logger            log.Logger
+		HistoryClient     resource.HistoryClient
+		FrontendClient    workflowservice.WorkflowServiceClient
+		NamespaceRegistry namespace.Registry
 	}
 
 	fxResult struct {
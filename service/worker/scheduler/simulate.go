@@ -0,0 +1,280 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"errors"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	schedulepb "go.temporal.io/api/schedule/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// MaxSimulatedActions is the maximum number of actions returned by Simulate.
+	MaxSimulatedActions = 1000
+)
+
+type (
+	// SimulationParams describes a schedule simulation.
+	SimulationParams struct {
+		Schedule   *schedulepb.Schedule
+		JitterSeed string
+		StartTime  time.Time
+		EndTime    time.Time
+		// How long each started workflow runs.
+		RunDuration time.Duration
+		// The scheduler doesn't take any action before this time, if set.
+		ResumeTime time.Time
+		// Maximum number of actions to return, capped at MaxSimulatedActions.
+		MaxActions int
+	}
+
+	// SimulationResult is the outcome of a schedule simulation.
+	SimulationResult struct {
+		Actions             []*schedulespb.SimulatedScheduleAction
+		ActionCount         int64
+		OverlapSkipped      int64
+		BufferDropped       int64
+		MissedCatchupWindow int64
+		// Set if the simulation stopped early because MaxActions was reached.
+		Truncated bool
+	}
+
+	simulator struct {
+		params           SimulationParams
+		tweakables       TweakablePolicies
+		paused           bool
+		limitedActions   bool
+		remainingActions int64
+
+		now     time.Time
+		buffer  []*schedulespb.BufferedStart
+		actions map[*schedulespb.BufferedStart]*schedulespb.SimulatedScheduleAction
+		// Close times of the running workflows that the overlap policy applies to.
+		running []time.Time
+		result  SimulationResult
+	}
+)
+
+var (
+	errInvalidSimulationRange = errors.New("simulation end time must not be before start time")
+)
+
+// Simulate projects the actions that a schedule would take over a time range. It follows the
+// logic of the scheduler workflow: spec times are computed by CompiledSpec, buffered starts are
+// resolved by ProcessBuffer, and the catchup window, pause and remaining actions of the
+// schedule are applied. Started workflows are assumed to run for params.RunDuration, and
+// canceled or terminated workflows to close immediately.
+func Simulate(specBuilder *SpecBuilder, params SimulationParams) (*SimulationResult, error) {
+	if params.EndTime.Before(params.StartTime) {
+		return nil, errInvalidSimulationRange
+	}
+	if params.MaxActions <= 0 || params.MaxActions > MaxSimulatedActions {
+		params.MaxActions = MaxSimulatedActions
+	}
+	cspec, err := specBuilder.NewCompiledSpec(params.Schedule.GetSpec())
+	if err != nil {
+		return nil, err
+	}
+
+	state := params.Schedule.GetState()
+	s := &simulator{
+		params:           params,
+		tweakables:       CurrentTweakablePolicies,
+		paused:           state.GetPaused(),
+		limitedActions:   state.GetLimitedActions(),
+		remainingActions: state.GetRemainingActions(),
+		actions:          make(map[*schedulespb.BufferedStart]*schedulespb.SimulatedScheduleAction),
+	}
+
+	next := cspec.GetNextTime(params.JitterSeed, params.StartTime)
+	for !s.result.Truncated {
+		wakeup, ok := s.nextWakeup(next.Next)
+		if !ok {
+			break
+		}
+		s.advance(wakeup)
+		for !next.Next.IsZero() && !next.Next.After(s.now) && !s.result.Truncated {
+			s.addStart(next)
+			next = cspec.GetNextTime(params.JitterSeed, next.Next)
+		}
+		s.processBuffer()
+	}
+	return &s.result, nil
+}
+
+// nextWakeup returns the time the scheduler would wake up next: either for the next spec time,
+// or for a running workflow closing while there are buffered starts.
+func (s *simulator) nextWakeup(nextTime time.Time) (time.Time, bool) {
+	var wakeup time.Time
+	if !nextTime.IsZero() {
+		wakeup = nextTime
+	}
+	if len(s.buffer) > 0 && len(s.running) > 0 {
+		closeTime := s.running[0]
+		for _, t := range s.running[1:] {
+			if t.Before(closeTime) {
+				closeTime = t
+			}
+		}
+		if wakeup.IsZero() || closeTime.Before(wakeup) {
+			wakeup = closeTime
+		}
+	}
+	if wakeup.IsZero() {
+		return time.Time{}, false
+	}
+	if wakeup.Before(s.params.ResumeTime) {
+		wakeup = s.params.ResumeTime
+	}
+	return wakeup, !wakeup.After(s.params.EndTime)
+}
+
+func (s *simulator) advance(now time.Time) {
+	s.now = now
+	running := s.running[:0]
+	for _, closeTime := range s.running {
+		if closeTime.After(now) {
+			running = append(running, closeTime)
+		}
+	}
+	s.running = running
+}
+
+func (s *simulator) addStart(next GetNextTimeResult) {
+	action := &schedulespb.SimulatedScheduleAction{
+		NominalTime: timestamppb.New(next.Nominal),
+		ActualTime:  timestamppb.New(next.Next),
+	}
+	s.result.Actions = append(s.result.Actions, action)
+	if len(s.result.Actions) >= s.params.MaxActions {
+		s.result.Truncated = true
+	}
+
+	switch {
+	case s.paused:
+		action.Outcome = enumsspb.SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_PAUSED
+	case !s.canTakeScheduledAction(false):
+		action.Outcome = enumsspb.SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_NO_REMAINING_ACTIONS
+	case s.now.Sub(next.Next) > getCatchupWindow(s.params.Schedule.GetPolicies(), s.tweakables):
+		action.Outcome = enumsspb.SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_CATCHUP_WINDOW
+		s.result.MissedCatchupWindow++
+	case s.tweakables.MaxBufferSize > 0 && len(s.buffer) >= s.tweakables.MaxBufferSize:
+		action.Outcome = enumsspb.SIMULATED_SCHEDULE_ACTION_OUTCOME_DROPPED_BUFFER_FULL
+		s.result.BufferDropped++
+	default:
+		action.Outcome = enumsspb.SIMULATED_SCHEDULE_ACTION_OUTCOME_BUFFERED
+		start := &schedulespb.BufferedStart{
+			NominalTime: action.NominalTime,
+			ActualTime:  action.ActualTime,
+		}
+		s.buffer = append(s.buffer, start)
+		s.actions[start] = action
+	}
+}
+
+func (s *simulator) processBuffer() {
+	for len(s.buffer) > 0 {
+		result := ProcessBuffer(s.buffer, len(s.running) > 0, s.resolveOverlapPolicy)
+
+		kept := make(map[*schedulespb.BufferedStart]bool, len(s.buffer))
+		for _, start := range result.NewBuffer {
+			kept[start] = true
+		}
+		allStarts := result.OverlappingStarts
+		if result.NonOverlappingStart != nil {
+			allStarts = append(allStarts, result.NonOverlappingStart)
+		}
+		for _, start := range allStarts {
+			kept[start] = true
+		}
+		for _, start := range s.buffer {
+			if !kept[start] {
+				action := s.actions[start]
+				action.Outcome = enumsspb.SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_OVERLAP
+				action.OverlapPolicy = s.resolveOverlapPolicy(start.OverlapPolicy)
+				action.CancelsRunning = false
+				action.TerminatesRunning = false
+			}
+		}
+		s.buffer = result.NewBuffer
+		s.result.OverlapSkipped += result.OverlapSkipped
+
+		for _, start := range allStarts {
+			action := s.actions[start]
+			action.OverlapPolicy = s.resolveOverlapPolicy(start.OverlapPolicy)
+			if !s.canTakeScheduledAction(true) {
+				action.Outcome = enumsspb.SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_NO_REMAINING_ACTIONS
+				continue
+			}
+			action.Outcome = enumsspb.SIMULATED_SCHEDULE_ACTION_OUTCOME_STARTED
+			action.StartTime = timestamppb.New(s.now)
+			s.result.ActionCount++
+			// Only non-overlapping starts are tracked as running, like in the scheduler workflow.
+			if start == result.NonOverlappingStart && s.params.RunDuration > 0 {
+				s.running = append(s.running, s.now.Add(s.params.RunDuration))
+			}
+		}
+
+		if !result.NeedCancel && !result.NeedTerminate {
+			return
+		}
+		// The start that asked for the running workflow to be stopped stays in the buffer and
+		// is started on the next iteration, once the running workflow is closed.
+		for _, start := range s.buffer {
+			switch s.resolveOverlapPolicy(start.OverlapPolicy) {
+			case enumspb.SCHEDULE_OVERLAP_POLICY_CANCEL_OTHER:
+				s.actions[start].CancelsRunning = result.NeedCancel
+			case enumspb.SCHEDULE_OVERLAP_POLICY_TERMINATE_OTHER:
+				s.actions[start].TerminatesRunning = result.NeedTerminate
+			}
+		}
+		s.running = nil
+	}
+}
+
+func (s *simulator) canTakeScheduledAction(decrement bool) bool {
+	if s.paused {
+		return false
+	}
+	if !s.limitedActions {
+		return true
+	}
+	if s.remainingActions > 0 {
+		if decrement {
+			s.remainingActions--
+		}
+		return true
+	}
+	return false
+}
+
+func (s *simulator) resolveOverlapPolicy(overlapPolicy enumspb.ScheduleOverlapPolicy) enumspb.ScheduleOverlapPolicy {
+	return resolveOverlapPolicy(s.params.Schedule.GetPolicies(), overlapPolicy)
+}
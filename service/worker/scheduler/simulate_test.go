@@ -0,0 +1,222 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	schedulepb "go.temporal.io/api/schedule/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type (
+	simulateSuite struct {
+		suite.Suite
+		specBuilder *SpecBuilder
+		t0          time.Time
+	}
+)
+
+func TestSimulate(t *testing.T) {
+	suite.Run(t, new(simulateSuite))
+}
+
+func (s *simulateSuite) SetupTest() {
+	s.specBuilder = NewSpecBuilder()
+	s.t0 = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func (s *simulateSuite) everyMinute(overlapPolicy enumspb.ScheduleOverlapPolicy) *schedulepb.Schedule {
+	return &schedulepb.Schedule{
+		Spec: &schedulepb.ScheduleSpec{
+			Interval: []*schedulepb.IntervalSpec{{Interval: durationpb.New(time.Minute)}},
+		},
+		Policies: &schedulepb.SchedulePolicies{
+			OverlapPolicy: overlapPolicy,
+		},
+	}
+}
+
+func (s *simulateSuite) simulate(params SimulationParams) *SimulationResult {
+	if params.StartTime.IsZero() {
+		params.StartTime = s.t0
+	}
+	if params.EndTime.IsZero() {
+		params.EndTime = s.t0.Add(5 * time.Minute)
+	}
+	result, err := Simulate(s.specBuilder, params)
+	s.NoError(err)
+	return result
+}
+
+func (s *simulateSuite) checkOutcomes(result *SimulationResult, expected ...enumsspb.SimulatedScheduleActionOutcome) {
+	var outcomes []enumsspb.SimulatedScheduleActionOutcome
+	for _, action := range result.Actions {
+		outcomes = append(outcomes, action.Outcome)
+	}
+	s.Equal(expected, outcomes)
+}
+
+func (s *simulateSuite) checkStartTimes(result *SimulationResult, expected ...time.Duration) {
+	var startTimes []time.Duration
+	for _, action := range result.Actions {
+		if action.StartTime != nil {
+			startTimes = append(startTimes, action.StartTime.AsTime().Sub(s.t0))
+		}
+	}
+	s.Equal(expected, startTimes)
+}
+
+func (s *simulateSuite) TestSkip() {
+	result := s.simulate(SimulationParams{
+		Schedule:    s.everyMinute(enumspb.SCHEDULE_OVERLAP_POLICY_SKIP),
+		RunDuration: 90 * time.Second,
+	})
+	s.checkOutcomes(result,
+		enumsspb.SIMULATED_SCHEDULE_ACTION_OUTCOME_STARTED,
+		enumsspb.SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_OVERLAP,
+		enumsspb.SIMULATED_SCHEDULE_ACTION_OUTCOME_STARTED,
+		enumsspb.SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_OVERLAP,
+		enumsspb.SIMULATED_SCHEDULE_ACTION_OUTCOME_STARTED,
+	)
+	s.checkStartTimes(result, time.Minute, 3*time.Minute, 5*time.Minute)
+	s.Equal(int64(3), result.ActionCount)
+	s.Equal(int64(2), result.OverlapSkipped)
+	s.False(result.Truncated)
+	for _, action := range result.Actions {
+		s.Equal(enumspb.SCHEDULE_OVERLAP_POLICY_SKIP, action.OverlapPolicy)
+	}
+}
+
+func (s *simulateSuite) TestBufferOne() {
+	result := s.simulate(SimulationParams{
+		Schedule:    s.everyMinute(enumspb.SCHEDULE_OVERLAP_POLICY_BUFFER_ONE),
+		RunDuration: 90 * time.Second,
+	})
+	s.checkOutcomes(result,
+		enumsspb.SIMULATED_SCHEDULE_ACTION_OUTCOME_STARTED,
+		enumsspb.SIMULATED_SCHEDULE_ACTION_OUTCOME_STARTED,
+		enumsspb.SIMULATED_SCHEDULE_ACTION_OUTCOME_STARTED,
+		enumsspb.SIMULATED_SCHEDULE_ACTION_OUTCOME_BUFFERED,
+		enumsspb.SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_OVERLAP,
+	)
+	// buffered starts are taken as soon as the running workflow closes
+	s.checkStartTimes(result, time.Minute, 150*time.Second, 4*time.Minute)
+	s.Equal(int64(3), result.ActionCount)
+	s.Equal(int64(1), result.OverlapSkipped)
+}
+
+func (s *simulateSuite) TestAllowAll() {
+	result := s.simulate(SimulationParams{
+		Schedule:    s.everyMinute(enumspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL),
+		RunDuration: time.Hour,
+	})
+	s.Len(result.Actions, 5)
+	s.checkStartTimes(result, time.Minute, 2*time.Minute, 3*time.Minute, 4*time.Minute, 5*time.Minute)
+	s.Equal(int64(5), result.ActionCount)
+	s.Zero(result.OverlapSkipped)
+}
+
+func (s *simulateSuite) TestCancelOther() {
+	result := s.simulate(SimulationParams{
+		Schedule:    s.everyMinute(enumspb.SCHEDULE_OVERLAP_POLICY_CANCEL_OTHER),
+		RunDuration: 90 * time.Second,
+	})
+	s.checkStartTimes(result, time.Minute, 2*time.Minute, 3*time.Minute, 4*time.Minute, 5*time.Minute)
+	s.False(result.Actions[0].CancelsRunning)
+	for _, action := range result.Actions[1:] {
+		s.True(action.CancelsRunning)
+		s.False(action.TerminatesRunning)
+	}
+}
+
+func (s *simulateSuite) TestPaused() {
+	schedule := s.everyMinute(enumspb.SCHEDULE_OVERLAP_POLICY_SKIP)
+	schedule.State = &schedulepb.ScheduleState{Paused: true}
+	result := s.simulate(SimulationParams{Schedule: schedule})
+	s.Len(result.Actions, 5)
+	for _, action := range result.Actions {
+		s.Equal(enumsspb.SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_PAUSED, action.Outcome)
+		s.Nil(action.StartTime)
+	}
+	s.Zero(result.ActionCount)
+}
+
+func (s *simulateSuite) TestRemainingActions() {
+	schedule := s.everyMinute(enumspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL)
+	schedule.State = &schedulepb.ScheduleState{LimitedActions: true, RemainingActions: 2}
+	result := s.simulate(SimulationParams{Schedule: schedule})
+	s.checkOutcomes(result,
+		enumsspb.SIMULATED_SCHEDULE_ACTION_OUTCOME_STARTED,
+		enumsspb.SIMULATED_SCHEDULE_ACTION_OUTCOME_STARTED,
+		enumsspb.SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_NO_REMAINING_ACTIONS,
+		enumsspb.SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_NO_REMAINING_ACTIONS,
+		enumsspb.SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_NO_REMAINING_ACTIONS,
+	)
+	s.Equal(int64(2), result.ActionCount)
+}
+
+func (s *simulateSuite) TestCatchupWindow() {
+	schedule := s.everyMinute(enumspb.SCHEDULE_OVERLAP_POLICY_SKIP)
+	schedule.Policies.CatchupWindow = durationpb.New(time.Minute)
+	result := s.simulate(SimulationParams{
+		Schedule:   schedule,
+		ResumeTime: s.t0.Add(210 * time.Second),
+	})
+	s.checkOutcomes(result,
+		enumsspb.SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_CATCHUP_WINDOW,
+		enumsspb.SIMULATED_SCHEDULE_ACTION_OUTCOME_SKIPPED_CATCHUP_WINDOW,
+		enumsspb.SIMULATED_SCHEDULE_ACTION_OUTCOME_STARTED,
+		enumsspb.SIMULATED_SCHEDULE_ACTION_OUTCOME_STARTED,
+		enumsspb.SIMULATED_SCHEDULE_ACTION_OUTCOME_STARTED,
+	)
+	s.checkStartTimes(result, 210*time.Second, 4*time.Minute, 5*time.Minute)
+	s.Equal(int64(2), result.MissedCatchupWindow)
+	s.Equal(int64(3), result.ActionCount)
+}
+
+func (s *simulateSuite) TestTruncated() {
+	result := s.simulate(SimulationParams{
+		Schedule:   s.everyMinute(enumspb.SCHEDULE_OVERLAP_POLICY_SKIP),
+		EndTime:    s.t0.Add(time.Hour),
+		MaxActions: 3,
+	})
+	s.Len(result.Actions, 3)
+	s.True(result.Truncated)
+	s.Equal(int64(3), result.ActionCount)
+}
+
+func (s *simulateSuite) TestInvalidRange() {
+	_, err := Simulate(s.specBuilder, SimulationParams{
+		Schedule:  s.everyMinute(enumspb.SCHEDULE_OVERLAP_POLICY_SKIP),
+		StartTime: s.t0,
+		EndTime:   s.t0.Add(-time.Minute),
+	})
+	s.ErrorIs(err, errInvalidSimulationRange)
+}
@@ -33,6 +33,8 @@ import (
 
 	"github.com/dgryski/go-farm"
 	schedulepb "go.temporal.io/api/schedule/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -45,6 +47,8 @@ type (
 		tz       *time.Location
 		calendar []*compiledCalendar
 		excludes []*compiledCalendar
+		// Business calendars referenced by the schedule
+		businessCalendars []*compiledBusinessCalendar
 	}
 
 	GetNextTimeResult struct {
@@ -78,6 +82,15 @@ func NewSpecBuilder() *SpecBuilder {
 }
 
 func (b *SpecBuilder) NewCompiledSpec(spec *schedulepb.ScheduleSpec) (*CompiledSpec, error) {
+	return b.NewCompiledSpecWithBusinessCalendars(spec, nil)
+}
+
+// NewCompiledSpecWithBusinessCalendars compiles a spec that skips or shifts times that fall on
+// non-business days of the given (resolved) business calendars.
+func (b *SpecBuilder) NewCompiledSpecWithBusinessCalendars(
+	spec *schedulepb.ScheduleSpec,
+	businessCalendars []*schedulespb.ScheduleBusinessCalendar,
+) (*CompiledSpec, error) {
 	spec, err := canonicalizeSpec(spec)
 	if err != nil {
 		return nil, err
@@ -101,11 +114,26 @@ func (b *SpecBuilder) NewCompiledSpec(spec *schedulepb.ScheduleSpec) (*CompiledS
 		excludes[i] = newCompiledCalendar(excal, tz)
 	}
 
+	// compile business calendars
+	var cbcs []*compiledBusinessCalendar
+	for _, ref := range businessCalendars {
+		if ref.GetCalendar() == nil {
+			// not resolved, nothing to apply
+			continue
+		}
+		cbc, err := b.newCompiledBusinessCalendar(ref, tz)
+		if err != nil {
+			return nil, err
+		}
+		cbcs = append(cbcs, cbc)
+	}
+
 	cspec := &CompiledSpec{
-		spec:     spec,
-		tz:       tz,
-		calendar: ccs,
-		excludes: excludes,
+		spec:              spec,
+		tz:                tz,
+		calendar:          ccs,
+		excludes:          excludes,
+		businessCalendars: cbcs,
 	}
 
 	return cspec, nil
@@ -274,12 +302,15 @@ func (b *SpecBuilder) loadTimezone(spec *schedulepb.ScheduleSpec) (*time.Locatio
 	if spec.TimezoneData != nil {
 		return time.LoadLocationFromTZData(spec.TimezoneName, spec.TimezoneData)
 	}
+	return b.loadLocation(spec.TimezoneName)
+}
 
-	if cached, ok := b.locationCache.Get(spec.TimezoneName).(*locationAndError); ok {
+func (b *SpecBuilder) loadLocation(name string) (*time.Location, error) {
+	if cached, ok := b.locationCache.Get(name).(*locationAndError); ok {
 		return cached.loc, cached.err
 	}
-	loc, err := time.LoadLocation(spec.TimezoneName)
-	b.locationCache.Put(spec.TimezoneName, &locationAndError{
+	loc, err := time.LoadLocation(name)
+	b.locationCache.Put(name, &locationAndError{
 		loc: loc,
 		err: err,
 	})
@@ -304,10 +335,16 @@ func (cs *CompiledSpec) GetNextTime(jitterSeed string, after time.Time) GetNextT
 	}
 	var nominal time.Time
 	for nominal.IsZero() || cs.excluded(nominal) {
-		nominal = cs.rawNextTime(after)
-		after = nominal
+		raw := cs.rawNextTime(after)
+		after = raw
 
-		if nominal.IsZero() || pastEndTime(nominal) {
+		if raw.IsZero() || pastEndTime(raw) {
+			return GetNextTimeResult{}
+		}
+		// Times that are moved to the next business day may land on later matching times,
+		// which are then skipped by the next call since they're not after the moved time.
+		var ok bool
+		if nominal, ok = cs.applyBusinessCalendars(raw); ok && pastEndTime(nominal) {
 			return GetNextTimeResult{}
 		}
 	}
@@ -372,6 +409,33 @@ func (cs *CompiledSpec) excluded(nominal time.Time) bool {
 	return false
 }
 
+// Applies business calendars to a matching time. Returns the time, possibly moved to a later
+// business day, or false if the time should be skipped.
+func (cs *CompiledSpec) applyBusinessCalendars(nominal time.Time) (time.Time, bool) {
+	// Moving a time for one calendar can land on a non-business day of another one, so repeat
+	// until all calendars agree.
+	for range maxBusinessCalendarShiftDays {
+		moved := false
+		for _, bc := range cs.businessCalendars {
+			if bc.isBusinessDay(nominal) {
+				continue
+			}
+			if bc.action != enumsspb.BUSINESS_CALENDAR_ACTION_SHIFT_NEXT_BUSINESS_DAY {
+				return time.Time{}, false
+			}
+			next, ok := bc.nextBusinessDay(nominal)
+			if !ok {
+				return time.Time{}, false
+			}
+			nominal, moved = next, true
+		}
+		if !moved {
+			return nominal, true
+		}
+	}
+	return time.Time{}, false
+}
+
 // Adds jitter to a nominal time, deterministically (by hashing the given time and a seed).
 func (cs *CompiledSpec) addJitter(seed string, nominal time.Time, maxJitter time.Duration) time.Time {
 	if maxJitter < 0 {
@@ -96,10 +96,11 @@ const (
 	// id, used for validation in the frontend.
 	AppendedTimestampForValidation = "-2009-11-10T23:00:00Z"
 
-	SignalNameUpdate   = "update"
-	SignalNamePatch    = "patch"
-	SignalNameRefresh  = "refresh"
-	SignalNameForceCAN = "force-continue-as-new"
+	SignalNameUpdate               = "update"
+	SignalNamePatch                = "patch"
+	SignalNameRefresh              = "refresh"
+	SignalNameForceCAN             = "force-continue-as-new"
+	SignalNameSetBusinessCalendars = "set-business-calendars"
 
 	QueryNameDescribe          = "describe"
 	QueryNameListMatchingTimes = "listMatchingTimes"
@@ -145,9 +146,10 @@ type (
 		watchingFuture     workflow.Future
 
 		// Signal requests
-		pendingPatch  *schedulepb.SchedulePatch
-		pendingUpdate *schedulespb.FullUpdateRequest
-		forceCAN      bool
+		pendingPatch             *schedulepb.SchedulePatch
+		pendingUpdate            *schedulespb.FullUpdateRequest
+		pendingBusinessCalendars *schedulespb.SetBusinessCalendarsRequest
+		forceCAN                 bool
 
 		uuidBatch []string
 
@@ -292,10 +294,12 @@ func (s *scheduler) run() error {
 		} else {
 			suggestContinueAsNew = suggestContinueAsNew || info.GetContinueAsNewSuggested() || s.forceCAN
 		}
-		if suggestContinueAsNew && s.pendingUpdate == nil && s.pendingPatch == nil {
+		if suggestContinueAsNew && s.pendingUpdate == nil && s.pendingPatch == nil && s.pendingBusinessCalendars == nil {
 			break
 		}
 
+		s.refreshBusinessCalendars()
+
 		t1 := timestamp.TimeValue(s.State.LastProcessedTime)
 		t2 := s.now()
 		if t2.Before(t1) {
@@ -339,7 +343,7 @@ func (s *scheduler) run() error {
 		if exp := s.getRetentionExpiration(nextWakeup); !exp.IsZero() && !exp.After(s.now()) {
 			return nil
 		}
-		if suggestContinueAsNew && s.pendingUpdate == nil && s.pendingPatch == nil && s.hasMinVersion(CANAfterSignals) {
+		if suggestContinueAsNew && s.pendingUpdate == nil && s.pendingPatch == nil && s.pendingBusinessCalendars == nil && s.hasMinVersion(CANAfterSignals) {
 			// If suggestContinueAsNew was true but we had a pending update or patch, we would
 			// not break above, but process the update/patch. Now that we're done, we should
 			// break here to do CAN. (pendingUpdate and pendingPatch should always be nil here,
@@ -395,7 +399,7 @@ func (s *scheduler) compileSpec() {
 	s.nextTimeCacheV1 = nil
 	s.nextTimeCacheV2 = nil
 
-	cspec, err := s.specBuilder.NewCompiledSpec(s.Schedule.Spec)
+	cspec, err := s.specBuilder.NewCompiledSpecWithBusinessCalendars(s.Schedule.Spec, s.State.GetBusinessCalendars())
 	if err != nil {
 		if s.logger != nil {
 			s.logger.Error("Invalid schedule", "error", err)
@@ -719,6 +723,11 @@ func (s *scheduler) sleep(nextWakeup time.Time) {
 		ch.Receive(s.ctx, &s.pendingPatch)
 	})
 
+	calendarsCh := workflow.GetSignalChannel(s.ctx, SignalNameSetBusinessCalendars)
+	sel.AddReceive(calendarsCh, func(ch workflow.ReceiveChannel, _ bool) {
+		ch.Receive(s.ctx, &s.pendingBusinessCalendars)
+	})
+
 	refreshCh := workflow.GetSignalChannel(s.ctx, SignalNameRefresh)
 	sel.AddReceive(refreshCh, s.handleRefreshSignal)
 
@@ -918,6 +927,18 @@ func (s *scheduler) processUpdate(req *schedulespb.FullUpdateRequest) {
 	s.incSeqNo()
 }
 
+// processSetBusinessCalendars replaces the business calendars that the schedule references. The
+// frontend resolves their definitions before sending the signal.
+func (s *scheduler) processSetBusinessCalendars(req *schedulespb.SetBusinessCalendarsRequest) {
+	s.logger.Debug("Schedule business calendars update")
+
+	s.State.BusinessCalendars = req.GetBusinessCalendars()
+	s.compileSpec()
+
+	s.Info.UpdateTime = timestamppb.New(s.now())
+	s.incSeqNo()
+}
+
 func (s *scheduler) handleRefreshSignal(ch workflow.ReceiveChannel, _ bool) {
 	ch.Receive(s.ctx, nil)
 	s.logger.Debug("got refresh signal")
@@ -943,6 +964,11 @@ func (s *scheduler) processSignals() bool {
 		s.pendingUpdate = nil
 		scheduleChanged = true
 	}
+	if s.pendingBusinessCalendars != nil {
+		s.processSetBusinessCalendars(s.pendingBusinessCalendars)
+		s.pendingBusinessCalendars = nil
+		scheduleChanged = true
+	}
 	return scheduleChanged
 }
 
@@ -1169,19 +1195,30 @@ func (s *scheduler) updateTweakables() {
 }
 
 func (s *scheduler) getCatchupWindow() time.Duration {
-	cw := s.Schedule.Policies.CatchupWindow
+	return getCatchupWindow(s.Schedule.Policies, s.tweakables)
+}
+
+func getCatchupWindow(policies *schedulepb.SchedulePolicies, tweakables TweakablePolicies) time.Duration {
+	cw := policies.GetCatchupWindow()
 	if cw == nil {
-		return s.tweakables.DefaultCatchupWindow
-	} else if cw.AsDuration() < s.tweakables.MinCatchupWindow {
-		return s.tweakables.MinCatchupWindow
+		return tweakables.DefaultCatchupWindow
+	} else if cw.AsDuration() < tweakables.MinCatchupWindow {
+		return tweakables.MinCatchupWindow
 	} else {
 		return cw.AsDuration()
 	}
 }
 
 func (s *scheduler) resolveOverlapPolicy(overlapPolicy enumspb.ScheduleOverlapPolicy) enumspb.ScheduleOverlapPolicy {
+	return resolveOverlapPolicy(s.Schedule.Policies, overlapPolicy)
+}
+
+func resolveOverlapPolicy(
+	policies *schedulepb.SchedulePolicies,
+	overlapPolicy enumspb.ScheduleOverlapPolicy,
+) enumspb.ScheduleOverlapPolicy {
 	if overlapPolicy == enumspb.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED {
-		overlapPolicy = s.Schedule.Policies.OverlapPolicy
+		overlapPolicy = policies.GetOverlapPolicy()
 	}
 	if overlapPolicy == enumspb.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED {
 		overlapPolicy = enumspb.SCHEDULE_OVERLAP_POLICY_SKIP
@@ -1402,11 +1439,16 @@ func (s *scheduler) identity() string {
 
 func (s *scheduler) jitterSeed() string {
 	if s.hasMinVersion(NewCacheAndJitter) {
-		return fmt.Sprintf("%s-%s", s.State.NamespaceId, s.State.ScheduleId)
+		return JitterSeed(s.State.NamespaceId, s.State.ScheduleId)
 	}
 	return ""
 }
 
+// JitterSeed returns the seed used to compute jitter for the schedule with the given id.
+func JitterSeed(namespaceID, scheduleID string) string {
+	return fmt.Sprintf("%s-%s", namespaceID, scheduleID)
+}
+
 func (s *scheduler) addSearchAttributes(
 	attributes *commonpb.SearchAttributes,
 	nominal time.Time,
@@ -1464,6 +1506,35 @@ func (s *scheduler) startLongPollWatcher(ex *commonpb.WorkflowExecution) {
 	s.watchingWorkflowId = ex.WorkflowId
 }
 
+// refreshBusinessCalendars picks up changes to the definitions of the business calendars that
+// the schedule references. This is done every time the schedule is evaluated, so that changes
+// apply to the next actions.
+func (s *scheduler) refreshBusinessCalendars() {
+	// Schedules that don't reference business calendars don't run the activity, which keeps
+	// their histories compatible with earlier versions.
+	if len(s.State.BusinessCalendars) == 0 {
+		return
+	}
+	ctx := workflow.WithLocalActivityOptions(s.ctx, defaultLocalActivityOptions)
+	req := &schedulespb.ResolveBusinessCalendarsRequest{
+		BusinessCalendars: s.State.BusinessCalendars,
+	}
+	var res schedulespb.ResolveBusinessCalendarsResponse
+	err := workflow.ExecuteLocalActivity(ctx, s.a.ResolveBusinessCalendars, req).Get(s.ctx, &res)
+	if err != nil {
+		// keep using the current definitions
+		s.logger.Error("resolve business calendars failed", "error", err)
+		return
+	}
+	if slices.EqualFunc(s.State.BusinessCalendars, res.BusinessCalendars, func(a, b *schedulespb.ScheduleBusinessCalendar) bool {
+		return proto.Equal(a, b)
+	}) {
+		return
+	}
+	s.State.BusinessCalendars = res.BusinessCalendars
+	s.compileSpec()
+}
+
 func (s *scheduler) cancelWorkflow(ex *commonpb.WorkflowExecution) {
 	ctx := workflow.WithLocalActivityOptions(s.ctx, defaultLocalActivityOptions)
 	areq := &schedulespb.CancelWorkflowRequest{
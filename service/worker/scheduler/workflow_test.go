@@ -43,7 +43,9 @@ import (
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/sdk/testsuite"
 	"go.temporal.io/sdk/workflow"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/searchattribute"
@@ -380,6 +382,104 @@ func (s *workflowSuite) TestStart() {
 	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
 }
 
+func (s *workflowSuite) TestBusinessCalendarRefresh() {
+	calendar := &schedulespb.BusinessCalendar{Name: "holidays"}
+	resolves := 0
+	s.env.OnActivity(new(activities).ResolveBusinessCalendars, mock.Anything, mock.Anything).Times(3).Return(
+		func(_ context.Context, req *schedulespb.ResolveBusinessCalendarsRequest) (*schedulespb.ResolveBusinessCalendarsResponse, error) {
+			resolves++
+			resolved := common.CloneProto(req.BusinessCalendars[0])
+			if resolves == 3 {
+				// the calendar is updated after the first action
+				resolved.Calendar = &schedulespb.BusinessCalendar{
+					Name:     "holidays",
+					Holidays: []*schedulespb.BusinessCalendarDate{{Month: 6, Day: 1}},
+				}
+			}
+			return &schedulespb.ResolveBusinessCalendarsResponse{
+				BusinessCalendars: []*schedulespb.ScheduleBusinessCalendar{resolved},
+			}, nil
+		})
+	s.expectStart(func(req *schedulespb.StartWorkflowRequest) (*schedulespb.StartWorkflowResponse, error) {
+		s.True(time.Date(2022, 6, 1, 1, 0, 0, 0, time.UTC).Equal(s.now()))
+		return nil, nil
+	})
+
+	CurrentTweakablePolicies.IterationsBeforeContinueAsNew = 3
+	s.env.SetStartTime(baseStartTime)
+	s.env.ExecuteWorkflow(SchedulerWorkflow, &schedulespb.StartScheduleArgs{
+		Schedule: &schedulepb.Schedule{
+			Spec: &schedulepb.ScheduleSpec{
+				Interval: []*schedulepb.IntervalSpec{{
+					Interval: durationpb.New(time.Hour),
+				}},
+			},
+			Action: s.defaultAction("myid"),
+		},
+		State: &schedulespb.InternalState{
+			Namespace:     "myns",
+			NamespaceId:   "mynsid",
+			ScheduleId:    "myschedule",
+			ConflictToken: InitialConflictToken,
+			BusinessCalendars: []*schedulespb.ScheduleBusinessCalendar{{
+				Name:     "holidays",
+				Action:   enumsspb.BUSINESS_CALENDAR_ACTION_SKIP,
+				Calendar: calendar,
+			}},
+		},
+	})
+	// the action at 02:00 is skipped since the calendar was updated before it
+	s.True(s.env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
+	s.Equal(3, resolves)
+}
+
+func (s *workflowSuite) TestSetBusinessCalendars() {
+	ref := &schedulespb.ScheduleBusinessCalendar{
+		Name:   "holidays",
+		Action: enumsspb.BUSINESS_CALENDAR_ACTION_SKIP,
+		Calendar: &schedulespb.BusinessCalendar{
+			Name:     "holidays",
+			Holidays: []*schedulespb.BusinessCalendarDate{{Month: 6, Day: 1}},
+		},
+	}
+	s.env.OnActivity(new(activities).ResolveBusinessCalendars, mock.Anything, mock.Anything).Once().Return(
+		&schedulespb.ResolveBusinessCalendarsResponse{
+			BusinessCalendars: []*schedulespb.ScheduleBusinessCalendar{ref},
+		}, nil)
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNameSetBusinessCalendars, &schedulespb.SetBusinessCalendarsRequest{
+			BusinessCalendars: []*schedulespb.ScheduleBusinessCalendar{ref},
+		})
+	}, 30*time.Minute)
+	// all actions on the holiday are skipped
+	s.expectStart(func(req *schedulespb.StartWorkflowRequest) (*schedulespb.StartWorkflowResponse, error) {
+		s.True(time.Date(2022, 6, 2, 0, 0, 0, 0, time.UTC).Equal(s.now()))
+		return nil, nil
+	})
+
+	CurrentTweakablePolicies.IterationsBeforeContinueAsNew = 3
+	s.env.SetStartTime(baseStartTime)
+	s.env.ExecuteWorkflow(SchedulerWorkflow, &schedulespb.StartScheduleArgs{
+		Schedule: &schedulepb.Schedule{
+			Spec: &schedulepb.ScheduleSpec{
+				Interval: []*schedulepb.IntervalSpec{{
+					Interval: durationpb.New(time.Hour),
+				}},
+			},
+			Action: s.defaultAction("myid"),
+		},
+		State: &schedulespb.InternalState{
+			Namespace:     "myns",
+			NamespaceId:   "mynsid",
+			ScheduleId:    "myschedule",
+			ConflictToken: InitialConflictToken,
+		},
+	})
+	s.True(s.env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
+}
+
 func (s *workflowSuite) TestInitialPatch() {
 	// written using low-level mocks so we can set initial patch
 
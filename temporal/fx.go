@@ -221,13 +221,16 @@ func ServerOptionsProvider(opts []ServerOption) (serverOptionsProvider, error) {
 	// DynamicConfigClient
 	dcClient := so.dynamicConfigClient
 	if dcClient == nil {
-		dcConfig := so.config.DynamicConfigClient
-		if dcConfig != nil {
-			dcClient, err = dynamicconfig.NewFileBasedClient(dcConfig, logger, stopChan)
-			if err != nil {
-				return serverOptionsProvider{}, fmt.Errorf("unable to create dynamic config client: %w", err)
-			}
-		} else {
+		dcClient, err = dynamicconfig.NewClientFromConfig(
+			so.config.DynamicConfigClient,
+			so.config.RemoteDynamicConfigClient,
+			logger,
+			stopChan,
+		)
+		if err != nil {
+			return serverOptionsProvider{}, fmt.Errorf("unable to create dynamic config client: %w", err)
+		}
+		if dcClient == nil {
 			// noop client
 			logger.Info("Dynamic config client is not configured. Using default values.")
 			dcClient = dynamicconfig.NewNoopClient()
@@ -282,7 +282,7 @@ func NewLiteServer(liteConfig *LiteServerConfig, opts ...temporal.ServerOption)
 		return nil, fmt.Errorf("error creating namespaces: %w", err)
 	}
 
-	authorizer, err := authorization.GetAuthorizerFromConfig(&liteConfig.BaseConfig.Global.Authorization)
+	authorizer, err := authorization.NewAuthorizerFromConfig(&liteConfig.BaseConfig.Global.Authorization, liteConfig.Logger)
 	if err != nil {
 		return nil, fmt.Errorf("unable to instantiate authorizer: %w", err)
 	}
@@ -305,8 +305,8 @@ func NewLiteServer(liteConfig *LiteServerConfig, opts ...temporal.ServerOption)
 	if len(liteConfig.DynamicConfig) > 0 {
 		// To prevent having to code fall-through semantics right now, we currently
 		// eagerly fail if dynamic config is being configured in two ways
-		if liteConfig.BaseConfig.DynamicConfigClient != nil {
-			return nil, fmt.Errorf("unable to have file-based dynamic config and individual dynamic config values")
+		if liteConfig.BaseConfig.DynamicConfigClient != nil || liteConfig.BaseConfig.RemoteDynamicConfigClient != nil {
+			return nil, fmt.Errorf("unable to have file-based or remote dynamic config and individual dynamic config values")
 		}
 		serverOpts = append(serverOpts, temporal.WithDynamicConfigClient(liteConfig.DynamicConfig))
 	}
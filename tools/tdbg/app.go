@@ -25,6 +25,7 @@
 package tdbg
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -41,6 +42,12 @@ type (
 	Params struct {
 		// ClientFactory creates Temporal service clients for tdbg to use.
 		ClientFactory ClientFactory
+		// AddressClientFactory creates Temporal service clients for an explicitly given frontend address,
+		// e.g. to compare a workflow between clusters. The default is NewAddressClientFactory.
+		AddressClientFactory AddressClientFactory
+		// PersistenceFactory creates persistence clients for commands which bypass the Temporal services and
+		// access the database directly, e.g. shard scan. The default is ConfigPersistenceFactory.
+		PersistenceFactory PersistenceFactory
 		// TaskCategoryRegistry is used to determine which task categories are available for tdbg to use.
 		TaskCategoryRegistry tasks.TaskCategoryRegistry
 		// Writer is used to write output from tdbg. The default is os.Stdout.
@@ -58,6 +65,8 @@ type (
 func NewCliApp(opts ...Option) *cli.App {
 	params := Params{
 		ClientFactory:        NewClientFactory(),
+		AddressClientFactory: NewAddressClientFactory(),
+		PersistenceFactory:   ConfigPersistenceFactory{},
 		TaskCategoryRegistry: tasks.NewDefaultTaskCategoryRegistry(),
 		Writer:               os.Stdout,
 		ErrWriter:            os.Stderr,
@@ -133,6 +142,8 @@ func NewCliApp(opts ...Option) *cli.App {
 	prompterFactory := NewPrompterFactory()
 	app.Commands = getCommands(
 		params.ClientFactory,
+		params.AddressClientFactory,
+		params.PersistenceFactory,
 		NewDLQServiceProvider(
 			params.ClientFactory,
 			params.TaskBlobEncoder,
@@ -156,6 +167,17 @@ func handleError(c *cli.Context, err error) {
 		return
 	}
 
+	exitCode := 1
+	var exitCoder cli.ExitCoder
+	if errors.As(err, &exitCoder) {
+		exitCode = exitCoder.ExitCode()
+		// an exit coder without message only reports a result through the exit code, e.g. that a diff was found
+		if exitCoder.Error() == "" {
+			cli.OsExiter(exitCode)
+			return
+		}
+	}
+
 	_, _ = fmt.Fprintf(c.App.ErrWriter, "%s %+v\n", color.Red(c, "Error:"), err)
 	if os.Getenv(showErrorStackEnv) != `` {
 		_, _ = fmt.Fprintln(c.App.ErrWriter, color.Magenta(c, "Stack trace:"))
@@ -164,5 +186,5 @@ func handleError(c *cli.Context, err error) {
 		_, _ = fmt.Fprintf(c.App.ErrWriter, "('export %s=1' to see stack traces)\n", showErrorStackEnv)
 	}
 
-	cli.OsExiter(1)
+	cli.OsExiter(exitCode)
 }
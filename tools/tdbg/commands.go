@@ -293,6 +293,17 @@ func AdminDescribeWorkflow(c *cli.Context, clientFactory ClientFactory) error {
 
 		fmt.Fprintf(c.App.Writer, "History service address: %s\n", resp.GetHistoryAddr())
 		fmt.Fprintf(c.App.Writer, "Shard Id: %s\n", resp.GetShardId())
+
+		if outputFileName := c.String(FlagOutputFilename); outputFileName != "" {
+			encoder := codec.NewJSONPBIndentEncoder("  ")
+			data, err := encoder.Encode(resp)
+			if err != nil {
+				return fmt.Errorf("unable to serialize Workflow Mutable State: %s", err)
+			}
+			if err := os.WriteFile(outputFileName, data, 0666); err != nil {
+				return fmt.Errorf("unable to write Workflow Mutable State file: %s", err)
+			}
+		}
 	}
 	return nil
 }
@@ -59,6 +59,9 @@ type (
 		AdminClient(c *cli.Context) adminservice.AdminServiceClient
 		WorkflowClient(c *cli.Context) workflowservice.WorkflowServiceClient
 	}
+	// AddressClientFactory creates a ClientFactory whose clients connect to the given frontend address.
+	// It is used by commands which talk to more than one cluster.
+	AddressClientFactory func(address string) ClientFactory
 	// ClientFactoryOption is used to configure the ClientFactory via NewClientFactory.
 	ClientFactoryOption func(params *clientFactoryParams)
 	// DefaultFrontendAddressProvider uses FlagAddress to determine the frontend address, defaulting to
@@ -98,6 +101,14 @@ func NewClientFactory(opts ...ClientFactoryOption) ClientFactory {
 	}
 }
 
+// NewAddressClientFactory creates a new AddressClientFactory, which uses NewClientFactory with WithFrontendAddress
+// to create clients for the given address.
+func NewAddressClientFactory() AddressClientFactory {
+	return func(address string) ClientFactory {
+		return NewClientFactory(WithFrontendAddress(address))
+	}
+}
+
 // WithFrontendAddress ensures that admin clients created by the factory will connect to the specified address.
 func WithFrontendAddress(address string) ClientFactoryOption {
 	return func(params *clientFactoryParams) {
@@ -91,4 +91,26 @@ var (
 	FlagBuildIDs                   = "select-build-id"
 	FlagUnversioned                = "select-unversioned"
 	FlagAllActive                  = "select-all-active"
+	FlagLeftAddress                = "left-address"
+	FlagRightAddress               = "right-address"
+	FlagLeftFile                   = "left-file"
+	FlagRightFile                  = "right-file"
+	FlagIgnoreField                = "ignore-field"
+	FlagConfigDir                  = "config-dir"
+	FlagEnv                        = "env"
+	FlagZone                       = "zone"
+	FlagMinShardID                 = "min-shard-id"
+	FlagMaxShardID                 = "max-shard-id"
+	FlagFix                        = "fix"
+	FlagDeep                       = "deep"
+	FlagScanHistoryBranches        = "scan-history-branches"
+	FlagMinBranchAge               = "min-branch-age"
+	FlagRPS                        = "rps"
+	FlagTargetTaskQueue            = "target-task-queue"
+	FlagTargetPartitionID          = "target-partition-id"
+	FlagBuildID                    = "build-id"
+	FlagSubqueue                   = "subqueue"
+	FlagCopy                       = "copy"
+	FlagSetRateLimit               = "set-rate-limit"
+	FlagRemoveActivityType         = "remove-activity-type"
 )
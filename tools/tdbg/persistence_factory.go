@@ -0,0 +1,124 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+	otelnoop "go.opentelemetry.io/otel/trace/noop"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	persistenceClient "go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/persistence/serialization"
+	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"      // needed to load mysql plugin
+	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql" // needed to load postgresql plugin
+	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"     // needed to load sqlite plugin
+	"go.temporal.io/server/common/resolver"
+)
+
+type (
+	// PersistenceFactory creates persistence clients for commands which access the database directly
+	// instead of going through the Temporal services, e.g. during incidents when a service is unhealthy.
+	PersistenceFactory interface {
+		NewPersistenceClients(c *cli.Context) (*PersistenceClients, error)
+	}
+
+	// PersistenceClients are the persistence managers used by commands which access the database directly.
+	PersistenceClients struct {
+		ExecutionManager persistence.ExecutionManager
+		MetadataManager  persistence.MetadataManager
+		NumHistoryShards int32
+		closeFns         []func()
+	}
+
+	// ConfigPersistenceFactory creates persistence clients from the static config of the Temporal server,
+	// which is loaded from FlagConfigDir, FlagEnv and FlagZone.
+	ConfigPersistenceFactory struct{}
+)
+
+var _ PersistenceFactory = ConfigPersistenceFactory{}
+
+// NewPersistenceClients loads the server config and connects to its default data store.
+func (ConfigPersistenceFactory) NewPersistenceClients(c *cli.Context) (*PersistenceClients, error) {
+	cfg, err := config.LoadConfig(c.String(FlagEnv), c.String(FlagConfigDir), c.String(FlagZone))
+	if err != nil {
+		return nil, fmt.Errorf("unable to load server config: %w", err)
+	}
+	if cfg.ClusterMetadata == nil {
+		return nil, errors.New("server config has no clusterMetadata")
+	}
+
+	logger := log.NewCLILogger()
+	clusterName := persistenceClient.ClusterName(cfg.ClusterMetadata.CurrentClusterName)
+	dataStoreFactory := persistenceClient.DataStoreFactoryProvider(
+		clusterName,
+		resolver.NewNoopResolver(),
+		&cfg.Persistence,
+		nil,
+		logger,
+		metrics.NoopMetricsHandler,
+		otelnoop.NewTracerProvider(),
+	)
+	factory := persistenceClient.NewFactory(
+		dataStoreFactory,
+		&cfg.Persistence,
+		nil,
+		nil,
+		nil,
+		serialization.NewSerializer(),
+		nil,
+		string(clusterName),
+		metrics.NoopMetricsHandler,
+		logger,
+		persistence.NoopHealthSignalAggregator,
+	)
+
+	clients := &PersistenceClients{
+		NumHistoryShards: cfg.Persistence.NumHistoryShards,
+		closeFns:         []func(){dataStoreFactory.Close},
+	}
+	if clients.ExecutionManager, err = factory.NewExecutionManager(); err != nil {
+		clients.Close()
+		return nil, fmt.Errorf("unable to create execution manager: %w", err)
+	}
+	clients.closeFns = append(clients.closeFns, clients.ExecutionManager.Close)
+	if clients.MetadataManager, err = factory.NewMetadataManager(); err != nil {
+		clients.Close()
+		return nil, fmt.Errorf("unable to create metadata manager: %w", err)
+	}
+	clients.closeFns = append(clients.closeFns, clients.MetadataManager.Close)
+	return clients, nil
+}
+
+// Close closes all persistence managers and the connection to the data store.
+func (p *PersistenceClients) Close() {
+	for i := len(p.closeFns) - 1; i >= 0; i-- {
+		p.closeFns[i]()
+	}
+}
@@ -0,0 +1,568 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/worker/scanner/executions"
+	"golang.org/x/exp/maps"
+)
+
+const (
+	// scanFindingDanglingHistoryBranch is the finding type of a history branch whose execution no longer exists
+	scanFindingDanglingHistoryBranch = "dangling_history_branch"
+	// scanFindingOrphanHistoryTask is the finding type of a history task whose execution no longer exists
+	scanFindingOrphanHistoryTask = "orphan_history_task"
+	// scanFindingValidationError is the finding type of an execution which can't be validated at all
+	scanFindingValidationError = "validation_error"
+
+	scanFixDeleteExecution     = "delete_execution"
+	scanFixDeleteHistoryBranch = "delete_history_branch"
+	scanFixCompleteHistoryTask = "complete_history_task"
+
+	// scanExitCodeFindings is the exit code of shard scan when unfixed findings remain
+	scanExitCodeFindings = 1
+	// scanExitCodeError is the exit code of shard scan when the scan itself fails
+	scanExitCodeError = 2
+
+	// scanExecutionDataDurationBuffer is the same default buffer the executions scavenger adds on top of retention
+	scanExecutionDataDurationBuffer = 90 * 24 * time.Hour
+)
+
+type (
+	// ShardScanReport is the JSON report emitted by shard scan.
+	ShardScanReport struct {
+		MinShardID             int32              `json:"minShardId"`
+		MaxShardID             int32              `json:"maxShardId"`
+		ExecutionsScanned      int                `json:"executionsScanned"`
+		HistoryTasksScanned    int                `json:"historyTasksScanned"`
+		HistoryBranchesScanned int                `json:"historyBranchesScanned"`
+		Findings               []ShardScanFinding `json:"findings"`
+	}
+
+	// ShardScanFinding is a single inconsistency found by shard scan.
+	ShardScanFinding struct {
+		ShardID     int32  `json:"shardId"`
+		Type        string `json:"type"`
+		Details     string `json:"details,omitempty"`
+		NamespaceID string `json:"namespaceId,omitempty"`
+		WorkflowID  string `json:"workflowId,omitempty"`
+		RunID       string `json:"runId,omitempty"`
+		// TaskCategory, TaskID and TaskFireTime identify the history task of an orphan_history_task finding
+		TaskCategory string     `json:"taskCategory,omitempty"`
+		TaskID       int64      `json:"taskId,omitempty"`
+		TaskFireTime *time.Time `json:"taskFireTime,omitempty"`
+		// BranchTokens are the history branches of a dangling_history_branch finding
+		BranchTokens [][]byte `json:"branchTokens,omitempty"`
+		// Fix is the action which resolves the finding, it's empty if the finding needs manual intervention
+		Fix      string `json:"fix,omitempty"`
+		Fixed    bool   `json:"fixed,omitempty"`
+		FixError string `json:"fixError,omitempty"`
+
+		taskCategory tasks.Category
+		taskKey      tasks.Key
+	}
+
+	shardScanner struct {
+		executionManager     persistence.ExecutionManager
+		adminClient          adminservice.AdminServiceClient
+		namespaceGetter      executions.NamespaceGetter
+		taskCategoryRegistry tasks.TaskCategoryRegistry
+		rateLimiter          quotas.RateLimiter
+		numHistoryShards     int32
+		pageSize             int
+		deep                 bool
+		minBranchAge         time.Duration
+		report               *ShardScanReport
+	}
+
+	// metadataNamespaceGetter looks up namespaces directly from the metadata store,
+	// as there is no namespace registry when running outside of a Temporal service.
+	metadataNamespaceGetter struct {
+		ctx             context.Context
+		metadataManager persistence.MetadataManager
+		namespaces      map[namespace.ID]*namespace.Namespace
+	}
+)
+
+// AdminScanShards checks executions, history branches and history tasks of a shard range for inconsistencies.
+func AdminScanShards(
+	c *cli.Context,
+	clientFactory ClientFactory,
+	persistenceFactory PersistenceFactory,
+	taskCategoryRegistry tasks.TaskCategoryRegistry,
+	prompter *Prompter,
+) error {
+	minShardID := int32(c.Int(FlagMinShardID))
+	maxShardID := int32(c.Int(FlagMaxShardID))
+	if minShardID < 1 || maxShardID < minShardID {
+		return cli.Exit(fmt.Sprintf("invalid shard range [%d, %d]", minShardID, maxShardID), scanExitCodeError)
+	}
+
+	clients, err := persistenceFactory.NewPersistenceClients(c)
+	if err != nil {
+		return cli.Exit(err.Error(), scanExitCodeError)
+	}
+	defer clients.Close()
+	if maxShardID > clients.NumHistoryShards {
+		return cli.Exit(fmt.Sprintf("max shard ID %d is larger than the number of history shards %d",
+			maxShardID, clients.NumHistoryShards), scanExitCodeError)
+	}
+
+	ctx := c.Context
+	scanner := &shardScanner{
+		executionManager:     clients.ExecutionManager,
+		namespaceGetter:      newMetadataNamespaceGetter(ctx, clients.MetadataManager),
+		taskCategoryRegistry: taskCategoryRegistry,
+		rateLimiter:          quotas.NewRateLimiter(float64(c.Int(FlagRPS)), c.Int(FlagRPS)),
+		numHistoryShards:     clients.NumHistoryShards,
+		pageSize:             c.Int(FlagPageSize),
+		deep:                 c.Bool(FlagDeep),
+		minBranchAge:         c.Duration(FlagMinBranchAge),
+		report: &ShardScanReport{
+			MinShardID: minShardID,
+			MaxShardID: maxShardID,
+			Findings:   []ShardScanFinding{},
+		},
+	}
+	for shardID := minShardID; shardID <= maxShardID; shardID++ {
+		if err := scanner.scanShard(ctx, shardID); err != nil {
+			return cli.Exit(fmt.Sprintf("unable to scan shard %d: %s", shardID, err), scanExitCodeError)
+		}
+	}
+	if c.Bool(FlagScanHistoryBranches) {
+		if err := scanner.scanHistoryBranches(ctx); err != nil {
+			return cli.Exit(fmt.Sprintf("unable to scan history branches: %s", err), scanExitCodeError)
+		}
+	}
+
+	report := scanner.report
+	if c.Bool(FlagFix) {
+		if fixes := report.fixCount(); fixes > 0 {
+			prompter.Prompt(fmt.Sprintf("Found %d findings, %d of them can be fixed by deleting data. Apply fixes?",
+				len(report.Findings), fixes))
+			// executions are deleted through the admin API so that history and visibility are cleaned up as well
+			scanner.adminClient = clientFactory.AdminClient(c)
+			scanner.fix(ctx)
+		}
+	}
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("unable to encode report: %s", err), scanExitCodeError)
+	}
+	if outputFileName := c.String(FlagOutputFilename); outputFileName != "" {
+		if err := os.WriteFile(outputFileName, data, 0666); err != nil {
+			return cli.Exit(fmt.Sprintf("unable to write report file: %s", err), scanExitCodeError)
+		}
+	} else {
+		fmt.Fprintln(c.App.Writer, string(data))
+	}
+
+	for _, finding := range report.Findings {
+		if !finding.Fixed {
+			return cli.Exit("", scanExitCodeFindings)
+		}
+	}
+	return nil
+}
+
+func (s *shardScanner) scanShard(ctx context.Context, shardID int32) error {
+	validators := []executions.Validator{
+		executions.NewMutableStateValidator(
+			s.namespaceGetter,
+			dynamicconfig.GetDurationPropertyFn(scanExecutionDataDurationBuffer),
+		),
+	}
+	if s.deep {
+		validators = append(validators, executions.NewHistoryBranchValidator(shardID, s.executionManager))
+	} else {
+		validators = append(validators, executions.NewHistoryEventIDValidator(shardID, s.executionManager))
+	}
+
+	scanned := make(map[definition.WorkflowKey]struct{})
+	var pageToken []byte
+	for doContinue := true; doContinue; doContinue = len(pageToken) != 0 {
+		if err := s.rateLimiter.Wait(ctx); err != nil {
+			return err
+		}
+		resp, err := s.executionManager.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+			ShardID:   shardID,
+			PageSize:  s.pageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return err
+		}
+		pageToken = resp.PageToken
+
+		for _, state := range resp.States {
+			executionInfo := state.GetExecutionInfo()
+			executionState := state.GetExecutionState()
+			key := definition.NewWorkflowKey(executionInfo.GetNamespaceId(), executionInfo.GetWorkflowId(), executionState.GetRunId())
+			scanned[key] = struct{}{}
+			s.report.ExecutionsScanned++
+
+			if err := s.validateExecution(ctx, shardID, key, &executions.MutableState{WorkflowMutableState: state}, validators); err != nil {
+				return err
+			}
+		}
+	}
+
+	categories := maps.Values(s.taskCategoryRegistry.GetCategories())
+	slices.SortFunc(categories, func(a, b tasks.Category) int { return a.ID() - b.ID() })
+	for _, category := range categories {
+		if err := s.scanHistoryTasks(ctx, shardID, category, scanned); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *shardScanner) validateExecution(
+	ctx context.Context,
+	shardID int32,
+	key definition.WorkflowKey,
+	mutableState *executions.MutableState,
+	validators []executions.Validator,
+) error {
+	// validators are run in order and stop at the first failure, same as the executions scavenger
+	for _, validator := range validators {
+		if err := s.rateLimiter.Wait(ctx); err != nil {
+			return err
+		}
+		results, err := validator.Validate(ctx, mutableState)
+		if err != nil {
+			if ctx.Err() != nil {
+				return err
+			}
+			s.addExecutionFinding(shardID, key, scanFindingValidationError, err.Error())
+			return nil
+		}
+		for _, result := range results {
+			s.addExecutionFinding(shardID, key, result.FailureType(), result.FailureDetails())
+		}
+		if len(results) > 0 {
+			return nil
+		}
+	}
+	return nil
+}
+
+func (s *shardScanner) addExecutionFinding(
+	shardID int32,
+	key definition.WorkflowKey,
+	findingType string,
+	details string,
+) {
+	finding := ShardScanFinding{
+		ShardID:     shardID,
+		Type:        findingType,
+		Details:     details,
+		NamespaceID: key.NamespaceID,
+		WorkflowID:  key.WorkflowID,
+		RunID:       key.RunID,
+	}
+	// only executions past their retention are deleted, same as the executions scavenger does.
+	// All other findings, e.g. missing history events, are reported for manual intervention.
+	if findingType == executions.MutableStateRetentionFailureType {
+		finding.Fix = scanFixDeleteExecution
+	}
+	s.report.Findings = append(s.report.Findings, finding)
+}
+
+func (s *shardScanner) scanHistoryTasks(
+	ctx context.Context,
+	shardID int32,
+	category tasks.Category,
+	scanned map[definition.WorkflowKey]struct{},
+) error {
+	minKey, maxKey := tasks.MinimumKey, tasks.MaximumKey
+	if category.Type() == tasks.CategoryTypeImmediate {
+		minKey, maxKey = tasks.NewImmediateKey(0), tasks.NewImmediateKey(math.MaxInt64)
+	}
+
+	// executions which were checked because of a task but not found in the listing, e.g. because they were created during the scan
+	checked := make(map[definition.WorkflowKey]bool)
+	var pageToken []byte
+	for doContinue := true; doContinue; doContinue = len(pageToken) != 0 {
+		if err := s.rateLimiter.Wait(ctx); err != nil {
+			return err
+		}
+		resp, err := s.executionManager.GetHistoryTasks(ctx, &persistence.GetHistoryTasksRequest{
+			ShardID:             shardID,
+			TaskCategory:        category,
+			InclusiveMinTaskKey: minKey,
+			ExclusiveMaxTaskKey: maxKey,
+			BatchSize:           s.pageSize,
+			NextPageToken:       pageToken,
+		})
+		if err != nil {
+			return err
+		}
+		pageToken = resp.NextPageToken
+
+		for _, task := range resp.Tasks {
+			s.report.HistoryTasksScanned++
+			if task.GetType() == enumsspb.TASK_TYPE_VISIBILITY_DELETE_EXECUTION {
+				// visibility records are deleted after the execution itself
+				continue
+			}
+			key := definition.NewWorkflowKey(task.GetNamespaceID(), task.GetWorkflowID(), task.GetRunID())
+			if _, ok := scanned[key]; ok {
+				continue
+			}
+			exists, ok := checked[key]
+			if !ok {
+				if exists, err = s.executionExists(ctx, shardID, key); err != nil {
+					return err
+				}
+				checked[key] = exists
+			}
+			if exists {
+				continue
+			}
+
+			finding := ShardScanFinding{
+				ShardID:      shardID,
+				Type:         scanFindingOrphanHistoryTask,
+				Details:      fmt.Sprintf("%s task of a workflow execution which does not exist", task.GetType()),
+				NamespaceID:  key.NamespaceID,
+				WorkflowID:   key.WorkflowID,
+				RunID:        key.RunID,
+				TaskCategory: category.Name(),
+				TaskID:       task.GetTaskID(),
+				Fix:          scanFixCompleteHistoryTask,
+				taskCategory: category,
+				taskKey:      task.GetKey(),
+			}
+			if category.Type() == tasks.CategoryTypeScheduled {
+				fireTime := task.GetVisibilityTime()
+				finding.TaskFireTime = &fireTime
+			}
+			s.report.Findings = append(s.report.Findings, finding)
+		}
+	}
+	return nil
+}
+
+func (s *shardScanner) scanHistoryBranches(ctx context.Context) error {
+	minForkTime := time.Now().UTC().Add(-s.minBranchAge)
+	var pageToken []byte
+	for doContinue := true; doContinue; doContinue = len(pageToken) != 0 {
+		if err := s.rateLimiter.Wait(ctx); err != nil {
+			return err
+		}
+		resp, err := s.executionManager.GetAllHistoryTreeBranches(ctx, &persistence.GetAllHistoryTreeBranchesRequest{
+			PageSize:      s.pageSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return err
+		}
+		pageToken = resp.NextPageToken
+
+		for _, branch := range resp.Branches {
+			namespaceID, workflowID, runID, err := persistence.SplitHistoryGarbageCleanupInfo(branch.Info)
+			if err != nil {
+				// branches of other shards can't be told apart, they are not reported
+				continue
+			}
+			shardID := common.WorkflowIDToHistoryShard(namespaceID, workflowID, s.numHistoryShards)
+			if shardID < s.report.MinShardID || shardID > s.report.MaxShardID {
+				continue
+			}
+			s.report.HistoryBranchesScanned++
+			// recently created branches may belong to an execution which is being created right now
+			if minForkTime.Before(branch.ForkTime.AsTime()) {
+				continue
+			}
+
+			key := definition.NewWorkflowKey(namespaceID, workflowID, runID)
+			exists, err := s.executionExists(ctx, shardID, key)
+			if err != nil {
+				return err
+			}
+			if exists {
+				continue
+			}
+			branchToken, err := serialization.HistoryBranchToBlob(branch.BranchInfo)
+			if err != nil {
+				return err
+			}
+			s.report.Findings = append(s.report.Findings, ShardScanFinding{
+				ShardID:      shardID,
+				Type:         scanFindingDanglingHistoryBranch,
+				Details:      fmt.Sprintf("history branch %s of tree %s belongs to a workflow execution which does not exist", branch.BranchInfo.GetBranchId(), branch.BranchInfo.GetTreeId()),
+				NamespaceID:  namespaceID,
+				WorkflowID:   workflowID,
+				RunID:        runID,
+				BranchTokens: [][]byte{branchToken.Data},
+				Fix:          scanFixDeleteHistoryBranch,
+			})
+		}
+	}
+	return nil
+}
+
+func (s *shardScanner) executionExists(ctx context.Context, shardID int32, key definition.WorkflowKey) (bool, error) {
+	if err := s.rateLimiter.Wait(ctx); err != nil {
+		return false, err
+	}
+	_, err := s.executionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: key.NamespaceID,
+		WorkflowID:  key.WorkflowID,
+		RunID:       key.RunID,
+	})
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case *serviceerror.NotFound:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+func (s *shardScanner) fix(ctx context.Context) {
+	for i := range s.report.Findings {
+		finding := &s.report.Findings[i]
+		var err error
+		switch finding.Fix {
+		case scanFixDeleteExecution:
+			err = s.deleteExecution(ctx, finding)
+		case scanFixDeleteHistoryBranch:
+			err = s.deleteHistoryBranches(ctx, finding)
+		case scanFixCompleteHistoryTask:
+			err = s.completeHistoryTask(ctx, finding)
+		default:
+			continue
+		}
+		if err != nil {
+			finding.FixError = err.Error()
+			continue
+		}
+		finding.Fixed = true
+	}
+}
+
+func (s *shardScanner) deleteExecution(ctx context.Context, finding *ShardScanFinding) error {
+	ns, err := s.namespaceGetter.GetNamespaceByID(namespace.ID(finding.NamespaceID))
+	if err != nil {
+		return err
+	}
+	if err := s.rateLimiter.Wait(ctx); err != nil {
+		return err
+	}
+	_, err = s.adminClient.DeleteWorkflowExecution(ctx, &adminservice.DeleteWorkflowExecutionRequest{
+		Namespace: ns.Name().String(),
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: finding.WorkflowID,
+			RunId:      finding.RunID,
+		},
+	})
+	return err
+}
+
+func (s *shardScanner) deleteHistoryBranches(ctx context.Context, finding *ShardScanFinding) error {
+	for _, branchToken := range finding.BranchTokens {
+		if err := s.rateLimiter.Wait(ctx); err != nil {
+			return err
+		}
+		err := s.executionManager.DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
+			ShardID:     finding.ShardID,
+			BranchToken: branchToken,
+		})
+		var notFound *serviceerror.NotFound
+		if err != nil && !errors.As(err, &notFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *shardScanner) completeHistoryTask(ctx context.Context, finding *ShardScanFinding) error {
+	if err := s.rateLimiter.Wait(ctx); err != nil {
+		return err
+	}
+	return s.executionManager.CompleteHistoryTask(ctx, &persistence.CompleteHistoryTaskRequest{
+		ShardID:      finding.ShardID,
+		TaskCategory: finding.taskCategory,
+		TaskKey:      finding.taskKey,
+	})
+}
+
+func (r *ShardScanReport) fixCount() int {
+	count := 0
+	for _, finding := range r.Findings {
+		if finding.Fix != "" {
+			count++
+		}
+	}
+	return count
+}
+
+func newMetadataNamespaceGetter(ctx context.Context, metadataManager persistence.MetadataManager) *metadataNamespaceGetter {
+	return &metadataNamespaceGetter{
+		ctx:             ctx,
+		metadataManager: metadataManager,
+		namespaces:      make(map[namespace.ID]*namespace.Namespace),
+	}
+}
+
+func (g *metadataNamespaceGetter) GetNamespaceByID(id namespace.ID) (*namespace.Namespace, error) {
+	if ns, ok := g.namespaces[id]; ok {
+		return ns, nil
+	}
+	resp, err := g.metadataManager.GetNamespace(g.ctx, &persistence.GetNamespaceRequest{ID: id.String()})
+	if err != nil {
+		return nil, err
+	}
+	ns := namespace.FromPersistentState(resp.Namespace)
+	g.namespaces[id] = ns
+	return ns, nil
+}
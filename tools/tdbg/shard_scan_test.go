@@ -0,0 +1,241 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/adminservicemock/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/worker/scanner/executions"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const scanTestNamespaceID = "ns-id"
+
+type scanTestPersistenceFactory struct {
+	clients *PersistenceClients
+}
+
+func (f *scanTestPersistenceFactory) NewPersistenceClients(*cli.Context) (*PersistenceClients, error) {
+	return f.clients, nil
+}
+
+type scanTestClientFactory struct {
+	adminClient adminservice.AdminServiceClient
+}
+
+func (f *scanTestClientFactory) AdminClient(*cli.Context) adminservice.AdminServiceClient {
+	return f.adminClient
+}
+
+func (f *scanTestClientFactory) WorkflowClient(*cli.Context) workflowservice.WorkflowServiceClient {
+	panic("unimplemented")
+}
+
+func newScanTestMutableState(workflowID string, state enumsspb.WorkflowExecutionState, lastUpdateTime time.Time) *persistencespb.WorkflowMutableState {
+	return &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			NamespaceId:    scanTestNamespaceID,
+			WorkflowId:     workflowID,
+			LastUpdateTime: timestamppb.New(lastUpdateTime),
+			VersionHistories: versionhistory.NewVersionHistories(versionhistory.NewVersionHistory(
+				[]byte(workflowID+"-branch"),
+				[]*historyspb.VersionHistoryItem{versionhistory.NewVersionHistoryItem(3, 1)},
+			)),
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			RunId: workflowID + "-run",
+			State: state,
+		},
+		NextEventId: 4,
+	}
+}
+
+func setupScanTest(t *testing.T) (*persistence.MockExecutionManager, *scanTestPersistenceFactory) {
+	ctrl := gomock.NewController(t)
+	executionManager := persistence.NewMockExecutionManager(ctrl)
+	metadataManager := persistence.NewMockMetadataManager(ctrl)
+
+	metadataManager.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{ID: scanTestNamespaceID}).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:   &persistencespb.NamespaceInfo{Id: scanTestNamespaceID, Name: "ns"},
+			Config: &persistencespb.NamespaceConfig{Retention: durationpb.New(24 * time.Hour)},
+		},
+	}, nil).Times(1)
+
+	now := time.Now()
+	executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), gomock.Any()).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{
+			newScanTestMutableState("wf-ok", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, now),
+			newScanTestMutableState("wf-missing-history", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, now),
+			newScanTestMutableState("wf-expired", enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, now.Add(-1000*24*time.Hour)),
+		},
+	}, nil)
+	executionManager.EXPECT().ReadRawHistoryBranch(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.ReadHistoryBranchRequest) (*persistence.ReadRawHistoryBranchResponse, error) {
+			if string(request.BranchToken) == "wf-missing-history-branch" {
+				return nil, serviceerror.NewNotFound("history not found")
+			}
+			return &persistence.ReadRawHistoryBranchResponse{}, nil
+		}).Times(2)
+	executionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.GetWorkflowExecutionRequest) (*persistence.GetWorkflowExecutionResponse, error) {
+			if request.WorkflowID == "wf-missing-history" {
+				return &persistence.GetWorkflowExecutionResponse{}, nil
+			}
+			return nil, serviceerror.NewNotFound("workflow not found")
+		}).Times(2)
+	executionManager.EXPECT().GetHistoryTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.GetHistoryTasksRequest) (*persistence.GetHistoryTasksResponse, error) {
+			if request.TaskCategory != tasks.CategoryTransfer {
+				return &persistence.GetHistoryTasksResponse{}, nil
+			}
+			require.Equal(t, tasks.NewImmediateKey(0), request.InclusiveMinTaskKey)
+			return &persistence.GetHistoryTasksResponse{
+				Tasks: []tasks.Task{
+					&tasks.ActivityTask{WorkflowKey: definition.NewWorkflowKey(scanTestNamespaceID, "wf-ok", "wf-ok-run"), TaskID: 1},
+					&tasks.ActivityTask{WorkflowKey: definition.NewWorkflowKey(scanTestNamespaceID, "wf-gone", "wf-gone-run"), TaskID: 2},
+					&tasks.DeleteExecutionVisibilityTask{WorkflowKey: definition.NewWorkflowKey(scanTestNamespaceID, "wf-deleted", "wf-deleted-run"), TaskID: 3},
+				},
+			}, nil
+		}).AnyTimes()
+
+	return executionManager, &scanTestPersistenceFactory{
+		clients: &PersistenceClients{
+			ExecutionManager: executionManager,
+			MetadataManager:  metadataManager,
+			NumHistoryShards: 4,
+		},
+	}
+}
+
+func runScanTest(t *testing.T, clientFactory ClientFactory, persistenceFactory PersistenceFactory, args ...string) (*ShardScanReport, error) {
+	var output bytes.Buffer
+	app := NewCliApp(func(params *Params) {
+		params.ClientFactory = clientFactory
+		params.PersistenceFactory = persistenceFactory
+		params.Writer = &output
+	})
+	app.ExitErrHandler = func(c *cli.Context, err error) {}
+	err := app.Run(append([]string{"tdbg", "--yes", "shard", "scan", "--min-shard-id", "1", "--max-shard-id", "1"}, args...))
+
+	var report ShardScanReport
+	require.NoError(t, json.Unmarshal(output.Bytes(), &report), output.String())
+	return &report, err
+}
+
+func TestAdminScanShards_ReportOnly(t *testing.T) {
+	_, persistenceFactory := setupScanTest(t)
+
+	report, err := runScanTest(t, &scanTestClientFactory{}, persistenceFactory)
+	var exitErr cli.ExitCoder
+	require.True(t, errors.As(err, &exitErr))
+	require.Equal(t, scanExitCodeFindings, exitErr.ExitCode())
+
+	require.Equal(t, 3, report.ExecutionsScanned)
+	require.Equal(t, 3, report.HistoryTasksScanned)
+	require.Len(t, report.Findings, 3)
+
+	require.Equal(t, executions.HistoryEventIDFailureType, report.Findings[0].Type)
+	require.Equal(t, "wf-missing-history", report.Findings[0].WorkflowID)
+	require.Empty(t, report.Findings[0].Fix)
+
+	require.Equal(t, executions.MutableStateRetentionFailureType, report.Findings[1].Type)
+	require.Equal(t, "wf-expired", report.Findings[1].WorkflowID)
+	require.Equal(t, scanFixDeleteExecution, report.Findings[1].Fix)
+
+	require.Equal(t, scanFindingOrphanHistoryTask, report.Findings[2].Type)
+	require.Equal(t, "wf-gone", report.Findings[2].WorkflowID)
+	require.Equal(t, tasks.CategoryTransfer.Name(), report.Findings[2].TaskCategory)
+	require.Equal(t, int64(2), report.Findings[2].TaskID)
+
+	for _, finding := range report.Findings {
+		require.False(t, finding.Fixed)
+	}
+}
+
+func TestAdminScanShards_Fix(t *testing.T) {
+	executionManager, persistenceFactory := setupScanTest(t)
+	adminClient := adminservicemock.NewMockAdminServiceClient(gomock.NewController(t))
+
+	adminClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), &adminservice.DeleteWorkflowExecutionRequest{
+		Namespace: "ns",
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: "wf-expired",
+			RunId:      "wf-expired-run",
+		},
+	}).Return(&adminservice.DeleteWorkflowExecutionResponse{}, nil)
+	executionManager.EXPECT().CompleteHistoryTask(gomock.Any(), &persistence.CompleteHistoryTaskRequest{
+		ShardID:      1,
+		TaskCategory: tasks.CategoryTransfer,
+		TaskKey:      tasks.NewImmediateKey(2),
+	}).Return(nil)
+
+	report, err := runScanTest(t, &scanTestClientFactory{adminClient: adminClient}, persistenceFactory, "--fix")
+	// the execution with missing history events needs manual intervention
+	var exitErr cli.ExitCoder
+	require.True(t, errors.As(err, &exitErr))
+	require.Equal(t, scanExitCodeFindings, exitErr.ExitCode())
+
+	require.Len(t, report.Findings, 3)
+	require.False(t, report.Findings[0].Fixed)
+	for _, finding := range report.Findings[1:] {
+		require.True(t, finding.Fixed)
+		require.Empty(t, finding.FixError)
+	}
+}
+
+func TestAdminScanShards_InvalidShardRange(t *testing.T) {
+	app := NewCliApp(func(params *Params) {
+		params.PersistenceFactory = &scanTestPersistenceFactory{clients: &PersistenceClients{NumHistoryShards: 4}}
+	})
+	app.ExitErrHandler = func(c *cli.Context, err error) {}
+
+	err := app.Run([]string{"tdbg", "shard", "scan", "--min-shard-id", "1", "--max-shard-id", "8"})
+	var exitErr cli.ExitCoder
+	require.True(t, errors.As(err, &exitErr))
+	require.Equal(t, scanExitCodeError, exitErr.ExitCode())
+	require.Contains(t, err.Error(), "larger than the number of history shards")
+}
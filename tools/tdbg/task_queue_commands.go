@@ -27,12 +27,16 @@ package tdbg
 import (
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/urfave/cli/v2"
 	enumspb "go.temporal.io/api/enums/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/server/api/adminservice/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/tqid"
 )
 
 // AdminListTaskQueueTasks displays task information
@@ -267,3 +271,132 @@ func AdminForceUnloadTaskQueuePartition(c *cli.Context, clientFactory ClientFact
 	}
 	return nil
 }
+
+// AdminMigrateTaskQueueBacklog moves or copies the backlog of a task queue partition to another task queue
+func AdminMigrateTaskQueueBacklog(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqName, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return err
+	}
+	targetName, err := getRequiredOption(c, FlagTargetTaskQueue)
+	if err != nil {
+		return err
+	}
+	tqTypeString, err := getRequiredOption(c, FlagTaskQueueType)
+	if err != nil {
+		return err
+	}
+
+	tlTypeInt, err := StringToEnum(tqTypeString, enumspb.TaskQueueType_value)
+	if err != nil {
+		return fmt.Errorf("invalid task queue type: %w", err)
+	}
+	tqType := enumspb.TaskQueueType(tlTypeInt)
+	if tqType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		return errors.New("invalid task queue type") // nolint
+	}
+	if c.IsSet(FlagTargetPartitionID) {
+		targetName = tqid.UnsafeTaskQueueFamily("", targetName).TaskQueue(tqType).NormalPartition(c.Int(FlagTargetPartitionID)).RpcName()
+	}
+
+	batchSize := c.Int(FlagPageSize)
+	rps := c.Int(FlagRPS)
+	if batchSize <= 0 || rps <= 0 {
+		return fmt.Errorf("%s and %s must be positive", FlagPageSize, FlagRPS)
+	}
+	// allow a full batch to be scanned at once even with a low rps
+	rateLimiter := quotas.NewRateLimiter(float64(rps), max(rps, batchSize))
+
+	client := clientFactory.AdminClient(c)
+	req := &adminservice.MigrateTaskQueueBacklogRequest{
+		Namespace: namespace,
+		TaskQueuePartition: &taskqueuespb.TaskQueuePartition{
+			TaskQueue:     tqName,
+			TaskQueueType: tqType,
+			PartitionId:   &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: int32(c.Int(FlagPartitionID))},
+		},
+		BuildId:         c.String(FlagBuildID),
+		TargetTaskQueue: targetName,
+		Copy:            c.Bool(FlagCopy),
+		WorkflowId:      c.String(FlagWorkflowID),
+		Subqueue:        int32(c.Int(FlagSubqueue)),
+		LastTaskId:      c.Int64(FlagMinTaskID),
+		BatchSize:       int32(batchSize),
+	}
+
+	var totalScanned, totalMigrated int64
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := client.MigrateTaskQueueBacklog(ctx, req)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("unable to migrate backlog, resume with --%s %d --%s %d: %w",
+				FlagSubqueue, req.GetSubqueue(), FlagMinTaskID, req.GetLastTaskId(), err)
+		}
+
+		totalScanned += int64(resp.GetTasksScanned())
+		totalMigrated += int64(resp.GetTasksMigrated())
+		if resp.GetTasksScanned() > 0 {
+			fmt.Fprintf(c.App.Writer, "subqueue %d, last task ID %d: scanned %d, migrated %d (total scanned %d, migrated %d)\n",
+				resp.GetSubqueue(), resp.GetLastTaskId(), resp.GetTasksScanned(), resp.GetTasksMigrated(), totalScanned, totalMigrated)
+		}
+		if resp.GetDone() {
+			break
+		}
+		req.Subqueue = resp.GetSubqueue()
+		req.LastTaskId = resp.GetLastTaskId()
+
+		if err := rateLimiter.WaitN(c.Context, max(1, int(resp.GetTasksScanned()))); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(c.App.Writer, "done: scanned %d, migrated %d\n", totalScanned, totalMigrated)
+	return nil
+}
+
+// AdminUpdateActivityTypeRateLimits sets or removes per-activity-type dispatch rate limits of a task queue
+func AdminUpdateActivityTypeRateLimits(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqName, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return err
+	}
+
+	setRateLimits := make(map[string]float64)
+	for _, limit := range c.StringSlice(FlagSetRateLimit) {
+		activityType, rpsString, ok := strings.Cut(limit, "=")
+		if !ok || activityType == "" {
+			return fmt.Errorf("invalid --%s %q, expected <activity type>=<rps>", FlagSetRateLimit, limit)
+		}
+		rps, err := strconv.ParseFloat(rpsString, 64)
+		if err != nil {
+			return fmt.Errorf("invalid --%s %q: %w", FlagSetRateLimit, limit, err)
+		}
+		setRateLimits[activityType] = rps
+	}
+
+	client := clientFactory.AdminClient(c)
+	req := &adminservice.UpdateActivityTypeRateLimitsRequest{
+		Namespace:           namespace,
+		TaskQueue:           tqName,
+		SetRateLimits:       setRateLimits,
+		RemoveActivityTypes: c.StringSlice(FlagRemoveActivityType),
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.UpdateActivityTypeRateLimits(ctx, req)
+	if err != nil {
+		return fmt.Errorf("unable to update activity type rate limits: %w", err)
+	}
+	prettyPrintJSONObject(c, response)
+	return nil
+}
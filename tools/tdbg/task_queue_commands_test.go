@@ -32,8 +32,10 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/urfave/cli/v2"
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
 )
@@ -43,6 +45,8 @@ type (
 		adminservice.AdminServiceClient
 		describeTaskQueuePartitionFn    func(request *adminservice.DescribeTaskQueuePartitionRequest) (*adminservice.DescribeTaskQueuePartitionResponse, error)
 		forceUnloadTaskQueuePartitionFn func(request *adminservice.ForceUnloadTaskQueuePartitionRequest) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error)
+		migrateTaskQueueBacklogFn       func(request *adminservice.MigrateTaskQueueBacklogRequest) (*adminservice.MigrateTaskQueueBacklogResponse, error)
+		updateActivityTypeRateLimitsFn  func(request *adminservice.UpdateActivityTypeRateLimitsRequest) (*adminservice.UpdateActivityTypeRateLimitsResponse, error)
 	}
 )
 
@@ -106,6 +110,14 @@ func (t *testClient) ForceUnloadTaskQueuePartition(_ context.Context, request *a
 	return t.forceUnloadTaskQueuePartitionFn(request)
 }
 
+func (t *testClient) MigrateTaskQueueBacklog(_ context.Context, request *adminservice.MigrateTaskQueueBacklogRequest, opts ...grpc.CallOption) (*adminservice.MigrateTaskQueueBacklogResponse, error) {
+	return t.migrateTaskQueueBacklogFn(request)
+}
+
+func (t *testClient) UpdateActivityTypeRateLimits(_ context.Context, request *adminservice.UpdateActivityTypeRateLimitsRequest, opts ...grpc.CallOption) (*adminservice.UpdateActivityTypeRateLimitsResponse, error) {
+	return t.updateActivityTypeRateLimitsFn(request)
+}
+
 func (s *taskQueueCommandTestSuite) SetupTest() {
 	s.Assertions = require.New(s.T())
 	s.controller = gomock.NewController(s.T())
@@ -119,6 +131,7 @@ func (s *taskQueueCommandTestSuite) SetupTest() {
 			return &adminservice.ForceUnloadTaskQueuePartitionResponse{}, nil
 		},
 	}
+	s.client = client
 	s.app = NewCliApp(func(params *Params) {
 		params.ClientFactory = client
 	})
@@ -132,6 +145,7 @@ type taskQueueCommandTestSuite struct {
 	*require.Assertions
 	suite.Suite
 	controller *gomock.Controller
+	client     *testClient
 	app        *cli.App
 }
 
@@ -187,3 +201,62 @@ func (s *taskQueueCommandTestSuite) TestForceUnloadTaskQueuePartition() {
 		}
 	}
 }
+
+// TestMigrateTaskQueueBacklog tests that migrate-backlog keeps calling the server, resuming from
+// the returned position, until the backlog is done
+func (s *taskQueueCommandTestSuite) TestMigrateTaskQueueBacklog() {
+	var requests []*adminservice.MigrateTaskQueueBacklogRequest
+	s.client.migrateTaskQueueBacklogFn = func(request *adminservice.MigrateTaskQueueBacklogRequest) (*adminservice.MigrateTaskQueueBacklogResponse, error) {
+		requests = append(requests, common.CloneProto(request))
+		switch len(requests) {
+		case 1:
+			return &adminservice.MigrateTaskQueueBacklogResponse{LastTaskId: 10, TasksScanned: 5, TasksMigrated: 5}, nil
+		case 2:
+			return &adminservice.MigrateTaskQueueBacklogResponse{Subqueue: 1, LastTaskId: 20, TasksScanned: 5, TasksMigrated: 2}, nil
+		default:
+			return &adminservice.MigrateTaskQueueBacklogResponse{Subqueue: 2, Done: true}, nil
+		}
+	}
+
+	err := s.app.Run([]string{"tdbg", "taskqueue", "migrate-backlog",
+		"--task-queue", "test", "--target-task-queue", "other", "--target-partition-id", "2",
+		"--task-queue-type", "TASK_QUEUE_TYPE_ACTIVITY", "--partition-id", "1", "--copy", "--pagesize", "5"})
+	s.NoError(err)
+	s.Len(requests, 3)
+	s.Equal("/_sys/other/2", requests[0].GetTargetTaskQueue())
+	s.Equal(int32(1), requests[0].GetTaskQueuePartition().GetNormalPartitionId())
+	s.Equal(enumspb.TASK_QUEUE_TYPE_ACTIVITY, requests[0].GetTaskQueuePartition().GetTaskQueueType())
+	s.True(requests[0].GetCopy())
+	s.Equal(int32(5), requests[0].GetBatchSize())
+	s.Equal(int64(10), requests[1].GetLastTaskId())
+	s.Equal(int32(1), requests[2].GetSubqueue())
+	s.Equal(int64(20), requests[2].GetLastTaskId())
+
+	s.client.migrateTaskQueueBacklogFn = func(request *adminservice.MigrateTaskQueueBacklogRequest) (*adminservice.MigrateTaskQueueBacklogResponse, error) {
+		return nil, errors.New("unavailable")
+	}
+	err = s.app.Run([]string{"tdbg", "taskqueue", "migrate-backlog",
+		"--task-queue", "test", "--target-task-queue", "other", "--subqueue", "1", "--min-task-id", "20"})
+	s.ErrorContains(err, "resume with --subqueue 1 --min-task-id 20")
+}
+
+// TestUpdateActivityTypeRateLimits tests that update-activity-type-rate-limits parses the limits to
+// set and the activity types to remove
+func (s *taskQueueCommandTestSuite) TestUpdateActivityTypeRateLimits() {
+	var request *adminservice.UpdateActivityTypeRateLimitsRequest
+	s.client.updateActivityTypeRateLimitsFn = func(r *adminservice.UpdateActivityTypeRateLimitsRequest) (*adminservice.UpdateActivityTypeRateLimitsResponse, error) {
+		request = r
+		return &adminservice.UpdateActivityTypeRateLimitsResponse{RateLimits: r.GetSetRateLimits()}, nil
+	}
+
+	err := s.app.Run([]string{"tdbg", "taskqueue", "update-activity-type-rate-limits", "--task-queue", "test",
+		"--set-rate-limit", "charge=2.5", "--set-rate-limit", "ship=10", "--remove-activity-type", "refund"})
+	s.NoError(err)
+	s.Equal("test", request.GetTaskQueue())
+	s.Equal(map[string]float64{"charge": 2.5, "ship": 10}, request.GetSetRateLimits())
+	s.Equal([]string{"refund"}, request.GetRemoveActivityTypes())
+
+	err = s.app.Run([]string{"tdbg", "taskqueue", "update-activity-type-rate-limits", "--task-queue", "test",
+		"--set-rate-limit", "charge"})
+	s.ErrorContains(err, "expected <activity type>=<rps>")
+}
@@ -29,6 +29,7 @@ import (
 	"io"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/urfave/cli/v2"
 	commonpb "go.temporal.io/api/common/v1"
@@ -39,6 +40,8 @@ import (
 
 func getCommands(
 	clientFactory ClientFactory,
+	addressClientFactory AddressClientFactory,
+	persistenceFactory PersistenceFactory,
 	dlqServiceProvider *DLQServiceProvider,
 	taskCategoryRegistry tasks.TaskCategoryRegistry,
 	prompterFactory PrompterFactory,
@@ -49,13 +52,13 @@ func getCommands(
 			Name:        "workflow",
 			Aliases:     []string{"w"},
 			Usage:       "Run admin operation on workflow",
-			Subcommands: newAdminWorkflowCommands(clientFactory, prompterFactory),
+			Subcommands: newAdminWorkflowCommands(clientFactory, addressClientFactory, prompterFactory),
 		},
 		{
 			Name:        "shard",
 			Aliases:     []string{"s"},
 			Usage:       "Run admin operation on specific shard",
-			Subcommands: newAdminShardManagementCommands(clientFactory, taskCategoryRegistry),
+			Subcommands: newAdminShardManagementCommands(clientFactory, persistenceFactory, taskCategoryRegistry, prompterFactory),
 		},
 		{
 			Name:        "history-host",
@@ -95,7 +98,11 @@ func getCommands(
 	}
 }
 
-func newAdminWorkflowCommands(clientFactory ClientFactory, prompterFactory PrompterFactory) []*cli.Command {
+func newAdminWorkflowCommands(
+	clientFactory ClientFactory,
+	addressClientFactory AddressClientFactory,
+	prompterFactory PrompterFactory,
+) []*cli.Command {
 	return []*cli.Command{
 		{
 			Name:  "import",
@@ -173,11 +180,61 @@ func newAdminWorkflowCommands(clientFactory ClientFactory, prompterFactory Promp
 					Aliases: FlagRunIDAlias,
 					Usage:   "Run ID",
 				},
+				&cli.StringFlag{
+					Name:  FlagOutputFilename,
+					Usage: "Save the full DescribeMutableState response as JSON to this file, e.g. as input of workflow diff",
+				},
 			},
 			Action: func(c *cli.Context) error {
 				return AdminDescribeWorkflow(c, clientFactory)
 			},
 		},
+		{
+			Name:  "diff",
+			Usage: "Compare the mutable state of a workflow between two clusters or a saved snapshot and a cluster",
+			Description: "Each side is read from --{left,right}-file, from the cluster at --{left,right}-address, " +
+				"or from the cluster at --address if neither is set. " +
+				"Exits with 0 if the mutable states are identical, 1 if they differ and 2 on error.",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID, required unless both sides are read from files",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+				&cli.StringFlag{
+					Name:  FlagLeftAddress,
+					Usage: "host:port of the frontend service to read the left mutable state from",
+				},
+				&cli.StringFlag{
+					Name:  FlagLeftFile,
+					Usage: "File written by workflow describe --output-filename to read the left mutable state from",
+				},
+				&cli.StringFlag{
+					Name:  FlagRightAddress,
+					Usage: "host:port of the frontend service to read the right mutable state from",
+				},
+				&cli.StringFlag{
+					Name:  FlagRightFile,
+					Usage: "File written by workflow describe --output-filename to read the right mutable state from",
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagIgnoreField,
+					Usage: "Field path to leave out of the diff, e.g. execution_info.last_update_time. Can be passed multiple times",
+				},
+				&cli.BoolFlag{
+					Name:  FlagPrintJSON,
+					Usage: "Print the diff as JSON",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDiffWorkflow(c, clientFactory, addressClientFactory)
+			},
+		},
 		{
 			Name:    "refresh-tasks",
 			Aliases: []string{"rt"},
@@ -261,7 +318,12 @@ func newAdminWorkflowCommands(clientFactory ClientFactory, prompterFactory Promp
 	}
 }
 
-func newAdminShardManagementCommands(clientFactory ClientFactory, taskCategoryRegistry tasks.TaskCategoryRegistry) []*cli.Command {
+func newAdminShardManagementCommands(
+	clientFactory ClientFactory,
+	persistenceFactory PersistenceFactory,
+	taskCategoryRegistry tasks.TaskCategoryRegistry,
+	prompterFactory PrompterFactory,
+) []*cli.Command {
 	// There are two different categories for the task type, and they have slightly
 	// different semantics. The first is the task category for the list-tasks command,
 	// which is required and does not have a default. The second is the task category
@@ -371,6 +433,70 @@ func newAdminShardManagementCommands(clientFactory ClientFactory, taskCategoryRe
 				return AdminRemoveTask(c, clientFactory, taskCategoryRegistry)
 			},
 		},
+		{
+			Name:  "scan",
+			Usage: "Check executions, history branches and history tasks of a shard range for inconsistencies by reading the database directly",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagConfigDir,
+					Value: "config",
+					Usage: "Directory of the Temporal server config used to connect to the database",
+				},
+				&cli.StringFlag{
+					Name:  FlagEnv,
+					Value: "development",
+					Usage: "Environment of the Temporal server config",
+				},
+				&cli.StringFlag{
+					Name:  FlagZone,
+					Usage: "Availability zone of the Temporal server config",
+				},
+				&cli.IntFlag{
+					Name:     FlagMinShardID,
+					Usage:    "Inclusive min shard ID to scan",
+					Required: true,
+				},
+				&cli.IntFlag{
+					Name:     FlagMaxShardID,
+					Usage:    "Inclusive max shard ID to scan",
+					Required: true,
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: 100,
+					Usage: "Page size used when listing executions and history tasks",
+				},
+				&cli.IntFlag{
+					Name:  FlagRPS,
+					Value: 100,
+					Usage: "Max persistence requests per second",
+				},
+				&cli.BoolFlag{
+					Name:  FlagDeep,
+					Usage: "Read the whole history branch of every execution instead of only its first event batch",
+				},
+				&cli.BoolFlag{
+					Name:  FlagScanHistoryBranches,
+					Usage: "Also check all history branches of the cluster for branches whose execution no longer exists",
+				},
+				&cli.DurationFlag{
+					Name:  FlagMinBranchAge,
+					Value: 24 * time.Hour,
+					Usage: "Only report history branches created at least this long ago as dangling",
+				},
+				&cli.BoolFlag{
+					Name:  FlagFix,
+					Usage: "Apply the fix actions of the report after confirmation",
+				},
+				&cli.StringFlag{
+					Name:  FlagOutputFilename,
+					Usage: "Write the JSON report to this file instead of stdout",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminScanShards(c, clientFactory, persistenceFactory, taskCategoryRegistry, prompterFactory(c))
+			},
+		},
 	}
 }
 
@@ -607,6 +733,93 @@ func newAdminTaskQueueCommands(clientFactory ClientFactory) []*cli.Command {
 				return AdminForceUnloadTaskQueuePartition(c, clientFactory)
 			},
 		},
+		{
+			Name:  "migrate-backlog",
+			Usage: "Move or copy the backlog of a task queue partition to another task queue",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "TASK_QUEUE_TYPE_WORKFLOW",
+					Usage: "Task Queue type: activity, workflow",
+				},
+				&cli.Int64Flag{
+					Name:  FlagPartitionID,
+					Usage: "Partition ID",
+					Value: 0,
+				},
+				&cli.StringFlag{
+					Name:  FlagBuildID,
+					Usage: "Build ID of the versioned queue to migrate, unversioned queue if not set",
+				},
+				&cli.StringFlag{
+					Name:     FlagTargetTaskQueue,
+					Usage:    "Target Task Queue name",
+					Required: true,
+				},
+				&cli.Int64Flag{
+					Name: FlagTargetPartitionID,
+					Usage: "Target partition ID. If not set, tasks are spread over the partitions of the target task queue. " +
+						"Set it to move tasks to another partition of the same task queue (the root partition cannot be targeted)",
+				},
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Only migrate tasks of this Workflow ID",
+				},
+				&cli.BoolFlag{
+					Name:  FlagCopy,
+					Usage: "Copy tasks instead of moving them, leaving the source backlog unchanged",
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: 100,
+					Usage: "Number of tasks to read per batch",
+				},
+				&cli.IntFlag{
+					Name:  FlagRPS,
+					Value: 100,
+					Usage: "Maximum number of tasks to scan per second",
+				},
+				&cli.IntFlag{
+					Name:  FlagSubqueue,
+					Usage: "Subqueue to resume from",
+				},
+				&cli.Int64Flag{
+					Name:  FlagMinTaskID,
+					Usage: "Task ID to resume after, within the subqueue to resume from",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminMigrateTaskQueueBacklog(c, clientFactory)
+			},
+		},
+		{
+			Name:  "update-activity-type-rate-limits",
+			Usage: "Set or remove per-activity-type dispatch rate limits of a task queue. Prints the resulting limits",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagSetRateLimit,
+					Usage: "Limit to set, as <activity type>=<tasks per second>. Can be passed multiple times",
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagRemoveActivityType,
+					Usage: "Activity type whose limit should be removed. Can be passed multiple times",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUpdateActivityTypeRateLimits(c, clientFactory)
+			},
+		},
 	}
 }
 
@@ -0,0 +1,506 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/temporalio/tctl-kit/pkg/color"
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/server/api/adminservice/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// diffExitCodeDifferent is the exit code of workflow diff when the mutable states differ
+	diffExitCodeDifferent = 1
+	// diffExitCodeError is the exit code of workflow diff when a mutable state can't be loaded
+	diffExitCodeError = 2
+
+	diffKindAdded   = "added"
+	diffKindRemoved = "removed"
+	diffKindChanged = "changed"
+
+	versionHistoryIdentical  = "identical"
+	versionHistoryLeftAhead  = "left-ahead"
+	versionHistoryRightAhead = "right-ahead"
+	versionHistoryDiverged   = "diverged"
+	versionHistoryUnrelated  = "unrelated"
+
+	maxDiffBytesLength = 64
+)
+
+type (
+	// mutableStateDiff is a single difference between two mutable states. Added means the field is only set on
+	// the right side, removed means it's only set on the left side.
+	mutableStateDiff struct {
+		Path  string `json:"path"`
+		Kind  string `json:"kind"`
+		Left  string `json:"left,omitempty"`
+		Right string `json:"right,omitempty"`
+	}
+
+	// versionHistoryAlignment describes how the current version histories of both sides relate to each other.
+	versionHistoryAlignment struct {
+		Relation  string `json:"relation"`
+		LeftLast  string `json:"leftLastItem"`
+		RightLast string `json:"rightLastItem"`
+		LCA       string `json:"lcaItem,omitempty"`
+	}
+
+	mutableStateDiffResult struct {
+		Left           string                   `json:"left"`
+		Right          string                   `json:"right"`
+		VersionHistory *versionHistoryAlignment `json:"versionHistory,omitempty"`
+		Differences    []mutableStateDiff       `json:"differences"`
+	}
+
+	protoDiffer struct {
+		ignoredPaths []string
+		diffs        []mutableStateDiff
+	}
+)
+
+// AdminDiffWorkflow compares the database mutable state of a workflow read from two sources
+func AdminDiffWorkflow(c *cli.Context, clientFactory ClientFactory, addressClientFactory AddressClientFactory) error {
+	left, leftSource, err := loadMutableState(c, clientFactory, addressClientFactory, FlagLeftAddress, FlagLeftFile)
+	if err != nil {
+		return cli.Exit(err.Error(), diffExitCodeError)
+	}
+	right, rightSource, err := loadMutableState(c, clientFactory, addressClientFactory, FlagRightAddress, FlagRightFile)
+	if err != nil {
+		return cli.Exit(err.Error(), diffExitCodeError)
+	}
+	if leftSource == rightSource {
+		return cli.Exit(fmt.Sprintf("both sides are read from %s, set --%s/--%s or --%s/--%s", leftSource,
+			FlagLeftAddress, FlagLeftFile, FlagRightAddress, FlagRightFile), diffExitCodeError)
+	}
+
+	result := diffMutableStates(left, right, c.StringSlice(FlagIgnoreField))
+	result.Left = leftSource
+	result.Right = rightSource
+
+	if c.Bool(FlagPrintJSON) {
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return cli.Exit(fmt.Sprintf("unable to encode diff: %s", err), diffExitCodeError)
+		}
+		fmt.Fprintln(c.App.Writer, string(data))
+	} else {
+		printMutableStateDiff(c, result)
+	}
+
+	if len(result.Differences) > 0 {
+		return cli.Exit("", diffExitCodeDifferent)
+	}
+	return nil
+}
+
+// loadMutableState reads the mutable state from the file or address flag, or from the default cluster if neither
+// is set. It also returns a description of where the mutable state was read from.
+func loadMutableState(
+	c *cli.Context,
+	clientFactory ClientFactory,
+	addressClientFactory AddressClientFactory,
+	addressFlag string,
+	fileFlag string,
+) (*persistencespb.WorkflowMutableState, string, error) {
+	address := c.String(addressFlag)
+	file := c.String(fileFlag)
+	switch {
+	case address != "" && file != "":
+		return nil, "", fmt.Errorf("only one of --%s and --%s can be set", addressFlag, fileFlag)
+	case file != "":
+		mutableState, err := readMutableStateFile(file)
+		return mutableState, "file " + file, err
+	case address != "":
+		clientFactory = addressClientFactory(address)
+	default:
+		address = DefaultFrontendAddressProvider{}.GetFrontendAddress(c)
+	}
+
+	resp, err := describeMutableState(c, clientFactory)
+	if err != nil {
+		return nil, "", err
+	}
+	if resp.GetDatabaseMutableState() == nil {
+		return nil, "", fmt.Errorf("no database mutable state returned by %s", address)
+	}
+	return resp.GetDatabaseMutableState(), "cluster " + address, nil
+}
+
+// readMutableStateFile reads a DescribeMutableStateResponse written by workflow describe --output-filename.
+// A file containing only the database mutable state is accepted as well.
+func readMutableStateFile(fileName string) (*persistencespb.WorkflowMutableState, error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read mutable state file: %s", err)
+	}
+
+	encoder := codec.NewJSONPBEncoder()
+	resp := &adminservice.DescribeMutableStateResponse{}
+	if err := encoder.Decode(data, resp); err == nil && resp.GetDatabaseMutableState() != nil {
+		return resp.GetDatabaseMutableState(), nil
+	}
+	mutableState := &persistencespb.WorkflowMutableState{}
+	if err := encoder.Decode(data, mutableState); err != nil {
+		return nil, fmt.Errorf("unable to decode mutable state file %s: %s", fileName, err)
+	}
+	return mutableState, nil
+}
+
+func diffMutableStates(
+	left *persistencespb.WorkflowMutableState,
+	right *persistencespb.WorkflowMutableState,
+	ignoredPaths []string,
+) *mutableStateDiffResult {
+	differ := &protoDiffer{ignoredPaths: ignoredPaths}
+	differ.diffMessage("", left.ProtoReflect(), right.ProtoReflect())
+	return &mutableStateDiffResult{
+		VersionHistory: alignVersionHistories(
+			left.GetExecutionInfo().GetVersionHistories(),
+			right.GetExecutionInfo().GetVersionHistories(),
+		),
+		Differences: differ.diffs,
+	}
+}
+
+// alignVersionHistories finds the lowest common ancestor of the current version histories, which tells whether
+// one side is behind the other (e.g. replication lag) or the histories have diverged (e.g. a conflict).
+// It returns nil if either side has no version history.
+func alignVersionHistories(left, right *historyspb.VersionHistories) *versionHistoryAlignment {
+	if left == nil || right == nil {
+		return nil
+	}
+	leftCurrent, err := versionhistory.GetCurrentVersionHistory(left)
+	if err != nil {
+		return nil
+	}
+	rightCurrent, err := versionhistory.GetCurrentVersionHistory(right)
+	if err != nil {
+		return nil
+	}
+	leftLast, err := versionhistory.GetLastVersionHistoryItem(leftCurrent)
+	if err != nil {
+		return nil
+	}
+	rightLast, err := versionhistory.GetLastVersionHistoryItem(rightCurrent)
+	if err != nil {
+		return nil
+	}
+
+	alignment := &versionHistoryAlignment{
+		LeftLast:  formatVersionHistoryItem(leftLast),
+		RightLast: formatVersionHistoryItem(rightLast),
+	}
+	lca, err := versionhistory.FindLCAVersionHistoryItem(leftCurrent, rightCurrent)
+	if err != nil {
+		alignment.Relation = versionHistoryUnrelated
+		return alignment
+	}
+	alignment.LCA = formatVersionHistoryItem(lca)
+
+	leftIsLCA := proto.Equal(lca, leftLast)
+	rightIsLCA := proto.Equal(lca, rightLast)
+	switch {
+	case leftIsLCA && rightIsLCA:
+		alignment.Relation = versionHistoryIdentical
+	case rightIsLCA:
+		alignment.Relation = versionHistoryLeftAhead
+	case leftIsLCA:
+		alignment.Relation = versionHistoryRightAhead
+	default:
+		alignment.Relation = versionHistoryDiverged
+	}
+	return alignment
+}
+
+func formatVersionHistoryItem(item *historyspb.VersionHistoryItem) string {
+	return fmt.Sprintf("event %d @ version %d", item.GetEventId(), item.GetVersion())
+}
+
+func printMutableStateDiff(c *cli.Context, result *mutableStateDiffResult) {
+	fmt.Fprintf(c.App.Writer, "Left:  %s\n", result.Left)
+	fmt.Fprintf(c.App.Writer, "Right: %s\n", result.Right)
+
+	if alignment := result.VersionHistory; alignment != nil {
+		fmt.Fprintln(c.App.Writer, color.Green(c, "Current version history:"))
+		fmt.Fprintf(c.App.Writer, "  relation: %s\n", alignment.Relation)
+		fmt.Fprintf(c.App.Writer, "  left:     %s\n", alignment.LeftLast)
+		fmt.Fprintf(c.App.Writer, "  right:    %s\n", alignment.RightLast)
+		if alignment.LCA != "" {
+			fmt.Fprintf(c.App.Writer, "  lca:      %s\n", alignment.LCA)
+		}
+	}
+
+	if len(result.Differences) == 0 {
+		fmt.Fprintln(c.App.Writer, color.Green(c, "Mutable states are identical"))
+		return
+	}
+
+	section := ""
+	for _, diff := range result.Differences {
+		if diffSection := pathSection(diff.Path); diffSection != section {
+			section = diffSection
+			fmt.Fprintln(c.App.Writer, color.Yellow(c, "%s:", section))
+		}
+		switch diff.Kind {
+		case diffKindAdded:
+			fmt.Fprintf(c.App.Writer, "  %s %s: %s\n", color.Green(c, "+"), diff.Path, diff.Right)
+		case diffKindRemoved:
+			fmt.Fprintf(c.App.Writer, "  %s %s: %s\n", color.Red(c, "-"), diff.Path, diff.Left)
+		default:
+			fmt.Fprintf(c.App.Writer, "  %s %s: %s -> %s\n", color.Yellow(c, "~"), diff.Path, diff.Left, diff.Right)
+		}
+	}
+	fmt.Fprintf(c.App.Writer, "Found %d differences\n", len(result.Differences))
+}
+
+// pathSection returns the top level field of a diff path, which is used to group differences,
+// e.g. activity_infos for activity_infos[5].attempt.
+func pathSection(path string) string {
+	if idx := strings.IndexAny(path, ".["); idx != -1 {
+		return path[:idx]
+	}
+	return path
+}
+
+// diffMessage compares two messages of the same type field by field. Map entries are aligned by key
+// and list elements by index, so e.g. an activity only present on one side is reported as a single difference.
+func (d *protoDiffer) diffMessage(path string, left, right protoreflect.Message) {
+	if isLeafMessage(left.Descriptor()) {
+		if !proto.Equal(left.Interface(), right.Interface()) {
+			d.add(path, diffKindChanged, formatMessage(left), formatMessage(right))
+		}
+		return
+	}
+
+	fields := left.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		fieldPath := joinFieldPath(path, string(fd.Name()))
+		if d.ignored(fieldPath) {
+			continue
+		}
+
+		switch {
+		case fd.IsMap():
+			d.diffMap(fieldPath, fd, left.Get(fd).Map(), right.Get(fd).Map())
+		case fd.IsList():
+			d.diffList(fieldPath, fd, left.Get(fd).List(), right.Get(fd).List())
+		case fd.Message() != nil:
+			leftHas, rightHas := left.Has(fd), right.Has(fd)
+			switch {
+			case leftHas && rightHas:
+				d.diffMessage(fieldPath, left.Get(fd).Message(), right.Get(fd).Message())
+			case leftHas:
+				d.add(fieldPath, diffKindRemoved, formatMessage(left.Get(fd).Message()), "")
+			case rightHas:
+				d.add(fieldPath, diffKindAdded, "", formatMessage(right.Get(fd).Message()))
+			}
+		default:
+			leftValue, rightValue := left.Get(fd), right.Get(fd)
+			if !leftValue.Equal(rightValue) {
+				d.add(fieldPath, diffKindChanged, formatValue(fd, leftValue), formatValue(fd, rightValue))
+			}
+		}
+	}
+}
+
+func (d *protoDiffer) diffMap(path string, fd protoreflect.FieldDescriptor, left, right protoreflect.Map) {
+	keys := make(map[string]protoreflect.MapKey)
+	left.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys[key.String()] = key
+		return true
+	})
+	right.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys[key.String()] = key
+		return true
+	})
+
+	for _, key := range sortedMapKeys(keys) {
+		entryPath := fmt.Sprintf("%s[%s]", path, formatMapKey(key))
+		if d.ignored(entryPath) {
+			continue
+		}
+		valueFd := fd.MapValue()
+		leftHas, rightHas := left.Has(key), right.Has(key)
+		switch {
+		case leftHas && rightHas:
+			d.diffValue(entryPath, valueFd, left.Get(key), right.Get(key))
+		case leftHas:
+			d.add(entryPath, diffKindRemoved, formatValue(valueFd, left.Get(key)), "")
+		default:
+			d.add(entryPath, diffKindAdded, "", formatValue(valueFd, right.Get(key)))
+		}
+	}
+}
+
+func (d *protoDiffer) diffList(path string, fd protoreflect.FieldDescriptor, left, right protoreflect.List) {
+	for i := 0; i < max(left.Len(), right.Len()); i++ {
+		elemPath := fmt.Sprintf("%s[%d]", path, i)
+		if d.ignored(elemPath) {
+			continue
+		}
+		switch {
+		case i < left.Len() && i < right.Len():
+			d.diffValue(elemPath, fd, left.Get(i), right.Get(i))
+		case i < left.Len():
+			d.add(elemPath, diffKindRemoved, formatValue(fd, left.Get(i)), "")
+		default:
+			d.add(elemPath, diffKindAdded, "", formatValue(fd, right.Get(i)))
+		}
+	}
+}
+
+// diffValue compares a single map value or list element.
+func (d *protoDiffer) diffValue(path string, fd protoreflect.FieldDescriptor, left, right protoreflect.Value) {
+	if fd.Message() != nil {
+		d.diffMessage(path, left.Message(), right.Message())
+		return
+	}
+	if !left.Equal(right) {
+		d.add(path, diffKindChanged, formatValue(fd, left), formatValue(fd, right))
+	}
+}
+
+func (d *protoDiffer) add(path, kind, left, right string) {
+	d.diffs = append(d.diffs, mutableStateDiff{
+		Path:  path,
+		Kind:  kind,
+		Left:  left,
+		Right: right,
+	})
+}
+
+// ignored returns true if the path is one of the ignored paths or nested below one of them.
+func (d *protoDiffer) ignored(path string) bool {
+	for _, ignoredPath := range d.ignoredPaths {
+		if path == ignoredPath ||
+			strings.HasPrefix(path, ignoredPath+".") ||
+			strings.HasPrefix(path, ignoredPath+"[") {
+			return true
+		}
+	}
+	return false
+}
+
+func joinFieldPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+// isLeafMessage returns true for well known types which are compared and printed as a single value.
+func isLeafMessage(md protoreflect.MessageDescriptor) bool {
+	switch md.FullName() {
+	case "google.protobuf.Timestamp", "google.protobuf.Duration":
+		return true
+	default:
+		return false
+	}
+}
+
+// sortedMapKeys sorts integer keys numerically and all other keys lexically.
+func sortedMapKeys(keys map[string]protoreflect.MapKey) []protoreflect.MapKey {
+	sorted := make([]protoreflect.MapKey, 0, len(keys))
+	for _, key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		left, leftErr := strconv.ParseInt(sorted[i].String(), 10, 64)
+		right, rightErr := strconv.ParseInt(sorted[j].String(), 10, 64)
+		if leftErr == nil && rightErr == nil {
+			return left < right
+		}
+		return sorted[i].String() < sorted[j].String()
+	})
+	return sorted
+}
+
+func formatMapKey(key protoreflect.MapKey) string {
+	if s, ok := key.Interface().(string); ok {
+		return strconv.Quote(s)
+	}
+	return key.String()
+}
+
+func formatValue(fd protoreflect.FieldDescriptor, value protoreflect.Value) string {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return formatMessage(value.Message())
+	case protoreflect.EnumKind:
+		if enumValue := fd.Enum().Values().ByNumber(value.Enum()); enumValue != nil {
+			return string(enumValue.Name())
+		}
+		return strconv.Itoa(int(value.Enum()))
+	case protoreflect.StringKind:
+		return strconv.Quote(value.String())
+	case protoreflect.BytesKind:
+		encoded := base64.StdEncoding.EncodeToString(value.Bytes())
+		if len(encoded) > maxDiffBytesLength {
+			encoded = fmt.Sprintf("%s... (%d bytes)", encoded[:maxDiffBytesLength], len(value.Bytes()))
+		}
+		return encoded
+	default:
+		return value.String()
+	}
+}
+
+func formatMessage(message protoreflect.Message) string {
+	switch m := message.Interface().(type) {
+	case *timestamppb.Timestamp:
+		if m == nil {
+			return "<nil>"
+		}
+		return m.AsTime().Format("2006-01-02T15:04:05.999999999Z07:00")
+	case *durationpb.Duration:
+		if m == nil {
+			return "<nil>"
+		}
+		return m.AsDuration().String()
+	}
+
+	encoder := codec.NewJSONPBEncoder()
+	data, err := encoder.Encode(message.Interface())
+	if err != nil {
+		return fmt.Sprintf("<unable to encode %s: %s>", message.Descriptor().FullName(), err)
+	}
+	return string(data)
+}
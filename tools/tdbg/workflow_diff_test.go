@@ -0,0 +1,267 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type diffTestClient struct {
+	adminservice.AdminServiceClient
+	mutableState *persistencespb.WorkflowMutableState
+}
+
+func (t *diffTestClient) AdminClient(*cli.Context) adminservice.AdminServiceClient {
+	return t
+}
+
+func (t *diffTestClient) WorkflowClient(*cli.Context) workflowservice.WorkflowServiceClient {
+	panic("unimplemented")
+}
+
+func (t *diffTestClient) DescribeMutableState(
+	_ context.Context,
+	request *adminservice.DescribeMutableStateRequest,
+	_ ...grpc.CallOption,
+) (*adminservice.DescribeMutableStateResponse, error) {
+	if request.GetExecution().GetWorkflowId() != "test-workflow-id" {
+		return nil, errors.New("workflow not found")
+	}
+	return &adminservice.DescribeMutableStateResponse{DatabaseMutableState: t.mutableState}, nil
+}
+
+func newDiffTestMutableState() *persistencespb.WorkflowMutableState {
+	return &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			WorkflowId:     "test-workflow-id",
+			LastUpdateTime: timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+			VersionHistories: versionhistory.NewVersionHistories(versionhistory.NewVersionHistory(
+				[]byte("branch-token"),
+				[]*historyspb.VersionHistoryItem{{EventId: 10, Version: 1}},
+			)),
+			SubStateMachinesByType: map[string]*persistencespb.StateMachineMap{
+				"callbacks": {MachinesById: map[string]*persistencespb.StateMachineNode{
+					"1": {Data: []byte("scheduled"), TransitionCount: 1},
+				}},
+			},
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			State:  enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+			Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		},
+		ActivityInfos: map[int64]*persistencespb.ActivityInfo{
+			5: {ScheduledEventId: 5, ActivityId: "activity-5", Attempt: 1},
+		},
+		TimerInfos: map[string]*persistencespb.TimerInfo{
+			"timer-1": {TimerId: "timer-1", StartedEventId: 7},
+		},
+		NextEventId: 11,
+	}
+}
+
+func TestDiffMutableStates_Identical(t *testing.T) {
+	result := diffMutableStates(newDiffTestMutableState(), newDiffTestMutableState(), nil)
+	require.Empty(t, result.Differences)
+	require.Equal(t, versionHistoryIdentical, result.VersionHistory.Relation)
+}
+
+func TestDiffMutableStates(t *testing.T) {
+	left := newDiffTestMutableState()
+	right := newDiffTestMutableState()
+	right.ActivityInfos[5].Attempt = 2
+	right.ActivityInfos[9] = &persistencespb.ActivityInfo{ScheduledEventId: 9, ActivityId: "activity-9"}
+	delete(right.TimerInfos, "timer-1")
+	right.ExecutionInfo.SubStateMachinesByType["callbacks"].MachinesById["1"].TransitionCount = 2
+	right.ExecutionState.Status = enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+	right.ExecutionInfo.LastUpdateTime = timestamppb.New(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+
+	result := diffMutableStates(left, right, []string{"execution_info.last_update_time"})
+
+	diffs := make(map[string]mutableStateDiff, len(result.Differences))
+	for _, diff := range result.Differences {
+		diffs[diff.Path] = diff
+	}
+	require.Len(t, diffs, 5)
+	require.Equal(t, mutableStateDiff{
+		Path:  "activity_infos[5].attempt",
+		Kind:  diffKindChanged,
+		Left:  "1",
+		Right: "2",
+	}, diffs["activity_infos[5].attempt"])
+	require.Equal(t, diffKindAdded, diffs["activity_infos[9]"].Kind)
+	require.Contains(t, diffs["activity_infos[9]"].Right, "activity-9")
+	require.Equal(t, diffKindRemoved, diffs[`timer_infos["timer-1"]`].Kind)
+	require.Equal(t, diffKindChanged, diffs[`execution_info.sub_state_machines_by_type["callbacks"].machines_by_id["1"].transition_count`].Kind)
+	require.Equal(t, mutableStateDiff{
+		Path:  "execution_state.status",
+		Kind:  diffKindChanged,
+		Left:  "WORKFLOW_EXECUTION_STATUS_RUNNING",
+		Right: "WORKFLOW_EXECUTION_STATUS_COMPLETED",
+	}, diffs["execution_state.status"])
+}
+
+func TestAlignVersionHistories(t *testing.T) {
+	newHistories := func(items ...*historyspb.VersionHistoryItem) *historyspb.VersionHistories {
+		return versionhistory.NewVersionHistories(versionhistory.NewVersionHistory(nil, items))
+	}
+
+	testCases := []struct {
+		name     string
+		left     *historyspb.VersionHistories
+		right    *historyspb.VersionHistories
+		relation string
+		lca      string
+	}{
+		{
+			name:     "identical",
+			left:     newHistories(&historyspb.VersionHistoryItem{EventId: 10, Version: 1}),
+			right:    newHistories(&historyspb.VersionHistoryItem{EventId: 10, Version: 1}),
+			relation: versionHistoryIdentical,
+			lca:      "event 10 @ version 1",
+		},
+		{
+			name:     "left ahead",
+			left:     newHistories(&historyspb.VersionHistoryItem{EventId: 10, Version: 1}, &historyspb.VersionHistoryItem{EventId: 15, Version: 2}),
+			right:    newHistories(&historyspb.VersionHistoryItem{EventId: 10, Version: 1}),
+			relation: versionHistoryLeftAhead,
+			lca:      "event 10 @ version 1",
+		},
+		{
+			name:     "right ahead",
+			left:     newHistories(&historyspb.VersionHistoryItem{EventId: 8, Version: 1}),
+			right:    newHistories(&historyspb.VersionHistoryItem{EventId: 10, Version: 1}),
+			relation: versionHistoryRightAhead,
+			lca:      "event 8 @ version 1",
+		},
+		{
+			name:     "diverged",
+			left:     newHistories(&historyspb.VersionHistoryItem{EventId: 10, Version: 1}, &historyspb.VersionHistoryItem{EventId: 15, Version: 2}),
+			right:    newHistories(&historyspb.VersionHistoryItem{EventId: 10, Version: 1}, &historyspb.VersionHistoryItem{EventId: 12, Version: 3}),
+			relation: versionHistoryDiverged,
+			lca:      "event 10 @ version 1",
+		},
+		{
+			name:     "unrelated",
+			left:     newHistories(&historyspb.VersionHistoryItem{EventId: 10, Version: 1}),
+			right:    newHistories(&historyspb.VersionHistoryItem{EventId: 10, Version: 2}),
+			relation: versionHistoryUnrelated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			alignment := alignVersionHistories(tc.left, tc.right)
+			require.Equal(t, tc.relation, alignment.Relation)
+			require.Equal(t, tc.lca, alignment.LCA)
+		})
+	}
+
+	require.Nil(t, alignVersionHistories(nil, newHistories(&historyspb.VersionHistoryItem{EventId: 10, Version: 1})))
+}
+
+func TestAdminDiffWorkflow(t *testing.T) {
+	leftMutableState := newDiffTestMutableState()
+	rightMutableState := newDiffTestMutableState()
+	rightMutableState.NextEventId = 12
+
+	var output bytes.Buffer
+	newApp := func() *cli.App {
+		app := NewCliApp(func(params *Params) {
+			params.ClientFactory = &diffTestClient{mutableState: leftMutableState}
+			params.AddressClientFactory = func(address string) ClientFactory {
+				require.Equal(t, "remote:7233", address)
+				return &diffTestClient{mutableState: rightMutableState}
+			}
+			params.Writer = &output
+		})
+		app.ExitErrHandler = func(context *cli.Context, err error) {}
+		return app
+	}
+	exitCode := func(err error) int {
+		if err == nil {
+			return 0
+		}
+		var exitCoder cli.ExitCoder
+		require.ErrorAs(t, err, &exitCoder)
+		return exitCoder.ExitCode()
+	}
+
+	// save a snapshot of the left mutable state
+	snapshotFile := filepath.Join(t.TempDir(), "snapshot.json")
+	err := newApp().Run([]string{"tdbg", "workflow", "describe", "--workflow-id", "test-workflow-id", "--output-filename", snapshotFile})
+	require.NoError(t, err)
+	snapshot, err := readMutableStateFile(snapshotFile)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(leftMutableState, snapshot))
+
+	// snapshot and the cluster it was taken from are identical
+	output.Reset()
+	err = newApp().Run([]string{"tdbg", "workflow", "diff", "--workflow-id", "test-workflow-id", "--left-file", snapshotFile})
+	require.Equal(t, 0, exitCode(err))
+	require.Contains(t, output.String(), "Mutable states are identical")
+
+	// snapshot and another cluster differ
+	output.Reset()
+	err = newApp().Run([]string{"tdbg", "workflow", "diff", "--workflow-id", "test-workflow-id",
+		"--left-file", snapshotFile, "--right-address", "remote:7233", "--print-json"})
+	require.Equal(t, diffExitCodeDifferent, exitCode(err))
+	var result mutableStateDiffResult
+	require.NoError(t, json.Unmarshal(output.Bytes(), &result))
+	require.Equal(t, "file "+snapshotFile, result.Left)
+	require.Equal(t, "cluster remote:7233", result.Right)
+	require.Equal(t, []mutableStateDiff{{
+		Path:  "next_event_id",
+		Kind:  diffKindChanged,
+		Left:  "11",
+		Right: "12",
+	}}, result.Differences)
+
+	// both sides are the same cluster
+	err = newApp().Run([]string{"tdbg", "workflow", "diff", "--workflow-id", "test-workflow-id"})
+	require.Equal(t, diffExitCodeError, exitCode(err))
+
+	// the workflow can't be found
+	err = newApp().Run([]string{"tdbg", "workflow", "diff", "--workflow-id", "unknown", "--right-address", "remote:7233"})
+	require.Equal(t, diffExitCodeError, exitCode(err))
+}